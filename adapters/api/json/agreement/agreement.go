@@ -0,0 +1,100 @@
+package agreement
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/analyze"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/list"
+)
+
+func ToModel(v ag.Agreement) models.Agreement {
+	r := v.Report
+	pairs := []models.PairAgreement{}
+	for _, p := range r.Pairs {
+		pairs = append(pairs, models.PairAgreement{Raters: p.Raters[:], Kappa: p.Kappa, RegionF1: p.RegionF1})
+	}
+	images := []models.ImageAgreement{}
+	for _, i := range r.Images {
+		images = append(images, models.ImageAgreement{
+			ImageId: i.Image.String(), NumRegions: i.NumRegions, RegionF1: i.RegionF1, MeanIou: i.MeanIoU,
+		})
+	}
+	labels := []models.LabelAgreement{}
+	for _, l := range r.Labels {
+		labels = append(labels, models.LabelAgreement{
+			Label: l.Label, NumRegions: l.NumRegions, RegionF1: l.RegionF1, MeanIou: l.MeanIoU,
+		})
+	}
+	imageLabels := []models.ImageLabelAgreement{}
+	for _, l := range r.ImageLabels {
+		imageLabels = append(imageLabels, models.ImageLabelAgreement{Label: l.Label, Count: l.Count, Kappa: l.Kappa})
+	}
+	m := models.Agreement{
+		Id:           v.Id.String(),
+		Collections:  v.Collections,
+		Authors:      v.Authors,
+		Filter:       v.Filter,
+		IouThreshold: v.IoUThreshold,
+		Issuer:       v.Issuer,
+		CreatedAt:    v.CreatedAt,
+		Raters:       r.Raters,
+		NumImages:    r.NumImages,
+		RegionF1:     r.RegionF1,
+		MeanIou:      r.MeanIoU,
+		Kappa:        r.Kappa,
+		Pairs:        pairs,
+		Images:       images,
+		Labels:       labels,
+		ImageLabels:  imageLabels,
+	}
+	if m.Authors == nil {
+		m.Authors = []string{}
+	}
+	if c := r.Consensus; c != nil {
+		m.Consensus = &models.ConsensusSummary{
+			Collection:     c.Collection,
+			Method:         string(c.Method),
+			MinVotes:       c.MinVotes,
+			NumRegions:     c.NumRegions,
+			NumImageLabels: c.NumImageLabels,
+		}
+	}
+	return m
+}
+
+type Presenter struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Presenter) SuccessSubmitAgreementTask(r analyze.Response) {
+	json.WriteJSON(p.Writer, http.StatusAccepted, models.Task{
+		Id:     r.Id.String(),
+		Type:   r.Type.String(),
+		Issuer: r.Issuer,
+	})
+}
+
+func (p Presenter) SuccessFindAgreement(r find.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, ToModel(r.Agreement))
+}
+
+func (p Presenter) SuccessListAgreements(r list.Response) {
+	data := []models.Agreement{}
+	for _, v := range r.Agreements {
+		data = append(data, ToModel(v))
+	}
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListAgreementsResponse{
+		Data:       &data,
+		Pagination: json.BuildPaginationResponse(r.Pagination),
+	})
+}
+
+func NewPresenter(w http.ResponseWriter, l slog.Logger) Presenter {
+	return Presenter{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
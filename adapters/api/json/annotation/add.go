@@ -0,0 +1,62 @@
+package annotation
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	addbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-bbox"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
+	addmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-mask"
+	addpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polygon"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
+	addlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/assign-label"
+	mask2poly "github.com/lejeunel/go-image-annotator/use-cases/annotate/mask-to-polygons"
+	poly2mask "github.com/lejeunel/go-image-annotator/use-cases/annotate/polygon-to-mask"
+)
+
+type Add struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Add) SuccessAddBox(r addbox.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.Id.String()})
+}
+
+func (p Add) SuccessAddPolygon(r addpoly.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.Id.String()})
+}
+
+func (p Add) SuccessAddPolyline(r addline.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.Id.String()})
+}
+
+func (p Add) SuccessAddKeypoints(r addkp.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.Id.String()})
+}
+
+func (p Add) SuccessAddMask(r addmask.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.Id.String()})
+}
+
+func (p Add) SuccessConvertPolygonToMask(r poly2mask.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.Id.String()})
+}
+
+func (p Add) SuccessConvertMaskToPolygons(r mask2poly.Response) {
+	ids := []string{}
+	for _, id := range r.Ids {
+		ids = append(ids, id.String())
+	}
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationsResponse{Ids: ids})
+}
+
+func (p Add) SuccessAddLabel(r addlbl.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.NewAnnotationResponse{Id: r.AnnotationId})
+}
+
+func NewAddPresenter(w http.ResponseWriter, l slog.Logger) Add {
+	return Add{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
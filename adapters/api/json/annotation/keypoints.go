@@ -0,0 +1,22 @@
+package annotation
+
+import (
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+func KeypointsToModel(points []a.Keypoint) []models.Keypoint {
+	m := []models.Keypoint{}
+	for _, p := range points {
+		m = append(m, models.Keypoint{X: p.X, Y: p.Y, Visibility: int(p.Visibility)})
+	}
+	return m
+}
+
+func KeypointsFromModel(points []models.Keypoint) []a.Keypoint {
+	keypoints := make([]a.Keypoint, 0, len(points))
+	for _, p := range points {
+		keypoints = append(keypoints, a.Keypoint{X: p.X, Y: p.Y, Visibility: a.Visibility(p.Visibility)})
+	}
+	return keypoints
+}
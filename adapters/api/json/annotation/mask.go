@@ -0,0 +1,40 @@
+package annotation
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	paint "github.com/lejeunel/go-image-annotator/use-cases/annotate/paint-mask"
+)
+
+func RLEToModel(rle a.RLE) models.RLE {
+	counts := rle.Counts
+	if counts == nil {
+		counts = []int{}
+	}
+	return models.RLE{Size: []int{rle.Height, rle.Width}, Counts: counts}
+}
+
+func RLEFromModel(m models.RLE) (*a.RLE, error) {
+	if len(m.Size) != 2 {
+		return nil, fmt.Errorf("size of mask has %v values, expected height and width", len(m.Size))
+	}
+	return &a.RLE{Height: m.Size[0], Width: m.Size[1], Counts: m.Counts}, nil
+}
+
+type Paint struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Paint) SuccessPaintMask(r paint.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, RLEToModel(r.RLE))
+}
+
+func NewPaintPresenter(w http.ResponseWriter, l slog.Logger) Paint {
+	return Paint{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
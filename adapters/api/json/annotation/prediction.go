@@ -0,0 +1,37 @@
+package annotation
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
+	imppred "github.com/lejeunel/go-image-annotator/use-cases/annotate/import-predictions"
+)
+
+type ImportPredictions struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p ImportPredictions) SuccessImportPredictions(r imppred.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.ImportPredictionsResponse{Imported: r.NumImported})
+}
+
+func NewImportPredictionsPresenter(w http.ResponseWriter, l slog.Logger) ImportPredictions {
+	return ImportPredictions{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
+
+type AcceptPrediction struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p AcceptPrediction) SuccessAcceptPrediction(accpred.Response) {
+	p.Writer.WriteHeader(http.StatusNoContent)
+}
+
+func NewAcceptPredictionPresenter(w http.ResponseWriter, l slog.Logger) AcceptPrediction {
+	return AcceptPrediction{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
@@ -0,0 +1,88 @@
+package annotation
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/history"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
+)
+
+func stateToModel(s *a.Shape) *models.AnnotationState {
+	if s == nil {
+		return nil
+	}
+	m := models.AnnotationState{Type: s.Type, Label: s.Label.Name}
+	if s.Box != nil {
+		m.Xc, m.Yc, m.Width, m.Height, m.Angle = &s.Box.Xc, &s.Box.Yc, &s.Box.Width, &s.Box.Height, &s.Box.Angle
+	}
+	if s.Points != nil {
+		points := []models.Point{}
+		for _, p := range s.Points.Coordinates {
+			points = append(points, models.Point{p[0], p[1]})
+		}
+		m.Points = &points
+	}
+	if s.Keypoints != nil {
+		keypoints := KeypointsToModel(s.Keypoints)
+		m.Keypoints = &keypoints
+	}
+	if s.Mask != nil {
+		rle := RLEToModel(*s.Mask)
+		m.Rle = &rle
+	}
+	return &m
+}
+
+func RevisionToModel(r a.Revision) models.AnnotationRevision {
+	m := models.AnnotationRevision{
+		Id:           r.Id.String(),
+		AnnotationId: r.AnnotationId.String(),
+		Action:       r.Action.String(),
+		Before:       stateToModel(r.Before),
+		After:        stateToModel(r.After),
+		Time:         r.Time,
+	}
+	if r.Author != nil {
+		author := string(*r.Author)
+		m.Author = &author
+	}
+	if r.Restores != nil {
+		restores := r.Restores.String()
+		m.Restores = &restores
+	}
+	return m
+}
+
+type History struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p History) SuccessListRevisions(r history.Response) {
+	revisions := []models.AnnotationRevision{}
+	for _, rev := range r.Revisions {
+		revisions = append(revisions, RevisionToModel(rev))
+	}
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListAnnotationRevisionsResponse{Revisions: revisions})
+}
+
+func NewHistoryPresenter(w http.ResponseWriter, l slog.Logger) History {
+	return History{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
+
+type Revert struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Revert) SuccessRevert(r revert.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, RevisionToModel(r.Revision))
+}
+
+func NewRevertPresenter(w http.ResponseWriter, l slog.Logger) Revert {
+	return Revert{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
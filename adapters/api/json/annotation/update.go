@@ -0,0 +1,52 @@
+package annotation
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	updbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-bbox"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
+	updmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-mask"
+	updpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polygon"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
+	setattr "github.com/lejeunel/go-image-annotator/use-cases/annotate/set-attributes"
+	updlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/update-label"
+)
+
+type Update struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Update) SuccessUpdateBox(updbox.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func (p Update) SuccessUpdatePolygon(updpoly.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func (p Update) SuccessUpdatePolyline(updline.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func (p Update) SuccessUpdateKeypoints(updkp.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func (p Update) SuccessUpdateMask(updmask.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func (p Update) SuccessUpdateLabel(updlbl.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func (p Update) SuccessSetAttributes(setattr.Response) {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func NewUpdatePresenter(w http.ResponseWriter, l slog.Logger) Update {
+	return Update{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
@@ -0,0 +1,46 @@
+package assignment
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/create"
+)
+
+type Create struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func ToModel(b a.Batch) models.AssignmentBatch {
+	progress := b.Progress()
+	assignees := []models.AssigneeProgress{}
+	for _, p := range b.Assignees {
+		assignees = append(assignees, models.AssigneeProgress{
+			Assignee: p.Assignee,
+			Total:    p.Total,
+			Finished: p.Finished,
+		})
+	}
+	return models.AssignmentBatch{
+		Id:        b.Id.String(),
+		Name:      b.Name,
+		Filter:    b.Filter,
+		Creator:   b.Creator,
+		CreatedAt: b.CreatedAt,
+		Total:     progress.Total,
+		Finished:  progress.Finished,
+		Assignees: assignees,
+	}
+}
+
+func (p Create) SuccessCreateBatch(r create.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, ToModel(r.Batch))
+}
+
+func NewCreatePresenter(w http.ResponseWriter, l slog.Logger) Create {
+	return Create{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
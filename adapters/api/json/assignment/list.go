@@ -0,0 +1,40 @@
+package assignment
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/mine"
+)
+
+type List struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p List) SuccessListBatches(r list.Response) {
+	data := []models.AssignmentBatch{}
+	for _, batch := range r.Batches {
+		data = append(data, ToModel(batch))
+	}
+
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListAssignmentBatchesResponse{
+		Data:       &data,
+		Pagination: json.BuildPaginationResponse(r.Pagination),
+	})
+}
+
+func (p List) SuccessListMyBatches(r mine.Response) {
+	data := []models.AssignmentBatch{}
+	for _, batch := range r.Batches {
+		data = append(data, ToModel(batch))
+	}
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListMyAssignmentBatchesResponse{Data: &data})
+}
+
+func NewListPresenter(w http.ResponseWriter, l slog.Logger) List {
+	return List{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
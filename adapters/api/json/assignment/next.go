@@ -0,0 +1,41 @@
+package assignment
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/finish"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/next"
+)
+
+type Next struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Next) SuccessNextAssignment(r next.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, models.Assignment{
+		BatchId:    r.Assignment.Batch.String(),
+		ImageId:    r.Assignment.Image.ImageId.String(),
+		Collection: r.Assignment.Image.Collection,
+	})
+}
+
+func NewNextPresenter(w http.ResponseWriter, l slog.Logger) Next {
+	return Next{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
+
+type Finish struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Finish) SuccessFinishAssignment(finish.Request) {
+	p.Writer.WriteHeader(http.StatusNoContent)
+}
+
+func NewFinishPresenter(w http.ResponseWriter, l slog.Logger) Finish {
+	return Finish{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
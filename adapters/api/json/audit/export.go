@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/export"
+)
+
+type Export struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Export) SuccessExportAuditLog(r export.Response) {
+	p.Writer.Header().Set("Content-Type", "text/csv")
+	p.Writer.Header().Set("Content-Disposition", `attachment; filename="audit-log.csv"`)
+	p.Writer.WriteHeader(http.StatusOK)
+	if err := r.WriteCSV(p.Writer); err != nil {
+		p.Logger.Error("exporting audit log", "error", err)
+	}
+}
+
+func NewExportPresenter(w http.ResponseWriter, l slog.Logger) Export {
+	return Export{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
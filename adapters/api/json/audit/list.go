@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+)
+
+type List struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func ToModel(entry au.Entry) models.AuditEntry {
+	m := models.AuditEntry{
+		Actor:      entry.Actor,
+		Action:     entry.Action,
+		TargetType: entry.Target.Type,
+		TargetId:   entry.Target.Id,
+		Time:       entry.Time,
+		RequestId:  entry.RequestId,
+		Outcome:    entry.Outcome.String(),
+	}
+	if entry.Error != "" {
+		m.Error = &entry.Error
+	}
+	return m
+}
+
+func (p List) SuccessListAuditLog(r list.Response) {
+	data := []models.AuditEntry{}
+	for _, entry := range r.Entries {
+		data = append(data, ToModel(entry))
+	}
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListAuditLogResponse{
+		Data:       &data,
+		Pagination: json.BuildPaginationResponse(r.Pagination),
+	})
+}
+
+func NewListPresenter(w http.ResponseWriter, l slog.Logger) List {
+	return List{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
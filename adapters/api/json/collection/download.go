@@ -0,0 +1,29 @@
+package collection
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	download_export "github.com/lejeunel/go-image-annotator/use-cases/collection/download-export"
+)
+
+type Download struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Download) SuccessDownloadExport(r download_export.Response) {
+	defer r.Reader.Close()
+	p.Writer.Header().Set("Content-Type", "application/zip")
+	p.Writer.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=%q", r.FileName))
+	p.Writer.WriteHeader(http.StatusOK)
+	io.Copy(p.Writer, r.Reader)
+}
+
+func NewDownloadPresenter(w http.ResponseWriter, l slog.Logger) Download {
+	return Download{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
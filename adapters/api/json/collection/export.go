@@ -0,0 +1,27 @@
+package collection
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	export_task "github.com/lejeunel/go-image-annotator/use-cases/collection/export-task"
+)
+
+type Export struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Export) SuccessSubmitExportTask(r export_task.Response) {
+	json.WriteJSON(p.Writer, http.StatusAccepted, models.Task{
+		Id:     r.Id.String(),
+		Type:   r.Type.String(),
+		Issuer: r.Issuer,
+	})
+}
+
+func NewExportPresenter(w http.ResponseWriter, l slog.Logger) Export {
+	return Export{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
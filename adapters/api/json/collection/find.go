@@ -15,12 +15,19 @@ type Find struct {
 }
 
 func (p Find) SuccessFindCollection(r clc.Collection) {
-	response := models.Collection{
-		Name:        r.Name,
-		Description: &r.Description,
-	}
+	json.WriteJSON(p.Writer, 200, toModel(r))
+}
 
-	json.WriteJSON(p.Writer, 200, response)
+func toModel(c clc.Collection) models.Collection {
+	m := models.Collection{
+		Name:         c.Name,
+		Description:  &c.Description,
+		DefaultLabel: c.DefaultLabel,
+	}
+	if len(c.Labels) > 0 {
+		m.Labels = &c.Labels
+	}
+	return m
 }
 
 func NewFindPresenter(w http.ResponseWriter, l slog.Logger) Find {
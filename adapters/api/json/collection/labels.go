@@ -0,0 +1,23 @@
+package collection
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	set_labels "github.com/lejeunel/go-image-annotator/use-cases/collection/set-labels"
+)
+
+type SetLabels struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p SetLabels) SuccessSetCollectionLabels(r set_labels.Response) {
+	json.WriteJSON(p.Writer, 200, models.CollectionLabels{Labels: r.Labels, DefaultLabel: r.Default})
+}
+
+func NewSetLabelsPresenter(w http.ResponseWriter, l slog.Logger) SetLabels {
+	return SetLabels{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
@@ -17,11 +17,7 @@ type List struct {
 func (p List) SuccessListCollections(r list.Response) {
 	data := []models.Collection{}
 	for _, c := range r.Collections {
-		data = append(data,
-			models.Collection{
-				Name:        c.Name,
-				Description: &c.Description,
-			})
+		data = append(data, toModel(c))
 	}
 
 	response := models.ListCollectionsResponse{
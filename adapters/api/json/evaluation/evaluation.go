@@ -0,0 +1,84 @@
+package evaluation
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/evaluate"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/list"
+)
+
+func ToModel(v ev.Evaluation) models.Evaluation {
+	labels := []models.LabelMetrics{}
+	for _, l := range v.Report.Labels {
+		curve := []models.PRPoint{}
+		for _, p := range l.Curve {
+			curve = append(curve, models.PRPoint{Recall: p.Recall, Precision: p.Precision})
+		}
+		labels = append(labels, models.LabelMetrics{
+			Label:          l.Label,
+			NumGroundTruth: l.NumGroundTruth,
+			NumPredictions: l.NumPredictions,
+			Ap:             l.AP,
+			Ap50:           l.AP50,
+			Ap75:           l.AP75,
+			PrCurve:        curve,
+		})
+	}
+	return models.Evaluation{
+		Id:             v.Id.String(),
+		GroundTruth:    v.GroundTruth,
+		Predictions:    v.Predictions,
+		Filter:         v.Filter,
+		MinConfidence:  v.MinConfidence,
+		Issuer:         v.Issuer,
+		CreatedAt:      v.CreatedAt,
+		NumImages:      v.Report.NumImages,
+		NumGroundTruth: v.Report.NumGroundTruth,
+		NumPredictions: v.Report.NumPredictions,
+		Map:            v.Report.MAP,
+		Map50:          v.Report.MAP50,
+		Map75:          v.Report.MAP75,
+		Labels:         labels,
+		Confusion: models.ConfusionMatrix{
+			Labels: v.Report.Confusion.Labels,
+			Counts: v.Report.Confusion.Counts,
+		},
+	}
+}
+
+type Presenter struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Presenter) SuccessSubmitEvaluationTask(r evaluate.Response) {
+	json.WriteJSON(p.Writer, http.StatusAccepted, models.Task{
+		Id:     r.Id.String(),
+		Type:   r.Type.String(),
+		Issuer: r.Issuer,
+	})
+}
+
+func (p Presenter) SuccessFindEvaluation(r find.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, ToModel(r.Evaluation))
+}
+
+func (p Presenter) SuccessListEvaluations(r list.Response) {
+	data := []models.Evaluation{}
+	for _, v := range r.Evaluations {
+		data = append(data, ToModel(v))
+	}
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListEvaluationsResponse{
+		Data:       &data,
+		Pagination: json.BuildPaginationResponse(r.Pagination),
+	})
+}
+
+func NewPresenter(w http.ResponseWriter, l slog.Logger) Presenter {
+	return Presenter{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
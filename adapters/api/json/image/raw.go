@@ -1,38 +1,50 @@
 package image
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
-	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
 
 	"github.com/lejeunel/go-image-annotator/adapters/api/json"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/raw"
+	rawmask "github.com/lejeunel/go-image-annotator/use-cases/image/raw-mask"
 )
 
 type Raw struct {
-	Writer http.ResponseWriter
+	Writer  http.ResponseWriter
+	Request *http.Request
 	json.ErrorPresenter
 }
 
+// SuccessReadRawImage serves raw-data with conditional and range requests
+// support. Images are content-addressed, hence their hash is a strong ETag
+// and responses never go stale.
 func (p Raw) SuccessReadRawImage(r raw.Response) {
-	data, err := io.ReadAll(r.Reader)
-	if err != nil {
-		http.Error(p.Writer, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	sum := sha256.Sum256(data)
-	etag := `"` + hex.EncodeToString(sum[:]) + `"`
-	p.Writer.Header().Set("ETag", etag)
+	defer r.Close()
+	p.Writer.Header().Set("ETag", `"`+r.Hash+`"`)
+	p.Writer.Header().Set("Content-Type", r.MIMEType)
+	p.Writer.Header().Set("Cache-Control", "private, max-age=31536000, immutable")
+	http.ServeContent(p.Writer, p.Request, "", r.IngestedAt, r)
+}
+
+func NewRawImagePresenter(w http.ResponseWriter, r *http.Request, l slog.Logger) Raw {
+	return Raw{Writer: w, Request: r, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
+
+type RawMask struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+// SuccessReadRawMask is never cached, as masks change with every stroke.
+func (p RawMask) SuccessReadRawMask(r rawmask.Response) {
 	p.Writer.Header().Set("Content-Type", r.MIMEType)
-	p.Writer.Header().Set("Content-Length", strconv.Itoa(len(data)))
-	p.Writer.Header().Set("Cache-Control", "private, max-age=3600")
+	p.Writer.Header().Set("Content-Length", strconv.Itoa(len(r.Data)))
+	p.Writer.Header().Set("Cache-Control", "no-store")
 	p.Writer.WriteHeader(http.StatusOK)
-	p.Writer.Write(data)
+	p.Writer.Write(r.Data)
 }
 
-func NewRawImagePresenter(w http.ResponseWriter, l slog.Logger) Raw {
-	return Raw{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+func NewRawMaskPresenter(w http.ResponseWriter, l slog.Logger) RawMask {
+	return RawMask{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
 }
@@ -1,10 +1,33 @@
 package image
 
 import (
+	ja "github.com/lejeunel/go-image-annotator/adapters/api/json/annotation"
 	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 )
 
+func reviewStatus(r an.Review) *string {
+	s := r.CurrentStatus().String()
+	return &s
+}
+
+// source fills in the model and confidence of predictions.
+func source(s an.Source) (*string, *float32) {
+	if !s.IsPrediction() {
+		return nil, nil
+	}
+	return &s.Model, &s.Confidence
+}
+
+func attributesToModel(a an.Attributes) *models.AnnotationAttributes {
+	if len(a) == 0 {
+		return nil
+	}
+	m := models.AnnotationAttributes(a)
+	return &m
+}
+
 func BuildImageResponse(image im.Image) models.Image {
 	response := models.Image{
 		Id:         image.Id.String(),
@@ -21,11 +44,14 @@ func BuildImageResponse(image im.Image) models.Image {
 	if len(image.BoundingBoxes) > 0 {
 		boxesToAdd := []models.BoundingBox{}
 		for _, b := range image.BoundingBoxes {
-			boxesToAdd = append(boxesToAdd,
-				models.BoundingBox{
-					Id: b.Id.String(),
-					Xc: b.Xc, Yc: b.Yc, Height: b.Height, Width: b.Width, Label: b.Label.Name,
-				})
+			box := models.BoundingBox{
+				Id: b.Id.String(),
+				Xc: b.Xc, Yc: b.Yc, Height: b.Height, Width: b.Width, Label: b.Label.Name,
+				ReviewStatus: reviewStatus(b.Review),
+				Attributes:   attributesToModel(b.Attributes),
+			}
+			box.Model, box.Confidence = source(b.Source)
+			boxesToAdd = append(boxesToAdd, box)
 		}
 		response.BoundingBoxes = &boxesToAdd
 	}
@@ -37,15 +63,67 @@ func BuildImageResponse(image im.Image) models.Image {
 			for _, p := range poly.Points.Coordinates {
 				points = append(points, models.Point{p[0], p[1]})
 			}
-			polygonsToAdd = append(polygonsToAdd,
-				models.Polygon{
-					Id:     poly.Id.String(),
-					Points: points, Label: poly.Label.Name,
-				})
+			polygon := models.Polygon{
+				Id:     poly.Id.String(),
+				Points: points, Label: poly.Label.Name,
+				ReviewStatus: reviewStatus(poly.Review),
+				Attributes:   attributesToModel(poly.Attributes),
+			}
+			polygon.Model, polygon.Confidence = source(poly.Source)
+			polygonsToAdd = append(polygonsToAdd, polygon)
 		}
 		response.Polygons = &polygonsToAdd
 	}
 
+	if len(image.Polylines) > 0 {
+		polylinesToAdd := []models.Polyline{}
+		for _, line := range image.Polylines {
+			points := []models.Point{}
+			for _, p := range line.Points.Coordinates {
+				points = append(points, models.Point{p[0], p[1]})
+			}
+			polyline := models.Polyline{
+				Id:     line.Id.String(),
+				Points: points, Label: line.Label.Name,
+				ReviewStatus: reviewStatus(line.Review),
+				Attributes:   attributesToModel(line.Attributes),
+			}
+			polyline.Model, polyline.Confidence = source(line.Source)
+			polylinesToAdd = append(polylinesToAdd, polyline)
+		}
+		response.Polylines = &polylinesToAdd
+	}
+
+	if len(image.Keypoints) > 0 {
+		posesToAdd := []models.Keypoints{}
+		for _, k := range image.Keypoints {
+			pose := models.Keypoints{
+				Id:     k.Id.String(),
+				Points: ja.KeypointsToModel(k.Points), Label: k.Label.Name,
+				ReviewStatus: reviewStatus(k.Review),
+				Attributes:   attributesToModel(k.Attributes),
+			}
+			pose.Model, pose.Confidence = source(k.Source)
+			posesToAdd = append(posesToAdd, pose)
+		}
+		response.Keypoints = &posesToAdd
+	}
+
+	if len(image.Masks) > 0 {
+		masksToAdd := []models.Mask{}
+		for _, m := range image.Masks {
+			mask := models.Mask{
+				Id:  m.Id.String(),
+				Rle: ja.RLEToModel(m.RLE), Label: m.Label.Name,
+				ReviewStatus: reviewStatus(m.Review),
+				Attributes:   attributesToModel(m.Attributes),
+			}
+			mask.Model, mask.Confidence = source(m.Source)
+			masksToAdd = append(masksToAdd, mask)
+		}
+		response.Masks = &masksToAdd
+	}
+
 	if len(image.Meta) > 0 {
 		toAdd := make(map[string]any)
 		for _, m := range image.Meta {
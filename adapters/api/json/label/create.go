@@ -5,7 +5,7 @@ import (
 	"net/http"
 
 	"github.com/lejeunel/go-image-annotator/adapters/api/json"
-	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/create"
 )
 
@@ -15,12 +15,10 @@ type Create struct {
 }
 
 func (p Create) Success(r create.Response) {
-	response := models.NewLabel{
-		Name:        r.Name,
-		Description: &r.Description,
-	}
-
-	json.WriteJSON(p.Writer, 200, response)
+	json.WriteJSON(p.Writer, 200, ToModel(lbl.NewLabel(lbl.LabelId{}, r.Name,
+		lbl.WithDescription(r.Description), lbl.WithParent(r.Parent),
+		lbl.WithColor(r.Color), lbl.WithAliases(r.Aliases), lbl.WithAttributes(r.Attributes),
+		lbl.WithSkeleton(r.Skeleton))))
 }
 
 func NewCreatePresenter(w http.ResponseWriter, l slog.Logger) Create {
@@ -5,7 +5,6 @@ import (
 	"net/http"
 
 	"github.com/lejeunel/go-image-annotator/adapters/api/json"
-	"github.com/lejeunel/go-image-annotator/adapters/api/models"
 	l "github.com/lejeunel/go-image-annotator/entities/label"
 )
 
@@ -15,12 +14,7 @@ type Find struct {
 }
 
 func (p Find) SuccessFindLabel(r l.Label) {
-	response := models.Label{
-		Name:        &r.Name,
-		Description: &r.Description,
-	}
-
-	json.WriteJSON(p.Writer, 200, response)
+	json.WriteJSON(p.Writer, 200, ToModel(r))
 }
 
 func NewFindPresenter(w http.ResponseWriter, l slog.Logger) Find {
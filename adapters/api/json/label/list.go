@@ -17,11 +17,7 @@ type List struct {
 func (p List) SuccessListLabels(r list.Response) {
 	data := []models.Label{}
 	for _, label := range r.Labels {
-		data = append(data,
-			models.Label{
-				Name:        &label.Name,
-				Description: &label.Description,
-			})
+		data = append(data, ToModel(label))
 	}
 
 	response := models.ListLabelsResponse{
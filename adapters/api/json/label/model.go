@@ -0,0 +1,105 @@
+package label
+
+import (
+	"fmt"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+)
+
+func ToModel(l lbl.Label) models.Label {
+	aliases := l.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return models.Label{
+		Name:        &l.Name,
+		Description: &l.Description,
+		Parent:      l.Parent,
+		Color:       &l.Color,
+		Aliases:     &aliases,
+		Attributes:  attributesToModel(l.Attributes),
+		Skeleton:    skeletonToModel(l.Skeleton),
+	}
+}
+
+func skeletonToModel(s *lbl.Skeleton) *models.Skeleton {
+	if s == nil {
+		return nil
+	}
+	edges := [][]string{}
+	for _, e := range s.Edges {
+		edges = append(edges, []string{e[0], e[1]})
+	}
+	return &models.Skeleton{Points: s.Points, Edges: &edges}
+}
+
+// SkeletonFromModel reads the skeleton of a label request. Edges must join
+// exactly two points.
+func SkeletonFromModel(s *models.Skeleton) (*lbl.Skeleton, error) {
+	if s == nil {
+		return nil, nil
+	}
+	skeleton := lbl.Skeleton{Points: s.Points, Edges: [][2]string{}}
+	if s.Edges != nil {
+		for i, e := range *s.Edges {
+			if len(e) != 2 {
+				return nil, fmt.Errorf("edge %v joins %v points, expected 2", i, len(e))
+			}
+			skeleton.Edges = append(skeleton.Edges, [2]string{e[0], e[1]})
+		}
+	}
+	return &skeleton, nil
+}
+
+func attributesToModel(defs []lbl.Attribute) *[]models.LabelAttribute {
+	result := []models.LabelAttribute{}
+	for _, d := range defs {
+		a := models.LabelAttribute{Name: d.Name, Type: d.Type.String(), Default: d.Default}
+		if d.Required {
+			a.Required = &d.Required
+		}
+		if len(d.Options) > 0 {
+			a.Options = &d.Options
+		}
+		result = append(result, a)
+	}
+	return &result
+}
+
+// AttributesFromModel reads the attribute definitions of a label request.
+func AttributesFromModel(attributes *[]models.LabelAttribute) []lbl.Attribute {
+	if attributes == nil {
+		return nil
+	}
+	result := []lbl.Attribute{}
+	for _, a := range *attributes {
+		d := lbl.Attribute{Name: a.Name, Type: lbl.AttributeType(a.Type), Default: a.Default}
+		if a.Required != nil {
+			d.Required = *a.Required
+		}
+		if a.Options != nil {
+			d.Options = *a.Options
+		}
+		result = append(result, d)
+	}
+	return result
+}
+
+func toNodes(nodes []lbl.Node) []models.LabelNode {
+	result := []models.LabelNode{}
+	for _, n := range nodes {
+		aliases := n.Aliases
+		if aliases == nil {
+			aliases = []string{}
+		}
+		result = append(result, models.LabelNode{
+			Name:        n.Name,
+			Description: n.Description,
+			Color:       n.Color,
+			Aliases:     aliases,
+			Children:    toNodes(n.Children),
+		})
+	}
+	return result
+}
@@ -0,0 +1,23 @@
+package label
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/tree"
+)
+
+type Tree struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Tree) SuccessLabelTree(r tree.Response) {
+	json.WriteJSON(p.Writer, 200, models.LabelTree{Roots: toNodes(r.Roots)})
+}
+
+func NewTreePresenter(w http.ResponseWriter, l slog.Logger) Tree {
+	return Tree{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
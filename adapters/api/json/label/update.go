@@ -0,0 +1,26 @@
+package label
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/update"
+)
+
+type Update struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Update) SuccessUpdateLabel(r update.Response) {
+	json.WriteJSON(p.Writer, 200, ToModel(lbl.NewLabel(lbl.LabelId{}, r.Name,
+		lbl.WithDescription(r.Description), lbl.WithParent(r.Parent),
+		lbl.WithColor(r.Color), lbl.WithAliases(r.Aliases), lbl.WithAttributes(r.Attributes),
+		lbl.WithSkeleton(r.Skeleton))))
+}
+
+func NewUpdatePresenter(w http.ResponseWriter, l slog.Logger) Update {
+	return Update{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
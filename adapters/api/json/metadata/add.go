@@ -0,0 +1,23 @@
+package metadata
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	m "github.com/lejeunel/go-image-annotator/entities/meta"
+)
+
+type Add struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Add) SuccessAddMetadata(r m.MetaData) {
+	json.WriteJSON(p.Writer, http.StatusCreated, models.MetadataEntry{Key: r.Key, Value: r.Value})
+}
+
+func NewAddPresenter(w http.ResponseWriter, l slog.Logger) Add {
+	return Add{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
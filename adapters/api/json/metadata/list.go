@@ -0,0 +1,28 @@
+package metadata
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/list"
+)
+
+type List struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p List) SuccessListMetadata(r list.Response) {
+	response := models.ImageMetadata{}
+	for _, m := range r.MetaData {
+		response[m.Key] = m.Value
+	}
+
+	json.WriteJSON(p.Writer, 200, response)
+}
+
+func NewListPresenter(w http.ResponseWriter, l slog.Logger) List {
+	return List{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
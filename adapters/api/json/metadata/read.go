@@ -0,0 +1,23 @@
+package metadata
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/read"
+)
+
+type Read struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Read) SuccessReadMetadata(r read.Response) {
+	json.WriteJSON(p.Writer, 200, models.MetadataEntry{Key: r.Data.Key, Value: r.Data.Value})
+}
+
+func NewReadPresenter(w http.ResponseWriter, l slog.Logger) Read {
+	return Read{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
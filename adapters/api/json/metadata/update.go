@@ -0,0 +1,21 @@
+package metadata
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+)
+
+type Update struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Update) SuccessUpdateMetadata() {
+	p.Writer.WriteHeader(http.StatusOK)
+}
+
+func NewUpdatePresenter(w http.ResponseWriter, l slog.Logger) Update {
+	return Update{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
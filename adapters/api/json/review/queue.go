@@ -0,0 +1,43 @@
+package review
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/queue"
+)
+
+type Queue struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Queue) SuccessListQueue(r queue.Response) {
+	data := []models.Submission{}
+	for _, s := range r.Submissions {
+		m := models.Submission{
+			AnnotationId: s.AnnotationId.String(),
+			ImageId:      s.ImageId.String(),
+			Collection:   s.Collection,
+			Type:         s.Type,
+			Label:        s.Label,
+			TouchedAt:    s.Time,
+		}
+		if s.Author != nil {
+			author := string(*s.Author)
+			m.Author = &author
+		}
+		data = append(data, m)
+	}
+
+	json.WriteJSON(p.Writer, http.StatusOK, models.ListReviewQueueResponse{
+		Data:       &data,
+		Pagination: json.BuildPaginationResponse(r.Pagination),
+	})
+}
+
+func NewQueuePresenter(w http.ResponseWriter, l slog.Logger) Queue {
+	return Queue{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
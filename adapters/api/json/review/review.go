@@ -0,0 +1,36 @@
+package review
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/review"
+)
+
+type Review struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func ToModel(id string, r a.Review) models.Review {
+	m := models.Review{AnnotationId: id, Status: r.CurrentStatus().String(), ReviewedAt: r.Time}
+	if r.Reviewer != nil {
+		reviewer := string(*r.Reviewer)
+		m.Reviewer = &reviewer
+	}
+	if r.Comment != "" {
+		m.Comment = &r.Comment
+	}
+	return m
+}
+
+func (p Review) SuccessReview(r review.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, ToModel(r.AnnotationId, r.Review))
+}
+
+func NewReviewPresenter(w http.ResponseWriter, l slog.Logger) Review {
+	return Review{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
@@ -0,0 +1,23 @@
+package review
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/submit"
+)
+
+type Submit struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Submit) SuccessSubmit(r submit.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, models.SubmitResponse{Submitted: r.NumSubmitted})
+}
+
+func NewSubmitPresenter(w http.ResponseWriter, l slog.Logger) Submit {
+	return Submit{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
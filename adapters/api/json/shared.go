@@ -64,6 +64,10 @@ func HTTPStatusCodeFromErr(err error) int {
 		return http.StatusFailedDependency
 	case errors.Is(err, e.ErrNotFound):
 		return http.StatusNotFound
+	case errors.Is(err, e.ErrAuthentication):
+		return http.StatusUnauthorized
+	case errors.Is(err, e.ErrAuthorization):
+		return http.StatusForbidden
 	default:
 		return http.StatusInternalServerError
 	}
@@ -0,0 +1,22 @@
+package view
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/create"
+)
+
+type Create struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Create) SuccessCreateView(r create.Response) {
+	json.WriteJSON(p.Writer, http.StatusCreated, ToModel(r.View))
+}
+
+func NewCreatePresenter(w http.ResponseWriter, l slog.Logger) Create {
+	return Create{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
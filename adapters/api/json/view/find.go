@@ -0,0 +1,34 @@
+package view
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+)
+
+type Find struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func ToModel(v vw.View) models.View {
+	return models.View{
+		Id:     v.Id.String(),
+		Name:   v.Name,
+		Owner:  v.Owner,
+		Filter: v.Filter,
+		Order:  v.Order,
+		Groups: v.Groups,
+	}
+}
+
+func (p Find) SuccessFindView(v vw.View) {
+	json.WriteJSON(p.Writer, http.StatusOK, ToModel(v))
+}
+
+func NewFindPresenter(w http.ResponseWriter, l slog.Logger) Find {
+	return Find{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
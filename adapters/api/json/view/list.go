@@ -0,0 +1,33 @@
+package view
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/list"
+)
+
+type List struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p List) SuccessListViews(r list.Response) {
+	data := []models.View{}
+	for _, view := range r.Views {
+		data = append(data, ToModel(view))
+	}
+
+	response := models.ListViewsResponse{
+		Data:       &data,
+		Pagination: json.BuildPaginationResponse(r.Pagination),
+	}
+
+	json.WriteJSON(p.Writer, http.StatusOK, response)
+}
+
+func NewListPresenter(w http.ResponseWriter, l slog.Logger) List {
+	return List{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
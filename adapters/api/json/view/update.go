@@ -0,0 +1,22 @@
+package view
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/update"
+)
+
+type Update struct {
+	Writer http.ResponseWriter
+	json.ErrorPresenter
+}
+
+func (p Update) SuccessUpdateView(r update.Response) {
+	json.WriteJSON(p.Writer, http.StatusOK, ToModel(r.View))
+}
+
+func NewUpdatePresenter(w http.ResponseWriter, l slog.Logger) Update {
+	return Update{Writer: w, ErrorPresenter: json.NewErrPresenter(w, l)}
+}
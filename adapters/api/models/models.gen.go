@@ -4,11 +4,202 @@
 package models
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for NewReviewStatus.
+const (
+	Accepted NewReviewStatus = "accepted"
+	Rejected NewReviewStatus = "rejected"
+)
+
+// Agreement defines model for Agreement.
+type Agreement struct {
+	Authors     []string          `json:"authors"`
+	Collections []string          `json:"collections"`
+	Consensus   *ConsensusSummary `json:"consensus,omitempty"`
+
+	// CreatedAt time of completion
+	CreatedAt    time.Time             `json:"created_at"`
+	Filter       string                `json:"filter"`
+	Id           string                `json:"id"`
+	ImageLabels  []ImageLabelAgreement `json:"image_labels"`
+	Images       []ImageAgreement      `json:"images"`
+	IouThreshold float64               `json:"iou_threshold"`
+
+	// Issuer ID of the user who requested the analysis
+	Issuer string `json:"issuer"`
+
+	// Kappa Fleiss kappa on image labels, over all images and labels
+	Kappa  float64          `json:"kappa"`
+	Labels []LabelAgreement `json:"labels"`
+
+	// MeanIou mean IoU of matched regions
+	MeanIou float64 `json:"mean_iou"`
+
+	// NumImages number of images shared by all collections
+	NumImages int             `json:"num_images"`
+	Pairs     []PairAgreement `json:"pairs"`
+	Raters    []string        `json:"raters"`
+
+	// RegionF1 F1 score of regions matched by IoU and label, over all images and pairs of raters
+	RegionF1 float64 `json:"region_f1"`
+}
+
+// AnnotationAttributes values of the attributes of the label, by name
+type AnnotationAttributes map[string]interface{}
+
+// AnnotationLabel defines model for AnnotationLabel.
+type AnnotationLabel struct {
+	// Label Name of the label
+	Label string `json:"label"`
+}
+
+// AnnotationRevision defines model for AnnotationRevision.
+type AnnotationRevision struct {
+	// Action change made to the annotation (created, modified, deleted or reverted)
+	Action string           `json:"action"`
+	After  *AnnotationState `json:"after,omitempty"`
+
+	// AnnotationId ID of the annotation
+	AnnotationId string `json:"annotation_id"`
+
+	// Author ID of the user who made the change
+	Author *string          `json:"author,omitempty"`
+	Before *AnnotationState `json:"before,omitempty"`
+
+	// Id ID of the revision
+	Id string `json:"id"`
+
+	// Restores ID of the revision a revert brought the annotation back to
+	Restores *string `json:"restores,omitempty"`
+
+	// Time time of the change
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// AnnotationState defines model for AnnotationState.
+type AnnotationState struct {
+	// Angle angle of a bounding box
+	Angle *float32 `json:"angle,omitempty"`
+
+	// Height height of a bounding box
+	Height *float32 `json:"height,omitempty"`
+
+	// Keypoints keypoints of a pose
+	Keypoints *[]Keypoint `json:"keypoints,omitempty"`
+
+	// Label label
+	Label string `json:"label"`
+
+	// Points points of a polygon or polyline
+	Points *[]Point `json:"points,omitempty"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle *RLE `json:"rle,omitempty"`
+
+	// Type type of annotation (image, bounding_box, polygon, polyline, keypoints or mask)
+	Type string `json:"type"`
+
+	// Width width of a bounding box
+	Width *float32 `json:"width,omitempty"`
+
+	// Xc x coordinate of the center point of a bounding box
+	Xc *float32 `json:"xc,omitempty"`
+
+	// Yc y coordinate of the center point of a bounding box
+	Yc *float32 `json:"yc,omitempty"`
+}
+
+// AssigneeProgress defines model for AssigneeProgress.
+type AssigneeProgress struct {
+	// Assignee ID of the user
+	Assignee string `json:"assignee"`
+
+	// Finished number of images the user finished
+	Finished int64 `json:"finished"`
+
+	// Total number of images assigned to the user
+	Total int64 `json:"total"`
+}
+
+// Assignment defines model for Assignment.
+type Assignment struct {
+	// BatchId Id of the batch
+	BatchId string `json:"batch_id"`
+
+	// Collection name of the collection
+	Collection string `json:"collection"`
+
+	// ImageId ID of the image
+	ImageId string `json:"image_id"`
+}
+
+// AssignmentBatch defines model for AssignmentBatch.
+type AssignmentBatch struct {
+	Assignees []AssigneeProgress `json:"assignees"`
+
+	// CreatedAt time of creation
+	CreatedAt time.Time `json:"created_at"`
+
+	// Creator ID of the user who created the batch
+	Creator string `json:"creator"`
+
+	// Filter Filtering expression that selected the images
+	Filter string `json:"filter"`
+
+	// Finished number of finished images
+	Finished int64 `json:"finished"`
+
+	// Id Id of the batch
+	Id string `json:"id"`
+
+	// Name Name of the batch
+	Name string `json:"name"`
+
+	// Total number of assigned images
+	Total int64 `json:"total"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action name of the operation
+	Action string `json:"action"`
+
+	// Actor ID of the user who performed the operation, empty if anonymous
+	Actor string `json:"actor"`
+
+	// Error error message of a denied or failed operation
+	Error *string `json:"error,omitempty"`
+
+	// Outcome outcome of the operation (success, denied or failure)
+	Outcome string `json:"outcome"`
+
+	// RequestId ID of the HTTP request, as sent in the X-Request-Id header
+	RequestId string `json:"request_id"`
+
+	// TargetId identifier of the resource the operation applies to
+	TargetId string `json:"target_id"`
+
+	// TargetType type of the resource the operation applies to
+	TargetType string `json:"target_type"`
+
+	// Time time at which the operation ended
+	Time time.Time `json:"time"`
+}
+
 // BoundingBox defines model for BoundingBox.
 type BoundingBox struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
 	// Height height of the bounding box
 	Height float32 `json:"height"`
 
@@ -18,6 +209,30 @@ type BoundingBox struct {
 	// Label label
 	Label string `json:"label"`
 
+	// Model model run that predicted the bounding box, if any
+	Model *string `json:"model,omitempty"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+
+	// Width width of the bounding box
+	Width float32 `json:"width"`
+
+	// Xc x coordinate of the center point
+	Xc float32 `json:"xc"`
+
+	// Yc y coordinate of the center point
+	Yc float32 `json:"yc"`
+}
+
+// BoxCoordinates defines model for BoxCoordinates.
+type BoxCoordinates struct {
+	// Angle rotation angle of the bounding box
+	Angle *float32 `json:"angle,omitempty"`
+
+	// Height height of the bounding box
+	Height float32 `json:"height"`
+
 	// Width width of the bounding box
 	Width float32 `json:"width"`
 
@@ -30,13 +245,50 @@ type BoundingBox struct {
 
 // Collection defines model for Collection.
 type Collection struct {
+	// DefaultLabel label given to regions ingested without one
+	DefaultLabel *string `json:"default_label,omitempty"`
+
 	// Description Description of the collection
 	Description *string `json:"description,omitempty"`
 
+	// Labels labels allowed in the collection, all labels when absent
+	Labels *[]string `json:"labels,omitempty"`
+
 	// Name Name of the collection
 	Name string `json:"name"`
 }
 
+// CollectionLabels defines model for CollectionLabels.
+type CollectionLabels struct {
+	// DefaultLabel label given to regions ingested without one
+	DefaultLabel *string `json:"default_label,omitempty"`
+
+	// Labels names or aliases of the allowed labels
+	Labels []string `json:"labels"`
+}
+
+// ConfusionMatrix defines model for ConfusionMatrix.
+type ConfusionMatrix struct {
+	// Counts number of ground truth regions (rows) matched by predictions
+	// (columns) at an IoU of 0.5. The last row and column stand for
+	// background, i.e. unmatched predictions and missed ground truth.
+	Counts [][]int  `json:"counts"`
+	Labels []string `json:"labels"`
+}
+
+// ConsensusSummary defines model for ConsensusSummary.
+type ConsensusSummary struct {
+	Collection string `json:"collection"`
+	Method     string `json:"method"`
+	MinVotes   int    `json:"min_votes"`
+
+	// NumImageLabels number of merged image labels
+	NumImageLabels int `json:"num_image_labels"`
+
+	// NumRegions number of merged regions
+	NumRegions int `json:"num_regions"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -46,6 +298,51 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// Evaluation defines model for Evaluation.
+type Evaluation struct {
+	Confusion ConfusionMatrix `json:"confusion"`
+
+	// CreatedAt time of completion
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filter query string restricting evaluated images
+	Filter string `json:"filter"`
+
+	// GroundTruth name of the collection holding ground truth
+	GroundTruth string `json:"ground_truth"`
+
+	// Id ID of the evaluation task
+	Id string `json:"id"`
+
+	// Issuer ID of the user who requested the evaluation
+	Issuer string         `json:"issuer"`
+	Labels []LabelMetrics `json:"labels"`
+
+	// Map mean average precision over IoU thresholds from 0.5 to 0.95
+	Map float64 `json:"map"`
+
+	// Map50 mean average precision at an IoU of 0.5
+	Map50 float64 `json:"map50"`
+
+	// Map75 mean average precision at an IoU of 0.75
+	Map75 float64 `json:"map75"`
+
+	// MinConfidence minimum confidence of predictions in the confusion matrix
+	MinConfidence float32 `json:"min_confidence"`
+
+	// NumGroundTruth number of ground truth regions
+	NumGroundTruth int `json:"num_ground_truth"`
+
+	// NumImages number of images shared by both collections
+	NumImages int `json:"num_images"`
+
+	// NumPredictions number of predicted regions
+	NumPredictions int `json:"num_predictions"`
+
+	// Predictions name of the collection holding predictions
+	Predictions string `json:"predictions"`
+}
+
 // Image defines model for Image.
 type Image struct {
 	BoundingBoxes *[]BoundingBox `json:"bounding_boxes,omitempty"`
@@ -54,10 +351,27 @@ type Image struct {
 	Collection string `json:"collection"`
 
 	// Id ID of the image
-	Id       string                  `json:"id"`
-	Labels   *[]string               `json:"labels,omitempty"`
-	Meta     *map[string]interface{} `json:"meta,omitempty"`
-	Polygons *[]Polygon              `json:"polygons,omitempty"`
+	Id        string                  `json:"id"`
+	Keypoints *[]Keypoints            `json:"keypoints,omitempty"`
+	Labels    *[]string               `json:"labels,omitempty"`
+	Masks     *[]Mask                 `json:"masks,omitempty"`
+	Meta      *map[string]interface{} `json:"meta,omitempty"`
+	Polygons  *[]Polygon              `json:"polygons,omitempty"`
+	Polylines *[]Polyline             `json:"polylines,omitempty"`
+}
+
+// ImageAgreement defines model for ImageAgreement.
+type ImageAgreement struct {
+	ImageId string `json:"image_id"`
+
+	// MeanIou mean IoU of matched regions
+	MeanIou float64 `json:"mean_iou"`
+
+	// NumRegions number of regions of all raters
+	NumRegions int `json:"num_regions"`
+
+	// RegionF1 F1 score of matched regions, averaged over pairs of raters
+	RegionF1 float64 `json:"region_f1"`
 }
 
 // ImageIngestionResponse defines model for ImageIngestionResponse.
@@ -66,13 +380,196 @@ type ImageIngestionResponse struct {
 	Id *string `json:"id,omitempty"`
 }
 
+// ImageLabelAgreement defines model for ImageLabelAgreement.
+type ImageLabelAgreement struct {
+	// Count number of times raters assigned this label
+	Count int `json:"count"`
+
+	// Kappa Fleiss kappa on whether images carry this label
+	Kappa float64 `json:"kappa"`
+	Label string  `json:"label"`
+}
+
+// ImageMetadata defines model for ImageMetadata.
+type ImageMetadata map[string]interface{}
+
+// ImportPredictionsResponse defines model for ImportPredictionsResponse.
+type ImportPredictionsResponse struct {
+	// Imported number of predictions imported
+	Imported int `json:"imported"`
+}
+
+// Keypoint defines model for Keypoint.
+type Keypoint struct {
+	// Visibility 0 when not labeled, 1 when occluded, 2 when visible
+	Visibility int `json:"visibility"`
+
+	// X x coordinate of the keypoint
+	X float32 `json:"x"`
+
+	// Y y coordinate of the keypoint
+	Y float32 `json:"y"`
+}
+
+// Keypoints defines model for Keypoints.
+type Keypoints struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
+	// Id ID of the pose
+	Id string `json:"id"`
+
+	// Label Label of the pose
+	Label string `json:"label"`
+
+	// Model model run that predicted the pose, if any
+	Model *string `json:"model,omitempty"`
+
+	// Points one keypoint per point of the skeleton of the label, in the same order
+	Points []Keypoint `json:"points"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+}
+
 // Label defines model for Label.
 type Label struct {
+	// Aliases Alternative names of the label, matched on import
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Attributes Attributes of regions with this label
+	Attributes *[]LabelAttribute `json:"attributes,omitempty"`
+
+	// Color Display color of the label, as #rrggbb
+	Color *string `json:"color,omitempty"`
+
 	// Description Description of the label
 	Description *string `json:"description,omitempty"`
 
 	// Name Name of the label
 	Name *string `json:"name,omitempty"`
+
+	// Parent Name of the parent label
+	Parent *string `json:"parent,omitempty"`
+
+	// Skeleton Keypoints of poses with this label
+	Skeleton *Skeleton `json:"skeleton,omitempty"`
+}
+
+// LabelAgreement defines model for LabelAgreement.
+type LabelAgreement struct {
+	Label string `json:"label"`
+
+	// MeanIou mean IoU of matched regions
+	MeanIou float64 `json:"mean_iou"`
+
+	// NumRegions number of regions of all raters with this label
+	NumRegions int `json:"num_regions"`
+
+	// RegionF1 F1 score of matched regions, over all pairs of raters
+	RegionF1 float64 `json:"region_f1"`
+}
+
+// LabelAttribute defines model for LabelAttribute.
+type LabelAttribute struct {
+	// Default value of regions that have none
+	Default interface{} `json:"default,omitempty"`
+
+	// Name Name of the attribute
+	Name string `json:"name"`
+
+	// Options allowed values of enum attributes
+	Options *[]string `json:"options,omitempty"`
+
+	// Required Whether regions must have a value to be submitted for review
+	Required *bool `json:"required,omitempty"`
+
+	// Type type of the values (bool, enum, number or text)
+	Type string `json:"type"`
+}
+
+// LabelMetrics defines model for LabelMetrics.
+type LabelMetrics struct {
+	// Ap average precision averaged over IoU thresholds from 0.5 to 0.95
+	Ap float64 `json:"ap"`
+
+	// Ap50 average precision at an IoU of 0.5
+	Ap50 float64 `json:"ap50"`
+
+	// Ap75 average precision at an IoU of 0.75
+	Ap75  float64 `json:"ap75"`
+	Label string  `json:"label"`
+
+	// NumGroundTruth number of ground truth regions with this label
+	NumGroundTruth int `json:"num_ground_truth"`
+
+	// NumPredictions number of predicted regions with this label
+	NumPredictions int `json:"num_predictions"`
+
+	// PrCurve precision at 101 recall levels, at an IoU of 0.5
+	PrCurve []PRPoint `json:"pr_curve"`
+}
+
+// LabelNode defines model for LabelNode.
+type LabelNode struct {
+	Aliases     []string    `json:"aliases"`
+	Children    []LabelNode `json:"children"`
+	Color       string      `json:"color"`
+	Description string      `json:"description"`
+	Name        string      `json:"name"`
+}
+
+// LabelTree defines model for LabelTree.
+type LabelTree struct {
+	// Roots labels without a parent
+	Roots []LabelNode `json:"roots"`
+}
+
+// LabelUpdate defines model for LabelUpdate.
+type LabelUpdate struct {
+	// Aliases Alternative names of the label, matched on import
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Attributes Attributes of regions with this label
+	Attributes *[]LabelAttribute `json:"attributes,omitempty"`
+
+	// Color Display color of the label, as #rrggbb
+	Color *string `json:"color,omitempty"`
+
+	// Description Description of the label
+	Description *string `json:"description,omitempty"`
+
+	// Parent Name or alias of the parent label, an empty string removes the parent
+	Parent *string `json:"parent,omitempty"`
+
+	// Skeleton Keypoints of poses with this label
+	Skeleton *Skeleton `json:"skeleton,omitempty"`
+}
+
+// ListAgreementsResponse defines model for ListAgreementsResponse.
+type ListAgreementsResponse struct {
+	Data       *[]Agreement `json:"data,omitempty"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+// ListAnnotationRevisionsResponse defines model for ListAnnotationRevisionsResponse.
+type ListAnnotationRevisionsResponse struct {
+	Revisions []AnnotationRevision `json:"revisions"`
+}
+
+// ListAssignmentBatchesResponse defines model for ListAssignmentBatchesResponse.
+type ListAssignmentBatchesResponse struct {
+	Data       *[]AssignmentBatch `json:"data,omitempty"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+// ListAuditLogResponse defines model for ListAuditLogResponse.
+type ListAuditLogResponse struct {
+	Data       *[]AuditEntry `json:"data,omitempty"`
+	Pagination Pagination    `json:"pagination"`
 }
 
 // ListCollectionsResponse defines model for ListCollectionsResponse.
@@ -81,6 +578,12 @@ type ListCollectionsResponse struct {
 	Pagination Pagination    `json:"pagination"`
 }
 
+// ListEvaluationsResponse defines model for ListEvaluationsResponse.
+type ListEvaluationsResponse struct {
+	Data       *[]Evaluation `json:"data,omitempty"`
+	Pagination Pagination    `json:"pagination"`
+}
+
 // ListImagesResponse defines model for ListImagesResponse.
 type ListImagesResponse struct {
 	Images     []Image    `json:"images"`
@@ -93,8 +596,129 @@ type ListLabelsResponse struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// ListMyAssignmentBatchesResponse defines model for ListMyAssignmentBatchesResponse.
+type ListMyAssignmentBatchesResponse struct {
+	Data *[]AssignmentBatch `json:"data,omitempty"`
+}
+
+// ListReviewQueueResponse defines model for ListReviewQueueResponse.
+type ListReviewQueueResponse struct {
+	Data       *[]Submission `json:"data,omitempty"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// ListViewsResponse defines model for ListViewsResponse.
+type ListViewsResponse struct {
+	Data       *[]View    `json:"data,omitempty"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// Mask defines model for Mask.
+type Mask struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
+	// Id ID of the mask
+	Id string `json:"id"`
+
+	// Label Label of the mask
+	Label string `json:"label"`
+
+	// Model model run that predicted the mask, if any
+	Model *string `json:"model,omitempty"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle RLE `json:"rle"`
+}
+
+// MaskUpdate defines model for MaskUpdate.
+type MaskUpdate struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the mask
+	Label string `json:"label"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle RLE `json:"rle"`
+}
+
+// MetadataEntry defines model for MetadataEntry.
+type MetadataEntry struct {
+	// Key Metadata key
+	Key string `json:"key"`
+
+	// Value Metadata value
+	Value interface{} `json:"value"`
+}
+
+// MetadataValue defines model for MetadataValue.
+type MetadataValue struct {
+	// Value Metadata value
+	Value interface{} `json:"value"`
+}
+
+// NewAgreement defines model for NewAgreement.
+type NewAgreement struct {
+	// Authors when given, these authors are the raters instead of the collections
+	Authors *[]string `json:"authors,omitempty"`
+
+	// Collections collections whose shared images are compared
+	Collections []string      `json:"collections"`
+	Consensus   *NewConsensus `json:"consensus,omitempty"`
+
+	// Filter query string restricting compared images, e.g. meta.site:lab
+	Filter *string `json:"filter,omitempty"`
+
+	// IouThreshold overlap above which regions of two raters match, 0.5 by default
+	IouThreshold *float64 `json:"iou_threshold,omitempty"`
+}
+
+// NewAnnotationResponse defines model for NewAnnotationResponse.
+type NewAnnotationResponse struct {
+	// Id ID of the created annotation
+	Id string `json:"id"`
+}
+
+// NewAnnotationsResponse defines model for NewAnnotationsResponse.
+type NewAnnotationsResponse struct {
+	// Ids IDs of the created annotations
+	Ids []string `json:"ids"`
+}
+
+// NewAssignmentBatch defines model for NewAssignmentBatch.
+type NewAssignmentBatch struct {
+	// Filter Filtering expression selecting the images to assign
+	Filter *string `json:"filter,omitempty"`
+
+	// Groups Groups whose members are assigned images
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Name Name of the batch
+	Name string `json:"name"`
+
+	// Users Users to assign images to
+	Users *[]string `json:"users,omitempty"`
+}
+
 // NewBoundingBox defines model for NewBoundingBox.
 type NewBoundingBox struct {
+	// Angle rotation angle of the bounding box
+	Angle *float32 `json:"angle,omitempty"`
+
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
 	// Height height of the bounding box
 	Height float32 `json:"height"`
 
@@ -120,6 +744,38 @@ type NewCollection struct {
 	Name string `json:"name"`
 }
 
+// NewConsensus defines model for NewConsensus.
+type NewConsensus struct {
+	// Collection name of the collection to create with the consensus
+	Collection string `json:"collection"`
+
+	// Group group of the created collection
+	Group *string `json:"group,omitempty"`
+
+	// Method majority (default) keeps the region of each cluster that overlaps
+	// the others most, wbf averages clusters of boxes weighted by their
+	// confidence
+	Method *string `json:"method,omitempty"`
+
+	// MinVotes number of raters that must agree, a majority by default
+	MinVotes *int `json:"min_votes,omitempty"`
+}
+
+// NewEvaluation defines model for NewEvaluation.
+type NewEvaluation struct {
+	// Filter query string restricting evaluated images, e.g. meta.site:lab
+	Filter *string `json:"filter,omitempty"`
+
+	// GroundTruth name of the collection holding ground truth
+	GroundTruth string `json:"ground_truth"`
+
+	// MinConfidence predictions less confident than this are left out of the confusion matrix
+	MinConfidence *float32 `json:"min_confidence,omitempty"`
+
+	// Predictions name of the collection holding predictions
+	Predictions string `json:"predictions"`
+}
+
 // NewImage defines model for NewImage.
 type NewImage struct {
 	BoundingBoxes *[]NewBoundingBox `json:"bounding_boxes,omitempty"`
@@ -129,15 +785,114 @@ type NewImage struct {
 	Labels     *[]string `json:"labels,omitempty"`
 }
 
+// NewKeypoints defines model for NewKeypoints.
+type NewKeypoints struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the pose
+	Label string `json:"label"`
+
+	// Points one keypoint per point of the skeleton of the label, in the same order
+	Points []Keypoint `json:"points"`
+}
+
 // NewLabel defines model for NewLabel.
 type NewLabel struct {
+	// Aliases Alternative names of the label, matched on import
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Attributes Attributes of regions with this label
+	Attributes *[]LabelAttribute `json:"attributes,omitempty"`
+
+	// Color Display color of the label, as #rrggbb
+	Color *string `json:"color,omitempty"`
+
 	// Description Description of the label
 	Description *string `json:"description,omitempty"`
 
 	// Name Name of the label
 	Name string `json:"name"`
+
+	// Parent Name or alias of the parent label
+	Parent *string `json:"parent,omitempty"`
+
+	// Skeleton Keypoints of poses with this label
+	Skeleton *Skeleton `json:"skeleton,omitempty"`
+}
+
+// NewMask defines model for NewMask.
+type NewMask struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the mask
+	Label string `json:"label"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle *RLE `json:"rle,omitempty"`
+}
+
+// NewPolygon defines model for NewPolygon.
+type NewPolygon struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the polygon
+	Label  string  `json:"label"`
+	Points []Point `json:"points"`
+}
+
+// NewPolyline defines model for NewPolyline.
+type NewPolyline struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the polyline
+	Label string `json:"label"`
+
+	// Points vertices of the polyline, from one end to the other
+	Points []Point `json:"points"`
+}
+
+// NewPrediction a shape predicted on an image, either a bounding box or a polygon
+type NewPrediction struct {
+	BoundingBox *BoxCoordinates `json:"bounding_box,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence float32 `json:"confidence"`
+
+	// ImageId ID of the image
+	ImageId string `json:"image_id"`
+
+	// Label predicted label
+	Label string `json:"label"`
+
+	// Polygon points of the polygon
+	Polygon *[]Point `json:"polygon,omitempty"`
 }
 
+// NewPredictions defines model for NewPredictions.
+type NewPredictions struct {
+	// Model name of the model run that produced the predictions
+	Model       string          `json:"model"`
+	Predictions []NewPrediction `json:"predictions"`
+}
+
+// NewReview defines model for NewReview.
+type NewReview struct {
+	// Comment comment of the reviewer, required upon rejection
+	Comment *string `json:"comment,omitempty"`
+
+	// Status decision of the reviewer
+	Status NewReviewStatus `json:"status"`
+}
+
+// NewReviewStatus decision of the reviewer
+type NewReviewStatus string
+
 // NewUser defines model for NewUser.
 type NewUser struct {
 	Groups *[]string `json:"groups,omitempty"`
@@ -147,6 +902,28 @@ type NewUser struct {
 	Roles *[]string `json:"roles,omitempty"`
 }
 
+// NewView defines model for NewView.
+type NewView struct {
+	// Filter Filtering expression
+	Filter *string `json:"filter,omitempty"`
+
+	// Groups Groups the view is shared with
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Name Name of the view
+	Name string `json:"name"`
+
+	// Order Ordering expression
+	Order *string `json:"order,omitempty"`
+}
+
+// PRPoint defines model for PRPoint.
+type PRPoint struct {
+	// Precision interpolated precision at this recall
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+}
+
 // Pagination defines model for Pagination.
 type Pagination struct {
 	// Page current page number
@@ -162,17 +939,152 @@ type Pagination struct {
 	TotalPages int64 `json:"total_pages"`
 }
 
+// PairAgreement defines model for PairAgreement.
+type PairAgreement struct {
+	// Kappa Cohen kappa on image labels
+	Kappa  float64  `json:"kappa"`
+	Raters []string `json:"raters"`
+
+	// RegionF1 F1 score of regions matched by IoU and label
+	RegionF1 float64 `json:"region_f1"`
+}
+
 // Point defines model for Point.
 type Point = []float32
 
 // Polygon defines model for Polygon.
 type Polygon struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
 	// Id ID of the polygon
 	Id string `json:"id"`
 
 	// Label Label of the polygon
-	Label  string  `json:"label"`
+	Label string `json:"label"`
+
+	// Model model run that predicted the polygon, if any
+	Model  *string `json:"model,omitempty"`
 	Points []Point `json:"points"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+}
+
+// Polyline defines model for Polyline.
+type Polyline struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
+	// Id ID of the polyline
+	Id string `json:"id"`
+
+	// Label Label of the polyline
+	Label string `json:"label"`
+
+	// Model model run that predicted the polyline, if any
+	Model *string `json:"model,omitempty"`
+
+	// Points vertices of the polyline, from one end to the other
+	Points []Point `json:"points"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+}
+
+// RLE Binary mask in the run-length encoding of COCO. Counts alternate
+// between runs of background and foreground pixels, starting with
+// background, going down the columns of the image from left to right.
+type RLE struct {
+	// Counts lengths of the runs, summing to height times width
+	Counts []int `json:"counts"`
+
+	// Size height and width of the mask, in pixels
+	Size []int `json:"size"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	// AnnotationId ID of the annotation
+	AnnotationId string `json:"annotation_id"`
+
+	// Comment comment of the reviewer
+	Comment *string `json:"comment,omitempty"`
+
+	// ReviewedAt time of the review
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// Reviewer ID of the reviewer
+	Reviewer *string `json:"reviewer,omitempty"`
+
+	// Status review status (draft, submitted, accepted or rejected)
+	Status string `json:"status"`
+}
+
+// Skeleton defines model for Skeleton.
+type Skeleton struct {
+	// Edges pairs of names of the points joined by an edge
+	Edges *[][]string `json:"edges,omitempty"`
+
+	// Points names of the keypoints, in order
+	Points []string `json:"points"`
+}
+
+// Stroke defines model for Stroke.
+type Stroke struct {
+	// Points path of the brush, in pixels
+	Points []Point `json:"points"`
+
+	// Radius radius of the brush, in pixels
+	Radius float32 `json:"radius"`
+}
+
+// SubmitResponse defines model for SubmitResponse.
+type SubmitResponse struct {
+	// Submitted number of annotations submitted for review
+	Submitted int `json:"submitted"`
+}
+
+// Submission defines model for Submission.
+type Submission struct {
+	// AnnotationId ID of the annotation
+	AnnotationId string `json:"annotation_id"`
+
+	// Author ID of the author
+	Author *string `json:"author,omitempty"`
+
+	// Collection name of the collection
+	Collection string `json:"collection"`
+
+	// ImageId ID of the image
+	ImageId string `json:"image_id"`
+
+	// Label label of the annotation
+	Label string `json:"label"`
+
+	// TouchedAt time of the last modification
+	TouchedAt *time.Time `json:"touched_at,omitempty"`
+
+	// Type type of annotation (image, bounding_box, polygon, polyline, keypoints or mask)
+	Type string `json:"type"`
+}
+
+// Task defines model for Task.
+type Task struct {
+	// Id ID of the task
+	Id string `json:"id"`
+
+	// Issuer ID of the user who issued the task
+	Issuer string `json:"issuer"`
+
+	// Type type of the task
+	Type string `json:"type"`
 }
 
 // UpdateCollection defines model for UpdateCollection.
@@ -193,6 +1105,90 @@ type User struct {
 	Roles []string `json:"roles"`
 }
 
+// View defines model for View.
+type View struct {
+	// Filter Filtering expression
+	Filter string `json:"filter"`
+
+	// Groups Groups the view is shared with
+	Groups []string `json:"groups"`
+
+	// Id Id of the view
+	Id string `json:"id"`
+
+	// Name Name of the view
+	Name string `json:"name"`
+
+	// Order Ordering expression
+	Order string `json:"order"`
+
+	// Owner Id of the user who saved the view
+	Owner string `json:"owner"`
+}
+
+// ListAgreementsParams defines parameters for ListAgreements.
+type ListAgreementsParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of analyses to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListAssignmentBatchesParams defines parameters for ListAssignmentBatches.
+type ListAssignmentBatchesParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of batches to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// NextAssignmentParams defines parameters for NextAssignment.
+type NextAssignmentParams struct {
+	// ImageId ID of an image to skip, e.g. the current one
+	ImageId *string `form:"image_id,omitempty" json:"image_id,omitempty"`
+
+	// Collection collection of the image to skip
+	Collection *string `form:"collection,omitempty" json:"collection,omitempty"`
+}
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	// Actor ID of the user who performed the operation
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action name of the operation, e.g. DeleteLabel
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// From earliest time, as RFC3339 or YYYY-MM-DD
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To latest time (exclusive), as RFC3339 or YYYY-MM-DD (inclusive day)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of entries to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ExportAuditLogParams defines parameters for ExportAuditLog.
+type ExportAuditLogParams struct {
+	// Actor ID of the user who performed the operation
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action name of the operation, e.g. DeleteLabel
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// From earliest time, as RFC3339 or YYYY-MM-DD
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To latest time (exclusive), as RFC3339 or YYYY-MM-DD (inclusive day)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListCollectionsParams defines parameters for ListCollections.
 type ListCollectionsParams struct {
 	// Page page number
@@ -202,6 +1198,42 @@ type ListCollectionsParams struct {
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
+// ExportCollectionParams defines parameters for ExportCollection.
+type ExportCollectionParams struct {
+	// Format export format (coco, yolo or voc)
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+
+	// Filter query string restricting exported images, e.g. meta.site:lab
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// ReviewStatus only export annotations of this review status, e.g. accepted
+	ReviewStatus *string `form:"review_status,omitempty" json:"review_status,omitempty"`
+
+	// Train ratio of images assigned to the train subset
+	Train *float64 `form:"train,omitempty" json:"train,omitempty"`
+
+	// Val ratio of images assigned to the validation subset
+	Val *float64 `form:"val,omitempty" json:"val,omitempty"`
+
+	// Test ratio of images assigned to the test subset
+	Test *float64 `form:"test,omitempty" json:"test,omitempty"`
+
+	// Seed seed of the random split
+	Seed *int64 `form:"seed,omitempty" json:"seed,omitempty"`
+
+	// Stratify split each label separately
+	Stratify *bool `form:"stratify,omitempty" json:"stratify,omitempty"`
+}
+
+// ListEvaluationsParams defines parameters for ListEvaluations.
+type ListEvaluationsParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of evaluations to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
 // ListImagesParams defines parameters for ListImages.
 type ListImagesParams struct {
 	// Page page number
@@ -215,6 +1247,9 @@ type ListImagesParams struct {
 
 	// Order ordering expression
 	Order *string `form:"order,omitempty" json:"order,omitempty"`
+
+	// View id of a saved view whose filtering and ordering expressions replace those given
+	View *string `form:"view,omitempty" json:"view,omitempty"`
 }
 
 // IngestImageMultipartBody defines parameters for IngestImage.
@@ -233,17 +1268,122 @@ type ListLabelsParams struct {
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
+// ReadRawImageParams defines parameters for ReadRawImage.
+type ReadRawImageParams struct {
+	// Size length in pixels of the longest side of the returned thumbnail (128, 256, 512 or 1024)
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListReviewQueueParams defines parameters for ListReviewQueue.
+type ListReviewQueueParams struct {
+	// Collection restrict the queue to a collection
+	Collection *string `form:"collection,omitempty" json:"collection,omitempty"`
+
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of annotations to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListViewsParams defines parameters for ListViews.
+type ListViewsParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of views to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// CreateAgreementJSONRequestBody defines body for CreateAgreement for application/json ContentType.
+type CreateAgreementJSONRequestBody = NewAgreement
+
+// SetAnnotationAttributesJSONRequestBody defines body for SetAnnotationAttributes for application/json ContentType.
+type SetAnnotationAttributesJSONRequestBody = AnnotationAttributes
+
+// UpdateAnnotationLabelJSONRequestBody defines body for UpdateAnnotationLabel for application/json ContentType.
+type UpdateAnnotationLabelJSONRequestBody = AnnotationLabel
+
+// ReviewAnnotationJSONRequestBody defines body for ReviewAnnotation for application/json ContentType.
+type ReviewAnnotationJSONRequestBody = NewReview
+
+// CreateAssignmentBatchJSONRequestBody defines body for CreateAssignmentBatch for application/json ContentType.
+type CreateAssignmentBatchJSONRequestBody = NewAssignmentBatch
+
+// UpdateBoundingBoxJSONRequestBody defines body for UpdateBoundingBox for application/json ContentType.
+type UpdateBoundingBoxJSONRequestBody = NewBoundingBox
+
 // CreateCollectionJSONRequestBody defines body for CreateCollection for application/json ContentType.
 type CreateCollectionJSONRequestBody = NewCollection
 
 // UpdateCollectionByNameJSONRequestBody defines body for UpdateCollectionByName for application/json ContentType.
 type UpdateCollectionByNameJSONRequestBody = UpdateCollection
 
+// AddBoundingBoxJSONRequestBody defines body for AddBoundingBox for application/json ContentType.
+type AddBoundingBoxJSONRequestBody = NewBoundingBox
+
+// AddImageLabelJSONRequestBody defines body for AddImageLabel for application/json ContentType.
+type AddImageLabelJSONRequestBody = AnnotationLabel
+
+// UpdateImageMetadataJSONRequestBody defines body for UpdateImageMetadata for application/json ContentType.
+type UpdateImageMetadataJSONRequestBody = MetadataValue
+
+// SetImageMetadataJSONRequestBody defines body for SetImageMetadata for application/json ContentType.
+type SetImageMetadataJSONRequestBody = MetadataValue
+
+// AddKeypointsJSONRequestBody defines body for AddKeypoints for application/json ContentType.
+type AddKeypointsJSONRequestBody = NewKeypoints
+
+// AddMaskJSONRequestBody defines body for AddMask for application/json ContentType.
+type AddMaskJSONRequestBody = NewMask
+
+// AddPolygonJSONRequestBody defines body for AddPolygon for application/json ContentType.
+type AddPolygonJSONRequestBody = NewPolygon
+
+// AddPolylineJSONRequestBody defines body for AddPolyline for application/json ContentType.
+type AddPolylineJSONRequestBody = NewPolyline
+
 // IngestImageMultipartRequestBody defines body for IngestImage for multipart/form-data ContentType.
 type IngestImageMultipartRequestBody IngestImageMultipartBody
 
+// SetCollectionLabelsJSONRequestBody defines body for SetCollectionLabels for application/json ContentType.
+type SetCollectionLabelsJSONRequestBody = CollectionLabels
+
+// ImportPredictionsJSONRequestBody defines body for ImportPredictions for application/json ContentType.
+type ImportPredictionsJSONRequestBody = NewPredictions
+
+// CreateEvaluationJSONRequestBody defines body for CreateEvaluation for application/json ContentType.
+type CreateEvaluationJSONRequestBody = NewEvaluation
+
+// UpdateKeypointsJSONRequestBody defines body for UpdateKeypoints for application/json ContentType.
+type UpdateKeypointsJSONRequestBody = NewKeypoints
+
 // CreateLabelJSONRequestBody defines body for CreateLabel for application/json ContentType.
 type CreateLabelJSONRequestBody = NewLabel
 
+// UpdateLabelJSONRequestBody defines body for UpdateLabel for application/json ContentType.
+type UpdateLabelJSONRequestBody = LabelUpdate
+
+// UpdateMaskJSONRequestBody defines body for UpdateMask for application/json ContentType.
+type UpdateMaskJSONRequestBody = MaskUpdate
+
+// PaintMaskJSONRequestBody defines body for PaintMask for application/json ContentType.
+type PaintMaskJSONRequestBody = Stroke
+
+// EraseMaskJSONRequestBody defines body for EraseMask for application/json ContentType.
+type EraseMaskJSONRequestBody = Stroke
+
+// UpdatePolygonJSONRequestBody defines body for UpdatePolygon for application/json ContentType.
+type UpdatePolygonJSONRequestBody = NewPolygon
+
+// UpdatePolylineJSONRequestBody defines body for UpdatePolyline for application/json ContentType.
+type UpdatePolylineJSONRequestBody = NewPolyline
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = NewUser
+
+// CreateViewJSONRequestBody defines body for CreateView for application/json ContentType.
+type CreateViewJSONRequestBody = NewView
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = NewView
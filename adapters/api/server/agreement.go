@@ -0,0 +1,55 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/agreement"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/analyze"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/find"
+)
+
+func (s *Server) CreateAgreement(w http.ResponseWriter, r *http.Request) {
+	body, ok := json.MustDecodeJSON[models.NewAgreement](w, r)
+	if !ok {
+		return
+	}
+	req := analyze.Request{Collections: body.Collections}
+	if body.Authors != nil {
+		req.Authors = *body.Authors
+	}
+	if body.Filter != nil {
+		req.Filter = *body.Filter
+	}
+	if body.IouThreshold != nil {
+		req.IoUThreshold = *body.IouThreshold
+	}
+	if c := body.Consensus; c != nil {
+		req.Consensus = &analyze.ConsensusRequest{Destination: c.Collection, Group: c.Group}
+		if c.Method != nil {
+			req.Consensus.Method = ag.Method(*c.Method)
+		}
+		if c.MinVotes != nil {
+			req.Consensus.MinVotes = *c.MinVotes
+		}
+	}
+	s.Agreement.Analyze.Execute(r.Context(), req, p.NewPresenter(w, s.Logger))
+}
+
+func (s *Server) ListAgreements(w http.ResponseWriter, r *http.Request, params ListAgreementsParams) {
+	req := pa.PaginationParams{}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	s.Agreement.List.Execute(r.Context(), req, p.NewPresenter(w, s.Logger))
+}
+
+func (s *Server) FindAgreement(w http.ResponseWriter, r *http.Request, taskId string) {
+	s.Agreement.Find.Execute(r.Context(), find.Request{Id: taskId}, p.NewPresenter(w, s.Logger))
+}
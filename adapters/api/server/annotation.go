@@ -0,0 +1,314 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/annotation"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
+	addbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-bbox"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
+	addmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-mask"
+	addpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polygon"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
+	addlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/assign-label"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/history"
+	imppred "github.com/lejeunel/go-image-annotator/use-cases/annotate/import-predictions"
+	mask2poly "github.com/lejeunel/go-image-annotator/use-cases/annotate/mask-to-polygons"
+	updbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-bbox"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
+	updmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-mask"
+	updpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polygon"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
+	paint "github.com/lejeunel/go-image-annotator/use-cases/annotate/paint-mask"
+	poly2mask "github.com/lejeunel/go-image-annotator/use-cases/annotate/polygon-to-mask"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/remove"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
+	setattr "github.com/lejeunel/go-image-annotator/use-cases/annotate/set-attributes"
+	updlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/update-label"
+)
+
+func (s *Server) AddBoundingBox(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	body, ok := json.MustDecodeJSON[models.NewBoundingBox](w, r)
+	if !ok {
+		return
+	}
+	req := addbox.Request{
+		ImageId: imageId, Collection: name, Label: body.Label,
+		Xc: body.Xc, Yc: body.Yc, Width: body.Width, Height: body.Height,
+		Attributes: attributesFromModel(body.Attributes),
+	}
+	if body.Angle != nil {
+		req.Angle = *body.Angle
+	}
+	s.Annotation.AddBox.Execute(r.Context(), req, p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) AddPolygon(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	body, ok := json.MustDecodeJSON[models.NewPolygon](w, r)
+	if !ok {
+		return
+	}
+	points, err := pointsFromModel(body.Points)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.Annotation.AddPolygon.Execute(r.Context(),
+		addpoly.Request{ImageId: imageId, Collection: name, Label: body.Label, Points: *points,
+			Attributes: attributesFromModel(body.Attributes)},
+		p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) AddPolyline(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	body, ok := json.MustDecodeJSON[models.NewPolyline](w, r)
+	if !ok {
+		return
+	}
+	points, err := pointsFromModel(body.Points)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.Annotation.AddPolyline.Execute(r.Context(),
+		addline.Request{ImageId: imageId, Collection: name, Label: body.Label, Points: *points,
+			Attributes: attributesFromModel(body.Attributes)},
+		p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) AddKeypoints(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	body, ok := json.MustDecodeJSON[models.NewKeypoints](w, r)
+	if !ok {
+		return
+	}
+	s.Annotation.AddKeypoints.Execute(r.Context(),
+		addkp.Request{ImageId: imageId, Collection: name, Label: body.Label,
+			Points: p.KeypointsFromModel(body.Points), Attributes: attributesFromModel(body.Attributes)},
+		p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) AddMask(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	body, ok := json.MustDecodeJSON[models.NewMask](w, r)
+	if !ok {
+		return
+	}
+	req := addmask.Request{ImageId: imageId, Collection: name, Label: body.Label,
+		Attributes: attributesFromModel(body.Attributes)}
+	if body.Rle != nil {
+		rle, err := p.RLEFromModel(*body.Rle)
+		if err != nil {
+			json.WriteError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		req.RLE = rle
+	}
+	s.Annotation.AddMask.Execute(r.Context(), req, p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) AddImageLabel(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	body, ok := json.MustDecodeJSON[models.AnnotationLabel](w, r)
+	if !ok {
+		return
+	}
+	s.Annotation.AddImageLabel.Execute(r.Context(),
+		addlbl.Request{ImageId: imageId, Collection: name, Label: body.Label},
+		p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) UpdateBoundingBox(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.NewBoundingBox](w, r)
+	if !ok {
+		return
+	}
+	req := updbox.Request{
+		AnnotationId: annotationId, Label: body.Label,
+		Xc: body.Xc, Yc: body.Yc, Width: body.Width, Height: body.Height,
+		Attributes: attributesFromModel(body.Attributes),
+	}
+	if body.Angle != nil {
+		req.Angle = *body.Angle
+	}
+	s.Annotation.UpdateBox.Execute(r.Context(), req, p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) UpdatePolygon(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.NewPolygon](w, r)
+	if !ok {
+		return
+	}
+	points, err := pointsFromModel(body.Points)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.Annotation.UpdatePolygon.Execute(r.Context(),
+		updpoly.Request{AnnotationId: annotationId, Label: body.Label, Points: *points,
+			Attributes: attributesFromModel(body.Attributes)},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) UpdatePolyline(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.NewPolyline](w, r)
+	if !ok {
+		return
+	}
+	points, err := pointsFromModel(body.Points)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.Annotation.UpdatePolyline.Execute(r.Context(),
+		updline.Request{AnnotationId: annotationId, Label: body.Label, Points: *points,
+			Attributes: attributesFromModel(body.Attributes)},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) UpdateKeypoints(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.NewKeypoints](w, r)
+	if !ok {
+		return
+	}
+	s.Annotation.UpdateKeypoints.Execute(r.Context(),
+		updkp.Request{AnnotationId: annotationId, Label: body.Label,
+			Points: p.KeypointsFromModel(body.Points), Attributes: attributesFromModel(body.Attributes)},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) UpdateMask(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.MaskUpdate](w, r)
+	if !ok {
+		return
+	}
+	rle, err := p.RLEFromModel(body.Rle)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.Annotation.UpdateMask.Execute(r.Context(),
+		updmask.Request{AnnotationId: annotationId, Label: body.Label, RLE: *rle,
+			Attributes: attributesFromModel(body.Attributes)},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) PaintMask(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.stroke(w, r, annotationId, false)
+}
+
+func (s *Server) EraseMask(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.stroke(w, r, annotationId, true)
+}
+
+func (s *Server) stroke(w http.ResponseWriter, r *http.Request, annotationId string, erase bool) {
+	body, ok := json.MustDecodeJSON[models.Stroke](w, r)
+	if !ok {
+		return
+	}
+	points, err := pointsFromModel(body.Points)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.Annotation.PaintMask.Execute(r.Context(),
+		paint.Request{AnnotationId: annotationId, Points: *points, Radius: body.Radius, Erase: erase},
+		p.NewPaintPresenter(w, s.Logger))
+}
+
+func (s *Server) ConvertPolygonToMask(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.Annotation.PolygonToMask.Execute(r.Context(),
+		poly2mask.Request{AnnotationId: annotationId}, p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) ConvertMaskToPolygons(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.Annotation.MaskToPolygons.Execute(r.Context(),
+		mask2poly.Request{AnnotationId: annotationId}, p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) UpdateAnnotationLabel(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.AnnotationLabel](w, r)
+	if !ok {
+		return
+	}
+	s.Annotation.UpdateLabel.Execute(r.Context(),
+		updlbl.Request{AnnotationId: annotationId, Label: body.Label},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) SetAnnotationAttributes(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.AnnotationAttributes](w, r)
+	if !ok {
+		return
+	}
+	s.Annotation.SetAttributes.Execute(r.Context(),
+		setattr.Request{AnnotationId: annotationId, Attributes: an.Attributes(*body)},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) DeleteAnnotation(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.Annotation.Delete.Execute(r.Context(), remove.Request{Id: annotationId},
+		p.NewDeletePresenter(w, s.Logger))
+}
+
+func (s *Server) ListAnnotationRevisions(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.Annotation.History.Execute(r.Context(), history.Request{AnnotationId: annotationId},
+		p.NewHistoryPresenter(w, s.Logger))
+}
+
+func (s *Server) RevertAnnotation(w http.ResponseWriter, r *http.Request, revisionId string) {
+	s.Annotation.Revert.Execute(r.Context(), revert.Request{RevisionId: revisionId},
+		p.NewRevertPresenter(w, s.Logger))
+}
+
+func (s *Server) ImportPredictions(w http.ResponseWriter, r *http.Request, name string) {
+	body, ok := json.MustDecodeJSON[models.NewPredictions](w, r)
+	if !ok {
+		return
+	}
+	req := imppred.Request{Collection: name, Model: body.Model}
+	for _, m := range body.Predictions {
+		prediction := imppred.Prediction{ImageId: m.ImageId, Label: m.Label, Confidence: m.Confidence}
+		if b := m.BoundingBox; b != nil {
+			prediction.Box = &an.BoxCoordinates{Xc: b.Xc, Yc: b.Yc, Width: b.Width, Height: b.Height}
+			if b.Angle != nil {
+				prediction.Box.Angle = *b.Angle
+			}
+		}
+		if m.Polygon != nil {
+			points, err := pointsFromModel(*m.Polygon)
+			if err != nil {
+				json.WriteError(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			prediction.Points = points
+		}
+		req.Predictions = append(req.Predictions, prediction)
+	}
+	s.Annotation.ImportPredictions.Execute(r.Context(), req, p.NewImportPredictionsPresenter(w, s.Logger))
+}
+
+func (s *Server) AcceptPrediction(w http.ResponseWriter, r *http.Request, annotationId string) {
+	s.Annotation.AcceptPrediction.Execute(r.Context(), accpred.Request{Id: annotationId},
+		p.NewAcceptPredictionPresenter(w, s.Logger))
+}
+
+func pointsFromModel(points []models.Point) (*an.Points, error) {
+	coords := make([][2]float32, 0, len(points))
+	for i, pt := range points {
+		if len(pt) != 2 {
+			return nil, fmt.Errorf("point %v has %v coordinates, expected 2", i, len(pt))
+		}
+		coords = append(coords, [2]float32{pt[0], pt[1]})
+	}
+	return &an.Points{Coordinates: coords}, nil
+}
+
+// attributesFromModel leaves attributes nil when omitted, which keeps those
+// of modified regions.
+func attributesFromModel(attributes *models.AnnotationAttributes) an.Attributes {
+	if attributes == nil {
+		return nil
+	}
+	return an.Attributes(*attributes)
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/assignment"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/finish"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/next"
+)
+
+func (s *Server) CreateAssignmentBatch(w http.ResponseWriter, r *http.Request) {
+	body, ok := json.MustDecodeJSON[models.NewAssignmentBatch](w, r)
+	if !ok {
+		return
+	}
+	req := create.Request{Name: body.Name}
+	if body.Filter != nil {
+		req.Filter = *body.Filter
+	}
+	if body.Users != nil {
+		req.Users = *body.Users
+	}
+	if body.Groups != nil {
+		req.Groups = *body.Groups
+	}
+	s.Assignment.Create.Execute(r.Context(), req, p.NewCreatePresenter(w, s.Logger))
+}
+
+func (s *Server) ListAssignmentBatches(w http.ResponseWriter, r *http.Request, params ListAssignmentBatchesParams) {
+	req := pa.PaginationParams{}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	s.Assignment.List.Execute(r.Context(), req, p.NewListPresenter(w, s.Logger))
+}
+
+func (s *Server) DeleteAssignmentBatch(w http.ResponseWriter, r *http.Request, batchId string) {
+	s.Assignment.Delete.Execute(r.Context(), batchId, p.NewDeletePresenter(w, s.Logger))
+}
+
+func (s *Server) ListMyAssignmentBatches(w http.ResponseWriter, r *http.Request) {
+	s.Assignment.Mine.Execute(r.Context(), p.NewListPresenter(w, s.Logger))
+}
+
+func (s *Server) NextAssignment(w http.ResponseWriter, r *http.Request, params NextAssignmentParams) {
+	req := next.Request{}
+	if params.ImageId != nil {
+		req.ImageId = *params.ImageId
+	}
+	if params.Collection != nil {
+		req.Collection = *params.Collection
+	}
+	s.Assignment.Next.Execute(r.Context(), req, p.NewNextPresenter(w, s.Logger))
+}
+
+func (s *Server) FinishAssignment(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	s.Assignment.Finish.Execute(r.Context(), finish.Request{ImageId: imageId, Collection: name},
+		p.NewFinishPresenter(w, s.Logger))
+}
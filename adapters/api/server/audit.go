@@ -0,0 +1,41 @@
+package server
+
+import (
+	"net/http"
+
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/audit"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+)
+
+func search(actor, action, from, to *string) list.Search {
+	s := list.Search{}
+	if actor != nil {
+		s.Actor = *actor
+	}
+	if action != nil {
+		s.Action = *action
+	}
+	if from != nil {
+		s.From = *from
+	}
+	if to != nil {
+		s.To = *to
+	}
+	return s
+}
+
+func (s *Server) ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams) {
+	req := list.Request{Search: search(params.Actor, params.Action, params.From, params.To)}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	s.Audit.List.Execute(r.Context(), req, p.NewListPresenter(w, s.Logger))
+}
+
+func (s *Server) ExportAuditLog(w http.ResponseWriter, r *http.Request, params ExportAuditLogParams) {
+	s.Audit.Export.Execute(r.Context(), search(params.Actor, params.Action, params.From, params.To),
+		p.NewExportPresenter(w, s.Logger))
+}
@@ -6,8 +6,12 @@ import (
 	"github.com/lejeunel/go-image-annotator/adapters/api/json"
 	presenter "github.com/lejeunel/go-image-annotator/adapters/api/json/collection"
 	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
 	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/create"
+	download_export "github.com/lejeunel/go-image-annotator/use-cases/collection/download-export"
+	export_task "github.com/lejeunel/go-image-annotator/use-cases/collection/export-task"
+	set_labels "github.com/lejeunel/go-image-annotator/use-cases/collection/set-labels"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/update"
 )
 
@@ -58,3 +62,59 @@ func (s *Server) UpdateCollectionByName(w http.ResponseWriter, r *http.Request,
 		update.Request{Name: name, NewName: body.Name, NewDescription: body.Description},
 		presenter.NewUpdatePresenter(w, s.Logger))
 }
+
+func (s *Server) SetCollectionLabels(w http.ResponseWriter, r *http.Request, name string) {
+	body, ok := json.MustDecodeJSON[models.CollectionLabels](w, r)
+	if !ok {
+		return
+	}
+
+	s.Collection.SetLabels.Execute(r.Context(),
+		set_labels.Request{Collection: name, Labels: body.Labels, Default: body.DefaultLabel},
+		presenter.NewSetLabelsPresenter(w, s.Logger))
+}
+
+func (s *Server) ExportCollection(
+	w http.ResponseWriter,
+	r *http.Request,
+	name string,
+	params ExportCollectionParams,
+) {
+	req := export_task.Request{Collection: name, Format: ax.COCOFormat.String()}
+	if f := params.Format; f != nil {
+		req.Format = *f
+	}
+	if f := params.Filter; f != nil {
+		req.Filter = *f
+	}
+	if s := params.ReviewStatus; s != nil {
+		req.ReviewStatus = *s
+	}
+	if params.Train != nil || params.Val != nil || params.Test != nil {
+		split := ax.Split{}
+		if params.Train != nil {
+			split.Train = *params.Train
+		}
+		if params.Val != nil {
+			split.Val = *params.Val
+		}
+		if params.Test != nil {
+			split.Test = *params.Test
+		}
+		if params.Seed != nil {
+			split.Seed = uint64(*params.Seed)
+		}
+		if params.Stratify != nil {
+			split.Stratify = *params.Stratify
+		}
+		req.Split = &split
+	}
+	s.Collection.ExportTask.Execute(r.Context(), req,
+		presenter.NewExportPresenter(w, s.Logger))
+}
+
+func (s *Server) DownloadExport(w http.ResponseWriter, r *http.Request, taskId string) {
+	s.Collection.DownloadExport.Execute(r.Context(),
+		download_export.Request{TaskId: taskId},
+		presenter.NewDownloadPresenter(w, s.Logger))
+}
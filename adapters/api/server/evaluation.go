@@ -0,0 +1,42 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/evaluation"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/evaluate"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/find"
+)
+
+func (s *Server) CreateEvaluation(w http.ResponseWriter, r *http.Request) {
+	body, ok := json.MustDecodeJSON[models.NewEvaluation](w, r)
+	if !ok {
+		return
+	}
+	req := evaluate.Request{GroundTruth: body.GroundTruth, Predictions: body.Predictions}
+	if body.Filter != nil {
+		req.Filter = *body.Filter
+	}
+	if body.MinConfidence != nil {
+		req.MinConfidence = *body.MinConfidence
+	}
+	s.Evaluation.Evaluate.Execute(r.Context(), req, p.NewPresenter(w, s.Logger))
+}
+
+func (s *Server) ListEvaluations(w http.ResponseWriter, r *http.Request, params ListEvaluationsParams) {
+	req := pa.PaginationParams{}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	s.Evaluation.List.Execute(r.Context(), req, p.NewPresenter(w, s.Logger))
+}
+
+func (s *Server) FindEvaluation(w http.ResponseWriter, r *http.Request, taskId string) {
+	s.Evaluation.Find.Execute(r.Context(), find.Request{Id: taskId}, p.NewPresenter(w, s.Logger))
+}
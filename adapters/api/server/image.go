@@ -10,9 +10,10 @@ import (
 	"github.com/lejeunel/go-image-annotator/adapters/api/models"
 	an "github.com/lejeunel/go-image-annotator/entities/annotation"
 	ig "github.com/lejeunel/go-image-annotator/modules/image-ingester"
-	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/image/raw"
+	rawmask "github.com/lejeunel/go-image-annotator/use-cases/image/raw-mask"
 )
 
 func (s *Server) IngestImage(w http.ResponseWriter, r *http.Request) {
@@ -59,21 +60,32 @@ func (s *Server) IngestImage(w http.ResponseWriter, r *http.Request) {
 		presenter.NewIngestPresenter(w, s.Logger))
 }
 
-func (s *Server) ReadRawImage(w http.ResponseWriter, r *http.Request, imageId string) {
-	s.Image.Raw.Execute(imageId, presenter.NewRawImagePresenter(w, s.Logger))
+func (s *Server) ReadRawImage(w http.ResponseWriter, r *http.Request, imageId string,
+	params ReadRawImageParams) {
+	req := raw.Request{ImageId: imageId}
+	if params.Size != nil {
+		req.Size = *params.Size
+	}
+	s.Image.Raw.Execute(r.Context(), req, presenter.NewRawImagePresenter(w, r, s.Logger))
+}
+
+func (s *Server) ReadRawMask(w http.ResponseWriter, r *http.Request, collectionName, imageId string) {
+	s.Image.RawMask.Execute(r.Context(), rawmask.Request{ImageId: imageId, Collection: collectionName},
+		presenter.NewRawMaskPresenter(w, s.Logger))
 }
 
 func (s *Server) ReadImage(w http.ResponseWriter, r *http.Request, collectionName, imageId string) {
-	s.Image.Find.Execute(find.Request{ImageId: imageId, Collection: collectionName},
+	s.Image.Find.Execute(r.Context(), find.Request{ImageId: imageId, Collection: collectionName},
 		presenter.NewReadMetaPresenter(w, s.Logger))
 }
 
 func (s *Server) ListImages(w http.ResponseWriter, r *http.Request, params ListImagesParams) {
-	req := list.Request{
-		PaginationParams: pa.PaginationParams{
-			PageSize: *params.PageSize,
-			Page:     *params.Page,
-		},
+	req := list.Request{}
+	if p := params.Page; p != nil {
+		req.Page = *p
+	}
+	if p := params.PageSize; p != nil {
+		req.PageSize = *p
 	}
 	if params.Filter != nil {
 		req.FilterStr = *params.Filter
@@ -81,7 +93,10 @@ func (s *Server) ListImages(w http.ResponseWriter, r *http.Request, params ListI
 	if params.Order != nil {
 		req.OrderStr = *params.Order
 	}
-	s.Image.List.Execute(req, presenter.NewListPresenter(w, s.Logger))
+	if params.View != nil {
+		req.View = *params.View
+	}
+	s.Image.List.Execute(r.Context(), req, presenter.NewListPresenter(w, s.Logger))
 }
 
 func NewIngestImageRequest(meta models.NewImage, reader io.Reader) ig.Request {
@@ -99,7 +114,8 @@ func appendBoundingBoxesToIngestImageRequest(req *ig.Request, boxes *[]models.Ne
 		for _, box := range *boxes {
 			req.BoundingBoxes = append(req.BoundingBoxes,
 				an.BoundingBoxRequest{
-					Xc: box.Xc, Yc: box.Yc,
+					Label: box.Label,
+					Xc:    box.Xc, Yc: box.Yc,
 					Width: box.Width, Height: box.Height,
 				})
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/lejeunel/go-image-annotator/adapters/api/models"
 	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/update"
 )
 
 func (s *Server) FindLabelByName(w http.ResponseWriter, r *http.Request, name string) {
@@ -19,13 +20,50 @@ func (s *Server) CreateLabel(w http.ResponseWriter, r *http.Request) {
 	if !ok {
 		return
 	}
-	req := create.Request{Name: body.Name}
+	req := create.Request{Name: body.Name, Parent: body.Parent}
 	if body.Description != nil {
 		req.Description = *body.Description
 	}
+	if body.Color != nil {
+		req.Color = *body.Color
+	}
+	if body.Aliases != nil {
+		req.Aliases = *body.Aliases
+	}
+	req.Attributes = p.AttributesFromModel(body.Attributes)
+	skeleton, err := p.SkeletonFromModel(body.Skeleton)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Skeleton = skeleton
 	s.Label.Create.Execute(r.Context(), req, p.NewCreatePresenter(w, s.Logger))
 }
 
+func (s *Server) UpdateLabel(w http.ResponseWriter, r *http.Request, name string) {
+	body, ok := json.MustDecodeJSON[models.LabelUpdate](w, r)
+	if !ok {
+		return
+	}
+	req := update.Request{Name: name, NewDescription: body.Description, NewParent: body.Parent,
+		NewColor: body.Color, NewAliases: body.Aliases}
+	if body.Attributes != nil {
+		attributes := p.AttributesFromModel(body.Attributes)
+		req.NewAttributes = &attributes
+	}
+	skeleton, err := p.SkeletonFromModel(body.Skeleton)
+	if err != nil {
+		json.WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.NewSkeleton = skeleton
+	s.Label.Update.Execute(r.Context(), req, p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) GetLabelTree(w http.ResponseWriter, r *http.Request) {
+	s.Label.Tree.Execute(r.Context(), p.NewTreePresenter(w, s.Logger))
+}
+
 func (s *Server) DeleteLabelByName(w http.ResponseWriter, r *http.Request, name string) {
 	s.Label.Delete.Execute(r.Context(), name, p.NewDeletePresenter(w, s.Logger))
 }
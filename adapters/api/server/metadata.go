@@ -0,0 +1,51 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/metadata"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/add"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/delete"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/read"
+	"github.com/lejeunel/go-image-annotator/use-cases/metadata/update"
+)
+
+func (s *Server) ListImageMetadata(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	s.Metadata.List.Execute(r.Context(), list.Request{ImageId: imageId, Collection: name},
+		p.NewListPresenter(w, s.Logger))
+}
+
+func (s *Server) ReadImageMetadata(w http.ResponseWriter, r *http.Request, name, imageId, key string) {
+	s.Metadata.Read.Execute(r.Context(),
+		read.Request{ImageId: imageId, Collection: name, Key: key},
+		p.NewReadPresenter(w, s.Logger))
+}
+
+func (s *Server) SetImageMetadata(w http.ResponseWriter, r *http.Request, name, imageId, key string) {
+	body, ok := json.MustDecodeJSON[models.MetadataValue](w, r)
+	if !ok {
+		return
+	}
+	s.Metadata.Add.Execute(r.Context(),
+		add.Request{ImageId: imageId, Collection: name, Key: key, Value: body.Value},
+		p.NewAddPresenter(w, s.Logger))
+}
+
+func (s *Server) UpdateImageMetadata(w http.ResponseWriter, r *http.Request, name, imageId, key string) {
+	body, ok := json.MustDecodeJSON[models.MetadataValue](w, r)
+	if !ok {
+		return
+	}
+	s.Metadata.Update.Execute(r.Context(),
+		update.Request{ImageId: imageId, Collection: name, Key: key, Value: body.Value},
+		p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) DeleteImageMetadata(w http.ResponseWriter, r *http.Request, name, imageId, key string) {
+	s.Metadata.Delete.Execute(r.Context(),
+		delete.Request{ImageId: imageId, Collection: name, Key: key},
+		p.NewDeletePresenter(w, s.Logger))
+}
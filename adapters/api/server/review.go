@@ -0,0 +1,44 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/review"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/queue"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/review"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/submit"
+)
+
+func (s *Server) SubmitForReview(w http.ResponseWriter, r *http.Request, name, imageId string) {
+	s.Review.Submit.Execute(r.Context(),
+		submit.Request{ImageId: imageId, Collection: name},
+		p.NewSubmitPresenter(w, s.Logger))
+}
+
+func (s *Server) ReviewAnnotation(w http.ResponseWriter, r *http.Request, annotationId string) {
+	body, ok := json.MustDecodeJSON[models.NewReview](w, r)
+	if !ok {
+		return
+	}
+	req := review.Request{AnnotationId: annotationId, Status: string(body.Status)}
+	if body.Comment != nil {
+		req.Comment = *body.Comment
+	}
+	s.Review.Review.Execute(r.Context(), req, p.NewReviewPresenter(w, s.Logger))
+}
+
+func (s *Server) ListReviewQueue(w http.ResponseWriter, r *http.Request, params ListReviewQueueParams) {
+	req := queue.Request{}
+	if params.Collection != nil {
+		req.Collection = *params.Collection
+	}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	s.Review.Queue.Execute(r.Context(), req, p.NewQueuePresenter(w, s.Logger))
+}
@@ -9,13 +9,203 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for NewReviewStatus.
+const (
+	Accepted NewReviewStatus = "accepted"
+	Rejected NewReviewStatus = "rejected"
+)
+
+// Agreement defines model for Agreement.
+type Agreement struct {
+	Authors     []string          `json:"authors"`
+	Collections []string          `json:"collections"`
+	Consensus   *ConsensusSummary `json:"consensus,omitempty"`
+
+	// CreatedAt time of completion
+	CreatedAt    time.Time             `json:"created_at"`
+	Filter       string                `json:"filter"`
+	Id           string                `json:"id"`
+	ImageLabels  []ImageLabelAgreement `json:"image_labels"`
+	Images       []ImageAgreement      `json:"images"`
+	IouThreshold float64               `json:"iou_threshold"`
+
+	// Issuer ID of the user who requested the analysis
+	Issuer string `json:"issuer"`
+
+	// Kappa Fleiss kappa on image labels, over all images and labels
+	Kappa  float64          `json:"kappa"`
+	Labels []LabelAgreement `json:"labels"`
+
+	// MeanIou mean IoU of matched regions
+	MeanIou float64 `json:"mean_iou"`
+
+	// NumImages number of images shared by all collections
+	NumImages int             `json:"num_images"`
+	Pairs     []PairAgreement `json:"pairs"`
+	Raters    []string        `json:"raters"`
+
+	// RegionF1 F1 score of regions matched by IoU and label, over all images and pairs of raters
+	RegionF1 float64 `json:"region_f1"`
+}
+
+// AnnotationAttributes values of the attributes of the label, by name
+type AnnotationAttributes map[string]interface{}
+
+// AnnotationLabel defines model for AnnotationLabel.
+type AnnotationLabel struct {
+	// Label Name of the label
+	Label string `json:"label"`
+}
+
+// AnnotationRevision defines model for AnnotationRevision.
+type AnnotationRevision struct {
+	// Action change made to the annotation (created, modified, deleted or reverted)
+	Action string           `json:"action"`
+	After  *AnnotationState `json:"after,omitempty"`
+
+	// AnnotationId ID of the annotation
+	AnnotationId string `json:"annotation_id"`
+
+	// Author ID of the user who made the change
+	Author *string          `json:"author,omitempty"`
+	Before *AnnotationState `json:"before,omitempty"`
+
+	// Id ID of the revision
+	Id string `json:"id"`
+
+	// Restores ID of the revision a revert brought the annotation back to
+	Restores *string `json:"restores,omitempty"`
+
+	// Time time of the change
+	Time *time.Time `json:"time,omitempty"`
+}
+
+// AnnotationState defines model for AnnotationState.
+type AnnotationState struct {
+	// Angle angle of a bounding box
+	Angle *float32 `json:"angle,omitempty"`
+
+	// Height height of a bounding box
+	Height *float32 `json:"height,omitempty"`
+
+	// Keypoints keypoints of a pose
+	Keypoints *[]Keypoint `json:"keypoints,omitempty"`
+
+	// Label label
+	Label string `json:"label"`
+
+	// Points points of a polygon or polyline
+	Points *[]Point `json:"points,omitempty"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle *RLE `json:"rle,omitempty"`
+
+	// Type type of annotation (image, bounding_box, polygon, polyline, keypoints or mask)
+	Type string `json:"type"`
+
+	// Width width of a bounding box
+	Width *float32 `json:"width,omitempty"`
+
+	// Xc x coordinate of the center point of a bounding box
+	Xc *float32 `json:"xc,omitempty"`
+
+	// Yc y coordinate of the center point of a bounding box
+	Yc *float32 `json:"yc,omitempty"`
+}
+
+// AssigneeProgress defines model for AssigneeProgress.
+type AssigneeProgress struct {
+	// Assignee ID of the user
+	Assignee string `json:"assignee"`
+
+	// Finished number of images the user finished
+	Finished int64 `json:"finished"`
+
+	// Total number of images assigned to the user
+	Total int64 `json:"total"`
+}
+
+// Assignment defines model for Assignment.
+type Assignment struct {
+	// BatchId Id of the batch
+	BatchId string `json:"batch_id"`
+
+	// Collection name of the collection
+	Collection string `json:"collection"`
+
+	// ImageId ID of the image
+	ImageId string `json:"image_id"`
+}
+
+// AssignmentBatch defines model for AssignmentBatch.
+type AssignmentBatch struct {
+	Assignees []AssigneeProgress `json:"assignees"`
+
+	// CreatedAt time of creation
+	CreatedAt time.Time `json:"created_at"`
+
+	// Creator ID of the user who created the batch
+	Creator string `json:"creator"`
+
+	// Filter Filtering expression that selected the images
+	Filter string `json:"filter"`
+
+	// Finished number of finished images
+	Finished int64 `json:"finished"`
+
+	// Id Id of the batch
+	Id string `json:"id"`
+
+	// Name Name of the batch
+	Name string `json:"name"`
+
+	// Total number of assigned images
+	Total int64 `json:"total"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Action name of the operation
+	Action string `json:"action"`
+
+	// Actor ID of the user who performed the operation, empty if anonymous
+	Actor string `json:"actor"`
+
+	// Error error message of a denied or failed operation
+	Error *string `json:"error,omitempty"`
+
+	// Outcome outcome of the operation (success, denied or failure)
+	Outcome string `json:"outcome"`
+
+	// RequestId ID of the HTTP request, as sent in the X-Request-Id header
+	RequestId string `json:"request_id"`
+
+	// TargetId identifier of the resource the operation applies to
+	TargetId string `json:"target_id"`
+
+	// TargetType type of the resource the operation applies to
+	TargetType string `json:"target_type"`
+
+	// Time time at which the operation ended
+	Time time.Time `json:"time"`
+}
+
 // BoundingBox defines model for BoundingBox.
 type BoundingBox struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
 	// Height height of the bounding box
 	Height float32 `json:"height"`
 
@@ -25,6 +215,30 @@ type BoundingBox struct {
 	// Label label
 	Label string `json:"label"`
 
+	// Model model run that predicted the bounding box, if any
+	Model *string `json:"model,omitempty"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+
+	// Width width of the bounding box
+	Width float32 `json:"width"`
+
+	// Xc x coordinate of the center point
+	Xc float32 `json:"xc"`
+
+	// Yc y coordinate of the center point
+	Yc float32 `json:"yc"`
+}
+
+// BoxCoordinates defines model for BoxCoordinates.
+type BoxCoordinates struct {
+	// Angle rotation angle of the bounding box
+	Angle *float32 `json:"angle,omitempty"`
+
+	// Height height of the bounding box
+	Height float32 `json:"height"`
+
 	// Width width of the bounding box
 	Width float32 `json:"width"`
 
@@ -37,13 +251,50 @@ type BoundingBox struct {
 
 // Collection defines model for Collection.
 type Collection struct {
+	// DefaultLabel label given to regions ingested without one
+	DefaultLabel *string `json:"default_label,omitempty"`
+
 	// Description Description of the collection
 	Description *string `json:"description,omitempty"`
 
+	// Labels labels allowed in the collection, all labels when absent
+	Labels *[]string `json:"labels,omitempty"`
+
 	// Name Name of the collection
 	Name string `json:"name"`
 }
 
+// CollectionLabels defines model for CollectionLabels.
+type CollectionLabels struct {
+	// DefaultLabel label given to regions ingested without one
+	DefaultLabel *string `json:"default_label,omitempty"`
+
+	// Labels names or aliases of the allowed labels
+	Labels []string `json:"labels"`
+}
+
+// ConfusionMatrix defines model for ConfusionMatrix.
+type ConfusionMatrix struct {
+	// Counts number of ground truth regions (rows) matched by predictions
+	// (columns) at an IoU of 0.5. The last row and column stand for
+	// background, i.e. unmatched predictions and missed ground truth.
+	Counts [][]int  `json:"counts"`
+	Labels []string `json:"labels"`
+}
+
+// ConsensusSummary defines model for ConsensusSummary.
+type ConsensusSummary struct {
+	Collection string `json:"collection"`
+	Method     string `json:"method"`
+	MinVotes   int    `json:"min_votes"`
+
+	// NumImageLabels number of merged image labels
+	NumImageLabels int `json:"num_image_labels"`
+
+	// NumRegions number of merged regions
+	NumRegions int `json:"num_regions"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code Error code
@@ -53,6 +304,51 @@ type Error struct {
 	Message string `json:"message"`
 }
 
+// Evaluation defines model for Evaluation.
+type Evaluation struct {
+	Confusion ConfusionMatrix `json:"confusion"`
+
+	// CreatedAt time of completion
+	CreatedAt time.Time `json:"created_at"`
+
+	// Filter query string restricting evaluated images
+	Filter string `json:"filter"`
+
+	// GroundTruth name of the collection holding ground truth
+	GroundTruth string `json:"ground_truth"`
+
+	// Id ID of the evaluation task
+	Id string `json:"id"`
+
+	// Issuer ID of the user who requested the evaluation
+	Issuer string         `json:"issuer"`
+	Labels []LabelMetrics `json:"labels"`
+
+	// Map mean average precision over IoU thresholds from 0.5 to 0.95
+	Map float64 `json:"map"`
+
+	// Map50 mean average precision at an IoU of 0.5
+	Map50 float64 `json:"map50"`
+
+	// Map75 mean average precision at an IoU of 0.75
+	Map75 float64 `json:"map75"`
+
+	// MinConfidence minimum confidence of predictions in the confusion matrix
+	MinConfidence float32 `json:"min_confidence"`
+
+	// NumGroundTruth number of ground truth regions
+	NumGroundTruth int `json:"num_ground_truth"`
+
+	// NumImages number of images shared by both collections
+	NumImages int `json:"num_images"`
+
+	// NumPredictions number of predicted regions
+	NumPredictions int `json:"num_predictions"`
+
+	// Predictions name of the collection holding predictions
+	Predictions string `json:"predictions"`
+}
+
 // Image defines model for Image.
 type Image struct {
 	BoundingBoxes *[]BoundingBox `json:"bounding_boxes,omitempty"`
@@ -61,10 +357,27 @@ type Image struct {
 	Collection string `json:"collection"`
 
 	// Id ID of the image
-	Id       string                  `json:"id"`
-	Labels   *[]string               `json:"labels,omitempty"`
-	Meta     *map[string]interface{} `json:"meta,omitempty"`
-	Polygons *[]Polygon              `json:"polygons,omitempty"`
+	Id        string                  `json:"id"`
+	Keypoints *[]Keypoints            `json:"keypoints,omitempty"`
+	Labels    *[]string               `json:"labels,omitempty"`
+	Masks     *[]Mask                 `json:"masks,omitempty"`
+	Meta      *map[string]interface{} `json:"meta,omitempty"`
+	Polygons  *[]Polygon              `json:"polygons,omitempty"`
+	Polylines *[]Polyline             `json:"polylines,omitempty"`
+}
+
+// ImageAgreement defines model for ImageAgreement.
+type ImageAgreement struct {
+	ImageId string `json:"image_id"`
+
+	// MeanIou mean IoU of matched regions
+	MeanIou float64 `json:"mean_iou"`
+
+	// NumRegions number of regions of all raters
+	NumRegions int `json:"num_regions"`
+
+	// RegionF1 F1 score of matched regions, averaged over pairs of raters
+	RegionF1 float64 `json:"region_f1"`
 }
 
 // ImageIngestionResponse defines model for ImageIngestionResponse.
@@ -73,13 +386,196 @@ type ImageIngestionResponse struct {
 	Id *string `json:"id,omitempty"`
 }
 
+// ImageLabelAgreement defines model for ImageLabelAgreement.
+type ImageLabelAgreement struct {
+	// Count number of times raters assigned this label
+	Count int `json:"count"`
+
+	// Kappa Fleiss kappa on whether images carry this label
+	Kappa float64 `json:"kappa"`
+	Label string  `json:"label"`
+}
+
+// ImageMetadata defines model for ImageMetadata.
+type ImageMetadata map[string]interface{}
+
+// ImportPredictionsResponse defines model for ImportPredictionsResponse.
+type ImportPredictionsResponse struct {
+	// Imported number of predictions imported
+	Imported int `json:"imported"`
+}
+
+// Keypoint defines model for Keypoint.
+type Keypoint struct {
+	// Visibility 0 when not labeled, 1 when occluded, 2 when visible
+	Visibility int `json:"visibility"`
+
+	// X x coordinate of the keypoint
+	X float32 `json:"x"`
+
+	// Y y coordinate of the keypoint
+	Y float32 `json:"y"`
+}
+
+// Keypoints defines model for Keypoints.
+type Keypoints struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
+	// Id ID of the pose
+	Id string `json:"id"`
+
+	// Label Label of the pose
+	Label string `json:"label"`
+
+	// Model model run that predicted the pose, if any
+	Model *string `json:"model,omitempty"`
+
+	// Points one keypoint per point of the skeleton of the label, in the same order
+	Points []Keypoint `json:"points"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+}
+
 // Label defines model for Label.
 type Label struct {
+	// Aliases Alternative names of the label, matched on import
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Attributes Attributes of regions with this label
+	Attributes *[]LabelAttribute `json:"attributes,omitempty"`
+
+	// Color Display color of the label, as #rrggbb
+	Color *string `json:"color,omitempty"`
+
 	// Description Description of the label
 	Description *string `json:"description,omitempty"`
 
 	// Name Name of the label
 	Name *string `json:"name,omitempty"`
+
+	// Parent Name of the parent label
+	Parent *string `json:"parent,omitempty"`
+
+	// Skeleton Keypoints of poses with this label
+	Skeleton *Skeleton `json:"skeleton,omitempty"`
+}
+
+// LabelAgreement defines model for LabelAgreement.
+type LabelAgreement struct {
+	Label string `json:"label"`
+
+	// MeanIou mean IoU of matched regions
+	MeanIou float64 `json:"mean_iou"`
+
+	// NumRegions number of regions of all raters with this label
+	NumRegions int `json:"num_regions"`
+
+	// RegionF1 F1 score of matched regions, over all pairs of raters
+	RegionF1 float64 `json:"region_f1"`
+}
+
+// LabelAttribute defines model for LabelAttribute.
+type LabelAttribute struct {
+	// Default value of regions that have none
+	Default interface{} `json:"default,omitempty"`
+
+	// Name Name of the attribute
+	Name string `json:"name"`
+
+	// Options allowed values of enum attributes
+	Options *[]string `json:"options,omitempty"`
+
+	// Required Whether regions must have a value to be submitted for review
+	Required *bool `json:"required,omitempty"`
+
+	// Type type of the values (bool, enum, number or text)
+	Type string `json:"type"`
+}
+
+// LabelMetrics defines model for LabelMetrics.
+type LabelMetrics struct {
+	// Ap average precision averaged over IoU thresholds from 0.5 to 0.95
+	Ap float64 `json:"ap"`
+
+	// Ap50 average precision at an IoU of 0.5
+	Ap50 float64 `json:"ap50"`
+
+	// Ap75 average precision at an IoU of 0.75
+	Ap75  float64 `json:"ap75"`
+	Label string  `json:"label"`
+
+	// NumGroundTruth number of ground truth regions with this label
+	NumGroundTruth int `json:"num_ground_truth"`
+
+	// NumPredictions number of predicted regions with this label
+	NumPredictions int `json:"num_predictions"`
+
+	// PrCurve precision at 101 recall levels, at an IoU of 0.5
+	PrCurve []PRPoint `json:"pr_curve"`
+}
+
+// LabelNode defines model for LabelNode.
+type LabelNode struct {
+	Aliases     []string    `json:"aliases"`
+	Children    []LabelNode `json:"children"`
+	Color       string      `json:"color"`
+	Description string      `json:"description"`
+	Name        string      `json:"name"`
+}
+
+// LabelTree defines model for LabelTree.
+type LabelTree struct {
+	// Roots labels without a parent
+	Roots []LabelNode `json:"roots"`
+}
+
+// LabelUpdate defines model for LabelUpdate.
+type LabelUpdate struct {
+	// Aliases Alternative names of the label, matched on import
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Attributes Attributes of regions with this label
+	Attributes *[]LabelAttribute `json:"attributes,omitempty"`
+
+	// Color Display color of the label, as #rrggbb
+	Color *string `json:"color,omitempty"`
+
+	// Description Description of the label
+	Description *string `json:"description,omitempty"`
+
+	// Parent Name or alias of the parent label, none when omitted
+	Parent *string `json:"parent,omitempty"`
+
+	// Skeleton Keypoints of poses with this label
+	Skeleton *Skeleton `json:"skeleton,omitempty"`
+}
+
+// ListAgreementsResponse defines model for ListAgreementsResponse.
+type ListAgreementsResponse struct {
+	Data       *[]Agreement `json:"data,omitempty"`
+	Pagination Pagination   `json:"pagination"`
+}
+
+// ListAnnotationRevisionsResponse defines model for ListAnnotationRevisionsResponse.
+type ListAnnotationRevisionsResponse struct {
+	Revisions []AnnotationRevision `json:"revisions"`
+}
+
+// ListAssignmentBatchesResponse defines model for ListAssignmentBatchesResponse.
+type ListAssignmentBatchesResponse struct {
+	Data       *[]AssignmentBatch `json:"data,omitempty"`
+	Pagination Pagination         `json:"pagination"`
+}
+
+// ListAuditLogResponse defines model for ListAuditLogResponse.
+type ListAuditLogResponse struct {
+	Data       *[]AuditEntry `json:"data,omitempty"`
+	Pagination Pagination    `json:"pagination"`
 }
 
 // ListCollectionsResponse defines model for ListCollectionsResponse.
@@ -88,6 +584,12 @@ type ListCollectionsResponse struct {
 	Pagination Pagination    `json:"pagination"`
 }
 
+// ListEvaluationsResponse defines model for ListEvaluationsResponse.
+type ListEvaluationsResponse struct {
+	Data       *[]Evaluation `json:"data,omitempty"`
+	Pagination Pagination    `json:"pagination"`
+}
+
 // ListImagesResponse defines model for ListImagesResponse.
 type ListImagesResponse struct {
 	Images     []Image    `json:"images"`
@@ -100,8 +602,129 @@ type ListLabelsResponse struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// ListMyAssignmentBatchesResponse defines model for ListMyAssignmentBatchesResponse.
+type ListMyAssignmentBatchesResponse struct {
+	Data *[]AssignmentBatch `json:"data,omitempty"`
+}
+
+// ListReviewQueueResponse defines model for ListReviewQueueResponse.
+type ListReviewQueueResponse struct {
+	Data       *[]Submission `json:"data,omitempty"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// ListViewsResponse defines model for ListViewsResponse.
+type ListViewsResponse struct {
+	Data       *[]View    `json:"data,omitempty"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// Mask defines model for Mask.
+type Mask struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
+	// Id ID of the mask
+	Id string `json:"id"`
+
+	// Label Label of the mask
+	Label string `json:"label"`
+
+	// Model model run that predicted the mask, if any
+	Model *string `json:"model,omitempty"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle RLE `json:"rle"`
+}
+
+// MaskUpdate defines model for MaskUpdate.
+type MaskUpdate struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the mask
+	Label string `json:"label"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle RLE `json:"rle"`
+}
+
+// MetadataEntry defines model for MetadataEntry.
+type MetadataEntry struct {
+	// Key Metadata key
+	Key string `json:"key"`
+
+	// Value Metadata value
+	Value interface{} `json:"value"`
+}
+
+// MetadataValue defines model for MetadataValue.
+type MetadataValue struct {
+	// Value Metadata value
+	Value interface{} `json:"value"`
+}
+
+// NewAgreement defines model for NewAgreement.
+type NewAgreement struct {
+	// Authors when given, these authors are the raters instead of the collections
+	Authors *[]string `json:"authors,omitempty"`
+
+	// Collections collections whose shared images are compared
+	Collections []string      `json:"collections"`
+	Consensus   *NewConsensus `json:"consensus,omitempty"`
+
+	// Filter query string restricting compared images, e.g. meta.site:lab
+	Filter *string `json:"filter,omitempty"`
+
+	// IouThreshold overlap above which regions of two raters match, 0.5 by default
+	IouThreshold *float64 `json:"iou_threshold,omitempty"`
+}
+
+// NewAnnotationResponse defines model for NewAnnotationResponse.
+type NewAnnotationResponse struct {
+	// Id ID of the created annotation
+	Id string `json:"id"`
+}
+
+// NewAnnotationsResponse defines model for NewAnnotationsResponse.
+type NewAnnotationsResponse struct {
+	// Ids IDs of the created annotations
+	Ids []string `json:"ids"`
+}
+
+// NewAssignmentBatch defines model for NewAssignmentBatch.
+type NewAssignmentBatch struct {
+	// Filter Filtering expression selecting the images to assign
+	Filter *string `json:"filter,omitempty"`
+
+	// Groups Groups whose members are assigned images
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Name Name of the batch
+	Name string `json:"name"`
+
+	// Users Users to assign images to
+	Users *[]string `json:"users,omitempty"`
+}
+
 // NewBoundingBox defines model for NewBoundingBox.
 type NewBoundingBox struct {
+	// Angle rotation angle of the bounding box
+	Angle *float32 `json:"angle,omitempty"`
+
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
 	// Height height of the bounding box
 	Height float32 `json:"height"`
 
@@ -127,6 +750,38 @@ type NewCollection struct {
 	Name string `json:"name"`
 }
 
+// NewConsensus defines model for NewConsensus.
+type NewConsensus struct {
+	// Collection name of the collection to create with the consensus
+	Collection string `json:"collection"`
+
+	// Group group of the created collection
+	Group *string `json:"group,omitempty"`
+
+	// Method majority (default) keeps the region of each cluster that overlaps
+	// the others most, wbf averages clusters of boxes weighted by their
+	// confidence
+	Method *string `json:"method,omitempty"`
+
+	// MinVotes number of raters that must agree, a majority by default
+	MinVotes *int `json:"min_votes,omitempty"`
+}
+
+// NewEvaluation defines model for NewEvaluation.
+type NewEvaluation struct {
+	// Filter query string restricting evaluated images, e.g. meta.site:lab
+	Filter *string `json:"filter,omitempty"`
+
+	// GroundTruth name of the collection holding ground truth
+	GroundTruth string `json:"ground_truth"`
+
+	// MinConfidence predictions less confident than this are left out of the confusion matrix
+	MinConfidence *float32 `json:"min_confidence,omitempty"`
+
+	// Predictions name of the collection holding predictions
+	Predictions string `json:"predictions"`
+}
+
 // NewImage defines model for NewImage.
 type NewImage struct {
 	BoundingBoxes *[]NewBoundingBox `json:"bounding_boxes,omitempty"`
@@ -136,28 +791,149 @@ type NewImage struct {
 	Labels     *[]string `json:"labels,omitempty"`
 }
 
+// NewKeypoints defines model for NewKeypoints.
+type NewKeypoints struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the pose
+	Label string `json:"label"`
+
+	// Points one keypoint per point of the skeleton of the label, in the same order
+	Points []Keypoint `json:"points"`
+}
+
 // NewLabel defines model for NewLabel.
 type NewLabel struct {
+	// Aliases Alternative names of the label, matched on import
+	Aliases *[]string `json:"aliases,omitempty"`
+
+	// Attributes Attributes of regions with this label
+	Attributes *[]LabelAttribute `json:"attributes,omitempty"`
+
+	// Color Display color of the label, as #rrggbb
+	Color *string `json:"color,omitempty"`
+
 	// Description Description of the label
 	Description *string `json:"description,omitempty"`
 
 	// Name Name of the label
 	Name string `json:"name"`
-}
 
-// NewUser defines model for NewUser.
-type NewUser struct {
-	Groups *[]string `json:"groups,omitempty"`
+	// Parent Name or alias of the parent label
+	Parent *string `json:"parent,omitempty"`
 
-	// Id Id of the new user
-	Id    string    `json:"id"`
-	Roles *[]string `json:"roles,omitempty"`
+	// Skeleton Keypoints of poses with this label
+	Skeleton *Skeleton `json:"skeleton,omitempty"`
 }
 
-// Pagination defines model for Pagination.
-type Pagination struct {
-	// Page current page number
-	Page int64 `json:"page"`
+// NewMask defines model for NewMask.
+type NewMask struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the mask
+	Label string `json:"label"`
+
+	// Rle Binary mask in the run-length encoding of COCO. Counts alternate
+	// between runs of background and foreground pixels, starting with
+	// background, going down the columns of the image from left to right.
+	Rle *RLE `json:"rle,omitempty"`
+}
+
+// NewPolygon defines model for NewPolygon.
+type NewPolygon struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the polygon
+	Label  string  `json:"label"`
+	Points []Point `json:"points"`
+}
+
+// NewPolyline defines model for NewPolyline.
+type NewPolyline struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Label Label of the polyline
+	Label string `json:"label"`
+
+	// Points vertices of the polyline, from one end to the other
+	Points []Point `json:"points"`
+}
+
+// NewPrediction a shape predicted on an image, either a bounding box or a polygon
+type NewPrediction struct {
+	BoundingBox *BoxCoordinates `json:"bounding_box,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence float32 `json:"confidence"`
+
+	// ImageId ID of the image
+	ImageId string `json:"image_id"`
+
+	// Label predicted label
+	Label string `json:"label"`
+
+	// Polygon points of the polygon
+	Polygon *[]Point `json:"polygon,omitempty"`
+}
+
+// NewPredictions defines model for NewPredictions.
+type NewPredictions struct {
+	// Model name of the model run that produced the predictions
+	Model       string          `json:"model"`
+	Predictions []NewPrediction `json:"predictions"`
+}
+
+// NewReview defines model for NewReview.
+type NewReview struct {
+	// Comment comment of the reviewer, required upon rejection
+	Comment *string `json:"comment,omitempty"`
+
+	// Status decision of the reviewer
+	Status NewReviewStatus `json:"status"`
+}
+
+// NewReviewStatus decision of the reviewer
+type NewReviewStatus string
+
+// NewUser defines model for NewUser.
+type NewUser struct {
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Id Id of the new user
+	Id    string    `json:"id"`
+	Roles *[]string `json:"roles,omitempty"`
+}
+
+// NewView defines model for NewView.
+type NewView struct {
+	// Filter Filtering expression
+	Filter *string `json:"filter,omitempty"`
+
+	// Groups Groups the view is shared with
+	Groups *[]string `json:"groups,omitempty"`
+
+	// Name Name of the view
+	Name string `json:"name"`
+
+	// Order Ordering expression
+	Order *string `json:"order,omitempty"`
+}
+
+// PRPoint defines model for PRPoint.
+type PRPoint struct {
+	// Precision interpolated precision at this recall
+	Precision float64 `json:"precision"`
+	Recall    float64 `json:"recall"`
+}
+
+// Pagination defines model for Pagination.
+type Pagination struct {
+	// Page current page number
+	Page int64 `json:"page"`
 
 	// PageSize maximum number of items per page
 	PageSize int `json:"page_size"`
@@ -169,17 +945,152 @@ type Pagination struct {
 	TotalPages int64 `json:"total_pages"`
 }
 
+// PairAgreement defines model for PairAgreement.
+type PairAgreement struct {
+	// Kappa Cohen kappa on image labels
+	Kappa  float64  `json:"kappa"`
+	Raters []string `json:"raters"`
+
+	// RegionF1 F1 score of regions matched by IoU and label
+	RegionF1 float64 `json:"region_f1"`
+}
+
 // Point defines model for Point.
 type Point = []float32
 
 // Polygon defines model for Polygon.
 type Polygon struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
 	// Id ID of the polygon
 	Id string `json:"id"`
 
 	// Label Label of the polygon
-	Label  string  `json:"label"`
+	Label string `json:"label"`
+
+	// Model model run that predicted the polygon, if any
+	Model  *string `json:"model,omitempty"`
+	Points []Point `json:"points"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+}
+
+// Polyline defines model for Polyline.
+type Polyline struct {
+	// Attributes values of the attributes of the label, by name
+	Attributes *AnnotationAttributes `json:"attributes,omitempty"`
+
+	// Confidence confidence of the prediction, between 0 and 1
+	Confidence *float32 `json:"confidence,omitempty"`
+
+	// Id ID of the polyline
+	Id string `json:"id"`
+
+	// Label Label of the polyline
+	Label string `json:"label"`
+
+	// Model model run that predicted the polyline, if any
+	Model *string `json:"model,omitempty"`
+
+	// Points vertices of the polyline, from one end to the other
+	Points []Point `json:"points"`
+
+	// ReviewStatus review status (draft, submitted, accepted or rejected)
+	ReviewStatus *string `json:"review_status,omitempty"`
+}
+
+// RLE Binary mask in the run-length encoding of COCO. Counts alternate
+// between runs of background and foreground pixels, starting with
+// background, going down the columns of the image from left to right.
+type RLE struct {
+	// Counts lengths of the runs, summing to height times width
+	Counts []int `json:"counts"`
+
+	// Size height and width of the mask, in pixels
+	Size []int `json:"size"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	// AnnotationId ID of the annotation
+	AnnotationId string `json:"annotation_id"`
+
+	// Comment comment of the reviewer
+	Comment *string `json:"comment,omitempty"`
+
+	// ReviewedAt time of the review
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// Reviewer ID of the reviewer
+	Reviewer *string `json:"reviewer,omitempty"`
+
+	// Status review status (draft, submitted, accepted or rejected)
+	Status string `json:"status"`
+}
+
+// Skeleton defines model for Skeleton.
+type Skeleton struct {
+	// Edges pairs of names of the points joined by an edge
+	Edges *[][]string `json:"edges,omitempty"`
+
+	// Points names of the keypoints, in order
+	Points []string `json:"points"`
+}
+
+// Stroke defines model for Stroke.
+type Stroke struct {
+	// Points path of the brush, in pixels
 	Points []Point `json:"points"`
+
+	// Radius radius of the brush, in pixels
+	Radius float32 `json:"radius"`
+}
+
+// SubmitResponse defines model for SubmitResponse.
+type SubmitResponse struct {
+	// Submitted number of annotations submitted for review
+	Submitted int `json:"submitted"`
+}
+
+// Submission defines model for Submission.
+type Submission struct {
+	// AnnotationId ID of the annotation
+	AnnotationId string `json:"annotation_id"`
+
+	// Author ID of the author
+	Author *string `json:"author,omitempty"`
+
+	// Collection name of the collection
+	Collection string `json:"collection"`
+
+	// ImageId ID of the image
+	ImageId string `json:"image_id"`
+
+	// Label label of the annotation
+	Label string `json:"label"`
+
+	// TouchedAt time of the last modification
+	TouchedAt *time.Time `json:"touched_at,omitempty"`
+
+	// Type type of annotation (image, bounding_box, polygon, polyline, keypoints or mask)
+	Type string `json:"type"`
+}
+
+// Task defines model for Task.
+type Task struct {
+	// Id ID of the task
+	Id string `json:"id"`
+
+	// Issuer ID of the user who issued the task
+	Issuer string `json:"issuer"`
+
+	// Type type of the task
+	Type string `json:"type"`
 }
 
 // UpdateCollection defines model for UpdateCollection.
@@ -200,6 +1111,90 @@ type User struct {
 	Roles []string `json:"roles"`
 }
 
+// View defines model for View.
+type View struct {
+	// Filter Filtering expression
+	Filter string `json:"filter"`
+
+	// Groups Groups the view is shared with
+	Groups []string `json:"groups"`
+
+	// Id Id of the view
+	Id string `json:"id"`
+
+	// Name Name of the view
+	Name string `json:"name"`
+
+	// Order Ordering expression
+	Order string `json:"order"`
+
+	// Owner Id of the user who saved the view
+	Owner string `json:"owner"`
+}
+
+// ListAgreementsParams defines parameters for ListAgreements.
+type ListAgreementsParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of analyses to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListAssignmentBatchesParams defines parameters for ListAssignmentBatches.
+type ListAssignmentBatchesParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of batches to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// NextAssignmentParams defines parameters for NextAssignment.
+type NextAssignmentParams struct {
+	// ImageId ID of an image to skip, e.g. the current one
+	ImageId *string `form:"image_id,omitempty" json:"image_id,omitempty"`
+
+	// Collection collection of the image to skip
+	Collection *string `form:"collection,omitempty" json:"collection,omitempty"`
+}
+
+// ListAuditLogParams defines parameters for ListAuditLog.
+type ListAuditLogParams struct {
+	// Actor ID of the user who performed the operation
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action name of the operation, e.g. DeleteLabel
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// From earliest time, as RFC3339 or YYYY-MM-DD
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To latest time (exclusive), as RFC3339 or YYYY-MM-DD (inclusive day)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of entries to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ExportAuditLogParams defines parameters for ExportAuditLog.
+type ExportAuditLogParams struct {
+	// Actor ID of the user who performed the operation
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// Action name of the operation, e.g. DeleteLabel
+	Action *string `form:"action,omitempty" json:"action,omitempty"`
+
+	// From earliest time, as RFC3339 or YYYY-MM-DD
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To latest time (exclusive), as RFC3339 or YYYY-MM-DD (inclusive day)
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
 // ListCollectionsParams defines parameters for ListCollections.
 type ListCollectionsParams struct {
 	// Page page number
@@ -209,6 +1204,42 @@ type ListCollectionsParams struct {
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
+// ExportCollectionParams defines parameters for ExportCollection.
+type ExportCollectionParams struct {
+	// Format export format (coco, yolo or voc)
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+
+	// Filter query string restricting exported images, e.g. meta.site:lab
+	Filter *string `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// ReviewStatus only export annotations of this review status, e.g. accepted
+	ReviewStatus *string `form:"review_status,omitempty" json:"review_status,omitempty"`
+
+	// Train ratio of images assigned to the train subset
+	Train *float64 `form:"train,omitempty" json:"train,omitempty"`
+
+	// Val ratio of images assigned to the validation subset
+	Val *float64 `form:"val,omitempty" json:"val,omitempty"`
+
+	// Test ratio of images assigned to the test subset
+	Test *float64 `form:"test,omitempty" json:"test,omitempty"`
+
+	// Seed seed of the random split
+	Seed *int64 `form:"seed,omitempty" json:"seed,omitempty"`
+
+	// Stratify split each label separately
+	Stratify *bool `form:"stratify,omitempty" json:"stratify,omitempty"`
+}
+
+// ListEvaluationsParams defines parameters for ListEvaluations.
+type ListEvaluationsParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of evaluations to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
 // ListImagesParams defines parameters for ListImages.
 type ListImagesParams struct {
 	// Page page number
@@ -222,6 +1253,9 @@ type ListImagesParams struct {
 
 	// Order ordering expression
 	Order *string `form:"order,omitempty" json:"order,omitempty"`
+
+	// View id of a saved view whose filtering and ordering expressions replace those given
+	View *string `form:"view,omitempty" json:"view,omitempty"`
 }
 
 // IngestImageMultipartBody defines parameters for IngestImage.
@@ -240,23 +1274,179 @@ type ListLabelsParams struct {
 	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
 }
 
+// ReadRawImageParams defines parameters for ReadRawImage.
+type ReadRawImageParams struct {
+	// Size length in pixels of the longest side of the returned thumbnail (128, 256, 512 or 1024)
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListReviewQueueParams defines parameters for ListReviewQueue.
+type ListReviewQueueParams struct {
+	// Collection restrict the queue to a collection
+	Collection *string `form:"collection,omitempty" json:"collection,omitempty"`
+
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of annotations to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// ListViewsParams defines parameters for ListViews.
+type ListViewsParams struct {
+	// Page page number
+	Page *int64 `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize maximum number of views to return
+	PageSize *int `form:"page_size,omitempty" json:"page_size,omitempty"`
+}
+
+// CreateAgreementJSONRequestBody defines body for CreateAgreement for application/json ContentType.
+type CreateAgreementJSONRequestBody = NewAgreement
+
+// SetAnnotationAttributesJSONRequestBody defines body for SetAnnotationAttributes for application/json ContentType.
+type SetAnnotationAttributesJSONRequestBody = AnnotationAttributes
+
+// UpdateAnnotationLabelJSONRequestBody defines body for UpdateAnnotationLabel for application/json ContentType.
+type UpdateAnnotationLabelJSONRequestBody = AnnotationLabel
+
+// ReviewAnnotationJSONRequestBody defines body for ReviewAnnotation for application/json ContentType.
+type ReviewAnnotationJSONRequestBody = NewReview
+
+// CreateAssignmentBatchJSONRequestBody defines body for CreateAssignmentBatch for application/json ContentType.
+type CreateAssignmentBatchJSONRequestBody = NewAssignmentBatch
+
+// UpdateBoundingBoxJSONRequestBody defines body for UpdateBoundingBox for application/json ContentType.
+type UpdateBoundingBoxJSONRequestBody = NewBoundingBox
+
 // CreateCollectionJSONRequestBody defines body for CreateCollection for application/json ContentType.
 type CreateCollectionJSONRequestBody = NewCollection
 
 // UpdateCollectionByNameJSONRequestBody defines body for UpdateCollectionByName for application/json ContentType.
 type UpdateCollectionByNameJSONRequestBody = UpdateCollection
 
+// AddBoundingBoxJSONRequestBody defines body for AddBoundingBox for application/json ContentType.
+type AddBoundingBoxJSONRequestBody = NewBoundingBox
+
+// AddImageLabelJSONRequestBody defines body for AddImageLabel for application/json ContentType.
+type AddImageLabelJSONRequestBody = AnnotationLabel
+
+// UpdateImageMetadataJSONRequestBody defines body for UpdateImageMetadata for application/json ContentType.
+type UpdateImageMetadataJSONRequestBody = MetadataValue
+
+// SetImageMetadataJSONRequestBody defines body for SetImageMetadata for application/json ContentType.
+type SetImageMetadataJSONRequestBody = MetadataValue
+
+// AddKeypointsJSONRequestBody defines body for AddKeypoints for application/json ContentType.
+type AddKeypointsJSONRequestBody = NewKeypoints
+
+// AddMaskJSONRequestBody defines body for AddMask for application/json ContentType.
+type AddMaskJSONRequestBody = NewMask
+
+// AddPolygonJSONRequestBody defines body for AddPolygon for application/json ContentType.
+type AddPolygonJSONRequestBody = NewPolygon
+
+// AddPolylineJSONRequestBody defines body for AddPolyline for application/json ContentType.
+type AddPolylineJSONRequestBody = NewPolyline
+
 // IngestImageMultipartRequestBody defines body for IngestImage for multipart/form-data ContentType.
 type IngestImageMultipartRequestBody IngestImageMultipartBody
 
+// SetCollectionLabelsJSONRequestBody defines body for SetCollectionLabels for application/json ContentType.
+type SetCollectionLabelsJSONRequestBody = CollectionLabels
+
+// ImportPredictionsJSONRequestBody defines body for ImportPredictions for application/json ContentType.
+type ImportPredictionsJSONRequestBody = NewPredictions
+
+// CreateEvaluationJSONRequestBody defines body for CreateEvaluation for application/json ContentType.
+type CreateEvaluationJSONRequestBody = NewEvaluation
+
+// UpdateKeypointsJSONRequestBody defines body for UpdateKeypoints for application/json ContentType.
+type UpdateKeypointsJSONRequestBody = NewKeypoints
+
 // CreateLabelJSONRequestBody defines body for CreateLabel for application/json ContentType.
 type CreateLabelJSONRequestBody = NewLabel
 
+// UpdateLabelJSONRequestBody defines body for UpdateLabel for application/json ContentType.
+type UpdateLabelJSONRequestBody = LabelUpdate
+
+// UpdateMaskJSONRequestBody defines body for UpdateMask for application/json ContentType.
+type UpdateMaskJSONRequestBody = MaskUpdate
+
+// PaintMaskJSONRequestBody defines body for PaintMask for application/json ContentType.
+type PaintMaskJSONRequestBody = Stroke
+
+// EraseMaskJSONRequestBody defines body for EraseMask for application/json ContentType.
+type EraseMaskJSONRequestBody = Stroke
+
+// UpdatePolygonJSONRequestBody defines body for UpdatePolygon for application/json ContentType.
+type UpdatePolygonJSONRequestBody = NewPolygon
+
+// UpdatePolylineJSONRequestBody defines body for UpdatePolyline for application/json ContentType.
+type UpdatePolylineJSONRequestBody = NewPolyline
+
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = NewUser
 
+// CreateViewJSONRequestBody defines body for CreateView for application/json ContentType.
+type CreateViewJSONRequestBody = NewView
+
+// UpdateViewJSONRequestBody defines body for UpdateView for application/json ContentType.
+type UpdateViewJSONRequestBody = NewView
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// ListAgreements List my agreement analyses
+	// (GET /agreements)
+	ListAgreements(w http.ResponseWriter, r *http.Request, params ListAgreementsParams)
+	// CreateAgreement Analyze inter-annotator agreement
+	// (POST /agreements)
+	CreateAgreement(w http.ResponseWriter, r *http.Request)
+	// FindAgreement Fetch an agreement analysis
+	// (GET /agreements/{task_id})
+	FindAgreement(w http.ResponseWriter, r *http.Request, taskId string)
+	// DeleteAnnotation Delete an annotation
+	// (DELETE /annotations/{annotation_id})
+	DeleteAnnotation(w http.ResponseWriter, r *http.Request, annotationId string)
+	// AcceptPrediction Accept a prediction
+	// (POST /annotations/{annotation_id}/accept)
+	AcceptPrediction(w http.ResponseWriter, r *http.Request, annotationId string)
+	// SetAnnotationAttributes Set the attributes of an annotation
+	// (PUT /annotations/{annotation_id}/attributes)
+	SetAnnotationAttributes(w http.ResponseWriter, r *http.Request, annotationId string)
+	// UpdateAnnotationLabel Update the label of an annotation
+	// (PUT /annotations/{annotation_id}/label)
+	UpdateAnnotationLabel(w http.ResponseWriter, r *http.Request, annotationId string)
+	// ReviewAnnotation Review an annotation
+	// (PUT /annotations/{annotation_id}/review)
+	ReviewAnnotation(w http.ResponseWriter, r *http.Request, annotationId string)
+	// ListAnnotationRevisions List revisions of an annotation
+	// (GET /annotations/{annotation_id}/revisions)
+	ListAnnotationRevisions(w http.ResponseWriter, r *http.Request, annotationId string)
+	// ListAssignmentBatches List assignment batches
+	// (GET /assignments)
+	ListAssignmentBatches(w http.ResponseWriter, r *http.Request, params ListAssignmentBatchesParams)
+	// CreateAssignmentBatch Create an assignment batch
+	// (POST /assignments)
+	CreateAssignmentBatch(w http.ResponseWriter, r *http.Request)
+	// ListMyAssignmentBatches List my assignment batches
+	// (GET /assignments/mine)
+	ListMyAssignmentBatches(w http.ResponseWriter, r *http.Request)
+	// NextAssignment Fetch my next assigned image
+	// (GET /assignments/next)
+	NextAssignment(w http.ResponseWriter, r *http.Request, params NextAssignmentParams)
+	// DeleteAssignmentBatch Delete an assignment batch
+	// (DELETE /assignments/{batch_id})
+	DeleteAssignmentBatch(w http.ResponseWriter, r *http.Request, batchId string)
+	// ListAuditLog List audit log entries
+	// (GET /audit)
+	ListAuditLog(w http.ResponseWriter, r *http.Request, params ListAuditLogParams)
+	// ExportAuditLog Export audit log entries
+	// (GET /audit/export)
+	ExportAuditLog(w http.ResponseWriter, r *http.Request, params ExportAuditLogParams)
+	// UpdateBoundingBox Update a bounding box
+	// (PUT /bounding_boxes/{annotation_id})
+	UpdateBoundingBox(w http.ResponseWriter, r *http.Request, annotationId string)
 	// ListCollections List collections
 	// (GET /collections)
 	ListCollections(w http.ResponseWriter, r *http.Request, params ListCollectionsParams)
@@ -272,6 +1462,69 @@ type ServerInterface interface {
 	// UpdateCollectionByName Update a collection
 	// (PUT /collections/{name})
 	UpdateCollectionByName(w http.ResponseWriter, r *http.Request, name string)
+	// ExportCollection Export a collection
+	// (POST /collections/{name}/export)
+	ExportCollection(w http.ResponseWriter, r *http.Request, name string, params ExportCollectionParams)
+	// AddBoundingBox Add a bounding box
+	// (POST /collections/{name}/images/{image_id}/bounding_boxes)
+	AddBoundingBox(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// AddImageLabel Assign a label to an image
+	// (POST /collections/{name}/images/{image_id}/labels)
+	AddImageLabel(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// FinishAssignment Finish an assigned image
+	// (POST /collections/{name}/images/{image_id}/finish)
+	FinishAssignment(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// SubmitForReview Submit annotations of an image for review
+	// (POST /collections/{name}/images/{image_id}/submit)
+	SubmitForReview(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// ListImageMetadata List metadata of an image
+	// (GET /collections/{name}/images/{image_id}/meta)
+	ListImageMetadata(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// DeleteImageMetadata Delete a metadata value
+	// (DELETE /collections/{name}/images/{image_id}/meta/{key})
+	DeleteImageMetadata(w http.ResponseWriter, r *http.Request, name string, imageId string, key string)
+	// ReadImageMetadata Read a metadata value
+	// (GET /collections/{name}/images/{image_id}/meta/{key})
+	ReadImageMetadata(w http.ResponseWriter, r *http.Request, name string, imageId string, key string)
+	// UpdateImageMetadata Update a metadata value
+	// (PATCH /collections/{name}/images/{image_id}/meta/{key})
+	UpdateImageMetadata(w http.ResponseWriter, r *http.Request, name string, imageId string, key string)
+	// SetImageMetadata Add a metadata value
+	// (PUT /collections/{name}/images/{image_id}/meta/{key})
+	SetImageMetadata(w http.ResponseWriter, r *http.Request, name string, imageId string, key string)
+	// AddKeypoints Add keypoints
+	// (POST /collections/{name}/images/{image_id}/keypoints)
+	AddKeypoints(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// ReadRawMask Read the masks of an image
+	// (GET /collections/{name}/images/{image_id}/mask)
+	ReadRawMask(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// AddMask Add a mask
+	// (POST /collections/{name}/images/{image_id}/masks)
+	AddMask(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// AddPolygon Add a polygon
+	// (POST /collections/{name}/images/{image_id}/polygons)
+	AddPolygon(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// AddPolyline Add a polyline
+	// (POST /collections/{name}/images/{image_id}/polylines)
+	AddPolyline(w http.ResponseWriter, r *http.Request, name string, imageId string)
+	// SetCollectionLabels Set the labels allowed in a collection
+	// (PUT /collections/{name}/labels)
+	SetCollectionLabels(w http.ResponseWriter, r *http.Request, name string)
+	// ImportPredictions Import predictions
+	// (POST /collections/{name}/predictions)
+	ImportPredictions(w http.ResponseWriter, r *http.Request, name string)
+	// ListEvaluations List my evaluations
+	// (GET /evaluations)
+	ListEvaluations(w http.ResponseWriter, r *http.Request, params ListEvaluationsParams)
+	// CreateEvaluation Evaluate predictions
+	// (POST /evaluations)
+	CreateEvaluation(w http.ResponseWriter, r *http.Request)
+	// FindEvaluation Fetch an evaluation
+	// (GET /evaluations/{task_id})
+	FindEvaluation(w http.ResponseWriter, r *http.Request, taskId string)
+	// DownloadExport Download an exported collection
+	// (GET /exports/{task_id})
+	DownloadExport(w http.ResponseWriter, r *http.Request, taskId string)
 	// ListImages List images
 	// (GET /images)
 	ListImages(w http.ResponseWriter, r *http.Request, params ListImagesParams)
@@ -281,6 +1534,9 @@ type ServerInterface interface {
 	// ReadImage Read image meta-data
 	// (GET /images/{collection_name}/{image_id})
 	ReadImage(w http.ResponseWriter, r *http.Request, collectionName string, imageId string)
+	// UpdateKeypoints Update keypoints
+	// (PUT /keypoints/{annotation_id})
+	UpdateKeypoints(w http.ResponseWriter, r *http.Request, annotationId string)
 	// ListLabels List labels
 	// (GET /labels)
 	ListLabels(w http.ResponseWriter, r *http.Request, params ListLabelsParams)
@@ -293,63 +1549,1934 @@ type ServerInterface interface {
 	// FindLabelByName Find a label by name
 	// (GET /labels/{name})
 	FindLabelByName(w http.ResponseWriter, r *http.Request, name string)
+	// UpdateLabel Update a label
+	// (PUT /labels/{name})
+	UpdateLabel(w http.ResponseWriter, r *http.Request, name string)
+	// UpdateMask Update a mask
+	// (PUT /masks/{annotation_id})
+	UpdateMask(w http.ResponseWriter, r *http.Request, annotationId string)
+	// PaintMask Paint a mask
+	// (POST /masks/{annotation_id}/brush)
+	PaintMask(w http.ResponseWriter, r *http.Request, annotationId string)
+	// EraseMask Erase a mask
+	// (POST /masks/{annotation_id}/erase)
+	EraseMask(w http.ResponseWriter, r *http.Request, annotationId string)
+	// ConvertMaskToPolygons Convert a mask to polygons
+	// (POST /masks/{annotation_id}/polygons)
+	ConvertMaskToPolygons(w http.ResponseWriter, r *http.Request, annotationId string)
+	// UpdatePolygon Update a polygon
+	// (PUT /polygons/{annotation_id})
+	UpdatePolygon(w http.ResponseWriter, r *http.Request, annotationId string)
+	// ConvertPolygonToMask Convert a polygon to a mask
+	// (POST /polygons/{annotation_id}/mask)
+	ConvertPolygonToMask(w http.ResponseWriter, r *http.Request, annotationId string)
+	// UpdatePolyline Update a polyline
+	// (PUT /polylines/{annotation_id})
+	UpdatePolyline(w http.ResponseWriter, r *http.Request, annotationId string)
 	// ReadRawImage Read image raw-data
 	// (GET /raw/{image_id})
-	ReadRawImage(w http.ResponseWriter, r *http.Request, imageId string)
+	ReadRawImage(w http.ResponseWriter, r *http.Request, imageId string, params ReadRawImageParams)
 	// CreateUser Create a new user
 	// (POST /users)
 	CreateUser(w http.ResponseWriter, r *http.Request)
+	// RevertAnnotation Revert an annotation
+	// (POST /revisions/{revision_id}/revert)
+	RevertAnnotation(w http.ResponseWriter, r *http.Request, revisionId string)
+	// ListReviewQueue List the review queue
+	// (GET /reviews)
+	ListReviewQueue(w http.ResponseWriter, r *http.Request, params ListReviewQueueParams)
+	// GetLabelTree Fetch the label taxonomy
+	// (GET /taxonomy)
+	GetLabelTree(w http.ResponseWriter, r *http.Request)
+	// ListViews List saved views
+	// (GET /views)
+	ListViews(w http.ResponseWriter, r *http.Request, params ListViewsParams)
+	// CreateView Save a new view
+	// (POST /views)
+	CreateView(w http.ResponseWriter, r *http.Request)
+	// DeleteView Delete a saved view
+	// (DELETE /views/{view_id})
+	DeleteView(w http.ResponseWriter, r *http.Request, viewId string)
+	// FindView Find a saved view
+	// (GET /views/{view_id})
+	FindView(w http.ResponseWriter, r *http.Request, viewId string)
+	// UpdateView Update a saved view
+	// (PUT /views/{view_id})
+	UpdateView(w http.ResponseWriter, r *http.Request, viewId string)
 	// WhoAmI Get current user's identity
 	// (GET /whoami)
 	WhoAmI(w http.ResponseWriter, r *http.Request)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListAgreements operation middleware
+func (siw *ServerInterfaceWrapper) ListAgreements(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAgreementsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAgreements(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAgreement operation middleware
+func (siw *ServerInterfaceWrapper) CreateAgreement(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAgreement(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindAgreement operation middleware
+func (siw *ServerInterfaceWrapper) FindAgreement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "task_id" -------------
+	var taskId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_id", r.PathValue("task_id"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "task_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindAgreement(w, r, taskId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAnnotation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAnnotation(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptPrediction operation middleware
+func (siw *ServerInterfaceWrapper) AcceptPrediction(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptPrediction(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetAnnotationAttributes operation middleware
+func (siw *ServerInterfaceWrapper) SetAnnotationAttributes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetAnnotationAttributes(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAnnotationLabel operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAnnotationLabel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAnnotationLabel(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReviewAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) ReviewAnnotation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReviewAnnotation(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAnnotationRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListAnnotationRevisions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAnnotationRevisions(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAssignmentBatches operation middleware
+func (siw *ServerInterfaceWrapper) ListAssignmentBatches(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAssignmentBatchesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAssignmentBatches(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAssignmentBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateAssignmentBatch(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAssignmentBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyAssignmentBatches operation middleware
+func (siw *ServerInterfaceWrapper) ListMyAssignmentBatches(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyAssignmentBatches(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// NextAssignment operation middleware
+func (siw *ServerInterfaceWrapper) NextAssignment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NextAssignmentParams
+
+	// ------------- Optional query parameter "image_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "image_id", r.URL.Query(), &params.ImageId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "image_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "collection" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "collection", r.URL.Query(), &params.Collection, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "collection"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "collection", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.NextAssignment(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAssignmentBatch operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAssignmentBatch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "batch_id" -------------
+	var batchId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "batch_id", r.PathValue("batch_id"), &batchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "batch_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAssignmentBatch(w, r, batchId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditLogParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "actor", r.URL.Query(), &params.Actor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "actor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "action", r.URL.Query(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "action"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAuditLog(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) ExportAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportAuditLogParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "actor", r.URL.Query(), &params.Actor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "actor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "action", r.URL.Query(), &params.Action, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "action"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportAuditLog(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBoundingBox operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBoundingBox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBoundingBox(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCollections operation middleware
+func (siw *ServerInterfaceWrapper) ListCollections(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCollectionsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCollections(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCollection operation middleware
+func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCollection(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCollectionByName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCollectionByName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCollectionByName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindCollectionByName operation middleware
+func (siw *ServerInterfaceWrapper) FindCollectionByName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindCollectionByName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCollectionByName operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCollectionByName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCollectionByName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportCollection operation middleware
+func (siw *ServerInterfaceWrapper) ExportCollection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportCollectionParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "review_status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "review_status", r.URL.Query(), &params.ReviewStatus, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "review_status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "review_status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "train" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "train", r.URL.Query(), &params.Train, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "train"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "train", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "val" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "val", r.URL.Query(), &params.Val, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "val"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "val", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "test" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "test", r.URL.Query(), &params.Test, runtime.BindQueryParameterOptions{Type: "number", Format: "double"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "test"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "test", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "seed" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "seed", r.URL.Query(), &params.Seed, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "seed"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "seed", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "stratify" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "stratify", r.URL.Query(), &params.Stratify, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "stratify"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stratify", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportCollection(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddBoundingBox operation middleware
+func (siw *ServerInterfaceWrapper) AddBoundingBox(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddBoundingBox(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddImageLabel operation middleware
+func (siw *ServerInterfaceWrapper) AddImageLabel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddImageLabel(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FinishAssignment operation middleware
+func (siw *ServerInterfaceWrapper) FinishAssignment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FinishAssignment(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitForReview operation middleware
+func (siw *ServerInterfaceWrapper) SubmitForReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitForReview(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListImageMetadata operation middleware
+func (siw *ServerInterfaceWrapper) ListImageMetadata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListImageMetadata(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteImageMetadata operation middleware
+func (siw *ServerInterfaceWrapper) DeleteImageMetadata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteImageMetadata(w, r, name, imageId, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadImageMetadata operation middleware
+func (siw *ServerInterfaceWrapper) ReadImageMetadata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadImageMetadata(w, r, name, imageId, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateImageMetadata operation middleware
+func (siw *ServerInterfaceWrapper) UpdateImageMetadata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateImageMetadata(w, r, name, imageId, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetImageMetadata operation middleware
+func (siw *ServerInterfaceWrapper) SetImageMetadata(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetImageMetadata(w, r, name, imageId, key)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddKeypoints operation middleware
+func (siw *ServerInterfaceWrapper) AddKeypoints(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddKeypoints(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadRawMask operation middleware
+func (siw *ServerInterfaceWrapper) ReadRawMask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadRawMask(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddMask operation middleware
+func (siw *ServerInterfaceWrapper) AddMask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddMask(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddPolygon operation middleware
+func (siw *ServerInterfaceWrapper) AddPolygon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPolygon(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddPolyline operation middleware
+func (siw *ServerInterfaceWrapper) AddPolyline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPolyline(w, r, name, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetCollectionLabels operation middleware
+func (siw *ServerInterfaceWrapper) SetCollectionLabels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetCollectionLabels(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportPredictions operation middleware
+func (siw *ServerInterfaceWrapper) ImportPredictions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportPredictions(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEvaluations operation middleware
+func (siw *ServerInterfaceWrapper) ListEvaluations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEvaluationsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEvaluations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateEvaluation operation middleware
+func (siw *ServerInterfaceWrapper) CreateEvaluation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEvaluation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindEvaluation operation middleware
+func (siw *ServerInterfaceWrapper) FindEvaluation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "task_id" -------------
+	var taskId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_id", r.PathValue("task_id"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "task_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindEvaluation(w, r, taskId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DownloadExport operation middleware
+func (siw *ServerInterfaceWrapper) DownloadExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "task_id" -------------
+	var taskId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "task_id", r.PathValue("task_id"), &taskId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "task_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadExport(w, r, taskId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListImages operation middleware
+func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListImagesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "view", r.URL.Query(), &params.View, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "view"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListImages(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IngestImage operation middleware
+func (siw *ServerInterfaceWrapper) IngestImage(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestImage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadImage operation middleware
+func (siw *ServerInterfaceWrapper) ReadImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "collection_name" -------------
+	var collectionName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "collection_name", r.PathValue("collection_name"), &collectionName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "collection_name", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReadImage(w, r, collectionName, imageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateKeypoints operation middleware
+func (siw *ServerInterfaceWrapper) UpdateKeypoints(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateKeypoints(w, r, annotationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListLabels operation middleware
+func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLabelsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListLabels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateLabel operation middleware
+func (siw *ServerInterfaceWrapper) CreateLabel(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateLabel(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteLabelByName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteLabelByName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteLabelByName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FindLabelByName operation middleware
+func (siw *ServerInterfaceWrapper) FindLabelByName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FindLabelByName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// ListCollections operation middleware
-func (siw *ServerInterfaceWrapper) ListCollections(w http.ResponseWriter, r *http.Request) {
+// UpdateLabel operation middleware
+func (siw *ServerInterfaceWrapper) UpdateLabel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCollectionsParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "name" -------------
+	var name string
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page_size" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateLabel(w, r, name)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMask operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCollections(w, r, params)
+		siw.Handler.UpdateMask(w, r, annotationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -359,11 +3486,23 @@ func (siw *ServerInterfaceWrapper) ListCollections(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// CreateCollection operation middleware
-func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *http.Request) {
+// PaintMask operation middleware
+func (siw *ServerInterfaceWrapper) PaintMask(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCollection(w, r)
+		siw.Handler.PaintMask(w, r, annotationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -373,23 +3512,23 @@ func (siw *ServerInterfaceWrapper) CreateCollection(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteCollectionByName operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCollectionByName(w http.ResponseWriter, r *http.Request) {
+// EraseMask operation middleware
+func (siw *ServerInterfaceWrapper) EraseMask(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCollectionByName(w, r, name)
+		siw.Handler.EraseMask(w, r, annotationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -399,23 +3538,23 @@ func (siw *ServerInterfaceWrapper) DeleteCollectionByName(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// FindCollectionByName operation middleware
-func (siw *ServerInterfaceWrapper) FindCollectionByName(w http.ResponseWriter, r *http.Request) {
+// ConvertMaskToPolygons operation middleware
+func (siw *ServerInterfaceWrapper) ConvertMaskToPolygons(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindCollectionByName(w, r, name)
+		siw.Handler.ConvertMaskToPolygons(w, r, annotationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -425,23 +3564,23 @@ func (siw *ServerInterfaceWrapper) FindCollectionByName(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateCollectionByName operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCollectionByName(w http.ResponseWriter, r *http.Request) {
+// UpdatePolygon operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePolygon(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCollectionByName(w, r, name)
+		siw.Handler.UpdatePolygon(w, r, annotationId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -451,69 +3590,91 @@ func (siw *ServerInterfaceWrapper) UpdateCollectionByName(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListImages operation middleware
-func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Request) {
+// ConvertPolygonToMask operation middleware
+func (siw *ServerInterfaceWrapper) ConvertPolygonToMask(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListImagesParams
-
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page_size" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConvertPolygonToMask(w, r, annotationId)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePolyline operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePolyline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "annotation_id" -------------
+	var annotationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "annotation_id", r.PathValue("annotation_id"), &annotationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "annotation_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "filter" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePolyline(w, r, annotationId)
+	}))
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "filter", r.URL.Query(), &params.Filter, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReadRawImage operation middleware
+func (siw *ServerInterfaceWrapper) ReadRawImage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "image_id" -------------
+	var imageId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		var requiredError *runtime.RequiredParameterError
-		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "filter"})
-		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
-		}
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "order" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReadRawImageParams
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", r.URL.Query(), &params.Size, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
 		var requiredError *runtime.RequiredParameterError
 		if errors.As(err, &requiredError) {
-			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "size"})
 		} else {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
 		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListImages(w, r, params)
+		siw.Handler.ReadRawImage(w, r, imageId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -523,11 +3684,11 @@ func (siw *ServerInterfaceWrapper) ListImages(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// IngestImage operation middleware
-func (siw *ServerInterfaceWrapper) IngestImage(w http.ResponseWriter, r *http.Request) {
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.IngestImage(w, r)
+		siw.Handler.CreateUser(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -537,32 +3698,23 @@ func (siw *ServerInterfaceWrapper) IngestImage(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ReadImage operation middleware
-func (siw *ServerInterfaceWrapper) ReadImage(w http.ResponseWriter, r *http.Request) {
+// RevertAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) RevertAnnotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "collection_name" -------------
-	var collectionName string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "collection_name", r.PathValue("collection_name"), &collectionName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "collection_name", Err: err})
-		return
-	}
+	// ------------- Path parameter "revision_id" -------------
+	var revisionId string
 
-	// ------------- Path parameter "image_id" -------------
-	var imageId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "revision_id", r.PathValue("revision_id"), &revisionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadImage(w, r, collectionName, imageId)
+		siw.Handler.RevertAnnotation(w, r, revisionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -572,14 +3724,27 @@ func (siw *ServerInterfaceWrapper) ReadImage(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListLabels operation middleware
-func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Request) {
+// ListReviewQueue operation middleware
+func (siw *ServerInterfaceWrapper) ListReviewQueue(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLabelsParams
+	var params ListReviewQueueParams
+
+	// ------------- Optional query parameter "collection" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "collection", r.URL.Query(), &params.Collection, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "collection"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "collection", Err: err})
+		}
+		return
+	}
 
 	// ------------- Optional query parameter "page" -------------
 
@@ -608,7 +3773,7 @@ func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListLabels(w, r, params)
+		siw.Handler.ListReviewQueue(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -618,11 +3783,11 @@ func (siw *ServerInterfaceWrapper) ListLabels(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// CreateLabel operation middleware
-func (siw *ServerInterfaceWrapper) CreateLabel(w http.ResponseWriter, r *http.Request) {
+// GetLabelTree operation middleware
+func (siw *ServerInterfaceWrapper) GetLabelTree(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateLabel(w, r)
+		siw.Handler.GetLabelTree(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -632,23 +3797,43 @@ func (siw *ServerInterfaceWrapper) CreateLabel(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteLabelByName operation middleware
-func (siw *ServerInterfaceWrapper) DeleteLabelByName(w http.ResponseWriter, r *http.Request) {
+// ListViews operation middleware
+func (siw *ServerInterfaceWrapper) ListViews(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListViewsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", r.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page_size", r.URL.Query(), &params.PageSize, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "page_size"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		}
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteLabelByName(w, r, name)
+		siw.Handler.ListViews(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -658,23 +3843,37 @@ func (siw *ServerInterfaceWrapper) DeleteLabelByName(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// FindLabelByName operation middleware
-func (siw *ServerInterfaceWrapper) FindLabelByName(w http.ResponseWriter, r *http.Request) {
+// CreateView operation middleware
+func (siw *ServerInterfaceWrapper) CreateView(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateView(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteView operation middleware
+func (siw *ServerInterfaceWrapper) DeleteView(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Path parameter "view_id" -------------
+	var viewId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", r.PathValue("view_id"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.FindLabelByName(w, r, name)
+		siw.Handler.DeleteView(w, r, viewId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -684,23 +3883,23 @@ func (siw *ServerInterfaceWrapper) FindLabelByName(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ReadRawImage operation middleware
-func (siw *ServerInterfaceWrapper) ReadRawImage(w http.ResponseWriter, r *http.Request) {
+// FindView operation middleware
+func (siw *ServerInterfaceWrapper) FindView(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "image_id" -------------
-	var imageId string
+	// ------------- Path parameter "view_id" -------------
+	var viewId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "image_id", r.PathValue("image_id"), &imageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", r.PathValue("view_id"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "image_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReadRawImage(w, r, imageId)
+		siw.Handler.FindView(w, r, viewId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -710,11 +3909,23 @@ func (siw *ServerInterfaceWrapper) ReadRawImage(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+// UpdateView operation middleware
+func (siw *ServerInterfaceWrapper) UpdateView(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "view_id" -------------
+	var viewId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "view_id", r.PathValue("view_id"), &viewId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "", ValueIsUnescaped: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r)
+		siw.Handler.UpdateView(w, r, viewId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -866,6 +4077,27 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/collections/{name}", wrapper.DeleteCollectionByName)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/collections/{name}", wrapper.FindCollectionByName)
 	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/collections/{name}", wrapper.UpdateCollectionByName)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/bounding_boxes", wrapper.AddBoundingBox)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/polygons", wrapper.AddPolygon)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/labels", wrapper.AddImageLabel)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/submit", wrapper.SubmitForReview)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/collections/{name}/images/{image_id}/meta", wrapper.ListImageMetadata)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/collections/{name}/images/{image_id}/meta/{key}", wrapper.DeleteImageMetadata)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/collections/{name}/images/{image_id}/meta/{key}", wrapper.ReadImageMetadata)
+	m.HandleFunc(http.MethodPatch+" "+options.BaseURL+"/collections/{name}/images/{image_id}/meta/{key}", wrapper.UpdateImageMetadata)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/collections/{name}/images/{image_id}/meta/{key}", wrapper.SetImageMetadata)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/bounding_boxes/{annotation_id}", wrapper.UpdateBoundingBox)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/keypoints", wrapper.AddKeypoints)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/keypoints/{annotation_id}", wrapper.UpdateKeypoints)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/polylines", wrapper.AddPolyline)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/collections/{name}/images/{image_id}/mask", wrapper.ReadRawMask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/masks", wrapper.AddMask)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/polygons/{annotation_id}", wrapper.UpdatePolygon)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/annotations/{annotation_id}/label", wrapper.UpdateAnnotationLabel)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/annotations/{annotation_id}/review", wrapper.ReviewAnnotation)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/annotations/{annotation_id}", wrapper.DeleteAnnotation)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/export", wrapper.ExportCollection)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/exports/{task_id}", wrapper.DownloadExport)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/users", wrapper.CreateUser)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/collections", wrapper.ListCollections)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections", wrapper.CreateCollection)
@@ -873,6 +4105,39 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/labels/{name}", wrapper.FindLabelByName)
 	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/labels", wrapper.ListLabels)
 	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/labels", wrapper.CreateLabel)
-
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/views/{view_id}", wrapper.FindView)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/views/{view_id}", wrapper.UpdateView)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/views/{view_id}", wrapper.DeleteView)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/reviews", wrapper.ListReviewQueue)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/views", wrapper.ListViews)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/views", wrapper.CreateView)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/assignments", wrapper.ListAssignmentBatches)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/assignments", wrapper.CreateAssignmentBatch)
+	m.HandleFunc(http.MethodDelete+" "+options.BaseURL+"/assignments/{batch_id}", wrapper.DeleteAssignmentBatch)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/assignments/mine", wrapper.ListMyAssignmentBatches)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/assignments/next", wrapper.NextAssignment)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/images/{image_id}/finish", wrapper.FinishAssignment)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/annotations/{annotation_id}/revisions", wrapper.ListAnnotationRevisions)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/revisions/{revision_id}/revert", wrapper.RevertAnnotation)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/audit", wrapper.ListAuditLog)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/audit/export", wrapper.ExportAuditLog)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/polylines/{annotation_id}", wrapper.UpdatePolyline)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/masks/{annotation_id}", wrapper.UpdateMask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/masks/{annotation_id}/brush", wrapper.PaintMask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/masks/{annotation_id}/erase", wrapper.EraseMask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/masks/{annotation_id}/polygons", wrapper.ConvertMaskToPolygons)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/polygons/{annotation_id}/mask", wrapper.ConvertPolygonToMask)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/annotations/{annotation_id}/accept", wrapper.AcceptPrediction)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/collections/{name}/predictions", wrapper.ImportPredictions)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/evaluations", wrapper.ListEvaluations)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/evaluations", wrapper.CreateEvaluation)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/evaluations/{task_id}", wrapper.FindEvaluation)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/agreements", wrapper.ListAgreements)
+	m.HandleFunc(http.MethodPost+" "+options.BaseURL+"/agreements", wrapper.CreateAgreement)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/agreements/{task_id}", wrapper.FindAgreement)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/labels/{name}", wrapper.UpdateLabel)
+	m.HandleFunc(http.MethodGet+" "+options.BaseURL+"/taxonomy", wrapper.GetLabelTree)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/annotations/{annotation_id}/attributes", wrapper.SetAnnotationAttributes)
+	m.HandleFunc(http.MethodPut+" "+options.BaseURL+"/collections/{name}/labels", wrapper.SetCollectionLabels)
 	return m
 }
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/api/json"
+	p "github.com/lejeunel/go-image-annotator/adapters/api/json/view"
+	"github.com/lejeunel/go-image-annotator/adapters/api/models"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/update"
+)
+
+func NewCreateViewRequest(body models.NewView) create.Request {
+	req := create.Request{Name: body.Name}
+	if body.Filter != nil {
+		req.Filter = *body.Filter
+	}
+	if body.Order != nil {
+		req.Order = *body.Order
+	}
+	if body.Groups != nil {
+		req.Groups = *body.Groups
+	}
+	return req
+}
+
+func (s *Server) FindView(w http.ResponseWriter, r *http.Request, viewId string) {
+	s.View.Find.Execute(r.Context(), viewId, p.NewFindPresenter(w, s.Logger))
+}
+
+func (s *Server) CreateView(w http.ResponseWriter, r *http.Request) {
+	body, ok := json.MustDecodeJSON[models.NewView](w, r)
+	if !ok {
+		return
+	}
+	s.View.Create.Execute(r.Context(), NewCreateViewRequest(*body), p.NewCreatePresenter(w, s.Logger))
+}
+
+func (s *Server) UpdateView(w http.ResponseWriter, r *http.Request, viewId string) {
+	body, ok := json.MustDecodeJSON[models.NewView](w, r)
+	if !ok {
+		return
+	}
+	c := NewCreateViewRequest(*body)
+	req := update.Request{Id: viewId, Name: c.Name, Filter: c.Filter, Order: c.Order, Groups: c.Groups}
+	s.View.Update.Execute(r.Context(), req, p.NewUpdatePresenter(w, s.Logger))
+}
+
+func (s *Server) DeleteView(w http.ResponseWriter, r *http.Request, viewId string) {
+	s.View.Delete.Execute(r.Context(), viewId, p.NewDeletePresenter(w, s.Logger))
+}
+
+func (s *Server) ListViews(w http.ResponseWriter, r *http.Request, params ListViewsParams) {
+	req := pa.PaginationParams{}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.PageSize != nil {
+		req.PageSize = *params.PageSize
+	}
+	s.View.List.Execute(r.Context(), req, p.NewListPresenter(w, s.Logger))
+}
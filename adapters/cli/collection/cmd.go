@@ -2,6 +2,7 @@ package collection
 
 import (
 	s "github.com/lejeunel/go-image-annotator/adapters/cli/shared"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
 	"github.com/spf13/cobra"
 )
 
@@ -27,3 +28,34 @@ func init() {
 	CreateCmd.Flags().StringVarP(&group, "group", "g", "", "an optional group")
 	CreateCmd.Flags().StringVarP(&description, "description", "d", "", "an optional description")
 }
+
+var (
+	format       string
+	filter       string
+	reviewStatus string
+	split        ax.Split
+	ExportCmd    = &cobra.Command{
+		Use:   "export-collection [name] [output]",
+		Short: "Exports images and annotations of collection [name] into zip archive [output]",
+		Args:  cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			var splitPtr *ax.Split
+			if cmd.Flags().Changed("train") || cmd.Flags().Changed("val") || cmd.Flags().Changed("test") {
+				splitPtr = &split
+			}
+			Export(s.AnonymousAdminCtx(), args[0], args[1], format, filter, reviewStatus, splitPtr)
+		},
+	}
+)
+
+func init() {
+	ExportCmd.Flags().StringVarP(&format, "format", "f", "coco", "export format (coco, yolo or voc)")
+	ExportCmd.Flags().StringVarP(&filter, "filter", "q", "", "an optional query restricting exported images")
+	ExportCmd.Flags().StringVar(&reviewStatus, "review-status", "",
+		"only export annotations of this review status (draft, submitted, accepted or rejected)")
+	ExportCmd.Flags().Float64Var(&split.Train, "train", 0, "ratio of images in train subset")
+	ExportCmd.Flags().Float64Var(&split.Val, "val", 0, "ratio of images in validation subset")
+	ExportCmd.Flags().Float64Var(&split.Test, "test", 0, "ratio of images in test subset")
+	ExportCmd.Flags().Uint64Var(&split.Seed, "seed", 0, "seed of the random split")
+	ExportCmd.Flags().BoolVar(&split.Stratify, "stratify", false, "split each label separately")
+}
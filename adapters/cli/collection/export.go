@@ -0,0 +1,40 @@
+package collection
+
+import (
+	"context"
+	"os"
+
+	cli "github.com/lejeunel/go-image-annotator/adapters/cli"
+	a "github.com/lejeunel/go-image-annotator/app/sqlite"
+	"github.com/lejeunel/go-image-annotator/config"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	l "github.com/lejeunel/go-image-annotator/shared/logging"
+	"github.com/lejeunel/go-image-annotator/use-cases/collection/export"
+)
+
+type ExportPresenter struct {
+	cli.ErrorPresenter
+}
+
+func (p ExportPresenter) SuccessExport(r export.Response) {
+	p.Logger.Info("exported collection", "name", r.Collection,
+		"images", r.NumImages, "annotations", r.NumAnnotations)
+	for subset, n := range r.NumPerSubset {
+		p.Logger.Info("exported subset", "name", subset, "images", n)
+	}
+}
+
+func Export(ctx context.Context, name, output, format, filter, reviewStatus string, split *ax.Split) {
+	f, err := os.Create(output)
+	if err != nil {
+		panic(err)
+	}
+	defer f.Close()
+
+	app := a.NewApp(config.Parse(), auth.NewVoidAuth(), *l.NewCliLogger())
+	app.Itrs.Collection.Export.Execute(ctx,
+		export.Request{Collection: name, Format: format, Filter: filter, ReviewStatus: reviewStatus,
+			Split: split, Writer: f},
+		ExportPresenter{cli.NewErrorPresenter()})
+}
@@ -18,3 +18,23 @@ var IngestDirCmd = &cobra.Command{
 		IngestDirectory(s.AnonymousAdminCtx(), dir, collection)
 	},
 }
+
+var (
+	collection            string
+	sizes                 []int
+	force                 bool
+	BackfillThumbnailsCmd = &cobra.Command{
+		Use:   "backfill-thumbnails",
+		Short: "Generates missing thumbnails of existing images",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			BackfillThumbnails(collection, sizes, force)
+		},
+	}
+)
+
+func init() {
+	BackfillThumbnailsCmd.Flags().StringVarP(&collection, "collection", "c", "", "restrict to images of collection")
+	BackfillThumbnailsCmd.Flags().IntSliceVarP(&sizes, "size", "s", nil, "sizes of thumbnails to generate (default all)")
+	BackfillThumbnailsCmd.Flags().BoolVarP(&force, "force", "f", false, "regenerate existing thumbnails")
+}
@@ -0,0 +1,31 @@
+package image
+
+import (
+	cli "github.com/lejeunel/go-image-annotator/adapters/cli"
+	s "github.com/lejeunel/go-image-annotator/app/sqlite"
+	"github.com/lejeunel/go-image-annotator/config"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	l "github.com/lejeunel/go-image-annotator/shared/logging"
+	bt "github.com/lejeunel/go-image-annotator/use-cases/image/backfill-thumbnails"
+)
+
+type BackfillThumbnailsPresenter struct {
+	cli.ErrorPresenter
+}
+
+func (p BackfillThumbnailsPresenter) SuccessBackfillThumbnails(r bt.Response) {
+	p.Info("backfilled thumbnails", "images", r.NumImages,
+		"generated", r.NumGenerated, "failed", r.NumFailed)
+}
+
+func (p BackfillThumbnailsPresenter) ErrorOnImage(id im.ImageId, err error) {
+	p.Logger.Error(err.Error(), "id", id)
+}
+
+func BackfillThumbnails(collection string, sizes []int, force bool) {
+	app := s.NewApp(config.Parse(), auth.NewVoidAuth(), *l.NewCliLogger())
+	app.Itrs.Image.BackfillThumbs.Execute(
+		bt.Request{Collection: collection, Sizes: sizes, Force: force},
+		BackfillThumbnailsPresenter{cli.NewErrorPresenter()})
+}
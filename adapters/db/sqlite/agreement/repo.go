@@ -0,0 +1,114 @@
+package agreement
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type AgreementRepo struct {
+	Db adb.Querier
+}
+
+type Row struct {
+	Id           t.TaskId  `db:"id"`
+	Collections  string    `db:"collections"`
+	Authors      string    `db:"authors"`
+	Filter       string    `db:"filter"`
+	IoUThreshold float64   `db:"iou_threshold"`
+	Issuer       string    `db:"issuer"`
+	CreatedAt    time.Time `db:"created_at"`
+	Report       string    `db:"report"`
+}
+
+const columns = "id,collections,authors,filter,iou_threshold,issuer,created_at,report"
+
+func (row Row) toEntity() (*ag.Agreement, error) {
+	v := ag.Agreement{
+		Id:           row.Id,
+		Filter:       row.Filter,
+		IoUThreshold: row.IoUThreshold,
+		Issuer:       row.Issuer,
+		CreatedAt:    row.CreatedAt,
+	}
+	fields := []string{row.Collections, row.Authors, row.Report}
+	for k, dst := range []any{&v.Collections, &v.Authors, &v.Report} {
+		if err := json.Unmarshal([]byte(fields[k]), dst); err != nil {
+			return nil, fmt.Errorf("unmarshaling agreement %v: %v: %w", row.Id, err, e.ErrInternal)
+		}
+	}
+	return &v, nil
+}
+
+func (r AgreementRepo) Create(v ag.Agreement) error {
+	errCtx := fmt.Errorf("inserting agreement record")
+	fields := []string{}
+	for _, src := range []any{v.Collections, v.Authors, v.Report} {
+		data, err := json.Marshal(src)
+		if err != nil {
+			return fmt.Errorf("%w: marshaling agreement %v: %v: %w", errCtx, v.Id, err, e.ErrInternal)
+		}
+		fields = append(fields, string(data))
+	}
+	query := `INSERT INTO agreements (` + columns + `) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	if _, err := r.Db.Exec(query, v.Id, fields[0], fields[1], v.Filter, v.IoUThreshold,
+		v.Issuer, v.CreatedAt, fields[2]); err != nil {
+		return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	return nil
+}
+
+func (r AgreementRepo) Find(id t.TaskId) (*ag.Agreement, error) {
+	errCtx := fmt.Errorf("fetching agreement with id %v", id)
+	row := Row{}
+	err := r.Db.Get(&row, `SELECT `+columns+` FROM agreements WHERE id=$1`, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrNotFound)
+		default:
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrInternal)
+		}
+	}
+	return row.toEntity()
+}
+
+// ListOf lists the agreements issued by user, most recent first.
+func (r AgreementRepo) ListOf(user u.UserId, p pag.PaginationParams) ([]ag.Agreement, error) {
+	query := `SELECT ` + columns + ` FROM agreements WHERE issuer=$1
+	ORDER BY created_at DESC, id LIMIT $2 OFFSET $3`
+	rows := []Row{}
+	if err := r.Db.Select(&rows, query, user, p.PageSize, (p.Page-1)*int64(p.PageSize)); err != nil {
+		return nil, fmt.Errorf("listing agreements of %v: %v: %w", user, err, e.ErrInternal)
+	}
+	agreements := []ag.Agreement{}
+	for _, row := range rows {
+		agreement, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("listing agreements of %v: %w", user, err)
+		}
+		agreements = append(agreements, *agreement)
+	}
+	return agreements, nil
+}
+
+func (r AgreementRepo) CountOf(user u.UserId) (*int64, error) {
+	var count int64
+	if err := r.Db.Get(&count, `SELECT COUNT(*) FROM agreements WHERE issuer=$1`, user); err != nil {
+		return nil, fmt.Errorf("counting agreements of %v: %v: %w", user, err, e.ErrInternal)
+	}
+	return &count, nil
+}
+
+func NewAgreementRepo(db adb.Querier) AgreementRepo {
+	return AgreementRepo{Db: db}
+}
@@ -0,0 +1,78 @@
+package agreement
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	tk "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newAgreement(issuer string, createdAt time.Time) ag.Agreement {
+	return ag.Agreement{
+		Id: tk.NewTaskId(), Collections: []string{"a", "b"}, Authors: []u.UserId{},
+		Filter: "meta.site:lab", IoUThreshold: 0.5, Issuer: issuer, CreatedAt: createdAt,
+		Report: ag.Report{
+			Raters: []string{"a", "b"}, NumImages: 1, RegionF1: 0.5, Kappa: 0.25,
+			Pairs:       []ag.PairAgreement{{Raters: [2]string{"a", "b"}, Kappa: 0.25, RegionF1: 0.5}},
+			Images:      []ag.ImageAgreement{{Image: im.NewImageId(), NumRegions: 2, RegionF1: 0.5}},
+			Labels:      []ag.LabelAgreement{{Label: "cat", NumRegions: 2, RegionF1: 0.5}},
+			ImageLabels: []ag.ImageLabelAgreement{{Label: "indoor", Count: 1, Kappa: 0.25}},
+			Consensus:   &ag.ConsensusSummary{Collection: "c", Method: ag.MajorityVote, MinVotes: 2},
+		},
+	}
+}
+
+func TestCreateAndFindAgreement(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewAgreementRepo(db)
+	agreement := newAgreement("alice@mail.com", start)
+	assert.NoError(t, repo.Create(agreement))
+
+	got, err := repo.Find(agreement.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, agreement, *got)
+}
+
+func TestFindMissingAgreement(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	_, err := NewAgreementRepo(db).Find(tk.NewTaskId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestListAgreementsOfUser(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewAgreementRepo(db)
+	first := newAgreement("alice@mail.com", start)
+	second := newAgreement("alice@mail.com", start.Add(time.Hour))
+	for _, agreement := range []ag.Agreement{first, second, newAgreement("bob@mail.com", start)} {
+		assert.NoError(t, repo.Create(agreement))
+	}
+
+	got, err := repo.ListOf("alice@mail.com", pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []tk.TaskId{second.Id, first.Id}, []tk.TaskId{got[0].Id, got[1].Id})
+	count, _ := repo.CountOf("alice@mail.com")
+	assert.Equal(t, int64(2), *count)
+}
+
+func TestErrOnListAgreementsWithClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repo := NewAgreementRepo(db)
+	db.Close()
+	_, err := repo.ListOf("alice@mail.com", pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repo.CountOf("alice@mail.com")
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
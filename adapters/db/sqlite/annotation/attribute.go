@@ -0,0 +1,87 @@
+package annotation
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	l "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+func marshalAttributes(attrs a.Attributes) string {
+	if attrs == nil {
+		return "{}"
+	}
+	bytes, _ := json.Marshal(attrs)
+	return string(bytes)
+}
+
+func unmarshalAttributes(str string) (a.Attributes, error) {
+	attrs := a.Attributes{}
+	if str == "" {
+		return attrs, nil
+	}
+	if err := json.Unmarshal([]byte(str), &attrs); err != nil {
+		return nil, fmt.Errorf("unmarshaling attributes %v: %v: %w", str, err, e.ErrInternal)
+	}
+	return attrs, nil
+}
+
+func (r AnnotationRepo) FindAttributes(id a.AnnotationId) (a.Attributes, error) {
+	var str string
+	if err := r.Db.Get(&str, "SELECT attributes FROM annotations WHERE id=$1", id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching attributes of annotation %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching attributes of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return unmarshalAttributes(str)
+}
+
+func (r AnnotationRepo) LabelOfAnnotation(id a.AnnotationId) (*l.Label, error) {
+	var labelId l.LabelId
+	if err := r.Db.Get(&labelId, "SELECT label_id FROM annotations WHERE id=$1", id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching label of annotation %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching label of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return r.findLabelById(labelId)
+}
+
+// UpdateAttributes replaces the attributes of an annotation, which returns
+// to draft.
+func (r AnnotationRepo) UpdateAttributes(id a.AnnotationId, attrs a.Attributes, userId *u.UserId, t *time.Time) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := fmt.Errorf("updating attributes of annotation %v", id)
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		if before == nil {
+			return fmt.Errorf("%w: %w", errCtx, e.ErrNotFound)
+		}
+		query := `UPDATE annotations SET attributes=$1, author=$2, touched_at=$3,
+		status=$4, reviewer=NULL, review_comment='', reviewed_at=NULL WHERE id=$5`
+		if _, err := tx.Db.Exec(query, marshalAttributes(attrs), userId, t, a.Draft.String(), id); err != nil {
+			return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		return nil
+	})
+}
+
+func (r AnnotationRepo) updateAttributes(id a.AnnotationId, attrs a.Attributes) error {
+	if _, err := r.Db.Exec("UPDATE annotations SET attributes=$1 WHERE id=$2",
+		marshalAttributes(attrs), id); err != nil {
+		return fmt.Errorf("updating attributes: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package annotation
+
+import (
+	"testing"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var carAttributes = []lbl.Attribute{
+	{Name: "occluded", Type: lbl.BoolAttribute, Default: false},
+	{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+	{Name: "speed", Type: lbl.NumberAttribute},
+}
+
+func TestAttributesOfBoundingBox(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, _ := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	car := lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithAttributes(carAttributes))
+	assert.NoError(t, repos.Label.Create(car))
+	attrs := a.Attributes{"occluded": true, "color": "red", "speed": 12.5}
+	box := a.NewBoundingBox(a.NewAnnotationId(), 1, 2, 3, 4, car, a.WithAttributes(attrs))
+	assert.NoError(t, repos.Annotation.AddBoundingBox(image.Id, collection.Name, box, nil, nil))
+
+	boxes, err := repos.Annotation.FindBoundingBoxes(image.Id, collection.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, attrs, boxes[0].Attributes)
+	assert.Equal(t, carAttributes, boxes[0].Label.Attributes)
+
+	found, err := repos.Annotation.FindAttributes(box.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, attrs, found)
+
+	label, err := repos.Annotation.LabelOfAnnotation(box.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, "car", label.Name)
+}
+
+func TestUpdateAttributes(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	polygon := a.NewPolygon(a.NewAnnotationId(), TestingPolygonPoints, label)
+	polygon.Attributes = a.Attributes{"color": "red"}
+	repos.Annotation.AddPolygon(image.Id, collection.Name, polygon, nil, nil)
+
+	assert.NoError(t, repos.Annotation.UpdateAttributes(polygon.Id, a.Attributes{"color": "blue"}, nil, nil))
+	polygons, _ := repos.Annotation.FindPolygons(image.Id, collection.Name)
+	assert.Equal(t, a.Attributes{"color": "blue"}, polygons[0].Attributes)
+
+	revisions, _ := repos.Annotation.ListRevisions(polygon.Id)
+	assert.Equal(t, a.Attributes{"color": "red"}, revisions[1].Before.Attributes)
+	assert.Equal(t, a.Attributes{"color": "blue"}, revisions[1].After.Attributes)
+
+	_, err := repos.Annotation.RestoreRevision(revisions[0].Id, nil, nil)
+	assert.NoError(t, err)
+	polygons, _ = repos.Annotation.FindPolygons(image.Id, collection.Name)
+	assert.Equal(t, a.Attributes{"color": "red"}, polygons[0].Attributes)
+}
+
+func TestUpdateAttributesOfMissingAnnotationShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	err := repos.Annotation.UpdateAttributes(a.NewAnnotationId(), a.Attributes{}, nil, nil)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
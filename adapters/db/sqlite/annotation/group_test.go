@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -26,3 +27,13 @@ func TestRetrieveGroupOfAnnotation(t *testing.T) {
 	assert.NotNil(t, group)
 	assert.Equal(t, *collection.Group, *r)
 }
+
+func TestRetrieveCollectionOfAnnotation(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	_, collection, label, imageLabel := CreateAnnotedImage(repos, "a-collection", "a-label", nil)
+	assert.NoError(t, repos.Collection.SetLabels(collection.Name, []lbl.LabelId{label.Id}, nil))
+	r, err := repos.Annotation.CollectionOfAnnotation(imageLabel.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, collection.Name, r.Name)
+	assert.Equal(t, []string{"a-label"}, r.Labels)
+}
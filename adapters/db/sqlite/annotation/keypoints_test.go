@@ -0,0 +1,79 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var TestingKeypoints = []a.Keypoint{{X: 1, Y: 2, Visibility: a.Visible}, {X: 3, Y: 4, Visibility: a.Occluded},
+	{Visibility: a.NotLabeled}}
+
+func TestInternalErrOnFindKeypointsShouldFail(t *testing.T) {
+	db := s.NewInMemory()
+	repos := NewAnnotationTestRepos(db)
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	repos.Annotation.AddKeypoints(image.Id, collection.Name,
+		a.NewKeypoints(a.NewAnnotationId(), TestingKeypoints, label), nil, nil)
+	db.Close()
+	_, err := repos.Annotation.FindKeypoints(image.Id, collection.Name)
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestAddKeypoints(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	k := a.NewKeypoints(a.NewAnnotationId(), TestingKeypoints, label)
+	k.Attributes = a.Attributes{"crowd": false}
+	assert.NoError(t, repos.Annotation.AddKeypoints(image.Id, collection.Name, k, nil, nil))
+
+	r, err := repos.Annotation.FindKeypoints(image.Id, collection.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r))
+	assert.Equal(t, k.Id, r[0].Id)
+	assert.Equal(t, "a-label", r[0].Label.Name)
+	assert.Equal(t, TestingKeypoints, r[0].Points)
+	assert.Equal(t, k.Attributes, r[0].Attributes)
+
+	polygons, _ := repos.Annotation.FindPolygons(image.Id, collection.Name)
+	assert.Equal(t, 0, len(polygons))
+	used, err := repos.Label.HasKeypoints("a-label")
+	assert.NoError(t, err)
+	assert.True(t, used)
+}
+
+func TestUpdateKeypointsIsRecorded(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	k := a.NewKeypoints(a.NewAnnotationId(), TestingKeypoints, label)
+	repos.Annotation.AddKeypoints(image.Id, collection.Name, k, nil, nil)
+	user := u.NewUser("user@example.com")
+	repos.User.Create(user)
+
+	moved := []a.Keypoint{{X: 5, Y: 6, Visibility: a.Visible}, {X: 3, Y: 4, Visibility: a.Visible},
+		{X: 7, Y: 8, Visibility: a.Occluded}}
+	now := time.Now()
+	err := repos.Annotation.UpdateKeypoints(k.Id, a.KeypointsUpdatables{LabelId: label.Id, Points: moved},
+		&user.Id, &now)
+	assert.NoError(t, err)
+
+	r, _ := repos.Annotation.FindKeypoints(image.Id, collection.Name)
+	assert.Equal(t, moved, r[0].Points)
+	assert.Equal(t, user.Id, *r[0].Author)
+
+	revisions, err := repos.Annotation.ListRevisions(k.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(revisions))
+	assert.Equal(t, TestingKeypoints, revisions[1].Before.Keypoints)
+	assert.Equal(t, moved, revisions[1].After.Keypoints)
+
+	_, err = repos.Annotation.RestoreRevision(revisions[0].Id, &user.Id, &now)
+	assert.NoError(t, err)
+	r, _ = repos.Annotation.FindKeypoints(image.Id, collection.Name)
+	assert.Equal(t, TestingKeypoints, r[0].Points)
+}
@@ -0,0 +1,93 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var TestingRLE = a.RLE{Width: 3, Height: 2, Counts: []int{1, 3, 2}}
+
+func TestInternalErrOnFindMasksShouldFail(t *testing.T) {
+	db := s.NewInMemory()
+	repos := NewAnnotationTestRepos(db)
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	repos.Annotation.AddMask(image.Id, collection.Name, a.NewMask(a.NewAnnotationId(), TestingRLE, label), nil, nil)
+	db.Close()
+	_, err := repos.Annotation.FindMasks(image.Id, collection.Name)
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestAddMask(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	m := a.NewMask(a.NewAnnotationId(), TestingRLE, label)
+	m.Attributes = a.Attributes{"crowd": true}
+	assert.NoError(t, repos.Annotation.AddMask(image.Id, collection.Name, m, nil, nil))
+
+	r, err := repos.Annotation.FindMasks(image.Id, collection.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r))
+	assert.Equal(t, m.Id, r[0].Id)
+	assert.Equal(t, "a-label", r[0].Label.Name)
+	assert.Equal(t, TestingRLE, r[0].RLE)
+	assert.Equal(t, m.Attributes, r[0].Attributes)
+
+	found, err := repos.Annotation.FindMask(m.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, TestingRLE, found.RLE)
+
+	base, err := repos.Annotation.ImageOfAnnotation(m.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, image.Id, base.ImageId)
+	assert.Equal(t, collection.Name, base.Collection)
+}
+
+func TestFindMaskOfOtherTypeShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	p := a.NewPolygon(a.NewAnnotationId(), TestingPolyline, label)
+	repos.Annotation.AddPolygon(image.Id, collection.Name, p, nil, nil)
+
+	_, err := repos.Annotation.FindMask(p.Id)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+	found, err := repos.Annotation.FindPolygon(p.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, TestingPolyline, found.Points)
+}
+
+func TestUpdateMaskIsRecorded(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	m := a.NewMask(a.NewAnnotationId(), TestingRLE, label)
+	repos.Annotation.AddMask(image.Id, collection.Name, m, nil, nil)
+	user := u.NewUser("user@example.com")
+	repos.User.Create(user)
+
+	painted := a.RLE{Width: 3, Height: 2, Counts: []int{0, 6}}
+	now := time.Now()
+	err := repos.Annotation.UpdateMask(m.Id, a.MaskUpdatables{LabelId: label.Id, RLE: painted},
+		&user.Id, &now)
+	assert.NoError(t, err)
+
+	r, _ := repos.Annotation.FindMasks(image.Id, collection.Name)
+	assert.Equal(t, painted, r[0].RLE)
+	assert.Equal(t, user.Id, *r[0].Author)
+
+	revisions, err := repos.Annotation.ListRevisions(m.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(revisions))
+	assert.Equal(t, "mask", revisions[1].Before.Type)
+	assert.Equal(t, TestingRLE, *revisions[1].Before.Mask)
+	assert.Equal(t, painted, *revisions[1].After.Mask)
+
+	_, err = repos.Annotation.RestoreRevision(revisions[0].Id, &user.Id, &now)
+	assert.NoError(t, err)
+	r, _ = repos.Annotation.FindMasks(image.Id, collection.Name)
+	assert.Equal(t, TestingRLE, r[0].RLE)
+}
@@ -0,0 +1,75 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var TestingPolyline = a.Points{Coordinates: [][2]float32{{0, 0}, {1, 2}, {3, 3}}}
+
+func TestInternalErrOnFindPolylinesShouldFail(t *testing.T) {
+	db := s.NewInMemory()
+	repos := NewAnnotationTestRepos(db)
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	repos.Annotation.AddPolyline(image.Id, collection.Name,
+		a.NewPolyline(a.NewAnnotationId(), TestingPolyline, label), nil, nil)
+	db.Close()
+	_, err := repos.Annotation.FindPolylines(image.Id, collection.Name)
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestAddPolyline(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	p := a.NewPolyline(a.NewAnnotationId(), TestingPolyline, label)
+	p.Attributes = a.Attributes{"dashed": true}
+	assert.NoError(t, repos.Annotation.AddPolyline(image.Id, collection.Name, p, nil, nil))
+
+	r, err := repos.Annotation.FindPolylines(image.Id, collection.Name)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r))
+	assert.Equal(t, p.Id, r[0].Id)
+	assert.Equal(t, "a-label", r[0].Label.Name)
+	assert.Equal(t, TestingPolyline, r[0].Points)
+	assert.Equal(t, p.Attributes, r[0].Attributes)
+
+	polygons, _ := repos.Annotation.FindPolygons(image.Id, collection.Name)
+	assert.Equal(t, 0, len(polygons))
+}
+
+func TestUpdatePolylineIsRecorded(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	p := a.NewPolyline(a.NewAnnotationId(), TestingPolyline, label)
+	repos.Annotation.AddPolyline(image.Id, collection.Name, p, nil, nil)
+	user := u.NewUser("user@example.com")
+	repos.User.Create(user)
+
+	moved := a.Points{Coordinates: [][2]float32{{5, 5}, {6, 6}}}
+	now := time.Now()
+	err := repos.Annotation.UpdatePolyline(p.Id, a.PolylineUpdatables{LabelId: label.Id, Points: moved},
+		&user.Id, &now)
+	assert.NoError(t, err)
+
+	r, _ := repos.Annotation.FindPolylines(image.Id, collection.Name)
+	assert.Equal(t, moved, r[0].Points)
+	assert.Equal(t, user.Id, *r[0].Author)
+
+	revisions, err := repos.Annotation.ListRevisions(p.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(revisions))
+	assert.Equal(t, "polyline", revisions[1].Before.Type)
+	assert.Equal(t, TestingPolyline, *revisions[1].Before.Points)
+	assert.Equal(t, moved, *revisions[1].After.Points)
+
+	_, err = repos.Annotation.RestoreRevision(revisions[0].Id, &user.Id, &now)
+	assert.NoError(t, err)
+	r, _ = repos.Annotation.FindPolylines(image.Id, collection.Name)
+	assert.Equal(t, TestingPolyline, r[0].Points)
+}
@@ -0,0 +1,71 @@
+package annotation
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const sourceColumns = "model,confidence"
+
+type SourceRow struct {
+	Model      string   `db:"model"`
+	Confidence *float32 `db:"confidence"`
+}
+
+func newSourceRow(s a.Source) SourceRow {
+	if !s.IsPrediction() {
+		return SourceRow{}
+	}
+	return SourceRow{Model: s.Model, Confidence: &s.Confidence}
+}
+
+func (r SourceRow) toEntity() a.Source {
+	s := a.Source{Model: r.Model}
+	if r.Confidence != nil {
+		s.Confidence = *r.Confidence
+	}
+	return s
+}
+
+func (r AnnotationRepo) FindSource(id a.AnnotationId) (*a.Source, error) {
+	row := SourceRow{}
+	err := r.Db.Get(&row, `SELECT `+sourceColumns+` FROM annotations WHERE id=$1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching source of annotation %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching source of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	source := row.toEntity()
+	return &source, nil
+}
+
+// AcceptPrediction turns a prediction into an annotation of the given user,
+// as if they had drawn it. The annotation returns to draft.
+func (r AnnotationRepo) AcceptPrediction(id a.AnnotationId, userId *u.UserId, t *time.Time) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := fmt.Errorf("accepting prediction %v", id)
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		if before == nil {
+			return fmt.Errorf("%w: %w", errCtx, e.ErrNotFound)
+		}
+		query := `UPDATE annotations SET model='', confidence=NULL, author=$1, touched_at=$2,
+		status=$3, reviewer=NULL, review_comment='', reviewed_at=NULL WHERE id=$4`
+		if _, err := tx.Db.Exec(query, userId, t, a.Draft.String(), id); err != nil {
+			return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPredictionKeepsItsSource(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	source, _ := a.NewPrediction("a-model", 0.8)
+	bbox := a.NewBoundingBox(a.NewAnnotationId(), 1, 2, 3, 4, label)
+	bbox.Source = source
+	points := a.Points{Coordinates: [][2]float32{{0, 0}, {1, 1}, {0, 1}}}
+	polygon := a.NewPolygon(a.NewAnnotationId(), points, label)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, nil, nil)
+	repos.Annotation.AddPolygon(image.Id, collection.Name, polygon, nil, nil)
+
+	boxes, _ := repos.Annotation.FindBoundingBoxes(image.Id, collection.Name)
+	assert.Equal(t, source, boxes[0].Source)
+	polygons, _ := repos.Annotation.FindPolygons(image.Id, collection.Name)
+	assert.False(t, polygons[0].Source.IsPrediction())
+
+	got, err := repos.Annotation.FindSource(bbox.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, source, *got)
+	revisions, _ := repos.Annotation.ListRevisions(bbox.Id)
+	assert.Equal(t, source, revisions[0].After.Source)
+}
+
+func TestAcceptPrediction(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	user := u.NewUser("user@example.com")
+	repos.User.Create(user)
+	bbox := a.NewBoundingBox(a.NewAnnotationId(), 1, 2, 3, 4, label)
+	bbox.Source, _ = a.NewPrediction("a-model", 0.8)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, nil, nil)
+	now := time.Now()
+
+	assert.NoError(t, repos.Annotation.AcceptPrediction(bbox.Id, &user.Id, &now))
+
+	boxes, _ := repos.Annotation.FindBoundingBoxes(image.Id, collection.Name)
+	assert.False(t, boxes[0].Source.IsPrediction())
+	assert.Equal(t, user.Id, *boxes[0].Author)
+	revisions, _ := repos.Annotation.ListRevisions(bbox.Id)
+	assert.Equal(t, 2, len(revisions))
+	assert.Equal(t, a.Modified, revisions[1].Action)
+	assert.True(t, revisions[1].Before.Source.IsPrediction())
+	assert.False(t, revisions[1].After.Source.IsPrediction())
+}
+
+func TestFindSourceOfMissingAnnotationShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	_, err := repos.Annotation.FindSource(a.NewAnnotationId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+	err = repos.Annotation.AcceptPrediction(a.NewAnnotationId(), nil, nil)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
@@ -2,9 +2,11 @@ package annotation
 
 import (
 	"testing"
+	"time"
 
 	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,7 +18,7 @@ func TestInternalErrOnRemoveAnnotationShouldFail(t *testing.T) {
 	annotationId := a.NewAnnotationId()
 	repos.Annotation.AddImageLabel(image.Id, collection.Name, a.NewImageLabel(label), nil, nil)
 	db.Close()
-	err := repos.Annotation.RemoveAnnotation(annotationId)
+	err := repos.Annotation.RemoveAnnotation(annotationId, nil, nil)
 	assert.ErrorIs(t, err, e.ErrInternal)
 }
 
@@ -25,7 +27,7 @@ func TestRemoveAnnotation(t *testing.T) {
 	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
 	annotationId := a.NewAnnotationId()
 	repos.Annotation.AddImageLabel(image.Id, collection.Name, a.NewImageLabel(label), nil, nil)
-	err := repos.Annotation.RemoveAnnotation(annotationId)
+	err := repos.Annotation.RemoveAnnotation(annotationId, nil, nil)
 	assert.NoError(t, err)
 }
 
@@ -34,7 +36,7 @@ func TestInternalErrOnRemoveImageLabelShouldFail(t *testing.T) {
 	repos := NewAnnotationTestRepos(db)
 	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
 	db.Close()
-	err := repos.Annotation.RemoveImageLabel(image.Id, collection.Name, label.Id)
+	err := repos.Annotation.RemoveImageLabel(image.Id, collection.Name, label.Id, nil, nil)
 	assert.ErrorIs(t, err, e.ErrInternal)
 }
 
@@ -42,7 +44,7 @@ func TestRemoveImageLabel(t *testing.T) {
 	repos := NewAnnotationTestRepos(s.NewInMemory())
 	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
 	repos.Annotation.AddImageLabel(image.Id, collection.Name, a.NewImageLabel(label), nil, nil)
-	err := repos.Annotation.RemoveImageLabel(image.Id, collection.Name, label.Id)
+	err := repos.Annotation.RemoveImageLabel(image.Id, collection.Name, label.Id, nil, nil)
 	assert.NoError(t, err)
 	labels, _ := repos.Annotation.FindImageLabels(image.Id, collection.Name)
 	assert.Equal(t, 0, len(labels))
@@ -52,8 +54,29 @@ func TestRemoveAllAnnotations(t *testing.T) {
 	repos := NewAnnotationTestRepos(s.NewInMemory())
 	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
 	repos.Annotation.AddImageLabel(image.Id, collection.Name, a.NewImageLabel(label), nil, nil)
-	err := repos.Annotation.RemoveAllAnnotations(image.Id, collection.Name)
+	err := repos.Annotation.RemoveAllAnnotations(image.Id, collection.Name, nil, nil)
 	assert.NoError(t, err)
 	labels, _ := repos.Annotation.FindImageLabels(image.Id, collection.Name)
 	assert.Equal(t, 0, len(labels))
 }
+
+func TestRemoveAllAnnotationsRecordsDeletions(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	imageLabel := a.NewImageLabel(label)
+	repos.Annotation.AddImageLabel(image.Id, collection.Name, imageLabel, nil, nil)
+	user := u.UserId("alice@mail.com")
+	now := time.Now()
+	err := repos.Annotation.RemoveAllAnnotations(image.Id, collection.Name, &user, &now)
+	assert.NoError(t, err)
+
+	revisions, _ := repos.Annotation.ListRevisions(imageLabel.Id)
+	assert.Equal(t, []a.RevisionAction{a.Created, a.Deleted},
+		[]a.RevisionAction{revisions[0].Action, revisions[1].Action})
+	assert.Equal(t, &user, revisions[1].Author)
+
+	_, err = repos.Annotation.RestoreRevision(revisions[0].Id, nil, nil)
+	assert.NoError(t, err)
+	labels, _ := repos.Annotation.FindImageLabels(image.Id, collection.Name)
+	assert.Equal(t, 1, len(labels))
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	sc "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
 	sl "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
 	c "github.com/lejeunel/go-image-annotator/entities/collection"
@@ -28,6 +29,9 @@ type AnnotationRow struct {
 	Coordinates string         `db:"coordinates"`
 	Author      *u.UserId      `db:"author"`
 	Time        *time.Time     `db:"touched_at"`
+	Attributes  string         `db:"attributes"`
+	ReviewRow
+	SourceRow
 }
 
 type BoundingBoxSpecs struct {
@@ -47,6 +51,81 @@ type PolygonSpecs struct {
 	Points []PointSpec `json:"points"`
 }
 
+type KeypointSpec struct {
+	X float32 `json:"x"`
+	Y float32 `json:"y"`
+	V int     `json:"v"`
+}
+
+type KeypointsSpecs struct {
+	Points []KeypointSpec `json:"points"`
+}
+
+func marshalPoints(points a.Points) string {
+	specs := PolygonSpecs{Points: []PointSpec{}}
+	for _, p := range points.Coordinates {
+		specs.Points = append(specs.Points, PointSpec{X: p[0], Y: p[1]})
+	}
+	bytes, _ := json.Marshal(specs)
+	return string(bytes)
+}
+
+func unmarshalPoints(coordinates []byte) (*a.Points, error) {
+	var specs PolygonSpecs
+	if err := json.Unmarshal(coordinates, &specs); err != nil {
+		return nil, fmt.Errorf("unmarshaling points %v: %v: %w", string(coordinates), err, e.ErrInternal)
+	}
+	points := a.Points{}
+	for _, p := range specs.Points {
+		points.Coordinates = append(points.Coordinates, [2]float32{p.X, p.Y})
+	}
+	return &points, nil
+}
+
+// RLESpecs follows the layout of COCO, where size is height then width.
+type RLESpecs struct {
+	Size   [2]int `json:"size"`
+	Counts []int  `json:"counts"`
+}
+
+func marshalRLE(rle a.RLE) string {
+	counts := rle.Counts
+	if counts == nil {
+		counts = []int{}
+	}
+	bytes, _ := json.Marshal(RLESpecs{Size: [2]int{rle.Height, rle.Width}, Counts: counts})
+	return string(bytes)
+}
+
+func unmarshalRLE(coordinates []byte) (*a.RLE, error) {
+	var specs RLESpecs
+	if err := json.Unmarshal(coordinates, &specs); err != nil {
+		return nil, fmt.Errorf("unmarshaling mask %v: %v: %w", string(coordinates), err, e.ErrInternal)
+	}
+	return &a.RLE{Height: specs.Size[0], Width: specs.Size[1], Counts: specs.Counts}, nil
+}
+
+func marshalKeypoints(points []a.Keypoint) string {
+	specs := []KeypointSpec{}
+	for _, p := range points {
+		specs = append(specs, KeypointSpec{X: p.X, Y: p.Y, V: int(p.Visibility)})
+	}
+	bytes, _ := json.Marshal(KeypointsSpecs{Points: specs})
+	return string(bytes)
+}
+
+func unmarshalKeypoints(coordinates []byte) ([]a.Keypoint, error) {
+	var specs KeypointsSpecs
+	if err := json.Unmarshal(coordinates, &specs); err != nil {
+		return nil, fmt.Errorf("unmarshaling keypoints specs: %+v: %v: %w", string(coordinates), err, e.ErrInternal)
+	}
+	points := []a.Keypoint{}
+	for _, p := range specs.Points {
+		points = append(points, a.Keypoint{X: p.X, Y: p.Y, Visibility: a.Visibility(p.V)})
+	}
+	return points, nil
+}
+
 func (r AnnotationRepo) AddImageLabel(
 	imageId i.ImageId,
 	collection c.CollectionName,
@@ -54,31 +133,48 @@ func (r AnnotationRepo) AddImageLabel(
 	userId *u.UserId,
 	t *time.Time,
 ) error {
-	query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, author, touched_at)
-		VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7)`
-	_, err := r.Db.Exec(query, ann.Id, imageId, collection, ann.Label.Id, "image", userId, t)
-	if err != nil {
-		return fmt.Errorf("adding image label annotation record: %v: %w", err, e.ErrInternal)
-	}
+	return r.inTx(func(tx AnnotationRepo) error {
+		rv := newReviewRow(ann.Review)
+		query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, author, touched_at,
+			status, reviewer, review_comment, reviewed_at)
+			VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8,$9,$10,$11)`
+		_, err := tx.Db.Exec(query, ann.Id, imageId, collection, ann.Label.Id, "image", userId, t,
+			rv.Status, rv.Reviewer, rv.Comment, rv.ReviewedAt)
+		if err != nil {
+			return fmt.Errorf("adding image label annotation record: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(ann.Id, a.Created, nil, nil, userId, t); err != nil {
+			return fmt.Errorf("adding image label: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (r AnnotationRepo) findLabelById(labelId l.LabelId) (*l.Label, error) {
 	rec := sl.LabelRecord{}
 	err := r.Db.Get(&rec,
-		"SELECT id,name,description FROM labels WHERE id=$1", labelId)
+		"SELECT id,name,description,color,attributes,skeleton FROM labels WHERE id=$1", labelId)
 	if err != nil {
 		return nil, fmt.Errorf("fetching label by id %v: %w", labelId, e.ErrInternal)
 	}
-	return &l.Label{Id: rec.Id, Name: rec.Name, Description: rec.Description}, nil
+	attributes, err := sl.UnmarshalAttributes(rec.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("fetching label by id %v: %w", labelId, err)
+	}
+	skeleton, err := sl.UnmarshalSkeleton(rec.Skeleton)
+	if err != nil {
+		return nil, fmt.Errorf("fetching label by id %v: %w", labelId, err)
+	}
+	return &l.Label{Id: rec.Id, Name: rec.Name, Description: rec.Description, Color: rec.Color,
+		Attributes: attributes, Skeleton: skeleton}, nil
 }
 
 func (r AnnotationRepo) FindImageLabels(
 	imageId i.ImageId,
 	collection c.CollectionName,
 ) ([]a.ImageLabel, error) {
-	query := `SELECT id,label_id,type,author,touched_at FROM annotations
+	query := `SELECT id,label_id,type,author,touched_at,` + reviewColumns + ` FROM annotations
 	WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND type='image'`
 
 	errCtx := "querying image annotations"
@@ -95,40 +191,65 @@ func (r AnnotationRepo) FindImageLabels(
 		}
 		imageLabels = append(
 			imageLabels,
-			a.ImageLabel{Id: rec.Id, Label: *label, Author: rec.Author, Time: rec.Time},
+			a.ImageLabel{Id: rec.Id, Label: *label, Author: rec.Author, Time: rec.Time,
+				Review: rec.ReviewRow.toEntity()},
 		)
 	}
 
 	return imageLabels, nil
 }
 
-func (r AnnotationRepo) RemoveAllAnnotations(imageId i.ImageId, collection string) error {
-	_, err := r.Db.Exec(
-		"DELETE FROM annotations WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2)",
+func (r AnnotationRepo) RemoveAllAnnotations(
+	imageId i.ImageId,
+	collection string,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	ids := []a.AnnotationId{}
+	err := r.Db.Select(
+		&ids,
+		"SELECT id FROM annotations WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2)",
 		imageId,
 		collection,
 	)
 	if err != nil {
 		return fmt.Errorf("deleting annotations: %v: %w", err, e.ErrInternal)
 	}
+	for _, id := range ids {
+		if err := r.RemoveAnnotation(id, userId, t); err != nil {
+			return fmt.Errorf("deleting annotations: %w", err)
+		}
+	}
 	return nil
 }
 
-func (r AnnotationRepo) RemoveAnnotation(id a.AnnotationId) error {
-	_, err := r.Db.Exec("DELETE FROM annotations WHERE id=$1", id)
-	if err != nil {
-		return fmt.Errorf("deleting annotation record: %v: %w", err, e.ErrInternal)
-	}
-	return nil
+func (r AnnotationRepo) RemoveAnnotation(id a.AnnotationId, userId *u.UserId, t *time.Time) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("deleting annotation: %w", err)
+		}
+		if _, err := tx.Db.Exec("DELETE FROM annotations WHERE id=$1", id); err != nil {
+			return fmt.Errorf("deleting annotation record: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(id, a.Deleted, before, nil, userId, t); err != nil {
+			return fmt.Errorf("deleting annotation: %w", err)
+		}
+		return nil
+	})
 }
 
 func (r AnnotationRepo) RemoveImageLabel(
 	imageId i.ImageId,
 	collection c.CollectionName,
 	labelId l.LabelId,
+	userId *u.UserId,
+	t *time.Time,
 ) error {
-	_, err := r.Db.Exec(
-		"DELETE FROM annotations WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND label_id=$3 AND type='image'",
+	ids := []a.AnnotationId{}
+	err := r.Db.Select(
+		&ids,
+		"SELECT id FROM annotations WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND label_id=$3 AND type='image'",
 		imageId,
 		collection,
 		labelId,
@@ -136,6 +257,11 @@ func (r AnnotationRepo) RemoveImageLabel(
 	if err != nil {
 		return fmt.Errorf("deleting image label: %v: %w", err, e.ErrInternal)
 	}
+	for _, id := range ids {
+		if err := r.RemoveAnnotation(id, userId, t); err != nil {
+			return fmt.Errorf("deleting image label: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -146,37 +272,46 @@ func (r AnnotationRepo) AddPolygon(
 	userId *u.UserId,
 	t *time.Time,
 ) error {
-	pointSpecs := []PointSpec{}
-	for _, p := range polygon.Points.Coordinates {
-		pointSpecs = append(pointSpecs, PointSpec{X: p[0], Y: p[1]})
-	}
-	coordsBytes, _ := json.Marshal(PolygonSpecs{Points: pointSpecs})
-	coordsString := string(coordsBytes)
-	query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at)
-		VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8)`
-	_, err := r.Db.Exec(
-		query,
-		polygon.Id,
-		imageId,
-		collection,
-		polygon.Label.Id,
-		"polygon",
-		coordsString,
-		userId,
-		t,
-	)
-	if err != nil {
-		return fmt.Errorf("inserting polygon: %v: %w", err, e.ErrInternal)
-	}
+	return r.inTx(func(tx AnnotationRepo) error {
+		rv := newReviewRow(polygon.Review)
+		src := newSourceRow(polygon.Source)
+		query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at,
+			status, reviewer, review_comment, reviewed_at, model, confidence, attributes)
+			VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)`
+		_, err := tx.Db.Exec(
+			query,
+			polygon.Id,
+			imageId,
+			collection,
+			polygon.Label.Id,
+			"polygon",
+			marshalPoints(polygon.Points),
+			userId,
+			t,
+			rv.Status,
+			rv.Reviewer,
+			rv.Comment,
+			rv.ReviewedAt,
+			src.Model,
+			src.Confidence,
+			marshalAttributes(polygon.Attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting polygon: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(polygon.Id, a.Created, nil, nil, userId, t); err != nil {
+			return fmt.Errorf("inserting polygon: %w", err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
 func (r AnnotationRepo) FindPolygons(
 	imageId i.ImageId,
 	collection c.CollectionName,
 ) ([]a.Polygon, error) {
-	query := `SELECT id,label_id,type,coordinates,author,touched_at
+	query := `SELECT id,label_id,type,coordinates,author,touched_at,attributes,` + reviewColumns + `,` + sourceColumns + `
 		FROM annotations
 		WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND type='polygon'`
 
@@ -208,54 +343,377 @@ func (r AnnotationRepo) FindPolygons(
 		if rec.Time != nil {
 			polygon.Time = rec.Time
 		}
+		polygon.Review = rec.ReviewRow.toEntity()
+		polygon.Source = rec.SourceRow.toEntity()
+		polygon.Attributes, err = unmarshalAttributes(rec.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
 		polygons = append(polygons, polygon)
 	}
 
 	return polygons, nil
 }
 
-func (r AnnotationRepo) AddBoundingBox(
+func (r AnnotationRepo) AddPolyline(
 	imageId i.ImageId,
 	collection c.CollectionName,
-	box a.BoundingBox,
+	polyline a.Polyline,
 	userId *u.UserId,
 	t *time.Time,
 ) error {
-	coordsBytes, _ := json.Marshal(
-		BoundingBoxSpecs{
-			Xc:     box.Xc,
-			Yc:     box.Yc,
-			Width:  box.Width,
-			Height: box.Height,
-			Angle:  box.Angle,
-		},
-	)
-	coordsString := string(coordsBytes)
-	query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at)
-		VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8)`
-	_, err := r.Db.Exec(
-		query,
-		box.Id,
-		imageId,
-		collection,
-		box.Label.Id,
-		"bounding_box",
-		coordsString,
-		userId,
-		t,
-	)
+	return r.inTx(func(tx AnnotationRepo) error {
+		rv := newReviewRow(polyline.Review)
+		src := newSourceRow(polyline.Source)
+		query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at,
+			status, reviewer, review_comment, reviewed_at, model, confidence, attributes)
+			VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)`
+		_, err := tx.Db.Exec(
+			query,
+			polyline.Id,
+			imageId,
+			collection,
+			polyline.Label.Id,
+			"polyline",
+			marshalPoints(polyline.Points),
+			userId,
+			t,
+			rv.Status,
+			rv.Reviewer,
+			rv.Comment,
+			rv.ReviewedAt,
+			src.Model,
+			src.Confidence,
+			marshalAttributes(polyline.Attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting polyline: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(polyline.Id, a.Created, nil, nil, userId, t); err != nil {
+			return fmt.Errorf("inserting polyline: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r AnnotationRepo) FindPolylines(
+	imageId i.ImageId,
+	collection c.CollectionName,
+) ([]a.Polyline, error) {
+	query := `SELECT id,label_id,type,coordinates,author,touched_at,attributes,` + reviewColumns + `,` + sourceColumns + `
+		FROM annotations
+		WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND type='polyline'`
+
+	errCtx := "querying polyline annotations"
+	records := []AnnotationRow{}
+	if err := r.Db.Select(&records, query, imageId, collection); err != nil {
+		return nil, fmt.Errorf("%v: applying query: %v: %w", errCtx, err, e.ErrInternal)
+	}
+
+	polylines := []a.Polyline{}
+	for _, rec := range records {
+		points, err := unmarshalPoints([]byte(rec.Coordinates))
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		label, err := r.findLabelById(rec.LabelId)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		polyline := a.NewPolyline(rec.Id, *points, *label)
+		polyline.Author = rec.Author
+		polyline.Time = rec.Time
+		polyline.Review = rec.ReviewRow.toEntity()
+		polyline.Source = rec.SourceRow.toEntity()
+		polyline.Attributes, err = unmarshalAttributes(rec.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		polylines = append(polylines, polyline)
+	}
+
+	return polylines, nil
+}
+
+func (r AnnotationRepo) AddKeypoints(
+	imageId i.ImageId,
+	collection c.CollectionName,
+	k a.Keypoints,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		rv := newReviewRow(k.Review)
+		src := newSourceRow(k.Source)
+		query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at,
+			status, reviewer, review_comment, reviewed_at, model, confidence, attributes)
+			VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)`
+		_, err := tx.Db.Exec(
+			query,
+			k.Id,
+			imageId,
+			collection,
+			k.Label.Id,
+			"keypoints",
+			marshalKeypoints(k.Points),
+			userId,
+			t,
+			rv.Status,
+			rv.Reviewer,
+			rv.Comment,
+			rv.ReviewedAt,
+			src.Model,
+			src.Confidence,
+			marshalAttributes(k.Attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting keypoints: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(k.Id, a.Created, nil, nil, userId, t); err != nil {
+			return fmt.Errorf("inserting keypoints: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r AnnotationRepo) FindKeypoints(
+	imageId i.ImageId,
+	collection c.CollectionName,
+) ([]a.Keypoints, error) {
+	query := `SELECT id,label_id,type,coordinates,author,touched_at,attributes,` + reviewColumns + `,` + sourceColumns + `
+		FROM annotations
+		WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND type='keypoints'`
+
+	errCtx := "querying keypoints annotations"
+	records := []AnnotationRow{}
+	if err := r.Db.Select(&records, query, imageId, collection); err != nil {
+		return nil, fmt.Errorf("%v: applying query: %v: %w", errCtx, err, e.ErrInternal)
+	}
+
+	poses := []a.Keypoints{}
+	for _, rec := range records {
+		points, err := unmarshalKeypoints([]byte(rec.Coordinates))
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		label, err := r.findLabelById(rec.LabelId)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		k := a.NewKeypoints(rec.Id, points, *label)
+		k.Author = rec.Author
+		k.Time = rec.Time
+		k.Review = rec.ReviewRow.toEntity()
+		k.Source = rec.SourceRow.toEntity()
+		k.Attributes, err = unmarshalAttributes(rec.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		poses = append(poses, k)
+	}
+
+	return poses, nil
+}
+
+func (r AnnotationRepo) AddMask(
+	imageId i.ImageId,
+	collection c.CollectionName,
+	mask a.Mask,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		rv := newReviewRow(mask.Review)
+		src := newSourceRow(mask.Source)
+		query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at,
+			status, reviewer, review_comment, reviewed_at, model, confidence, attributes)
+			VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)`
+		_, err := tx.Db.Exec(
+			query,
+			mask.Id,
+			imageId,
+			collection,
+			mask.Label.Id,
+			"mask",
+			marshalRLE(mask.RLE),
+			userId,
+			t,
+			rv.Status,
+			rv.Reviewer,
+			rv.Comment,
+			rv.ReviewedAt,
+			src.Model,
+			src.Confidence,
+			marshalAttributes(mask.Attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting mask: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(mask.Id, a.Created, nil, nil, userId, t); err != nil {
+			return fmt.Errorf("inserting mask: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (r AnnotationRepo) toMask(rec AnnotationRow) (*a.Mask, error) {
+	rle, err := unmarshalRLE([]byte(rec.Coordinates))
 	if err != nil {
-		return fmt.Errorf("inserting bounding box: %v: %w", err, e.ErrInternal)
+		return nil, err
+	}
+	label, err := r.findLabelById(rec.LabelId)
+	if err != nil {
+		return nil, err
+	}
+	mask := a.NewMask(rec.Id, *rle, *label)
+	mask.Author = rec.Author
+	mask.Time = rec.Time
+	mask.Review = rec.ReviewRow.toEntity()
+	mask.Source = rec.SourceRow.toEntity()
+	mask.Attributes, err = unmarshalAttributes(rec.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	return &mask, nil
+}
+
+func (r AnnotationRepo) FindMasks(
+	imageId i.ImageId,
+	collection c.CollectionName,
+) ([]a.Mask, error) {
+	query := `SELECT id,label_id,type,coordinates,author,touched_at,attributes,` + reviewColumns + `,` + sourceColumns + `
+		FROM annotations
+		WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND type='mask'`
+
+	errCtx := "querying mask annotations"
+	records := []AnnotationRow{}
+	if err := r.Db.Select(&records, query, imageId, collection); err != nil {
+		return nil, fmt.Errorf("%v: applying query: %v: %w", errCtx, err, e.ErrInternal)
 	}
 
-	return nil
+	masks := []a.Mask{}
+	for _, rec := range records {
+		mask, err := r.toMask(rec)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
+		masks = append(masks, *mask)
+	}
+
+	return masks, nil
+}
+
+// findOne fetches a single annotation of the given type by id.
+func (r AnnotationRepo) findOne(id a.AnnotationId, type_ string) (*AnnotationRow, error) {
+	query := `SELECT id,label_id,type,coordinates,author,touched_at,attributes,` + reviewColumns + `,` + sourceColumns + `
+		FROM annotations WHERE id=$1 AND type=$2`
+	rec := AnnotationRow{}
+	if err := r.Db.Get(&rec, query, id, type_); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching %v %v: %w", type_, id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching %v %v: %v: %w", type_, id, err, e.ErrInternal)
+	}
+	return &rec, nil
+}
+
+func (r AnnotationRepo) FindMask(id a.AnnotationId) (*a.Mask, error) {
+	rec, err := r.findOne(id, "mask")
+	if err != nil {
+		return nil, err
+	}
+	mask, err := r.toMask(*rec)
+	if err != nil {
+		return nil, fmt.Errorf("fetching mask %v: %w", id, err)
+	}
+	return mask, nil
+}
+
+func (r AnnotationRepo) FindPolygon(id a.AnnotationId) (*a.Polygon, error) {
+	errCtx := fmt.Sprintf("fetching polygon %v", id)
+	rec, err := r.findOne(id, "polygon")
+	if err != nil {
+		return nil, err
+	}
+	points, err := unmarshalPoints([]byte(rec.Coordinates))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", errCtx, err)
+	}
+	label, err := r.findLabelById(rec.LabelId)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", errCtx, err)
+	}
+	polygon := a.NewPolygon(rec.Id, *points, *label)
+	polygon.Author = rec.Author
+	polygon.Time = rec.Time
+	polygon.Review = rec.ReviewRow.toEntity()
+	polygon.Source = rec.SourceRow.toEntity()
+	if polygon.Attributes, err = unmarshalAttributes(rec.Attributes); err != nil {
+		return nil, fmt.Errorf("%v: %w", errCtx, err)
+	}
+	return &polygon, nil
+}
+
+func (r AnnotationRepo) AddBoundingBox(
+	imageId i.ImageId,
+	collection c.CollectionName,
+	box a.BoundingBox,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		coordsBytes, _ := json.Marshal(
+			BoundingBoxSpecs{
+				Xc:     box.Xc,
+				Yc:     box.Yc,
+				Width:  box.Width,
+				Height: box.Height,
+				Angle:  box.Angle,
+			},
+		)
+		coordsString := string(coordsBytes)
+		rv := newReviewRow(box.Review)
+		src := newSourceRow(box.Source)
+		query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author, touched_at,
+			status, reviewer, review_comment, reviewed_at, model, confidence, attributes)
+			VALUES ($1,$2,(SELECT id FROM collections WHERE name=$3),$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)`
+		_, err := tx.Db.Exec(
+			query,
+			box.Id,
+			imageId,
+			collection,
+			box.Label.Id,
+			"bounding_box",
+			coordsString,
+			userId,
+			t,
+			rv.Status,
+			rv.Reviewer,
+			rv.Comment,
+			rv.ReviewedAt,
+			src.Model,
+			src.Confidence,
+			marshalAttributes(box.Attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("inserting bounding box: %v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.record(box.Id, a.Created, nil, nil, userId, t); err != nil {
+			return fmt.Errorf("inserting bounding box: %w", err)
+		}
+
+		return nil
+	})
 }
 
 func (r AnnotationRepo) FindBoundingBoxes(
 	imageId i.ImageId,
 	collection c.CollectionName,
 ) ([]a.BoundingBox, error) {
-	query := `SELECT id,label_id,type,coordinates,author,touched_at
+	query := `SELECT id,label_id,type,coordinates,author,touched_at,attributes,` + reviewColumns + `,` + sourceColumns + `
 		FROM annotations
 		WHERE image_id=$1 AND collection_id=(SELECT id FROM collections WHERE name=$2) AND type='bounding_box'`
 
@@ -282,6 +740,12 @@ func (r AnnotationRepo) FindBoundingBoxes(
 		if rec.Time != nil {
 			box.Time = rec.Time
 		}
+		box.Review = rec.ReviewRow.toEntity()
+		box.Source = rec.SourceRow.toEntity()
+		box.Attributes, err = unmarshalAttributes(rec.Attributes)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %w", errCtx, err)
+		}
 		boxes = append(boxes, box)
 	}
 
@@ -289,21 +753,48 @@ func (r AnnotationRepo) FindBoundingBoxes(
 }
 
 func (r AnnotationRepo) UpdateLabelOfAnnotation(
+	id a.AnnotationId,
+	labelId l.LabelId,
+	attrs a.Attributes,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := "updating label of annotation"
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateLabel(id, labelId, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateAttributes(id, attrs); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		return nil
+	})
+}
+
+func (r AnnotationRepo) updateLabel(
 	id a.AnnotationId,
 	labelId l.LabelId,
 	userId *u.UserId,
 	t *time.Time,
 ) error {
 	errCtx := "updating bounding box"
-	if err := r.UpdateAuthor(id, userId); err != nil {
+	if err := r.updateAuthor(id, userId); err != nil {
 		return fmt.Errorf("%v: updating author: %w", errCtx, err)
 	}
-	if err := r.UpdateTime(id, t); err != nil {
+	if err := r.updateTime(id, t); err != nil {
 		return fmt.Errorf("%v: updating time: %w", errCtx, err)
 	}
 
-	query := "UPDATE annotations SET label_id=$1 WHERE id=$2"
-	_, err := r.Db.Exec(query, labelId, id)
+	query := `UPDATE annotations SET label_id=$1, status=$2, reviewer=NULL, review_comment='', reviewed_at=NULL
+		WHERE id=$3`
+	_, err := r.Db.Exec(query, labelId, a.Draft.String(), id)
 	if err != nil {
 		return fmt.Errorf("updating bounding box label: %v: %w", err, e.ErrInternal)
 	}
@@ -311,7 +802,7 @@ func (r AnnotationRepo) UpdateLabelOfAnnotation(
 	return nil
 }
 
-func (r AnnotationRepo) UpdateBoundingBoxCoordinates(
+func (r AnnotationRepo) updateBoundingBoxCoordinates(
 	id a.AnnotationId,
 	xc, yc, width, height, angle float32,
 ) error {
@@ -332,7 +823,7 @@ func (r AnnotationRepo) UpdateBoundingBoxCoordinates(
 	return nil
 }
 
-func (r AnnotationRepo) UpdateAuthor(id a.AnnotationId, userId *u.UserId) error {
+func (r AnnotationRepo) updateAuthor(id a.AnnotationId, userId *u.UserId) error {
 	query := "UPDATE annotations SET author=$1 WHERE id=$2"
 	_, err := r.Db.Exec(query, userId, id)
 	if err != nil {
@@ -342,7 +833,7 @@ func (r AnnotationRepo) UpdateAuthor(id a.AnnotationId, userId *u.UserId) error
 	return nil
 }
 
-func (r AnnotationRepo) UpdateTime(id a.AnnotationId, t *time.Time) error {
+func (r AnnotationRepo) updateTime(id a.AnnotationId, t *time.Time) error {
 	query := "UPDATE annotations SET touched_at=$1 WHERE id=$2"
 	_, err := r.Db.Exec(query, t, id)
 	if err != nil {
@@ -358,34 +849,40 @@ func (r AnnotationRepo) UpdateBoundingBox(
 	userId *u.UserId,
 	t *time.Time,
 ) error {
-	errCtx := "updating bounding box"
-	if err := r.UpdateLabelOfAnnotation(id, u.LabelId, userId, t); err != nil {
-		return fmt.Errorf("%v: updating label: %w", errCtx, err)
-	}
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := "updating bounding box"
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateLabel(id, u.LabelId, userId, t); err != nil {
+			return fmt.Errorf("%v: updating label: %w", errCtx, err)
+		}
 
-	if err := r.UpdateBoundingBoxCoordinates(
-		id,
-		u.Xc,
-		u.Yc,
-		u.Width,
-		u.Height,
-		u.Angle,
-	); err != nil {
-		return fmt.Errorf("%v: updating coordinates: %w", errCtx, err)
-	}
-	return nil
+		if err := tx.updateBoundingBoxCoordinates(
+			id,
+			u.Xc,
+			u.Yc,
+			u.Width,
+			u.Height,
+			u.Angle,
+		); err != nil {
+			return fmt.Errorf("%v: updating coordinates: %w", errCtx, err)
+		}
+		if err := tx.updateAttributes(id, u.Attributes); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		return nil
+	})
 }
 
-func (r AnnotationRepo) UpdatePolygonPoints(id a.AnnotationId, points a.Points) error {
+func (r AnnotationRepo) updatePolygonPoints(id a.AnnotationId, points a.Points) error {
 	errCtx := "updating polygon points"
-	pointSpecs := []PointSpec{}
-	for _, p := range points.Coordinates {
-		pointSpecs = append(pointSpecs, PointSpec{X: p[0], Y: p[1]})
-	}
-	coordsBytes, _ := json.Marshal(PolygonSpecs{Points: pointSpecs})
-	coordsString := string(coordsBytes)
 	query := "UPDATE annotations SET coordinates=$1 WHERE id=$2"
-	_, err := r.Db.Exec(query, coordsString, id)
+	_, err := r.Db.Exec(query, marshalPoints(points), id)
 	if err != nil {
 		return fmt.Errorf("%v: %v: %w", errCtx, err, e.ErrInternal)
 	}
@@ -398,22 +895,125 @@ func (r AnnotationRepo) UpdatePolygon(
 	userId *u.UserId,
 	t *time.Time,
 ) error {
-	errCtx := "updating polygon"
-	if err := r.UpdateLabelOfAnnotation(id, u.LabelId, userId, t); err != nil {
-		return fmt.Errorf("%v: updating label: %w", errCtx, err)
-	}
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := "updating polygon"
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateLabel(id, u.LabelId, userId, t); err != nil {
+			return fmt.Errorf("%v: updating label: %w", errCtx, err)
+		}
 
-	if err := r.UpdatePolygonPoints(id, u.Points); err != nil {
-		return fmt.Errorf("%v: updating polygon points: %w", errCtx, err)
-	}
-	return nil
+		if err := tx.updatePolygonPoints(id, u.Points); err != nil {
+			return fmt.Errorf("%v: updating polygon points: %w", errCtx, err)
+		}
+		if err := tx.updateAttributes(id, u.Attributes); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		return nil
+	})
+}
+
+func (r AnnotationRepo) UpdatePolyline(
+	id a.AnnotationId,
+	u a.PolylineUpdatables,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := "updating polyline"
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateLabel(id, u.LabelId, userId, t); err != nil {
+			return fmt.Errorf("%v: updating label: %w", errCtx, err)
+		}
+		if _, err := tx.Db.Exec("UPDATE annotations SET coordinates=$1 WHERE id=$2",
+			marshalPoints(u.Points), id); err != nil {
+			return fmt.Errorf("%v: updating points: %v: %w", errCtx, err, e.ErrInternal)
+		}
+		if err := tx.updateAttributes(id, u.Attributes); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		return nil
+	})
+}
+
+func (r AnnotationRepo) UpdateMask(
+	id a.AnnotationId,
+	u a.MaskUpdatables,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := "updating mask"
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateLabel(id, u.LabelId, userId, t); err != nil {
+			return fmt.Errorf("%v: updating label: %w", errCtx, err)
+		}
+		if _, err := tx.Db.Exec("UPDATE annotations SET coordinates=$1 WHERE id=$2",
+			marshalRLE(u.RLE), id); err != nil {
+			return fmt.Errorf("%v: updating pixels: %v: %w", errCtx, err, e.ErrInternal)
+		}
+		if err := tx.updateAttributes(id, u.Attributes); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		return nil
+	})
+}
+
+func (r AnnotationRepo) UpdateKeypoints(
+	id a.AnnotationId,
+	u a.KeypointsUpdatables,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	return r.inTx(func(tx AnnotationRepo) error {
+		errCtx := "updating keypoints"
+		before, err := tx.findState(id)
+		if err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if err := tx.updateLabel(id, u.LabelId, userId, t); err != nil {
+			return fmt.Errorf("%v: updating label: %w", errCtx, err)
+		}
+		if _, err := tx.Db.Exec("UPDATE annotations SET coordinates=$1 WHERE id=$2",
+			marshalKeypoints(u.Points), id); err != nil {
+			return fmt.Errorf("%v: updating points: %v: %w", errCtx, err, e.ErrInternal)
+		}
+		if err := tx.updateAttributes(id, u.Attributes); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		if _, err := tx.record(id, a.Modified, before, nil, userId, t); err != nil {
+			return fmt.Errorf("%v: %w", errCtx, err)
+		}
+		return nil
+	})
 }
 
+// GroupOfAnnotation fetches the group of the collection an annotation belongs
+// to. The history of deleted annotations still tells where they were.
 func (r AnnotationRepo) GroupOfAnnotation(id a.AnnotationId) (*string, error) {
 	var group string
 	err := r.Db.Get(
 		&group,
-		`SELECT name FROM groups WHERE id=(SELECT group_id FROM collections WHERE id=(SELECT collection_id FROM annotations WHERE id=$1))`,
+		`SELECT name FROM groups WHERE id=(SELECT group_id FROM collections WHERE id=COALESCE(
+		(SELECT collection_id FROM annotations WHERE id=$1),
+		(SELECT collection_id FROM annotation_revisions WHERE annotation_id=$1 LIMIT 1)))`,
 		id,
 	)
 	if err != nil {
@@ -425,6 +1025,50 @@ func (r AnnotationRepo) GroupOfAnnotation(id a.AnnotationId) (*string, error) {
 	return &group, nil
 }
 
+func (r AnnotationRepo) CollectionOfAnnotation(id a.AnnotationId) (*c.Collection, error) {
+	var name string
+	err := r.Db.Get(
+		&name,
+		`SELECT name FROM collections WHERE id=COALESCE(
+		(SELECT collection_id FROM annotations WHERE id=$1),
+		(SELECT collection_id FROM annotation_revisions WHERE annotation_id=$1 LIMIT 1))`,
+		id,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching collection of annotation by id %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching collection of annotation by id %v: %w", id, e.ErrInternal)
+	}
+	return sc.NewCollectionRepo(r.Db).Find(name)
+}
+
+// ImageOfAnnotation locates the image, and the collection, an annotation was
+// made on.
+func (r AnnotationRepo) ImageOfAnnotation(id a.AnnotationId) (*i.BaseImage, error) {
+	row := struct {
+		ImageId    i.ImageId `db:"image_id"`
+		Collection string    `db:"collection"`
+	}{}
+	err := r.Db.Get(&row, `SELECT a.image_id, c.name AS collection FROM annotations AS a
+		JOIN collections AS c ON c.id=a.collection_id WHERE a.id=$1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching image of annotation %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching image of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return &i.BaseImage{ImageId: row.ImageId, Collection: row.Collection}, nil
+}
+
+// inTx runs fn with a copy of the repository bound to a transaction, so that
+// a change and the revision recording it are stored together.
+func (r AnnotationRepo) inTx(fn func(AnnotationRepo) error) error {
+	return adb.RunInTx(r.Db, func(q adb.Querier) error {
+		return fn(NewAnnotationRepo(q))
+	})
+}
+
 func NewAnnotationRepo(db adb.Querier) AnnotationRepo {
 	return AnnotationRepo{Db: db}
 }
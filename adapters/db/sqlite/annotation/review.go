@@ -0,0 +1,129 @@
+package annotation
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	c "github.com/lejeunel/go-image-annotator/entities/collection"
+	i "github.com/lejeunel/go-image-annotator/entities/image"
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+const reviewColumns = "status,reviewer,review_comment,reviewed_at"
+
+type ReviewRow struct {
+	Status     string     `db:"status"`
+	Reviewer   *u.UserId  `db:"reviewer"`
+	Comment    string     `db:"review_comment"`
+	ReviewedAt *time.Time `db:"reviewed_at"`
+}
+
+func newReviewRow(r a.Review) ReviewRow {
+	return ReviewRow{
+		Status:     r.CurrentStatus().String(),
+		Reviewer:   r.Reviewer,
+		Comment:    r.Comment,
+		ReviewedAt: r.Time,
+	}
+}
+
+func (r ReviewRow) toEntity() a.Review {
+	return a.Review{
+		Status:   a.ReviewStatus(r.Status),
+		Reviewer: r.Reviewer,
+		Comment:  r.Comment,
+		Time:     r.ReviewedAt,
+	}
+}
+
+type SubmissionRow struct {
+	AnnotationId a.AnnotationId `db:"id"`
+	ImageId      i.ImageId      `db:"image_id"`
+	Collection   string         `db:"collection"`
+	Type         string         `db:"type"`
+	Label        string         `db:"label"`
+	Author       *u.UserId      `db:"author"`
+	Time         *time.Time     `db:"touched_at"`
+}
+
+func (r AnnotationRepo) FindReview(id a.AnnotationId) (*a.Review, error) {
+	row := ReviewRow{}
+	err := r.Db.Get(&row, `SELECT `+reviewColumns+` FROM annotations WHERE id=$1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching review of annotation %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching review of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	review := row.toEntity()
+	return &review, nil
+}
+
+func (r AnnotationRepo) SetReview(id a.AnnotationId, review a.Review) error {
+	row := newReviewRow(review)
+	query := `UPDATE annotations SET status=$1, reviewer=$2, review_comment=$3, reviewed_at=$4 WHERE id=$5`
+	if _, err := r.Db.Exec(query, row.Status, row.Reviewer, row.Comment, row.ReviewedAt, id); err != nil {
+		return fmt.Errorf("updating review of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return nil
+}
+
+// submissions selects annotations awaiting review. A nil list of collections
+// places no restriction.
+func submissions(q sq.SelectBuilder, collections []c.CollectionName) sq.SelectBuilder {
+	q = q.From("annotations AS a").
+		Join("collections AS c ON c.id=a.collection_id").
+		Where(sq.Eq{"a.status": a.Submitted.String()})
+	if collections != nil {
+		q = q.Where(sq.Eq{"c.name": collections})
+	}
+	return q
+}
+
+func (r AnnotationRepo) ListSubmissions(collections []c.CollectionName, p pag.PaginationParams) ([]rv.Submission, error) {
+	q := submissions(sq.StatementBuilder.Select(
+		"a.id,a.image_id,c.name AS collection,a.type,l.name AS label,a.author,a.touched_at"), collections)
+	q = q.Join("labels AS l ON l.id=a.label_id").
+		OrderBy("a.touched_at", "a.id").
+		Limit(uint64(p.PageSize)).Offset(uint64((p.Page - 1) * int64(p.PageSize)))
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	rows := []SubmissionRow{}
+	if err := r.Db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("listing submitted annotations: %v: %w", err, e.ErrInternal)
+	}
+	submissions := []rv.Submission{}
+	for _, row := range rows {
+		submissions = append(submissions, rv.Submission{
+			AnnotationId: row.AnnotationId,
+			ImageId:      row.ImageId,
+			Collection:   row.Collection,
+			Type:         row.Type,
+			Label:        row.Label,
+			Author:       row.Author,
+			Time:         row.Time,
+		})
+	}
+	return submissions, nil
+}
+
+func (r AnnotationRepo) CountSubmissions(collections []c.CollectionName) (*int64, error) {
+	query, args, err := submissions(sq.StatementBuilder.Select("COUNT(*)"), collections).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	var count int64
+	if err := r.Db.Get(&count, query, args...); err != nil {
+		return nil, fmt.Errorf("counting submitted annotations: %v: %w", err, e.ErrInternal)
+	}
+	return &count, nil
+}
@@ -0,0 +1,125 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAnnotationIsDraft(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	bbox := a.NewBoundingBox(a.NewAnnotationId(), 1, 1, 1, 1, label)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, nil, nil)
+
+	review, err := repos.Annotation.FindReview(bbox.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, a.Draft, review.Status)
+	assert.Nil(t, review.Reviewer)
+}
+
+func TestFindReviewOfMissingAnnotationShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	_, err := repos.Annotation.FindReview(a.NewAnnotationId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestSetReview(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	polygon := a.NewPolygon(a.NewAnnotationId(), a.Points{Coordinates: [][2]float32{{0, 0}, {1, 1}}}, label)
+	repos.Annotation.AddPolygon(image.Id, collection.Name, polygon, nil, nil)
+	reviewer := u.NewUser("reviewer@example.com")
+	repos.User.Create(reviewer)
+	now := time.Now()
+
+	assert.NoError(t, repos.Annotation.SetReview(polygon.Id,
+		a.Review{Status: a.Rejected, Reviewer: &reviewer.Id, Comment: "too loose", Time: &now}))
+
+	polygons, _ := repos.Annotation.FindPolygons(image.Id, collection.Name)
+	got := polygons[0].Review
+	assert.Equal(t, a.Rejected, got.Status)
+	assert.Equal(t, reviewer.Id, *got.Reviewer)
+	assert.Equal(t, "too loose", got.Comment)
+	assert.NotNil(t, got.Time)
+}
+
+func TestModifyingAnnotationResetsReview(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	bbox := a.NewBoundingBox(a.NewAnnotationId(), 1, 1, 1, 1, label)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, nil, nil)
+	repos.Annotation.SetReview(bbox.Id, a.Review{Status: a.Accepted})
+
+	assert.NoError(t, repos.Annotation.UpdateBoundingBox(bbox.Id,
+		a.BoundingBoxUpdatables{LabelId: label.Id, Xc: 2, Yc: 2, Width: 1, Height: 1}, nil, nil))
+
+	review, _ := repos.Annotation.FindReview(bbox.Id)
+	assert.Equal(t, a.Draft, review.Status)
+}
+
+func TestAddAnnotationKeepsReview(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	imageLabel := a.NewImageLabel(label)
+	imageLabel.Review = a.Review{Status: a.Accepted, Comment: "fine"}
+	repos.Annotation.AddImageLabel(image.Id, collection.Name, imageLabel, nil, nil)
+
+	labels, _ := repos.Annotation.FindImageLabels(image.Id, collection.Name)
+	assert.Equal(t, a.Accepted, labels[0].Review.Status)
+	assert.Equal(t, "fine", labels[0].Review.Comment)
+}
+
+func TestListSubmissions(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	otherCollection := clc.NewCollection(clc.NewCollectionId(), "another-collection")
+	repos.Collection.Create(otherCollection)
+	repos.Image.AddToCollection(image.Id, otherCollection.Name)
+	submitted := a.NewBoundingBox(a.NewAnnotationId(), 1, 1, 1, 1, label)
+	submitted.Review.Status = a.Submitted
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, submitted, nil, nil)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name,
+		a.NewBoundingBox(a.NewAnnotationId(), 1, 1, 1, 1, label), nil, nil)
+	elsewhere := a.NewImageLabel(label)
+	elsewhere.Review.Status = a.Submitted
+	assert.NoError(t, repos.Annotation.AddImageLabel(image.Id, otherCollection.Name, elsewhere, nil, nil))
+	page := pa.PaginationParams{Page: 1, PageSize: 10}
+
+	all, err := repos.Annotation.ListSubmissions(nil, page)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(all))
+	count, _ := repos.Annotation.CountSubmissions(nil)
+	assert.Equal(t, int64(2), *count)
+
+	restricted, err := repos.Annotation.ListSubmissions([]string{collection.Name}, page)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(restricted))
+	assert.Equal(t, submitted.Id, restricted[0].AnnotationId)
+	assert.Equal(t, image.Id, restricted[0].ImageId)
+	assert.Equal(t, collection.Name, restricted[0].Collection)
+	assert.Equal(t, "bounding_box", restricted[0].Type)
+	assert.Equal(t, label.Name, restricted[0].Label)
+
+	none, _ := repos.Annotation.ListSubmissions([]string{}, page)
+	assert.Empty(t, none)
+	count, _ = repos.Annotation.CountSubmissions([]string{})
+	assert.Equal(t, int64(0), *count)
+}
+
+func TestErrOnSetReviewWithClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repos := NewAnnotationTestRepos(db)
+	db.Close()
+	err := repos.Annotation.SetReview(a.NewAnnotationId(), a.Review{Status: a.Accepted})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repos.Annotation.ListSubmissions(nil, pa.PaginationParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
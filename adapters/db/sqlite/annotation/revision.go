@@ -0,0 +1,312 @@
+package annotation
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	i "github.com/lejeunel/go-image-annotator/entities/image"
+	l "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const revisionColumns = "id,annotation_id,image_id,collection_id,action,restores,before,after,author,created_at"
+
+// StateRow is the current state of an annotation, along with the image and
+// collection it belongs to.
+type StateRow struct {
+	Type         string    `db:"type"`
+	LabelId      l.LabelId `db:"label_id"`
+	Label        string    `db:"label"`
+	Coordinates  *string   `db:"coordinates"`
+	ImageId      i.ImageId `db:"image_id"`
+	CollectionId string    `db:"collection_id"`
+	Attributes   string    `db:"attributes"`
+	SourceRow
+}
+
+// ShapeRecord is the serialized form of a state, as stored in revisions.
+type ShapeRecord struct {
+	Type        string          `json:"type"`
+	LabelId     string          `json:"label_id"`
+	Label       string          `json:"label"`
+	Coordinates json.RawMessage `json:"coordinates,omitempty"`
+	Model       string          `json:"model,omitempty"`
+	Confidence  *float32        `json:"confidence,omitempty"`
+	Attributes  json.RawMessage `json:"attributes,omitempty"`
+}
+
+type RevisionRow struct {
+	Id           a.RevisionId   `db:"id"`
+	AnnotationId a.AnnotationId `db:"annotation_id"`
+	ImageId      i.ImageId      `db:"image_id"`
+	CollectionId string         `db:"collection_id"`
+	Action       string         `db:"action"`
+	Restores     *a.RevisionId  `db:"restores"`
+	Before       *string        `db:"before"`
+	After        *string        `db:"after"`
+	Author       *u.UserId      `db:"author"`
+	Time         *time.Time     `db:"created_at"`
+}
+
+func (s *StateRow) marshal() *string {
+	if s == nil {
+		return nil
+	}
+	rec := ShapeRecord{Type: s.Type, LabelId: s.LabelId.String(), Label: s.Label,
+		Model: s.Model, Confidence: s.Confidence}
+	if s.Coordinates != nil {
+		rec.Coordinates = json.RawMessage(*s.Coordinates)
+	}
+	if s.Attributes != "" && s.Attributes != "{}" {
+		rec.Attributes = json.RawMessage(s.Attributes)
+	}
+	bytes, _ := json.Marshal(rec)
+	str := string(bytes)
+	return &str
+}
+
+func unmarshalShape(str *string) (*a.Shape, error) {
+	if str == nil {
+		return nil, nil
+	}
+	var rec ShapeRecord
+	if err := json.Unmarshal([]byte(*str), &rec); err != nil {
+		return nil, fmt.Errorf("unmarshaling annotation state %v: %v: %w", *str, err, e.ErrInternal)
+	}
+	shape := a.Shape{Type: rec.Type, Label: l.Label{Name: rec.Label},
+		Source: SourceRow{Model: rec.Model, Confidence: rec.Confidence}.toEntity()}
+	if err := shape.Label.Id.Scan(rec.LabelId); err != nil {
+		return nil, fmt.Errorf("parsing label id %v: %v: %w", rec.LabelId, err, e.ErrInternal)
+	}
+	attrs, err := unmarshalAttributes(string(rec.Attributes))
+	if err != nil {
+		return nil, err
+	}
+	shape.Attributes = attrs
+	switch rec.Type {
+	case "bounding_box":
+		var specs BoundingBoxSpecs
+		if err := json.Unmarshal(rec.Coordinates, &specs); err != nil {
+			return nil, fmt.Errorf("unmarshaling bounding box specs: %v: %w", err, e.ErrInternal)
+		}
+		shape.Box = &a.BoxCoordinates{Xc: specs.Xc, Yc: specs.Yc, Width: specs.Width,
+			Height: specs.Height, Angle: specs.Angle}
+	case "polygon":
+		var specs PolygonSpecs
+		if err := json.Unmarshal(rec.Coordinates, &specs); err != nil {
+			return nil, fmt.Errorf("unmarshaling polygon specs: %v: %w", err, e.ErrInternal)
+		}
+		points := a.Points{}
+		for _, p := range specs.Points {
+			points.Coordinates = append(points.Coordinates, [2]float32{p.X, p.Y})
+		}
+		shape.Points = &points
+	case "polyline":
+		points, err := unmarshalPoints(rec.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		shape.Points = points
+	case "keypoints":
+		keypoints, err := unmarshalKeypoints(rec.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		shape.Keypoints = keypoints
+	case "mask":
+		rle, err := unmarshalRLE(rec.Coordinates)
+		if err != nil {
+			return nil, err
+		}
+		shape.Mask = rle
+	}
+	return &shape, nil
+}
+
+func (r RevisionRow) toEntity() (*a.Revision, error) {
+	before, err := unmarshalShape(r.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := unmarshalShape(r.After)
+	if err != nil {
+		return nil, err
+	}
+	return &a.Revision{
+		Id:           r.Id,
+		AnnotationId: r.AnnotationId,
+		Action:       a.RevisionAction(r.Action),
+		Before:       before,
+		After:        after,
+		Author:       r.Author,
+		Time:         r.Time,
+		Restores:     r.Restores,
+	}, nil
+}
+
+// findState fetches the current state of an annotation, which is nil if the
+// annotation does not exist.
+func (r AnnotationRepo) findState(id a.AnnotationId) (*StateRow, error) {
+	row := StateRow{}
+	query := `SELECT a.type,a.label_id,l.name AS label,a.coordinates,a.image_id,a.collection_id,a.model,a.confidence,a.attributes
+	FROM annotations AS a JOIN labels AS l ON l.id=a.label_id WHERE a.id=$1`
+	if err := r.Db.Get(&row, query, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching state of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return &row, nil
+}
+
+// record stores a revision of an annotation from its state before the
+// change and its current state. Changes that leave no trace, such as
+// removing a missing annotation, are not recorded.
+func (r AnnotationRepo) record(
+	id a.AnnotationId,
+	action a.RevisionAction,
+	before *StateRow,
+	restores *a.RevisionId,
+	userId *u.UserId,
+	t *time.Time,
+) (*a.RevisionId, error) {
+	after, err := r.findState(id)
+	if err != nil {
+		return nil, fmt.Errorf("recording revision: %w", err)
+	}
+	location := after
+	if location == nil {
+		location = before
+	}
+	if location == nil {
+		return nil, nil
+	}
+	revisionId := a.NewRevisionId()
+	query := `INSERT INTO annotation_revisions (` + revisionColumns + `) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`
+	if _, err := r.Db.Exec(query, revisionId, id, location.ImageId, location.CollectionId, action.String(),
+		restores, before.marshal(), after.marshal(), userId, t); err != nil {
+		return nil, fmt.Errorf("recording revision of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return &revisionId, nil
+}
+
+func (r AnnotationRepo) findRevisionRow(id a.RevisionId) (*RevisionRow, error) {
+	row := RevisionRow{}
+	err := r.Db.Get(&row, `SELECT `+revisionColumns+` FROM annotation_revisions WHERE id=$1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching revision %v: %w", id, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching revision %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return &row, nil
+}
+
+func (r AnnotationRepo) FindRevision(id a.RevisionId) (*a.Revision, error) {
+	row, err := r.findRevisionRow(id)
+	if err != nil {
+		return nil, err
+	}
+	return row.toEntity()
+}
+
+// ListRevisions fetches the history of an annotation, oldest first.
+func (r AnnotationRepo) ListRevisions(id a.AnnotationId) ([]a.Revision, error) {
+	query := `SELECT ` + revisionColumns + ` FROM annotation_revisions WHERE annotation_id=$1 ORDER BY rowid`
+	rows := []RevisionRow{}
+	if err := r.Db.Select(&rows, query, id); err != nil {
+		return nil, fmt.Errorf("listing revisions of annotation %v: %v: %w", id, err, e.ErrInternal)
+	}
+	revisions := []a.Revision{}
+	for _, row := range rows {
+		revision, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("listing revisions of annotation %v: %w", id, err)
+		}
+		revisions = append(revisions, *revision)
+	}
+	return revisions, nil
+}
+
+// RestoreRevision brings an annotation back to its state right after the
+// given revision, recreating it if it was deleted since. The annotation
+// returns to draft, and the revert is itself recorded as a revision.
+func (r AnnotationRepo) RestoreRevision(id a.RevisionId, userId *u.UserId, t *time.Time) (*a.Revision, error) {
+	errCtx := fmt.Errorf("restoring revision %v", id)
+	rev, err := r.findRevisionRow(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+	if rev.After == nil {
+		return nil, fmt.Errorf("%w: annotation was deleted by this revision: %w", errCtx, e.ErrValidation)
+	}
+	var target ShapeRecord
+	if err := json.Unmarshal([]byte(*rev.After), &target); err != nil {
+		return nil, fmt.Errorf("%w: unmarshaling annotation state: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	var labelExists bool
+	if err := r.Db.Get(&labelExists, `SELECT EXISTS (SELECT 1 FROM labels WHERE id=$1)`, target.LabelId); err != nil {
+		return nil, fmt.Errorf("%w: checking label: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	if !labelExists {
+		return nil, fmt.Errorf("%w: label %v no longer exists: %w", errCtx, target.Label, e.ErrNotFound)
+	}
+	var coordinates *string
+	if target.Coordinates != nil {
+		str := string(target.Coordinates)
+		coordinates = &str
+	}
+	attributes := "{}"
+	if target.Attributes != nil {
+		attributes = string(target.Attributes)
+	}
+
+	var revisionId *a.RevisionId
+	if err := r.inTx(func(tx AnnotationRepo) error {
+		before, err := tx.findState(rev.AnnotationId)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		if before != nil {
+			query := `UPDATE annotations SET label_id=$1, coordinates=$2, author=$3, touched_at=$4,
+			status=$5, reviewer=NULL, review_comment='', reviewed_at=NULL, model=$6, confidence=$7, attributes=$8
+			WHERE id=$9`
+			if _, err := tx.Db.Exec(query, target.LabelId, coordinates, userId, t, a.Draft.String(),
+				target.Model, target.Confidence, attributes, rev.AnnotationId); err != nil {
+				return fmt.Errorf("%w: updating annotation: %v: %w", errCtx, err, e.ErrInternal)
+			}
+		} else {
+			var inCollection bool
+			if err := tx.Db.Get(&inCollection,
+				`SELECT EXISTS (SELECT 1 FROM images_collections WHERE image_id=$1 AND collection_id=$2)`,
+				rev.ImageId, rev.CollectionId); err != nil {
+				return fmt.Errorf("%w: checking image: %v: %w", errCtx, err, e.ErrInternal)
+			}
+			if !inCollection {
+				return fmt.Errorf("%w: image %v no longer belongs to the collection: %w",
+					errCtx, rev.ImageId, e.ErrNotFound)
+			}
+			query := `INSERT INTO annotations (id, image_id, collection_id, label_id, type, coordinates, author,
+			touched_at, status, model, confidence, attributes) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)`
+			if _, err := tx.Db.Exec(query, rev.AnnotationId, rev.ImageId, rev.CollectionId, target.LabelId,
+				target.Type, coordinates, userId, t, a.Draft.String(), target.Model, target.Confidence,
+				attributes); err != nil {
+				return fmt.Errorf("%w: recreating annotation: %v: %w", errCtx, err, e.ErrInternal)
+			}
+		}
+
+		revisionId, err = tx.record(rev.AnnotationId, a.Reverted, before, &id, userId, t)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return r.FindRevision(*revisionId)
+}
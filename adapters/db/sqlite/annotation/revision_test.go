@@ -0,0 +1,167 @@
+package annotation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangesAreRecordedAsRevisions(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	user := u.NewUser("user@example.com")
+	repos.User.Create(user)
+	otherLabel := lbl.NewLabel(lbl.NewLabelId(), "another-label")
+	repos.Label.Create(otherLabel)
+	now := time.Now()
+	bbox := a.NewBoundingBox(a.NewAnnotationId(), 1, 2, 3, 4, label)
+
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, &user.Id, &now)
+	repos.Annotation.UpdateBoundingBox(bbox.Id,
+		a.BoundingBoxUpdatables{LabelId: label.Id, Xc: 5, Yc: 6, Width: 7, Height: 8}, &user.Id, &now)
+	repos.Annotation.UpdateLabelOfAnnotation(bbox.Id, otherLabel.Id, nil, &user.Id, &now)
+	repos.Annotation.RemoveAnnotation(bbox.Id, &user.Id, &now)
+
+	revisions, err := repos.Annotation.ListRevisions(bbox.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(revisions))
+	assert.Equal(t, []a.RevisionAction{a.Created, a.Modified, a.Modified, a.Deleted},
+		[]a.RevisionAction{revisions[0].Action, revisions[1].Action, revisions[2].Action, revisions[3].Action})
+
+	created := revisions[0]
+	assert.Nil(t, created.Before)
+	assert.Equal(t, "bounding_box", created.After.Type)
+	assert.Equal(t, label.Id, created.After.Label.Id)
+	assert.Equal(t, label.Name, created.After.Label.Name)
+	assert.Equal(t, a.BoxCoordinates{Xc: 1, Yc: 2, Width: 3, Height: 4}, *created.After.Box)
+	assert.Equal(t, user.Id, *created.Author)
+	assert.NotNil(t, created.Time)
+
+	assert.Equal(t, *created.After, *revisions[1].Before)
+	assert.Equal(t, a.BoxCoordinates{Xc: 5, Yc: 6, Width: 7, Height: 8}, *revisions[1].After.Box)
+	assert.Equal(t, otherLabel.Name, revisions[2].After.Label.Name)
+	assert.Equal(t, *revisions[2].After, *revisions[3].Before)
+	assert.Nil(t, revisions[3].After)
+}
+
+func TestPolygonRevisionHoldsPoints(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	points := a.Points{Coordinates: [][2]float32{{0, 0}, {1, 1}, {0, 1}}}
+	polygon := a.NewPolygon(a.NewAnnotationId(), points, label)
+	repos.Annotation.AddPolygon(image.Id, collection.Name, polygon, nil, nil)
+
+	revisions, _ := repos.Annotation.ListRevisions(polygon.Id)
+	assert.Equal(t, 1, len(revisions))
+	assert.Equal(t, points, *revisions[0].After.Points)
+	assert.Nil(t, revisions[0].After.Box)
+}
+
+func TestRemovingMissingAnnotationIsNotRecorded(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	id := a.NewAnnotationId()
+	assert.NoError(t, repos.Annotation.RemoveAnnotation(id, nil, nil))
+	revisions, err := repos.Annotation.ListRevisions(id)
+	assert.NoError(t, err)
+	assert.Empty(t, revisions)
+}
+
+func TestFindMissingRevisionShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	_, err := repos.Annotation.FindRevision(a.NewRevisionId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestRestoreModifiedAnnotation(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	bbox := a.NewBoundingBox(a.NewAnnotationId(), 1, 2, 3, 4, label)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, nil, nil)
+	repos.Annotation.UpdateBoundingBox(bbox.Id,
+		a.BoundingBoxUpdatables{LabelId: label.Id, Xc: 5, Yc: 6, Width: 7, Height: 8}, nil, nil)
+	repos.Annotation.SetReview(bbox.Id, a.Review{Status: a.Accepted})
+	revisions, _ := repos.Annotation.ListRevisions(bbox.Id)
+
+	restored, err := repos.Annotation.RestoreRevision(revisions[0].Id, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, a.Reverted, restored.Action)
+	assert.Equal(t, revisions[0].Id, *restored.Restores)
+	assert.Equal(t, *revisions[1].After, *restored.Before)
+	assert.Equal(t, *revisions[0].After, *restored.After)
+
+	boxes, _ := repos.Annotation.FindBoundingBoxes(image.Id, collection.Name)
+	assert.Equal(t, float32(1), boxes[0].Xc)
+	assert.Equal(t, float32(3), boxes[0].Width)
+	assert.Equal(t, a.Draft, boxes[0].Review.Status)
+}
+
+func TestRestoreDeletedAnnotation(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	imageLabel := a.NewImageLabel(label)
+	repos.Annotation.AddImageLabel(image.Id, collection.Name, imageLabel, nil, nil)
+	repos.Annotation.RemoveAnnotation(imageLabel.Id, nil, nil)
+	revisions, _ := repos.Annotation.ListRevisions(imageLabel.Id)
+
+	restored, err := repos.Annotation.RestoreRevision(revisions[0].Id, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, restored.Before)
+
+	labels, _ := repos.Annotation.FindImageLabels(image.Id, collection.Name)
+	assert.Equal(t, 1, len(labels))
+	assert.Equal(t, imageLabel.Id, labels[0].Id)
+}
+
+func TestRestoreDeletionShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	imageLabel := a.NewImageLabel(label)
+	repos.Annotation.AddImageLabel(image.Id, collection.Name, imageLabel, nil, nil)
+	repos.Annotation.RemoveAnnotation(imageLabel.Id, nil, nil)
+	revisions, _ := repos.Annotation.ListRevisions(imageLabel.Id)
+
+	_, err := repos.Annotation.RestoreRevision(revisions[1].Id, nil, nil)
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestRestoreOnImageRemovedFromCollectionShouldFail(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	imageLabel := a.NewImageLabel(label)
+	repos.Annotation.AddImageLabel(image.Id, collection.Name, imageLabel, nil, nil)
+	revisions, _ := repos.Annotation.ListRevisions(imageLabel.Id)
+	repos.Annotation.RemoveAllAnnotations(image.Id, collection.Name, nil, nil)
+	repos.Image.RemoveImageFromCollection(image.Id, collection.Name)
+
+	_, err := repos.Annotation.RestoreRevision(revisions[0].Id, nil, nil)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestGroupOfDeletedAnnotation(t *testing.T) {
+	repos := NewAnnotationTestRepos(s.NewInMemory())
+	group := "a-group"
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", &group)
+	imageLabel := a.NewImageLabel(label)
+	repos.Annotation.AddImageLabel(image.Id, collection.Name, imageLabel, nil, nil)
+	repos.Annotation.RemoveAnnotation(imageLabel.Id, nil, nil)
+
+	got, err := repos.Annotation.GroupOfAnnotation(imageLabel.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, group, *got)
+}
+
+func TestErrOnListRevisionsWithClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repos := NewAnnotationTestRepos(db)
+	db.Close()
+	_, err := repos.Annotation.ListRevisions(a.NewAnnotationId())
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repos.Annotation.RestoreRevision(a.NewRevisionId(), nil, nil)
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
@@ -70,3 +70,20 @@ func TestUpdateBoundingBox(t *testing.T) {
 	assert.Equal(t, user.Id, *r[0].Author)
 	assert.NotNil(t, r[0].Time)
 }
+
+func TestFailedRevisionRollsBackBoundingBoxUpdate(t *testing.T) {
+	db := s.NewInMemory()
+	repos := NewAnnotationTestRepos(db)
+	image, collection, label := CreateAnnotableImage(repos, "a-collection", "a-label", nil)
+	annotationId := a.NewAnnotationId()
+	bbox := a.NewBoundingBox(annotationId, 1, 1, 1, 1, label)
+	repos.Annotation.AddBoundingBox(image.Id, collection.Name, bbox, nil, nil)
+	db.MustExec("DROP TABLE annotation_revisions")
+
+	err := repos.Annotation.UpdateBoundingBox(annotationId,
+		a.BoundingBoxUpdatables{LabelId: label.Id, Xc: 2, Yc: 3, Width: 4, Height: 10}, nil, nil)
+	assert.ErrorIs(t, err, e.ErrInternal)
+	r, _ := repos.Annotation.FindBoundingBoxes(image.Id, collection.Name)
+	assert.Equal(t, bbox.Width, r[0].Width)
+	assert.Equal(t, bbox.Xc, r[0].Xc)
+}
@@ -19,7 +19,7 @@ func TestInternalErrOnUpdateLabelShouldFail(t *testing.T) {
 	label := lbl.NewLabel(lbl.NewLabelId(), "new-label")
 	bbox := a.NewBoundingBox(annotationId, 1, 1, 1, 1, label)
 	db.Close()
-	err := repos.Annotation.UpdateLabelOfAnnotation(bbox.Id, label.Id, nil, nil)
+	err := repos.Annotation.UpdateLabelOfAnnotation(bbox.Id, label.Id, nil, nil, nil)
 	assert.ErrorIs(t, err, e.ErrInternal)
 }
 
@@ -33,7 +33,7 @@ func TestUpdateLabelOfAnnotation(t *testing.T) {
 	user := u.NewUser("user@example.com")
 	repos.User.Create(user)
 	now := time.Now()
-	repos.Annotation.UpdateLabelOfAnnotation(bbox.Id, newLabel.Id, &user.Id, &now)
+	repos.Annotation.UpdateLabelOfAnnotation(bbox.Id, newLabel.Id, nil, &user.Id, &now)
 	r, _ := repos.Annotation.FindBoundingBoxes(image.Id, collection.Name)
 	assert.Equal(t, newLabel.Id, r[0].Label.Id)
 	assert.NotNil(t, r[0].Time)
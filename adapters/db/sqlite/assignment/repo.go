@@ -0,0 +1,251 @@
+package assignment
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type AssignmentRepo struct {
+	Db adb.Querier
+}
+
+type BatchRow struct {
+	Id        a.BatchId `db:"id"`
+	Name      string    `db:"name"`
+	Filter    string    `db:"filter"`
+	Creator   string    `db:"creator"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type ProgressRow struct {
+	Batch    a.BatchId `db:"batch_id"`
+	Assignee string    `db:"assignee"`
+	Total    int64     `db:"total"`
+	Finished int64     `db:"finished"`
+}
+
+type Row struct {
+	Batch      a.BatchId  `db:"batch_id"`
+	ImageId    im.ImageId `db:"image_id"`
+	Collection string     `db:"collection"`
+	Assignee   string     `db:"assignee"`
+	FinishedAt *time.Time `db:"finished_at"`
+}
+
+const batchColumns = "id,name,filter,creator,created_at"
+
+func (r AssignmentRepo) CreateBatch(b a.Batch, assignments []a.Assignment) error {
+	query := `INSERT INTO assignment_batches (id, name, filter, creator, created_at) VALUES ($1,$2,$3,$4,$5)`
+	if _, err := r.Db.Exec(query, b.Id, b.Name, b.Filter, b.Creator, b.CreatedAt); err != nil {
+		return fmt.Errorf("inserting assignment batch record: %v: %w", err, e.ErrInternal)
+	}
+	query = `INSERT INTO assignments (batch_id, image_id, collection_id, assignee, position)
+	SELECT $1, $2, id, $3, $4 FROM collections WHERE name=$5`
+	for position, asg := range assignments {
+		res, err := r.Db.Exec(query, b.Id, asg.Image.ImageId, asg.Assignee, position, asg.Image.Collection)
+		if err != nil {
+			return fmt.Errorf("assigning image %v of collection %v to %v: %v: %w",
+				asg.Image.ImageId, asg.Image.Collection, asg.Assignee, err, e.ErrInternal)
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return fmt.Errorf("assigning image %v of collection %v: checking whether assignment was added: %w",
+				asg.Image.ImageId, asg.Image.Collection, e.ErrInternal)
+		}
+	}
+	return nil
+}
+
+func (r AssignmentRepo) IsAssigned(image im.BaseImage) (bool, error) {
+	var exists bool
+	query := `SELECT EXISTS (SELECT 1 FROM assignments WHERE image_id=$1
+	AND collection_id=(SELECT id FROM collections WHERE name=$2))`
+	if err := r.Db.Get(&exists, query, image.ImageId, image.Collection); err != nil {
+		return false, fmt.Errorf("checking whether image %v of collection %v is assigned: %v: %w",
+			image.ImageId, image.Collection, err, e.ErrInternal)
+	}
+	return exists, nil
+}
+
+// progress counts the assigned and finished images of batches per assignee,
+// optionally restricted to a single assignee.
+func (r AssignmentRepo) progress(ids []a.BatchId, assignee *u.UserId) (map[a.BatchId][]a.AssigneeProgress, error) {
+	res := map[a.BatchId][]a.AssigneeProgress{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+	q := sq.StatementBuilder.
+		Select("batch_id,assignee,COUNT(*) AS total,COUNT(finished_at) AS finished").
+		From("assignments").
+		Where(sq.Eq{"batch_id": ids}).
+		GroupBy("batch_id", "assignee").
+		OrderBy("assignee")
+	if assignee != nil {
+		q = q.Where(sq.Eq{"assignee": *assignee})
+	}
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	rows := []ProgressRow{}
+	if err := r.Db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("counting assigned images: %v: %w", err, e.ErrInternal)
+	}
+	for _, row := range rows {
+		res[row.Batch] = append(res[row.Batch], a.AssigneeProgress{
+			Assignee: row.Assignee,
+			Progress: a.Progress{Total: row.Total, Finished: row.Finished},
+		})
+	}
+	return res, nil
+}
+
+func (r AssignmentRepo) rowsToEntities(rows []BatchRow, assignee *u.UserId) ([]a.Batch, error) {
+	ids := []a.BatchId{}
+	for _, row := range rows {
+		ids = append(ids, row.Id)
+	}
+	progress, err := r.progress(ids, assignee)
+	if err != nil {
+		return nil, err
+	}
+	batches := []a.Batch{}
+	for _, row := range rows {
+		assignees := progress[row.Id]
+		if assignees == nil {
+			assignees = []a.AssigneeProgress{}
+		}
+		batches = append(batches, a.Batch{
+			Id:        row.Id,
+			Name:      row.Name,
+			Filter:    row.Filter,
+			Creator:   row.Creator,
+			CreatedAt: row.CreatedAt,
+			Assignees: assignees,
+		})
+	}
+	return batches, nil
+}
+
+func (r AssignmentRepo) FindBatch(id a.BatchId) (*a.Batch, error) {
+	errCtx := fmt.Errorf("fetching assignment batch with id %v", id)
+	row := BatchRow{}
+	err := r.Db.Get(&row, `SELECT `+batchColumns+` FROM assignment_batches WHERE id=$1`, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrNotFound)
+		default:
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrInternal)
+		}
+	}
+	batches, err := r.rowsToEntities([]BatchRow{row}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+	return &batches[0], nil
+}
+
+func (r AssignmentRepo) ListBatches(p pag.PaginationParams) ([]a.Batch, error) {
+	query := `SELECT ` + batchColumns + ` FROM assignment_batches ORDER BY created_at DESC, id LIMIT $1 OFFSET $2`
+	rows := []BatchRow{}
+	if err := r.Db.Select(&rows, query, p.PageSize, (p.Page-1)*int64(p.PageSize)); err != nil {
+		return nil, fmt.Errorf("listing assignment batches: %v: %w", err, e.ErrInternal)
+	}
+	return r.rowsToEntities(rows, nil)
+}
+
+func (r AssignmentRepo) CountBatches() (*int64, error) {
+	var count int64
+	if err := r.Db.Get(&count, `SELECT COUNT(*) FROM assignment_batches`); err != nil {
+		return nil, fmt.Errorf("counting assignment batches: %v: %w", err, e.ErrInternal)
+	}
+	return &count, nil
+}
+
+// ListBatchesOf lists the batches that assign images to user, along with
+// their progress on these images only.
+func (r AssignmentRepo) ListBatchesOf(user u.UserId) ([]a.Batch, error) {
+	query := `SELECT ` + batchColumns + ` FROM assignment_batches
+	WHERE id IN (SELECT batch_id FROM assignments WHERE assignee=$1) ORDER BY created_at, id`
+	rows := []BatchRow{}
+	if err := r.Db.Select(&rows, query, user); err != nil {
+		return nil, fmt.Errorf("listing assignment batches of user %v: %v: %w", user, err, e.ErrInternal)
+	}
+	return r.rowsToEntities(rows, &user)
+}
+
+func (r AssignmentRepo) DeleteBatch(id a.BatchId) error {
+	if _, err := r.Db.Exec("DELETE FROM assignments WHERE batch_id=$1", id); err != nil {
+		return fmt.Errorf("deleting assignments of batch %v: %v: %w", id, err, e.ErrInternal)
+	}
+	if _, err := r.Db.Exec("DELETE FROM assignment_batches WHERE id=$1", id); err != nil {
+		return fmt.Errorf("deleting assignment batch record: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+// NextAssignment fetches the oldest unfinished assignment of user, other
+// than the image to skip, if any.
+func (r AssignmentRepo) NextAssignment(user u.UserId, skip *im.BaseImage) (*a.Assignment, error) {
+	q := sq.StatementBuilder.
+		Select("a.batch_id,a.image_id,c.name AS collection,a.assignee,a.finished_at").
+		From("assignments AS a").
+		Join("assignment_batches AS b ON b.id=a.batch_id").
+		Join("collections AS c ON c.id=a.collection_id").
+		Join("images_collections AS ic ON ic.image_id=a.image_id AND ic.collection_id=a.collection_id").
+		Where(sq.Eq{"a.assignee": user, "a.finished_at": nil}).
+		OrderBy("b.created_at", "a.position").
+		Limit(1)
+	if skip != nil {
+		q = q.Where(sq.Expr("NOT (a.image_id=? AND c.name=?)", skip.ImageId, skip.Collection))
+	}
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	row := Row{}
+	if err := r.Db.Get(&row, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("fetching next image assigned to %v: no image left: %w", user, e.ErrNotFound)
+		}
+		return nil, fmt.Errorf("fetching next image assigned to %v: %v: %w", user, err, e.ErrInternal)
+	}
+	return &a.Assignment{
+		Batch:      row.Batch,
+		Image:      im.BaseImage{ImageId: row.ImageId, Collection: row.Collection},
+		Assignee:   row.Assignee,
+		FinishedAt: row.FinishedAt,
+	}, nil
+}
+
+func (r AssignmentRepo) FinishAssignment(user u.UserId, image im.BaseImage, t time.Time) error {
+	errCtx := fmt.Errorf("finishing image %v of collection %v assigned to %v", image.ImageId, image.Collection, user)
+	query := `UPDATE assignments SET finished_at=$1 WHERE assignee=$2 AND image_id=$3
+	AND collection_id=(SELECT id FROM collections WHERE name=$4)`
+	res, err := r.Db.Exec(query, t, user, image.ImageId, image.Collection)
+	if err != nil {
+		return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	if n == 0 {
+		return fmt.Errorf("%w: no such assignment: %w", errCtx, e.ErrNotFound)
+	}
+	return nil
+}
+
+func NewAssignmentRepo(db adb.Querier) AssignmentRepo {
+	return AssignmentRepo{Db: db}
+}
@@ -0,0 +1,168 @@
+package assignment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	sim "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	ur "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func setup(t *testing.T, db *sqlx.DB, numImages int) (AssignmentRepo, []im.BaseImage) {
+	users := ur.NewUserRepo(db)
+	users.Create(u.NewUser("alice@mail.com"))
+	users.Create(u.NewUser("bob@mail.com"))
+	imr, cr, _ := sim.SetupAdd(db)
+	image, collection := sim.CreateSingleImageCollection(imr, cr, "a-collection")
+	images := []im.BaseImage{{ImageId: image.Id, Collection: collection.Name}}
+	for range numImages - 1 {
+		id := im.NewImageId()
+		assert.NoError(t, imr.AddImage(id, []byte(id.String()), im.Specs{}))
+		assert.NoError(t, imr.AddToCollection(id, collection.Name))
+		images = append(images, im.BaseImage{ImageId: id, Collection: collection.Name})
+	}
+	return NewAssignmentRepo(db), images
+}
+
+func createBatch(t *testing.T, repo AssignmentRepo, name string, images []im.BaseImage, assignees ...u.UserId) a.Batch {
+	batch := a.Batch{Id: a.NewBatchId(), Name: name, Filter: `collection="a-collection"`,
+		Creator: "admin@mail.com", CreatedAt: time.Now()}
+	assert.NoError(t, repo.CreateBatch(batch, a.Distribute(batch.Id, images, assignees)))
+	return batch
+}
+
+func TestCreateAndFindBatch(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 3)
+	batch := createBatch(t, repo, "a-batch", images, "alice@mail.com", "bob@mail.com")
+
+	got, err := repo.FindBatch(batch.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, batch.Name, got.Name)
+	assert.Equal(t, batch.Filter, got.Filter)
+	assert.Equal(t, batch.Creator, got.Creator)
+	assert.Equal(t, []a.AssigneeProgress{
+		{Assignee: "alice@mail.com", Progress: a.Progress{Total: 2}},
+		{Assignee: "bob@mail.com", Progress: a.Progress{Total: 1}},
+	}, got.Assignees)
+	assert.Equal(t, a.Progress{Total: 3}, got.Progress())
+}
+
+func TestFindMissingBatch(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, _ := setup(t, db, 1)
+	_, err := repo.FindBatch(a.NewBatchId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestIsAssigned(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 2)
+	createBatch(t, repo, "a-batch", images[:1], "alice@mail.com")
+
+	assigned, err := repo.IsAssigned(images[0])
+	assert.NoError(t, err)
+	assert.True(t, assigned)
+	assigned, _ = repo.IsAssigned(images[1])
+	assert.False(t, assigned)
+}
+
+func TestAssigningImageTwiceShouldFail(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 1)
+	createBatch(t, repo, "a-batch", images, "alice@mail.com")
+	batch := a.Batch{Id: a.NewBatchId(), Name: "another-batch", Creator: "admin@mail.com"}
+	err := repo.CreateBatch(batch, a.Distribute(batch.Id, images, []u.UserId{"bob@mail.com"}))
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestNextAndFinishAssignment(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 3)
+	createBatch(t, repo, "a-batch", images, "alice@mail.com")
+
+	next, err := repo.NextAssignment("alice@mail.com", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, images[0], next.Image)
+
+	next, _ = repo.NextAssignment("alice@mail.com", &images[0])
+	assert.Equal(t, images[1], next.Image)
+
+	assert.NoError(t, repo.FinishAssignment("alice@mail.com", images[0], time.Now()))
+	next, _ = repo.NextAssignment("alice@mail.com", nil)
+	assert.Equal(t, images[1], next.Image)
+
+	_, err = repo.NextAssignment("bob@mail.com", nil)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestFinishAssignmentOfAnotherUserShouldFail(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 1)
+	createBatch(t, repo, "a-batch", images, "alice@mail.com")
+	err := repo.FinishAssignment("bob@mail.com", images[0], time.Now())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestListBatches(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 4)
+	first := createBatch(t, repo, "first", images[:2], "alice@mail.com", "bob@mail.com")
+	createBatch(t, repo, "second", images[2:], "bob@mail.com")
+	repo.FinishAssignment("bob@mail.com", images[1], time.Now())
+
+	batches, err := repo.ListBatches(pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(batches))
+	count, _ := repo.CountBatches()
+	assert.Equal(t, int64(2), *count)
+
+	mine, err := repo.ListBatchesOf("alice@mail.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(mine))
+	assert.Equal(t, first.Id, mine[0].Id)
+	assert.Equal(t, []a.AssigneeProgress{{Assignee: "alice@mail.com", Progress: a.Progress{Total: 1}}},
+		mine[0].Assignees)
+
+	mine, _ = repo.ListBatchesOf("bob@mail.com")
+	assert.Equal(t, 2, len(mine))
+	assert.Equal(t, a.Progress{Total: 1, Finished: 1}, mine[0].Progress())
+}
+
+func TestDeleteBatchReleasesImages(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo, images := setup(t, db, 1)
+	batch := createBatch(t, repo, "a-batch", images, "alice@mail.com")
+
+	assert.NoError(t, repo.DeleteBatch(batch.Id))
+	_, err := repo.FindBatch(batch.Id)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+	assigned, _ := repo.IsAssigned(images[0])
+	assert.False(t, assigned)
+}
+
+func TestErrOnListBatchesWithClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repo, _ := setup(t, db, 1)
+	db.Close()
+	_, err := repo.ListBatches(pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repo.NextAssignment("alice@mail.com", nil)
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
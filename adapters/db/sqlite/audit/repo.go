@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type AuditRepo struct {
+	Db adb.Querier
+}
+
+type Row struct {
+	Actor      string    `db:"actor"`
+	Action     string    `db:"action"`
+	TargetType string    `db:"target_type"`
+	TargetId   string    `db:"target_id"`
+	Time       time.Time `db:"time"`
+	RequestId  string    `db:"request_id"`
+	Outcome    string    `db:"outcome"`
+	Error      string    `db:"error"`
+}
+
+const columns = "actor,action,target_type,target_id,time,request_id,outcome,error"
+
+func (r Row) toEntity() au.Entry {
+	return au.Entry{
+		Actor:     r.Actor,
+		Action:    r.Action,
+		Target:    au.Target{Type: r.TargetType, Id: r.TargetId},
+		Time:      r.Time,
+		RequestId: r.RequestId,
+		Outcome:   au.Outcome(r.Outcome),
+		Error:     r.Error,
+	}
+}
+
+// Times are stored in UTC so that they compare as strings.
+func (r AuditRepo) AddEntry(entry au.Entry) error {
+	query := `INSERT INTO audit_log (` + columns + `) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	if _, err := r.Db.Exec(query, entry.Actor, entry.Action, entry.Target.Type, entry.Target.Id,
+		entry.Time.UTC(), entry.RequestId, entry.Outcome.String(), entry.Error); err != nil {
+		return fmt.Errorf("inserting audit log entry: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+func where(q sq.SelectBuilder, f au.Filter) sq.SelectBuilder {
+	if f.Actor != nil {
+		q = q.Where(sq.Eq{"actor": *f.Actor})
+	}
+	if f.Action != nil {
+		q = q.Where(sq.Eq{"action": *f.Action})
+	}
+	if f.From != nil {
+		q = q.Where(sq.GtOrEq{"time": f.From.UTC()})
+	}
+	if f.To != nil {
+		q = q.Where(sq.Lt{"time": f.To.UTC()})
+	}
+	return q
+}
+
+func (r AuditRepo) selectEntries(q sq.SelectBuilder) ([]au.Entry, error) {
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	rows := []Row{}
+	if err := r.Db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("listing audit log entries: %v: %w", err, e.ErrInternal)
+	}
+	entries := []au.Entry{}
+	for _, row := range rows {
+		entries = append(entries, row.toEntity())
+	}
+	return entries, nil
+}
+
+// ListEntries fetches a page of the entries matching f, newest first.
+func (r AuditRepo) ListEntries(f au.Filter, p pag.PaginationParams) ([]au.Entry, error) {
+	q := where(sq.StatementBuilder.Select(columns).From("audit_log"), f).
+		OrderBy("id DESC").
+		Limit(uint64(p.PageSize)).
+		Offset(uint64((p.Page - 1) * int64(p.PageSize)))
+	return r.selectEntries(q)
+}
+
+// AllEntries fetches every entry matching f, oldest first.
+func (r AuditRepo) AllEntries(f au.Filter) ([]au.Entry, error) {
+	return r.selectEntries(where(sq.StatementBuilder.Select(columns).From("audit_log"), f).OrderBy("id"))
+}
+
+func (r AuditRepo) CountEntries(f au.Filter) (*int64, error) {
+	query, args, err := where(sq.StatementBuilder.Select("COUNT(*)").From("audit_log"), f).ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	var count int64
+	if err := r.Db.Get(&count, query, args...); err != nil {
+		return nil, fmt.Errorf("counting audit log entries: %v: %w", err, e.ErrInternal)
+	}
+	return &count, nil
+}
+
+func NewAuditRepo(db adb.Querier) AuditRepo {
+	return AuditRepo{Db: db}
+}
@@ -0,0 +1,87 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func addEntries(t *testing.T, repo AuditRepo) []au.Entry {
+	entries := []au.Entry{
+		{Actor: "alice@mail.com", Action: "DeleteLabel", Target: au.Target{Type: "label", Id: "cat"},
+			Time: start, RequestId: "req-1", Outcome: au.Success},
+		{Actor: "bob@mail.com", Action: "SetPolicies", Time: start.Add(time.Hour),
+			RequestId: "req-2", Outcome: au.Denied, Error: "authorization error"},
+		{Actor: "alice@mail.com", Action: "DeleteImage", Target: au.Target{Type: "image", Id: "an-image"},
+			Time: start.Add(2 * time.Hour), Outcome: au.Failure, Error: "not found"},
+	}
+	for _, entry := range entries {
+		assert.NoError(t, repo.AddEntry(entry))
+	}
+	return entries
+}
+
+func TestListEntriesNewestFirst(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewAuditRepo(db)
+	entries := addEntries(t, repo)
+
+	got, err := repo.ListEntries(au.Filter{}, pag.PaginationParams{Page: 1, PageSize: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(got))
+	assert.Equal(t, entries[2].Action, got[0].Action)
+	assert.Equal(t, entries[1], got[1])
+	count, _ := repo.CountEntries(au.Filter{})
+	assert.Equal(t, int64(3), *count)
+}
+
+func TestFilterEntries(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewAuditRepo(db)
+	entries := addEntries(t, repo)
+	actor, action := "alice@mail.com", "DeleteLabel"
+	from, to := start.Add(30*time.Minute), start.Add(2*time.Hour)
+
+	got, _ := repo.AllEntries(au.Filter{Actor: &actor})
+	assert.Equal(t, []au.Entry{entries[0], entries[2]}, got)
+
+	got, _ = repo.AllEntries(au.Filter{Actor: &actor, Action: &action})
+	assert.Equal(t, []au.Entry{entries[0]}, got)
+
+	got, _ = repo.AllEntries(au.Filter{From: &from, To: &to})
+	assert.Equal(t, []au.Entry{entries[1]}, got)
+	count, _ := repo.CountEntries(au.Filter{From: &from, To: &to})
+	assert.Equal(t, int64(1), *count)
+}
+
+func TestFilterByDateInAnotherTimezone(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewAuditRepo(db)
+	addEntries(t, repo)
+	from := start.Add(90 * time.Minute).In(time.FixedZone("UTC+5", 5*3600))
+
+	got, _ := repo.AllEntries(au.Filter{From: &from})
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, "DeleteImage", got[0].Action)
+}
+
+func TestErrOnListEntriesWithClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repo := NewAuditRepo(db)
+	db.Close()
+	_, err := repo.ListEntries(au.Filter{}, pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repo.CountEntries(au.Filter{})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	assert.ErrorIs(t, repo.AddEntry(au.Entry{}), e.ErrInternal)
+}
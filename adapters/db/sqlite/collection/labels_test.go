@@ -0,0 +1,55 @@
+package collection
+
+import (
+	"testing"
+
+	sl "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLabels(t *testing.T, repo sl.LabelRepo, names ...string) []lbl.LabelId {
+	ids := []lbl.LabelId{}
+	for _, n := range names {
+		l := lbl.NewLabel(lbl.NewLabelId(), n)
+		assert.NoError(t, repo.Create(l))
+		ids = append(ids, l.Id)
+	}
+	return ids
+}
+
+func TestSetLabels(t *testing.T) {
+	db := s.NewInMemory()
+	repo := NewCollectionRepo(db)
+	CreateCollection(repo, "a-collection")
+	ids := createLabels(t, sl.NewLabelRepo(db), "person", "car", "bicycle")
+
+	assert.NoError(t, repo.SetLabels("a-collection", ids[:2], &ids[1]))
+	c, err := repo.Find("a-collection")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"car", "person"}, c.Labels)
+	assert.Equal(t, "car", *c.DefaultLabel)
+
+	assert.NoError(t, repo.SetLabels("a-collection", ids[2:], nil))
+	list, err := repo.List(pa.PaginationParams{Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bicycle"}, list[0].Labels)
+	assert.Nil(t, list[0].DefaultLabel)
+}
+
+func TestDeletedLabelsLeaveCollection(t *testing.T) {
+	db := s.NewInMemory()
+	repo := NewCollectionRepo(db)
+	CreateCollection(repo, "a-collection")
+	labels := sl.NewLabelRepo(db)
+	ids := createLabels(t, labels, "person", "car")
+	assert.NoError(t, repo.SetLabels("a-collection", ids, &ids[0]))
+
+	assert.NoError(t, labels.Delete("person"))
+	c, err := repo.Find("a-collection")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"car"}, c.Labels)
+	assert.Nil(t, c.DefaultLabel)
+}
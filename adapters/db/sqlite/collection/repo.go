@@ -9,6 +9,7 @@ import (
 	adb "github.com/lejeunel/go-image-annotator/adapters/db"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	g "github.com/lejeunel/go-image-annotator/entities/group"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 )
@@ -71,10 +72,56 @@ func (r CollectionRepo) Find(name string) (*clc.Collection, error) {
 	}
 
 	entity := r.build(row)
+	if err := r.findLabels(&entity); err != nil {
+		return nil, err
+	}
 
 	return &entity, nil
 }
 
+type labelRow struct {
+	Name      string `db:"name"`
+	IsDefault bool   `db:"is_default"`
+}
+
+func (r CollectionRepo) findLabels(c *clc.Collection) error {
+	rows := []labelRow{}
+	err := r.Db.Select(&rows, `
+		SELECT l.name, cl.is_default FROM collections_labels AS cl
+		JOIN labels l ON l.id = cl.label_id
+		WHERE cl.collection_id=$1 ORDER BY l.name`, c.Id)
+	if err != nil {
+		return fmt.Errorf("fetching labels of collection %v: %v: %w", c.Name, err, e.ErrInternal)
+	}
+	for _, row := range rows {
+		c.Labels = append(c.Labels, row.Name)
+		if row.IsDefault {
+			c.DefaultLabel = &row.Name
+		}
+	}
+	return nil
+}
+
+// SetLabels replaces the labels allowed in a collection, and its default
+// label, which must be one of them.
+func (r CollectionRepo) SetLabels(name clc.CollectionName, labels []lbl.LabelId, defaultLabel *lbl.LabelId) error {
+	errCtx := fmt.Errorf("setting labels of collection %v", name)
+	_, err := r.Db.Exec(`DELETE FROM collections_labels
+		WHERE collection_id=(SELECT id FROM collections WHERE name=$1)`, name)
+	if err != nil {
+		return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	for _, l := range labels {
+		isDefault := defaultLabel != nil && *defaultLabel == l
+		_, err := r.Db.Exec(`INSERT INTO collections_labels (collection_id, label_id, is_default)
+			VALUES ((SELECT id FROM collections WHERE name=$1),$2,$3)`, name, l, isDefault)
+		if err != nil {
+			return fmt.Errorf("%w: %v: %w", errCtx, err, e.ErrInternal)
+		}
+	}
+	return nil
+}
+
 func (r CollectionRepo) Exists(name string) (bool, error) {
 	var exists bool
 
@@ -157,8 +204,11 @@ func (r CollectionRepo) List(m pa.PaginationParams) ([]*clc.Collection, error) {
 
 	objects := []*clc.Collection{}
 	for _, rec := range records {
-		e := r.build(rec)
-		objects = append(objects, &e)
+		c := r.build(rec)
+		if err := r.findLabels(&c); err != nil {
+			return nil, err
+		}
+		objects = append(objects, &c)
 	}
 
 	return objects, nil
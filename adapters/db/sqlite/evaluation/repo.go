@@ -0,0 +1,109 @@
+package evaluation
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type EvaluationRepo struct {
+	Db adb.Querier
+}
+
+type Row struct {
+	Id            t.TaskId  `db:"id"`
+	GroundTruth   string    `db:"ground_truth"`
+	Predictions   string    `db:"predictions"`
+	Filter        string    `db:"filter"`
+	MinConfidence float32   `db:"min_confidence"`
+	Issuer        string    `db:"issuer"`
+	CreatedAt     time.Time `db:"created_at"`
+	Report        string    `db:"report"`
+}
+
+const columns = "id,ground_truth,predictions,filter,min_confidence,issuer,created_at,report"
+
+func (row Row) toEntity() (*ev.Evaluation, error) {
+	report := ev.Report{}
+	if err := json.Unmarshal([]byte(row.Report), &report); err != nil {
+		return nil, fmt.Errorf("unmarshaling report of evaluation %v: %v: %w", row.Id, err, e.ErrInternal)
+	}
+	return &ev.Evaluation{
+		Id:            row.Id,
+		GroundTruth:   row.GroundTruth,
+		Predictions:   row.Predictions,
+		Filter:        row.Filter,
+		MinConfidence: row.MinConfidence,
+		Issuer:        row.Issuer,
+		CreatedAt:     row.CreatedAt,
+		Report:        report,
+	}, nil
+}
+
+func (r EvaluationRepo) Create(v ev.Evaluation) error {
+	report, err := json.Marshal(v.Report)
+	if err != nil {
+		return fmt.Errorf("marshaling report of evaluation %v: %v: %w", v.Id, err, e.ErrInternal)
+	}
+	query := `INSERT INTO evaluations (` + columns + `) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)`
+	if _, err := r.Db.Exec(query, v.Id, v.GroundTruth, v.Predictions, v.Filter, v.MinConfidence,
+		v.Issuer, v.CreatedAt, string(report)); err != nil {
+		return fmt.Errorf("inserting evaluation record: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+func (r EvaluationRepo) Find(id t.TaskId) (*ev.Evaluation, error) {
+	errCtx := fmt.Errorf("fetching evaluation with id %v", id)
+	row := Row{}
+	err := r.Db.Get(&row, `SELECT `+columns+` FROM evaluations WHERE id=$1`, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrNotFound)
+		default:
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrInternal)
+		}
+	}
+	return row.toEntity()
+}
+
+// ListOf lists the evaluations issued by user, most recent first.
+func (r EvaluationRepo) ListOf(user u.UserId, p pag.PaginationParams) ([]ev.Evaluation, error) {
+	query := `SELECT ` + columns + ` FROM evaluations WHERE issuer=$1
+	ORDER BY created_at DESC, id LIMIT $2 OFFSET $3`
+	rows := []Row{}
+	if err := r.Db.Select(&rows, query, user, p.PageSize, (p.Page-1)*int64(p.PageSize)); err != nil {
+		return nil, fmt.Errorf("listing evaluations of %v: %v: %w", user, err, e.ErrInternal)
+	}
+	evaluations := []ev.Evaluation{}
+	for _, row := range rows {
+		evaluation, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("listing evaluations of %v: %w", user, err)
+		}
+		evaluations = append(evaluations, *evaluation)
+	}
+	return evaluations, nil
+}
+
+func (r EvaluationRepo) CountOf(user u.UserId) (*int64, error) {
+	var count int64
+	if err := r.Db.Get(&count, `SELECT COUNT(*) FROM evaluations WHERE issuer=$1`, user); err != nil {
+		return nil, fmt.Errorf("counting evaluations of %v: %v: %w", user, err, e.ErrInternal)
+	}
+	return &count, nil
+}
+
+func NewEvaluationRepo(db adb.Querier) EvaluationRepo {
+	return EvaluationRepo{Db: db}
+}
@@ -0,0 +1,74 @@
+package evaluation
+
+import (
+	"testing"
+	"time"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	tk "github.com/lejeunel/go-image-annotator/entities/task"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+var start = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newEvaluation(issuer string, createdAt time.Time) ev.Evaluation {
+	return ev.Evaluation{
+		Id: tk.NewTaskId(), GroundTruth: "truth", Predictions: "predictions", Filter: "meta.site:lab",
+		MinConfidence: 0.25, Issuer: issuer, CreatedAt: createdAt,
+		Report: ev.Report{
+			NumImages: 2, MAP: 0.5, MAP50: 0.75,
+			Labels: []ev.LabelMetrics{{Label: "cat", NumGroundTruth: 1, AP: 0.5,
+				Curve: []ev.PRPoint{{Recall: 0, Precision: 1}}}},
+			Confusion: ev.ConfusionMatrix{Labels: []string{"cat"}, Counts: [][]int{{1, 0}, {0, 0}}},
+		},
+	}
+}
+
+func TestCreateAndFindEvaluation(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewEvaluationRepo(db)
+	evaluation := newEvaluation("alice@mail.com", start)
+	assert.NoError(t, repo.Create(evaluation))
+
+	got, err := repo.Find(evaluation.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, evaluation, *got)
+}
+
+func TestFindMissingEvaluation(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	_, err := NewEvaluationRepo(db).Find(tk.NewTaskId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestListEvaluationsOfUser(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewEvaluationRepo(db)
+	first := newEvaluation("alice@mail.com", start)
+	second := newEvaluation("alice@mail.com", start.Add(time.Hour))
+	for _, evaluation := range []ev.Evaluation{first, second, newEvaluation("bob@mail.com", start)} {
+		assert.NoError(t, repo.Create(evaluation))
+	}
+
+	got, err := repo.ListOf("alice@mail.com", pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []tk.TaskId{second.Id, first.Id}, []tk.TaskId{got[0].Id, got[1].Id})
+	count, _ := repo.CountOf("alice@mail.com")
+	assert.Equal(t, int64(2), *count)
+}
+
+func TestErrOnListEvaluationsWithClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repo := NewEvaluationRepo(db)
+	db.Close()
+	_, err := repo.ListOf("alice@mail.com", pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repo.CountOf("alice@mail.com")
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
@@ -3,7 +3,9 @@ package group
 import (
 	"testing"
 
+	usr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
 	g "github.com/lejeunel/go-image-annotator/entities/group"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +36,20 @@ func TestRetrieve(t *testing.T) {
 	assert.Equal(t, grp.Description, r.Description)
 	assert.Equal(t, grp.Id, r.Id)
 }
+
+func TestMembers(t *testing.T) {
+	repo := NewTestSQLiteGroupRepo()
+	CreateGroup(repo, "a-group")
+	CreateGroup(repo, "b-group")
+	users := usr.NewUserRepo(repo.Db)
+	for _, user := range []u.User{u.NewUser("bob@mail.com"), u.NewUser("alice@mail.com"), u.NewUser("eve@mail.com")} {
+		users.Create(user)
+	}
+	users.SetGroups("bob@mail.com", []string{"a-group"})
+	users.SetGroups("alice@mail.com", []string{"a-group", "b-group"})
+	users.SetGroups("eve@mail.com", []string{"b-group"})
+
+	members, err := repo.Members("a-group")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice@mail.com", "bob@mail.com"}, members)
+}
@@ -85,6 +85,17 @@ func (r GroupRepo) Exists(name string) (*bool, error) {
 	return &exists, nil
 }
 
+// Members lists the ids of the users who belong to a group.
+func (r GroupRepo) Members(name string) ([]string, error) {
+	members := []string{}
+	query := `SELECT user_id FROM users_groups WHERE group_id=(SELECT id FROM groups WHERE name=$1)
+	ORDER BY user_id`
+	if err := r.Db.Select(&members, query, name); err != nil {
+		return nil, fmt.Errorf("listing members of group %v: %v: %w", name, err, e.ErrInternal)
+	}
+	return members, nil
+}
+
 func (r GroupRepo) Delete(name string) error {
 	_, err := r.Db.Exec("DELETE FROM groups WHERE name=$1", name)
 	if err != nil {
@@ -3,6 +3,9 @@ package image
 import (
 	"testing"
 
+	grr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	grp "github.com/lejeunel/go-image-annotator/entities/group"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
@@ -34,3 +37,39 @@ func TestFindImageIdByHashInternalErrShouldFail(t *testing.T) {
 	_, err := repo.FindImageIdByHash(nil)
 	assert.ErrorIs(t, err, e.ErrInternal)
 }
+
+func TestGetHash(t *testing.T) {
+	repo, _ := BaseSetup()
+	imageId := im.NewImageId()
+	repo.AddImage(imageId, []byte{0xca, 0xfe}, im.Specs{})
+	hash, err := repo.GetHash(imageId)
+	assert.NoError(t, err)
+	assert.Equal(t, "cafe", hash)
+}
+
+func TestGetHashOfMissingImageShouldFail(t *testing.T) {
+	repo, _ := BaseSetup()
+	_, err := repo.GetHash(im.NewImageId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestGetGroups(t *testing.T) {
+	imr, cr, db := SetupList()
+	group := grp.NewGroup(grp.NewGroupId(), "a-group")
+	grr.NewGroupRepo(db).Create(group)
+	image, _ := CreateSingleImageCollection(imr, cr, "public")
+	grouped := clc.NewCollection(clc.NewCollectionId(), "private", clc.WithGroup(group.Name))
+	cr.Create(grouped)
+	imr.AddToCollection(image.Id, grouped.Name)
+
+	groups, err := imr.GetGroups(image.Id)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []*string{nil, &group.Name}, groups)
+}
+
+func TestGetGroupsOfMissingImage(t *testing.T) {
+	imr, _, _ := SetupList()
+	groups, err := imr.GetGroups(im.NewImageId())
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+}
@@ -44,6 +44,20 @@ func TestListOneImageInGivenCollection(t *testing.T) {
 	assert.True(t, images[0].Collection == firstCollection.Name)
 }
 
+func TestListImagesOfSeveralCollections(t *testing.T) {
+	imr, cr, _ := SetupList()
+	CreateSingleImageCollection(imr, cr, "first-collection")
+	CreateSingleImageCollection(imr, cr, "second-collection")
+	CreateSingleImageCollection(imr, cr, "third-collection")
+
+	r, err := imr.Slice(`(collection="first-collection" or collection="third-collection") and `+
+		`(collection="first-collection" or collection="second-collection")`,
+		pa.PaginationParams{PageSize: 3, Page: 1}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(r))
+	assert.Equal(t, "first-collection", r[0].Collection)
+}
+
 func CreateImageInCollectionFromString(
 	repo ImageRepo,
 	collection clc.Collection,
@@ -2,6 +2,7 @@ package image
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"go.tomakado.io/dumbql/query"
@@ -66,18 +67,71 @@ func makeWindowExpr(parser OrderStrParser, function string, ordering im.OrderStr
 	return res, nil
 }
 
+const (
+	annotationsOfImage     = `annotations AS a`
+	annotationsOfImageCond = `a.image_id=ic.image_id AND a.collection_id=ic.collection_id`
+)
+
+func countAnnotations(type_ string) string {
+	return fmt.Sprintf(`(SELECT COUNT(*) FROM %v WHERE %v AND a.type='%v')`,
+		annotationsOfImage, annotationsOfImageCond, type_)
+}
+
 func MakeQueryParsers() (qu.FilterParser, qu.OrderParser) {
+	number := schema.Any(schema.Is[int64](), schema.Is[float64]())
 	sb := schema.NewSchemaBuilder()
 	sb.AddField("collection", schema.Is[string]())
 	sb.AddField("ingested_at", schema.Is[string]())
+	sb.AddField("width", number)
+	sb.AddField("height", number)
+	sb.AddField("mimetype", schema.Is[string]())
+	sb.AddField("label", schema.Is[string]())
+	sb.AddField("has_label", schema.Is[bool]())
+	sb.AddField("num_boxes", number)
+	sb.AddField("num_polygons", number)
+	sb.AddField("num_polylines", number)
+	sb.AddField("num_keypoints", number)
+	sb.AddField("num_masks", number)
+	sb.AddField("annotated_by", schema.Is[string]())
+	sb.AddField("annotated_at", schema.Is[string]())
+	sb.AddField("review_status", schema.Is[string]())
 	sb.AddRegExpField(`^meta\..*$`, schema.Any(schema.Is[float64](), schema.Is[string](), schema.Is[bool]()))
+	sb.AddRegExpField(`^attr\.\w+$`, schema.Any(number, schema.Is[string](), schema.Is[bool]()))
 
 	rb := query.NewRenamerBuilder()
 	rb.Add(`\bmeta\.(.*)\b`, `json_extract(m.meta, '$.$1')`)
+	rb.Add(`^width$`, `i.width`)
+	rb.Add(`^height$`, `i.height`)
+	rb.Add(`^mimetype$`, `i.mimetype`)
+	rb.Add(`^num_boxes$`, countAnnotations("bounding_box"))
+	rb.Add(`^num_polygons$`, countAnnotations("polygon"))
+	rb.Add(`^num_polylines$`, countAnnotations("polyline"))
+	rb.Add(`^num_keypoints$`, countAnnotations("keypoints"))
+	rb.Add(`^num_masks$`, countAnnotations("mask"))
+	rb.Add(`^has_label$`, fmt.Sprintf(`EXISTS (SELECT 1 FROM %v WHERE %v AND a.type='image')`,
+		annotationsOfImage, annotationsOfImageCond))
 
 	filterParser := qu.NewFilterParser(
 		sb.Build(),
 		qu.WithRenamer(rb.Build()),
+		qu.WithSetField("label", qu.SetField{
+			From:   annotationsOfImage + ` JOIN label_lineage AS l ON a.label_id=l.label_id`,
+			Where:  annotationsOfImageCond,
+			Column: "l.name",
+		}),
+		qu.WithSetField("annotated_by", qu.SetField{
+			From: annotationsOfImage, Where: annotationsOfImageCond, Column: "a.author",
+		}),
+		qu.WithSetField("annotated_at", qu.SetField{
+			From: annotationsOfImage, Where: annotationsOfImageCond, Column: "a.touched_at",
+		}),
+		qu.WithSetField("review_status", qu.SetField{
+			From: annotationsOfImage, Where: annotationsOfImageCond, Column: "a.status",
+		}),
+		qu.WithSetFieldPattern(`attr\.(\w+)`, qu.SetField{
+			From: annotationsOfImage, Where: annotationsOfImageCond,
+			Column: "json_extract(a.attributes, '$$.${1}')", Nullable: true,
+		}),
 	)
 	ob := qu.NewOrderParserBuilder()
 	ob.AddField("image_id")
@@ -244,6 +244,43 @@ func (r ImageRepo) AddImage(imageId im.ImageId, hash []byte, specs im.Specs) err
 	return nil
 }
 
+func (r ImageRepo) GetHash(imageId im.ImageId) (string, error) {
+	errCtx := "finding image hash"
+	var hash string
+	err := r.Db.Get(&hash, "SELECT hash FROM images WHERE id = $1", imageId.String())
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return "", fmt.Errorf("%v: %v: %w", errCtx, err, e.ErrNotFound)
+		default:
+			return "", fmt.Errorf("%v: %v: %w", errCtx, err, e.ErrInternal)
+		}
+	}
+	return hash, nil
+}
+
+// GetGroups gives the groups of the collections that hold an image, nil
+// standing for a collection without group.
+func (r ImageRepo) GetGroups(imageId im.ImageId) ([]*string, error) {
+	rows := []sql.NullString{}
+	err := r.Db.Select(&rows, `SELECT g.name FROM images_collections AS ic
+		JOIN collections AS c ON ic.collection_id=c.id
+		LEFT JOIN groups AS g ON c.group_id=g.id
+		WHERE ic.image_id=$1`, imageId.String())
+	if err != nil {
+		return nil, fmt.Errorf("finding groups of image %v: %v: %w", imageId, err, e.ErrInternal)
+	}
+	groups := []*string{}
+	for _, row := range rows {
+		if row.Valid {
+			groups = append(groups, &row.String)
+		} else {
+			groups = append(groups, nil)
+		}
+	}
+	return groups, nil
+}
+
 func (r ImageRepo) FindImageIdByHash(hash []byte) (*im.ImageId, error) {
 	errCtx := "finding image record by hash"
 	var imageId im.ImageId
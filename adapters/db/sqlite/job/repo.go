@@ -0,0 +1,105 @@
+package job
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const (
+	queued  = "queued"
+	running = "running"
+)
+
+type JobRepo struct {
+	Db adb.Querier
+}
+
+type Job struct {
+	TaskId   t.TaskId   `db:"task_id"`
+	Type     t.TaskType `db:"type_"`
+	Payload  []byte     `db:"payload"`
+	Attempts int        `db:"attempts"`
+}
+
+func (j Job) toEntity() jq.Job {
+	return jq.Job{TaskId: j.TaskId, Type: j.Type, Payload: j.Payload, Attempt: j.Attempts}
+}
+
+func (r JobRepo) Create(job jq.Job, runAt time.Time) error {
+	query := `INSERT INTO jobs (task_id, type_, payload, status, attempts, run_at) VALUES ($1,$2,$3,$4,$5,$6)`
+	_, err := r.Db.Exec(query, job.TaskId, job.Type.String(), string(job.Payload), queued,
+		job.Attempt, runAt.UnixMilli())
+	if err != nil {
+		return fmt.Errorf("creating job record: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+func (r JobRepo) Claim(now time.Time) (*jq.Job, error) {
+	query := `UPDATE jobs SET status=$1, attempts=attempts+1
+	WHERE status=$2 AND task_id=(
+		SELECT task_id FROM jobs WHERE status=$2 AND run_at<=$3 ORDER BY run_at LIMIT 1)
+	RETURNING task_id, type_, payload, attempts`
+	row := Job{}
+	if err := r.Db.Get(&row, query, running, queued, now.UnixMilli()); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("claiming job: %v: %w", err, e.ErrInternal)
+	}
+	job := row.toEntity()
+	return &job, nil
+}
+
+func (r JobRepo) Retry(id t.TaskId, runAt time.Time, lastError string) error {
+	query := `UPDATE jobs SET status=$1, run_at=$2, last_error=$3 WHERE task_id=$4`
+	if _, err := r.Db.Exec(query, queued, runAt.UnixMilli(), lastError, id); err != nil {
+		return fmt.Errorf("rescheduling job: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+func (r JobRepo) Delete(id t.TaskId) error {
+	if _, err := r.Db.Exec(`DELETE FROM jobs WHERE task_id=$1`, id); err != nil {
+		return fmt.Errorf("deleting job: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+func (r JobRepo) ListRunning() ([]jq.Job, error) {
+	records := []Job{}
+	err := r.Db.Select(&records,
+		`SELECT task_id, type_, payload, attempts FROM jobs WHERE status=$1`, running)
+	if err != nil {
+		return nil, fmt.Errorf("listing running jobs: %v: %w", err, e.ErrInternal)
+	}
+	jobs := []jq.Job{}
+	for _, rec := range records {
+		jobs = append(jobs, rec.toEntity())
+	}
+	return jobs, nil
+}
+
+func (r JobRepo) ListOrphanedTasks() ([]t.TaskId, error) {
+	query := `SELECT id FROM tasks
+	WHERE NOT EXISTS (SELECT 1 FROM jobs WHERE jobs.task_id=tasks.id)
+	AND (SELECT state FROM events WHERE events.task_id=tasks.id
+		ORDER BY time DESC, rowid DESC LIMIT 1) IN ($1,$2)`
+	ids := []t.TaskId{}
+	if err := r.Db.Select(&ids, query, ev.PendingTask.String(), ev.StartedTask.String()); err != nil {
+		return nil, fmt.Errorf("listing orphaned tasks: %v: %w", err, e.ErrInternal)
+	}
+	return ids, nil
+}
+
+func NewJobRepo(db adb.Querier) JobRepo {
+	return JobRepo{Db: db}
+}
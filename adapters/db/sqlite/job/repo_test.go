@@ -0,0 +1,105 @@
+package job
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	evr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/event"
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	ur "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	ta "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTask(t *testing.T, db *sqlx.DB, state ev.State) ta.TaskId {
+	user := u.NewUser("user@mail.com")
+	ur.NewUserRepo(db).Create(user)
+	events := evr.NewEventRepo(db)
+	id := ta.NewTaskId()
+	assert.NoError(t, events.CreateTask(id, time.Now(), ta.CollectionCloneTask, user.Id))
+	assert.NoError(t, events.AddEvent(id, ev.Event{Time: time.Now(), State: state}))
+	return id
+}
+
+func TestClaimJob(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewJobRepo(db)
+	now := time.Now()
+	job := jq.Job{TaskId: createTask(t, db, ev.PendingTask), Type: ta.CollectionCloneTask,
+		Payload: []byte(`{"a":"b"}`)}
+	assert.NoError(t, repo.Create(job, now))
+
+	claimed, err := repo.Claim(now)
+	assert.NoError(t, err)
+	assert.Equal(t, job.TaskId, claimed.TaskId)
+	assert.Equal(t, ta.CollectionCloneTask, claimed.Type)
+	assert.Equal(t, job.Payload, claimed.Payload)
+	assert.Equal(t, 1, claimed.Attempt)
+
+	claimed, err = repo.Claim(now)
+	assert.NoError(t, err)
+	assert.Nil(t, claimed)
+}
+
+func TestJobIsNotClaimedBeforeDue(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewJobRepo(db)
+	now := time.Now()
+	job := jq.Job{TaskId: createTask(t, db, ev.PendingTask), Type: ta.CollectionCloneTask}
+	repo.Create(job, now)
+	repo.Claim(now)
+	assert.NoError(t, repo.Retry(job.TaskId, now.Add(time.Minute), "failed"))
+
+	claimed, _ := repo.Claim(now)
+	assert.Nil(t, claimed)
+	claimed, _ = repo.Claim(now.Add(time.Minute))
+	assert.Equal(t, 2, claimed.Attempt)
+}
+
+func TestListRunningJobs(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewJobRepo(db)
+	now := time.Now()
+	repo.Create(jq.Job{TaskId: createTask(t, db, ev.PendingTask), Type: ta.CollectionCloneTask}, now)
+	repo.Create(jq.Job{TaskId: createTask(t, db, ev.PendingTask), Type: ta.CollectionCloneTask}, now)
+	claimed, _ := repo.Claim(now)
+
+	running, err := repo.ListRunning()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(running))
+	assert.Equal(t, claimed.TaskId, running[0].TaskId)
+
+	assert.NoError(t, repo.Delete(claimed.TaskId))
+	running, _ = repo.ListRunning()
+	assert.Empty(t, running)
+}
+
+func TestListOrphanedTasks(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := NewJobRepo(db)
+	queued := createTask(t, db, ev.PendingTask)
+	repo.Create(jq.Job{TaskId: queued, Type: ta.CollectionCloneTask}, time.Now())
+	orphan := createTask(t, db, ev.StartedTask)
+	createTask(t, db, ev.DoneTask)
+
+	orphans, err := repo.ListOrphanedTasks()
+	assert.NoError(t, err)
+	assert.Equal(t, []ta.TaskId{orphan}, orphans)
+}
+
+func TestErrOnClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repo := NewJobRepo(db)
+	db.Close()
+	_, err := repo.Claim(time.Now())
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
@@ -0,0 +1,44 @@
+package label
+
+import (
+	"encoding/json"
+	"fmt"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// AttributeRecord is the serialized form of an attribute definition, as
+// stored in the attributes column of labels.
+type AttributeRecord struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required,omitempty"`
+	Default  any      `json:"default,omitempty"`
+	Options  []string `json:"options,omitempty"`
+}
+
+func MarshalAttributes(defs []lbl.Attribute) string {
+	records := []AttributeRecord{}
+	for _, d := range defs {
+		records = append(records, AttributeRecord{Name: d.Name, Type: d.Type.String(),
+			Required: d.Required, Default: d.Default, Options: d.Options})
+	}
+	bytes, _ := json.Marshal(records)
+	return string(bytes)
+}
+
+func UnmarshalAttributes(str string) ([]lbl.Attribute, error) {
+	records := []AttributeRecord{}
+	if str != "" {
+		if err := json.Unmarshal([]byte(str), &records); err != nil {
+			return nil, fmt.Errorf("unmarshaling attribute definitions %v: %v: %w", str, err, e.ErrInternal)
+		}
+	}
+	defs := []lbl.Attribute{}
+	for _, r := range records {
+		defs = append(defs, lbl.Attribute{Name: r.Name, Type: lbl.AttributeType(r.Type),
+			Required: r.Required, Default: r.Default, Options: r.Options})
+	}
+	return defs, nil
+}
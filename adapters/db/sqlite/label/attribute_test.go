@@ -0,0 +1,50 @@
+package label
+
+import (
+	"testing"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelAttributes(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	attributes := []lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute, Default: false},
+		{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}, Required: true},
+		{Name: "speed", Type: lbl.NumberAttribute, Default: 50.0},
+	}
+	assert.NoError(t, repo.Create(lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithAttributes(attributes))))
+	assert.NoError(t, repo.Create(lbl.NewLabel(lbl.NewLabelId(), "tree")))
+
+	car, err := repo.FindLabel("car")
+	assert.NoError(t, err)
+	assert.Equal(t, attributes, car.Attributes)
+
+	plate := []lbl.Attribute{{Name: "plate", Type: lbl.TextAttribute}}
+	assert.NoError(t, repo.Update(lbl.UpdatableModel{Name: "car", NewAttributes: plate}))
+	labels, err := repo.All()
+	assert.NoError(t, err)
+	assert.Equal(t, plate, labels[0].Attributes)
+	assert.Equal(t, []lbl.Attribute{}, labels[1].Attributes)
+}
+
+func TestLabelSkeleton(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	skeleton := &lbl.Skeleton{Points: []string{"head", "neck", "hip"},
+		Edges: [][2]string{{"head", "neck"}, {"neck", "hip"}}}
+	assert.NoError(t, repo.Create(lbl.NewLabel(lbl.NewLabelId(), "person", lbl.WithSkeleton(skeleton))))
+	assert.NoError(t, repo.Create(lbl.NewLabel(lbl.NewLabelId(), "car")))
+
+	person, err := repo.FindLabel("person")
+	assert.NoError(t, err)
+	assert.Equal(t, skeleton, person.Skeleton)
+
+	assert.NoError(t, repo.Update(lbl.UpdatableModel{Name: "person",
+		NewSkeleton: &lbl.Skeleton{Points: []string{"head"}}}))
+	labels, err := repo.All()
+	assert.NoError(t, err)
+	assert.Nil(t, labels[0].Skeleton)
+	assert.Equal(t, &lbl.Skeleton{Points: []string{"head"}, Edges: [][2]string{}}, labels[1].Skeleton)
+}
@@ -12,30 +12,72 @@ import (
 	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
 )
 
+// maxDepth bounds the number of ancestors walked through, should a cycle
+// have been introduced.
+const maxDepth = 100
+
 type LabelRepo struct {
 	Db adb.Querier
 }
 
 type LabelRecord struct {
-	Id          lbl.LabelId `db:"id"`
-	Name        string      `db:"name"`
-	Description string      `db:"description"`
+	Id          lbl.LabelId    `db:"id"`
+	Name        string         `db:"name"`
+	Description string         `db:"description"`
+	Color       string         `db:"color"`
+	Parent      sql.NullString `db:"parent"`
+	Attributes  string         `db:"attributes"`
+	Skeleton    sql.NullString `db:"skeleton"`
 }
 
-func (r LabelRepo) Create(l lbl.Label) error {
-	query := "INSERT INTO labels (id, name, description) VALUES ($1,$2,$3)"
-	_, err := r.Db.Exec(query, l.Id.String(), l.Name, l.Description)
+func (r LabelRecord) toEntity(aliases []string) (*lbl.Label, error) {
+	var parent *string
+	if r.Parent.Valid {
+		parent = &r.Parent.String
+	}
+	if aliases == nil {
+		aliases = []string{}
+	}
+	attributes, err := UnmarshalAttributes(r.Attributes)
 	if err != nil {
-		return fmt.Errorf("inserting label record: %v: %w", err, e.ErrInternal)
+		return nil, err
 	}
+	skeleton, err := UnmarshalSkeleton(r.Skeleton)
+	if err != nil {
+		return nil, err
+	}
+	l := lbl.NewLabel(r.Id, r.Name, lbl.WithDescription(r.Description),
+		lbl.WithParent(parent), lbl.WithColor(r.Color), lbl.WithAliases(aliases),
+		lbl.WithAttributes(attributes), lbl.WithSkeleton(skeleton))
+	return &l, nil
+}
 
-	return nil
+const (
+	labelColumns = "l.id,l.name,l.description,l.color,l.attributes,l.skeleton,p.name AS parent"
+	labelsFrom   = "labels AS l LEFT JOIN labels AS p ON p.id=l.parent_id"
+)
+
+func (r LabelRepo) Create(l lbl.Label) error {
+	return adb.RunInTx(r.Db, func(tx adb.Querier) error {
+		query := `INSERT INTO labels (id, name, description, parent_id, color, attributes, skeleton)
+			VALUES ($1,$2,$3,(SELECT id FROM labels WHERE name=$4),$5,$6,$7)`
+		_, err := tx.Exec(query, l.Id.String(), l.Name, l.Description, l.Parent, l.Color,
+			MarshalAttributes(l.Attributes), MarshalSkeleton(l.Skeleton))
+		if err != nil {
+			return fmt.Errorf("inserting label record: %v: %w", err, e.ErrInternal)
+		}
+		if err := NewLabelRepo(tx).insertAliases(l.Id, l.Aliases); err != nil {
+			return fmt.Errorf("inserting label record: %w", err)
+		}
+		return nil
+	})
 }
 
+// FindLabel finds a label by its name or by one of its aliases.
 func (r LabelRepo) FindLabel(name string) (*lbl.Label, error) {
 	record := LabelRecord{}
-	err := r.Db.Get(&record,
-		"SELECT id,name,description FROM labels WHERE name=$1", name)
+	err := r.Db.Get(&record, "SELECT "+labelColumns+" FROM "+labelsFrom+
+		" WHERE l.name=$1 OR l.id=(SELECT label_id FROM label_aliases WHERE alias=$1)", name)
 	if err != nil {
 		switch {
 		case errors.Is(err, sql.ErrNoRows):
@@ -45,8 +87,15 @@ func (r LabelRepo) FindLabel(name string) (*lbl.Label, error) {
 		}
 	}
 
-	l := lbl.NewLabel(record.Id, record.Name, lbl.WithDescription(record.Description))
-	return &l, nil
+	aliases, err := r.aliasesOf([]LabelRecord{record})
+	if err != nil {
+		return nil, fmt.Errorf("finding record by label: %w", err)
+	}
+	l, err := record.toEntity(aliases[record.Id.String()])
+	if err != nil {
+		return nil, fmt.Errorf("finding record by label: %w", err)
+	}
+	return l, nil
 }
 
 func (r LabelRepo) Delete(name string) error {
@@ -70,8 +119,26 @@ func (r LabelRepo) Count() (int64, error) {
 }
 
 func (r LabelRepo) List(m pag.PaginationParams) ([]*lbl.Label, error) {
-	q := sq.StatementBuilder.Select("id,name,description").From("labels")
+	q := sq.StatementBuilder.Select(labelColumns).From(labelsFrom)
 	q = q.Limit(uint64(m.PageSize)).Offset((uint64(m.Page-1) * uint64(m.PageSize)))
+	labels, err := r.selectLabels(q)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []*lbl.Label{}
+	for _, l := range labels {
+		objects = append(objects, &l)
+	}
+
+	return objects, nil
+}
+
+func (r LabelRepo) All() ([]lbl.Label, error) {
+	return r.selectLabels(sq.StatementBuilder.Select(labelColumns).From(labelsFrom).OrderBy("l.name"))
+}
+
+func (r LabelRepo) selectLabels(q sq.SelectBuilder) ([]lbl.Label, error) {
 	sql, args, err := q.ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
@@ -80,13 +147,55 @@ func (r LabelRepo) List(m pag.PaginationParams) ([]*lbl.Label, error) {
 	if err := r.Db.Select(&records, sql, args...); err != nil {
 		return nil, fmt.Errorf("applying query: %v: %w", err, e.ErrInternal)
 	}
+	aliases, err := r.aliasesOf(records)
+	if err != nil {
+		return nil, err
+	}
 
-	objects := []*lbl.Label{}
-	for _, r := range records {
-		objects = append(objects, &lbl.Label{Id: r.Id, Name: r.Name, Description: r.Description})
+	labels := []lbl.Label{}
+	for _, rec := range records {
+		l, err := rec.toEntity(aliases[rec.Id.String()])
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, *l)
 	}
+	return labels, nil
+}
 
-	return objects, nil
+func (r LabelRepo) aliasesOf(records []LabelRecord) (map[string][]string, error) {
+	ids := []string{}
+	for _, rec := range records {
+		ids = append(ids, rec.Id.String())
+	}
+	query, args, err := sq.StatementBuilder.Select("label_id,alias").From("label_aliases").
+		Where(sq.Eq{"label_id": ids}).OrderBy("alias").ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	rows := []struct {
+		LabelId string `db:"label_id"`
+		Alias   string `db:"alias"`
+	}{}
+	if err := r.Db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("fetching aliases: %v: %w", err, e.ErrInternal)
+	}
+
+	aliases := map[string][]string{}
+	for _, row := range rows {
+		aliases[row.LabelId] = append(aliases[row.LabelId], row.Alias)
+	}
+	return aliases, nil
+}
+
+func (r LabelRepo) insertAliases(id lbl.LabelId, aliases []string) error {
+	for _, alias := range aliases {
+		if _, err := r.Db.Exec("INSERT INTO label_aliases (alias, label_id) VALUES ($1,$2)",
+			alias, id.String()); err != nil {
+			return fmt.Errorf("inserting alias %v: %v: %w", alias, err, e.ErrInternal)
+		}
+	}
+	return nil
 }
 
 func (r LabelRepo) FetchAll() ([]string, error) {
@@ -103,14 +212,57 @@ func (r LabelRepo) FetchAll() ([]string, error) {
 	return names, nil
 }
 
+// Update replaces every field of a label, along with its aliases.
 func (r LabelRepo) Update(m lbl.UpdatableModel) error {
-	query := "UPDATE labels SET description=$1 WHERE name=$2"
-	_, err := r.Db.Exec(query, m.NewDescription, m.Name)
-	if err != nil {
-		return fmt.Errorf("%v: %w", err, e.ErrInternal)
+	return adb.RunInTx(r.Db, func(tx adb.Querier) error {
+		query := `UPDATE labels SET description=$1, parent_id=(SELECT id FROM labels WHERE name=$2), color=$3,
+			attributes=$4, skeleton=$5 WHERE name=$6`
+		_, err := tx.Exec(query, m.NewDescription, m.NewParent, m.NewColor,
+			MarshalAttributes(m.NewAttributes), MarshalSkeleton(m.NewSkeleton), m.Name)
+		if err != nil {
+			return fmt.Errorf("%v: %w", err, e.ErrInternal)
+		}
+
+		var id lbl.LabelId
+		if err := tx.Get(&id, "SELECT id FROM labels WHERE name=$1", m.Name); err != nil {
+			return fmt.Errorf("%v: %w", err, e.ErrInternal)
+		}
+		if _, err := tx.Exec("DELETE FROM label_aliases WHERE label_id=$1", id.String()); err != nil {
+			return fmt.Errorf("deleting aliases: %v: %w", err, e.ErrInternal)
+		}
+		return NewLabelRepo(tx).insertAliases(id, m.NewAliases)
+	})
+}
+
+// Canonical gives the name of the label that name designates, either as its
+// name or as one of its aliases.
+func (r LabelRepo) Canonical(name string) (*string, error) {
+	var canonical string
+	err := r.Db.Get(&canonical, `SELECT name FROM labels WHERE name=$1
+		UNION ALL SELECT l.name FROM label_aliases AS a JOIN labels AS l ON l.id=a.label_id WHERE a.alias=$1
+		LIMIT 1`, name)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("resolving label %v: %v: %w", name, err, e.ErrInternal)
 	}
+	return &canonical, nil
+}
 
-	return nil
+func (r LabelRepo) Lineage(name string) ([]string, error) {
+	names := []string{}
+	err := r.Db.Select(&names, `WITH RECURSIVE lineage(name, parent_id, depth) AS (
+			SELECT name, parent_id, 0 FROM labels WHERE name=$1
+			UNION ALL
+			SELECT l.name, l.parent_id, lineage.depth+1 FROM labels AS l
+			JOIN lineage ON l.id=lineage.parent_id WHERE lineage.depth < $2
+		)
+		SELECT name FROM lineage ORDER BY depth`, name, maxDepth)
+	if err != nil {
+		return nil, fmt.Errorf("fetching ancestors of label %v: %v: %w", name, err, e.ErrInternal)
+	}
+	return names, nil
 }
 
 func (r LabelRepo) Exists(name string) (bool, error) {
@@ -126,7 +278,8 @@ func (r LabelRepo) Exists(name string) (bool, error) {
 
 func (r LabelRepo) IsUsed(name string) (*bool, error) {
 	var count int64
-	query := "SELECT COUNT(*) FROM annotations WHERE label_id=(SELECT id FROM labels WHERE name=$1)"
+	query := `SELECT (SELECT COUNT(*) FROM annotations WHERE label_id=(SELECT id FROM labels WHERE name=$1))
+		+ (SELECT COUNT(*) FROM labels WHERE parent_id=(SELECT id FROM labels WHERE name=$1))`
 	err := r.Db.QueryRow(query, name).Scan(&count)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", err, e.ErrInternal)
@@ -136,6 +289,16 @@ func (r LabelRepo) IsUsed(name string) (*bool, error) {
 	return &isUsed, nil
 }
 
+func (r LabelRepo) HasKeypoints(name string) (bool, error) {
+	var exists bool
+	err := r.Db.Get(&exists, `SELECT EXISTS (SELECT 1 FROM annotations
+		WHERE type='keypoints' AND label_id=(SELECT id FROM labels WHERE name=$1))`, name)
+	if err != nil {
+		return false, fmt.Errorf("%v: %w", err, e.ErrInternal)
+	}
+	return exists, nil
+}
+
 func NewLabelRepo(db adb.Querier) LabelRepo {
 	return LabelRepo{Db: db}
 }
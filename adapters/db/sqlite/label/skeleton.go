@@ -0,0 +1,41 @@
+package label
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// SkeletonRecord is the serialized form of a skeleton, as stored in the
+// skeleton column of labels.
+type SkeletonRecord struct {
+	Points []string    `json:"points"`
+	Edges  [][2]string `json:"edges"`
+}
+
+func MarshalSkeleton(s *lbl.Skeleton) *string {
+	if s == nil {
+		return nil
+	}
+	rec := SkeletonRecord{Points: s.Points, Edges: s.Edges}
+	if rec.Edges == nil {
+		rec.Edges = [][2]string{}
+	}
+	bytes, _ := json.Marshal(rec)
+	str := string(bytes)
+	return &str
+}
+
+func UnmarshalSkeleton(str sql.NullString) (*lbl.Skeleton, error) {
+	if !str.Valid {
+		return nil, nil
+	}
+	rec := SkeletonRecord{}
+	if err := json.Unmarshal([]byte(str.String), &rec); err != nil {
+		return nil, fmt.Errorf("unmarshaling skeleton %v: %v: %w", str.String, err, e.ErrInternal)
+	}
+	return &lbl.Skeleton{Points: rec.Points, Edges: rec.Edges}, nil
+}
@@ -0,0 +1,138 @@
+package label
+
+import (
+	"testing"
+
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	"github.com/stretchr/testify/assert"
+)
+
+func createVehicles(t *testing.T, repo LabelRepo) {
+	vehicle, car := "vehicle", "car"
+	for _, l := range []lbl.Label{
+		lbl.NewLabel(lbl.NewLabelId(), "vehicle", lbl.WithColor("#ff0000")),
+		lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithParent(&vehicle),
+			lbl.WithAliases([]string{"automobile", "Car"})),
+		lbl.NewLabel(lbl.NewLabelId(), "taxi", lbl.WithParent(&car)),
+	} {
+		assert.NoError(t, repo.Create(l))
+	}
+}
+
+func TestFindLabelWithTaxonomy(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+
+	car, err := repo.FindLabel("car")
+	assert.NoError(t, err)
+	assert.Equal(t, "vehicle", *car.Parent)
+	assert.Equal(t, []string{"Car", "automobile"}, car.Aliases)
+
+	vehicle, err := repo.FindLabel("vehicle")
+	assert.NoError(t, err)
+	assert.Nil(t, vehicle.Parent)
+	assert.Equal(t, "#ff0000", vehicle.Color)
+	assert.Equal(t, []string{}, vehicle.Aliases)
+}
+
+func TestFindLabelByAlias(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	l, err := repo.FindLabel("automobile")
+	assert.NoError(t, err)
+	assert.Equal(t, "car", l.Name)
+}
+
+func TestCanonical(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+
+	name, err := repo.Canonical("Car")
+	assert.NoError(t, err)
+	assert.Equal(t, "car", *name)
+
+	name, err = repo.Canonical("taxi")
+	assert.NoError(t, err)
+	assert.Equal(t, "taxi", *name)
+
+	name, err = repo.Canonical("plane")
+	assert.NoError(t, err)
+	assert.Nil(t, name)
+}
+
+func TestLineage(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	lineage, err := repo.Lineage("taxi")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"taxi", "car", "vehicle"}, lineage)
+}
+
+func TestUpdateTaxonomy(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	vehicle := "vehicle"
+	err := repo.Update(lbl.UpdatableModel{Name: "taxi", NewParent: &vehicle, NewColor: "#00ff00",
+		NewAliases: []string{"cab"}})
+	assert.NoError(t, err)
+
+	taxi, err := repo.FindLabel("taxi")
+	assert.NoError(t, err)
+	assert.Equal(t, "vehicle", *taxi.Parent)
+	assert.Equal(t, "#00ff00", taxi.Color)
+	assert.Equal(t, []string{"cab"}, taxi.Aliases)
+
+	err = repo.Update(lbl.UpdatableModel{Name: "car"})
+	assert.NoError(t, err)
+	car, _ := repo.FindLabel("car")
+	assert.Nil(t, car.Parent)
+	assert.Equal(t, []string{}, car.Aliases)
+}
+
+func TestFailedUpdateKeepsLabel(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	assert.NoError(t, repo.Update(lbl.UpdatableModel{Name: "taxi", NewAliases: []string{"cab"}}))
+
+	err := repo.Update(lbl.UpdatableModel{Name: "taxi", NewDescription: "a yellow car",
+		NewAliases: []string{"yellow-cab", "automobile"}})
+	assert.Error(t, err)
+
+	taxi, err := repo.FindLabel("taxi")
+	assert.NoError(t, err)
+	assert.Equal(t, "", taxi.Description)
+	assert.Equal(t, []string{"cab"}, taxi.Aliases)
+}
+
+func TestAllLabels(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	labels, err := repo.All()
+	assert.NoError(t, err)
+	assert.Len(t, labels, 3)
+	assert.Equal(t, "car", labels[0].Name)
+	assert.Equal(t, []string{"Car", "automobile"}, labels[0].Aliases)
+}
+
+func TestParentLabelIsUsed(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	used, err := repo.IsUsed("vehicle")
+	assert.NoError(t, err)
+	assert.True(t, *used)
+	used, err = repo.IsUsed("taxi")
+	assert.NoError(t, err)
+	assert.False(t, *used)
+}
+
+func TestDeletingLabelDeletesAliases(t *testing.T) {
+	repo := NewLabelRepo(s.NewInMemory())
+	createVehicles(t, repo)
+	assert.NoError(t, repo.Delete("taxi"))
+	assert.NoError(t, repo.Update(lbl.UpdatableModel{Name: "car"}))
+	assert.NoError(t, repo.Delete("car"))
+	name, err := repo.Canonical("automobile")
+	assert.NoError(t, err)
+	assert.Nil(t, name)
+}
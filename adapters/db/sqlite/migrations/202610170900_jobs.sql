@@ -0,0 +1,16 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS jobs (
+  task_id varchar(36) PRIMARY KEY REFERENCES tasks(id) ON DELETE CASCADE,
+  type_ varchar(60) NOT NULL,
+  payload TEXT NOT NULL,
+  status varchar(20) NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  run_at INTEGER NOT NULL,
+  last_error TEXT
+);
+CREATE INDEX jobs_status_run_at_idx ON jobs(status, run_at);
+
+-- +goose Down
+
+DROP TABLE jobs;
@@ -0,0 +1,22 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS views (
+  id varchar(36) PRIMARY KEY,
+  name varchar(60) NOT NULL,
+  owner varchar(60) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  filter TEXT NOT NULL DEFAULT '',
+  ordering TEXT NOT NULL DEFAULT '',
+  created_at DATETIME
+);
+CREATE INDEX views_owner_idx ON views(owner);
+
+CREATE TABLE IF NOT EXISTS views_groups (
+  view_id varchar(36) REFERENCES views(id) ON DELETE CASCADE,
+  group_id varchar(36) REFERENCES groups(id) ON DELETE CASCADE,
+  PRIMARY KEY (view_id, group_id)
+);
+
+-- +goose Down
+
+DROP TABLE views_groups;
+DROP TABLE views;
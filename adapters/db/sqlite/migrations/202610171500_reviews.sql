@@ -0,0 +1,15 @@
+-- +goose Up
+
+ALTER TABLE annotations ADD COLUMN status varchar(15) NOT NULL DEFAULT 'draft';
+ALTER TABLE annotations ADD COLUMN reviewer varchar(60) NULL;
+ALTER TABLE annotations ADD COLUMN review_comment TEXT NOT NULL DEFAULT '';
+ALTER TABLE annotations ADD COLUMN reviewed_at DATETIME NULL;
+CREATE INDEX idx_annotations_status ON annotations(status);
+
+-- +goose Down
+
+DROP INDEX idx_annotations_status;
+ALTER TABLE annotations DROP COLUMN reviewed_at;
+ALTER TABLE annotations DROP COLUMN review_comment;
+ALTER TABLE annotations DROP COLUMN reviewer;
+ALTER TABLE annotations DROP COLUMN status;
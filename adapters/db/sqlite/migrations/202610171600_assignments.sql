@@ -0,0 +1,26 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS assignment_batches (
+  id varchar(36) PRIMARY KEY,
+  name varchar(60) NOT NULL,
+  filter TEXT NOT NULL DEFAULT '',
+  creator varchar(60) NOT NULL,
+  created_at DATETIME
+);
+
+CREATE TABLE IF NOT EXISTS assignments (
+  batch_id varchar(36) NOT NULL REFERENCES assignment_batches(id) ON DELETE CASCADE,
+  image_id varchar(36) NOT NULL REFERENCES images(id) ON DELETE CASCADE,
+  collection_id varchar(36) NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  assignee varchar(60) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  position INTEGER NOT NULL,
+  finished_at DATETIME NULL,
+  PRIMARY KEY (image_id, collection_id)
+);
+CREATE INDEX assignments_batch_idx ON assignments(batch_id);
+CREATE INDEX assignments_assignee_idx ON assignments(assignee, finished_at);
+
+-- +goose Down
+
+DROP TABLE assignments;
+DROP TABLE assignment_batches;
@@ -0,0 +1,20 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS annotation_revisions (
+  id varchar(36) PRIMARY KEY,
+  annotation_id varchar(36) NOT NULL,
+  image_id varchar(36) NOT NULL,
+  collection_id varchar(36) NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+  action varchar(15) NOT NULL,
+  restores varchar(36) NULL,
+  before TEXT NULL,
+  after TEXT NULL,
+  author varchar(60) NULL,
+  created_at DATETIME NULL
+);
+CREATE INDEX idx_annotation_revisions_annotation ON annotation_revisions(annotation_id);
+
+-- +goose Down
+
+DROP INDEX idx_annotation_revisions_annotation;
+DROP TABLE annotation_revisions;
@@ -0,0 +1,20 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS audit_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  actor varchar(60) NOT NULL DEFAULT '',
+  action varchar(60) NOT NULL,
+  target_type varchar(30) NOT NULL DEFAULT '',
+  target_id TEXT NOT NULL DEFAULT '',
+  time DATETIME NOT NULL,
+  request_id varchar(60) NOT NULL DEFAULT '',
+  outcome varchar(10) NOT NULL,
+  error TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX audit_log_time_idx ON audit_log(time);
+CREATE INDEX audit_log_actor_idx ON audit_log(actor, time);
+CREATE INDEX audit_log_action_idx ON audit_log(action, time);
+
+-- +goose Down
+
+DROP TABLE audit_log;
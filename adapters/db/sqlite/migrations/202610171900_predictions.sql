@@ -0,0 +1,9 @@
+-- +goose Up
+
+ALTER TABLE annotations ADD COLUMN model varchar(100) NOT NULL DEFAULT '';
+ALTER TABLE annotations ADD COLUMN confidence REAL NULL;
+
+-- +goose Down
+
+ALTER TABLE annotations DROP COLUMN confidence;
+ALTER TABLE annotations DROP COLUMN model;
@@ -0,0 +1,17 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS evaluations (
+  id varchar(36) PRIMARY KEY,
+  ground_truth varchar(60) NOT NULL,
+  predictions varchar(60) NOT NULL,
+  filter TEXT NOT NULL DEFAULT '',
+  min_confidence REAL NOT NULL DEFAULT 0,
+  issuer varchar(60) NOT NULL,
+  created_at DATETIME,
+  report TEXT NOT NULL
+);
+CREATE INDEX evaluations_issuer_idx ON evaluations(issuer, created_at);
+
+-- +goose Down
+
+DROP TABLE evaluations;
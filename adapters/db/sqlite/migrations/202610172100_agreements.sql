@@ -0,0 +1,17 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS agreements (
+  id varchar(36) PRIMARY KEY,
+  collections TEXT NOT NULL,
+  authors TEXT NOT NULL,
+  filter TEXT NOT NULL DEFAULT '',
+  iou_threshold REAL NOT NULL,
+  issuer varchar(60) NOT NULL,
+  created_at DATETIME,
+  report TEXT NOT NULL
+);
+CREATE INDEX agreements_issuer_idx ON agreements(issuer, created_at);
+
+-- +goose Down
+
+DROP TABLE agreements;
@@ -0,0 +1,32 @@
+-- +goose Up
+
+ALTER TABLE labels ADD COLUMN parent_id varchar(36) NULL;
+ALTER TABLE labels ADD COLUMN color varchar(7) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_labels_parent ON labels(parent_id);
+
+CREATE TABLE IF NOT EXISTS label_aliases (
+    alias varchar(100) NOT NULL,
+    label_id varchar(36) NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    PRIMARY KEY (alias)
+);
+
+CREATE INDEX idx_label_aliases_label ON label_aliases(label_id);
+
+CREATE VIEW label_lineage AS
+WITH RECURSIVE lineage(label_id, ancestor_id) AS (
+    SELECT id, id FROM labels
+    UNION
+    SELECT lineage.label_id, labels.parent_id FROM lineage
+    JOIN labels ON labels.id = lineage.ancestor_id
+    WHERE labels.parent_id IS NOT NULL
+)
+SELECT lineage.label_id, labels.name FROM lineage JOIN labels ON labels.id = lineage.ancestor_id;
+
+-- +goose Down
+
+DROP VIEW label_lineage;
+DROP TABLE label_aliases;
+DROP INDEX idx_labels_parent;
+ALTER TABLE labels DROP COLUMN color;
+ALTER TABLE labels DROP COLUMN parent_id;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+ALTER TABLE labels ADD COLUMN attributes TEXT NOT NULL DEFAULT '[]' CHECK (json_valid(attributes));
+ALTER TABLE annotations ADD COLUMN attributes TEXT NOT NULL DEFAULT '{}' CHECK (json_valid(attributes));
+
+-- +goose Down
+
+ALTER TABLE annotations DROP COLUMN attributes;
+ALTER TABLE labels DROP COLUMN attributes;
@@ -0,0 +1,15 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS collections_labels (
+    collection_id varchar(36) NOT NULL REFERENCES collections(id) ON DELETE CASCADE,
+    label_id varchar(36) NOT NULL REFERENCES labels(id) ON DELETE CASCADE,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (collection_id, label_id)
+);
+
+CREATE UNIQUE INDEX idx_collections_labels_default ON collections_labels(collection_id) WHERE is_default;
+
+-- +goose Down
+
+DROP INDEX idx_collections_labels_default;
+DROP TABLE collections_labels;
@@ -0,0 +1,7 @@
+-- +goose Up
+
+ALTER TABLE labels ADD COLUMN skeleton TEXT CHECK (skeleton IS NULL OR json_valid(skeleton));
+
+-- +goose Down
+
+ALTER TABLE labels DROP COLUMN skeleton;
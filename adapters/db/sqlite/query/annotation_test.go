@@ -0,0 +1,150 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	sa "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
+	sl "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	su "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+// InitAnnotatedImages creates three images in collection "a-collection":
+//   - 0: 1024x768 png with two "car" boxes by alice and a "day" image label,
+//     where "car" is a child of "vehicle", and the first box is an occluded
+//     red car at speed 30
+//   - 1: 640x480 jpeg with an accepted, not occluded, "person" polygon by bob
+//   - 2: 640x480 png without annotations
+func InitAnnotatedImages(t *testing.T, db *sqlx.DB) ScrollerRepos {
+	repos := NewTestScrollerRepos(db)
+	annotations := sa.NewAnnotationRepo(db)
+	labels := sl.NewLabelRepo(db)
+	users := su.NewUserRepo(db)
+
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection")
+	assert.NoError(t, repos.CollectionRepo.Create(collection))
+	specs := []im.Specs{
+		{MIMEType: "image/png", Width: 1024, Height: 768, IngestedAt: time.Now()},
+		{MIMEType: "image/jpeg", Width: 640, Height: 480, IngestedAt: time.Now()},
+		{MIMEType: "image/png", Width: 640, Height: 480, IngestedAt: time.Now()},
+	}
+	for i, spec := range specs {
+		id := *st.IdFromInt(i)
+		assert.NoError(t, repos.ImageRepo.AddImage(id, []byte(id.String()), spec))
+		assert.NoError(t, repos.ImageRepo.AddToCollection(id, collection.Name))
+	}
+
+	newLabel := func(name string, opts ...lbl.Option) lbl.Label {
+		l := lbl.NewLabel(lbl.NewLabelId(), name, opts...)
+		assert.NoError(t, labels.Create(l))
+		return l
+	}
+	vehicle := newLabel("vehicle")
+	car := newLabel("car", lbl.WithParent(&vehicle.Name))
+	day, person := newLabel("day"), newLabel("person")
+	alice, bob := u.UserId("alice@mail.com"), u.UserId("bob@mail.com")
+	users.Create(u.NewUser(alice))
+	users.Create(u.NewUser(bob))
+	touchedAt := MustParseTime("2026-08-10")
+
+	for i := range 2 {
+		box := an.NewBoundingBox(an.NewAnnotationId(), 10, 10, 5, 5, car)
+		if i == 0 {
+			box.Attributes = an.Attributes{"occluded": true, "color": "red", "speed": 30.0}
+		}
+		assert.NoError(t, annotations.AddBoundingBox(*st.IdFromInt(0), collection.Name, box, &alice, &touchedAt))
+	}
+	assert.NoError(t, annotations.AddImageLabel(*st.IdFromInt(0), collection.Name, an.NewImageLabel(day), &alice, &touchedAt))
+	polygon := an.NewPolygon(an.NewAnnotationId(), an.Points{Coordinates: [][2]float32{{0, 0}, {1, 0}, {1, 1}}}, person)
+	polygon.Review.Status = an.Accepted
+	polygon.Attributes = an.Attributes{"occluded": false}
+	assert.NoError(t, annotations.AddPolygon(*st.IdFromInt(1), collection.Name, polygon, &bob, &touchedAt))
+	return repos
+}
+
+var annotationFilterTests = []struct {
+	name    string
+	filter  im.FilterStr
+	wantIds []int
+}{
+	{"filter by label", `label:"car"`, []int{0}},
+	{"filter by absent label", `label!="car"`, []int{1, 2}},
+	{"filter by label in set", `label:["person","day"]`, []int{0, 1}},
+	{"filter by label pattern", `label~"ers"`, []int{1}},
+	{"filter by ancestor label", `label:"vehicle"`, []int{0}},
+	{"filter by absent ancestor label", `label!="vehicle"`, []int{1, 2}},
+	{"filter by image label", `has_label`, []int{0}},
+	{"filter by number of boxes", `num_boxes>=2`, []int{0}},
+	{"filter by number of polygons", `num_polygons:0`, []int{0, 2}},
+	{"filter by author", `annotated_by:"bob@mail.com"`, []int{1}},
+	{"filter images annotated by nobody", `not annotated_by?`, []int{2}},
+	{"filter by annotation time", `annotated_at>"2026-08-01"`, []int{0, 1}},
+	{"filter by review status", `review_status:"accepted"`, []int{1}},
+	{"filter images with drafts", `review_status:["draft","rejected"]`, []int{0}},
+	{"filter by width", `width>800`, []int{0}},
+	{"filter by height", `height:480`, []int{1, 2}},
+	{"filter by mimetype", `mimetype:"image/png"`, []int{0, 2}},
+	{"filter by bool attribute", `attr.occluded:true`, []int{0}},
+	{"filter by false bool attribute", `attr.occluded:false`, []int{1}},
+	{"filter by enum attribute", `attr.color:"red"`, []int{0}},
+	{"filter by number attribute", `attr.speed>20`, []int{0}},
+	{"filter by absent attribute value", `attr.color!="red"`, []int{1, 2}},
+	{"filter images with attribute", `attr.occluded?`, []int{0, 1}},
+	{"filter images without attribute", `not attr.occluded?`, []int{2}},
+	{"combine annotation and image fields", `mimetype:"image/png" and not label?`, []int{2}},
+}
+
+func TestAnnotationFilters(t *testing.T) {
+	for _, tt := range annotationFilterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := s.NewInMemory()
+			defer db.Close()
+			repos := InitAnnotatedImages(t, db)
+
+			images, err := repos.ImageRepo.Slice(tt.filter, pa.PaginationParams{Page: 1, PageSize: 10}, "")
+			assert.NoError(t, err)
+			gotIds := []im.ImageId{}
+			for _, image := range images {
+				gotIds = append(gotIds, image.ImageId)
+			}
+			wantIds := []im.ImageId{}
+			for _, i := range tt.wantIds {
+				wantIds = append(wantIds, *st.IdFromInt(i))
+			}
+			assert.Equal(t, wantIds, gotIds)
+
+			count, err := repos.ImageRepo.Count(tt.filter)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(len(tt.wantIds)), *count)
+		})
+	}
+}
+
+func TestAdjacencyWithAnnotationFilter(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repos := InitAnnotatedImages(t, db)
+
+	adj, err := repos.ImageRepo.GetAdjacent(*st.IdFromInt(0), "a-collection",
+		"label:\"car\" or num_polygons>0", "", im.ScrollNext)
+	assert.NoError(t, err)
+	assert.Nil(t, adj.Prev)
+	assert.NotNil(t, adj.Next)
+	assert.Equal(t, st.IdFromInt(1).String(), adj.Next.ImageId.String())
+
+	adj, err = repos.ImageRepo.GetAdjacent(*st.IdFromInt(2), "a-collection",
+		"not annotated_by? or width>800", "", im.ScrollNext)
+	assert.NoError(t, err)
+	assert.Equal(t, st.IdFromInt(0).String(), adj.Prev.ImageId.String())
+	assert.Nil(t, adj.Next)
+}
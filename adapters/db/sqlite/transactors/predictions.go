@@ -0,0 +1,26 @@
+package uow
+
+import (
+	"github.com/jmoiron/sqlx"
+	an "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
+	imppred "github.com/lejeunel/go-image-annotator/use-cases/annotate/import-predictions"
+)
+
+type PredictionTransactor struct{ db *sqlx.DB }
+
+func NewPredictionTransactor(db *sqlx.DB) *PredictionTransactor {
+	return &PredictionTransactor{db: db}
+}
+
+func (u *PredictionTransactor) RunInTx(fn func(imppred.Repo) error) error {
+	tx, err := u.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(an.NewAnnotationRepo(tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,164 @@
+package view
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	adb "github.com/lejeunel/go-image-annotator/adapters/db"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type ViewRepo struct {
+	Db adb.Querier
+}
+
+type Row struct {
+	Id       vw.ViewId `db:"id"`
+	Name     string    `db:"name"`
+	Owner    string    `db:"owner"`
+	Filter   string    `db:"filter"`
+	Ordering string    `db:"ordering"`
+}
+
+func (r ViewRepo) Create(v vw.View) error {
+	query := `INSERT INTO views (id, name, owner, filter, ordering, created_at) VALUES ($1,$2,$3,$4,$5,$6)`
+	_, err := r.Db.Exec(query, v.Id, v.Name, v.Owner, v.Filter, v.Order, time.Now())
+	if err != nil {
+		return fmt.Errorf("inserting view record: %v: %w", err, e.ErrInternal)
+	}
+	return r.setGroups(v.Id, v.Groups)
+}
+
+func (r ViewRepo) setGroups(id vw.ViewId, groups []string) error {
+	if _, err := r.Db.Exec("DELETE FROM views_groups WHERE view_id=$1", id); err != nil {
+		return fmt.Errorf("deleting groups of view: %v: %w", err, e.ErrInternal)
+	}
+	for _, group := range groups {
+		res, err := r.Db.Exec(
+			"INSERT INTO views_groups (view_id, group_id) SELECT $1, id FROM groups WHERE name=$2",
+			id, group)
+		if err != nil {
+			return fmt.Errorf("sharing view %v with group %v: %v: %w", id, group, err, e.ErrInternal)
+		}
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return fmt.Errorf("sharing view %v with group %v: checking whether group was added: %w",
+				id, group, e.ErrInternal)
+		}
+	}
+	return nil
+}
+
+func (r ViewRepo) getGroupNames(id vw.ViewId) ([]string, error) {
+	groups := []string{}
+	query := `SELECT name FROM groups WHERE id IN (SELECT group_id FROM views_groups WHERE view_id=$1)
+	ORDER BY name`
+	if err := r.Db.Select(&groups, query, id); err != nil {
+		return nil, fmt.Errorf("fetching groups of view %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return groups, nil
+}
+
+func (r ViewRepo) rowToEntity(row Row) (*vw.View, error) {
+	groups, err := r.getGroupNames(row.Id)
+	if err != nil {
+		return nil, err
+	}
+	v := vw.NewView(row.Id, row.Name, row.Owner,
+		vw.WithFilter(row.Filter), vw.WithOrder(row.Ordering), vw.WithGroups(groups))
+	return &v, nil
+}
+
+func (r ViewRepo) FindView(id vw.ViewId) (*vw.View, error) {
+	errCtx := fmt.Errorf("fetching view with id %v", id)
+	row := Row{}
+	err := r.Db.Get(&row, `SELECT id,name,owner,filter,ordering FROM views WHERE id=$1`, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrNotFound)
+		default:
+			return nil, fmt.Errorf("%w: %w: %w", errCtx, err, e.ErrInternal)
+		}
+	}
+	return r.rowToEntity(row)
+}
+
+func (r ViewRepo) Update(m vw.UpdateModel) error {
+	query := "UPDATE views SET name=$1,filter=$2,ordering=$3 WHERE id=$4"
+	if _, err := r.Db.Exec(query, m.Name, m.Filter, m.Order, m.Id); err != nil {
+		return fmt.Errorf("updating view record: %v: %w", err, e.ErrInternal)
+	}
+	return r.setGroups(m.Id, m.Groups)
+}
+
+func (r ViewRepo) Delete(id vw.ViewId) error {
+	if _, err := r.Db.Exec("DELETE FROM views_groups WHERE view_id=$1", id); err != nil {
+		return fmt.Errorf("deleting groups of view: %v: %w", err, e.ErrInternal)
+	}
+	if _, err := r.Db.Exec("DELETE FROM views WHERE id=$1", id); err != nil {
+		return fmt.Errorf("deleting view record: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
+
+// visibleTo restricts views to those owned by user or shared with one of
+// their groups.
+func visibleTo(q sq.SelectBuilder, user u.User) sq.SelectBuilder {
+	shared := sq.Expr(`EXISTS (SELECT 1 FROM views_groups AS vg JOIN groups AS g ON vg.group_id=g.id
+		WHERE vg.view_id=views.id AND g.name IN (SELECT value FROM json_each(?)))`, groupsAsJSON(user.Groups))
+	return q.Where(sq.Or{sq.Eq{"owner": user.Id}, shared})
+}
+
+func groupsAsJSON(groups []string) string {
+	if groups == nil {
+		groups = []string{}
+	}
+	data, _ := json.Marshal(groups)
+	return string(data)
+}
+
+func (r ViewRepo) ListVisible(user u.User, p pag.PaginationParams) ([]vw.View, error) {
+	q := visibleTo(sq.StatementBuilder.Select("id,name,owner,filter,ordering").From("views"), user)
+	q = q.OrderBy("name", "id").Limit(uint64(p.PageSize)).Offset(uint64((p.Page - 1) * int64(p.PageSize)))
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	rows := []Row{}
+	if err := r.Db.Select(&rows, query, args...); err != nil {
+		return nil, fmt.Errorf("listing views: %v: %w", err, e.ErrInternal)
+	}
+	views := []vw.View{}
+	for _, row := range rows {
+		v, err := r.rowToEntity(row)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, *v)
+	}
+	return views, nil
+}
+
+func (r ViewRepo) CountVisible(user u.User) (*int64, error) {
+	q := visibleTo(sq.StatementBuilder.Select("COUNT(*)").From("views"), user)
+	query, args, err := q.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building query: %v: %w", err, e.ErrInternal)
+	}
+	var count int64
+	if err := r.Db.Get(&count, query, args...); err != nil {
+		return nil, fmt.Errorf("counting views: %v: %w", err, e.ErrInternal)
+	}
+	return &count, nil
+}
+
+func NewViewRepo(db adb.Querier) ViewRepo {
+	return ViewRepo{Db: db}
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	sg "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
+	s "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/testing"
+	ur "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func setup(t *testing.T, db *sqlx.DB) ViewRepo {
+	users := ur.NewUserRepo(db)
+	users.Create(u.NewUser("alice@mail.com"))
+	users.Create(u.NewUser("bob@mail.com"))
+	groups := sg.NewGroupRepo(db)
+	for _, name := range []string{"a-group", "b-group"} {
+		_, err := sg.CreateGroup(groups, name)
+		assert.NoError(t, err)
+	}
+	return NewViewRepo(db)
+}
+
+func TestCreateAndFindView(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := setup(t, db)
+	view := vw.NewView(vw.NewViewId(), "cars", "alice@mail.com", vw.WithFilter(`label:"car"`),
+		vw.WithOrder("ingested_at:desc"), vw.WithGroups([]string{"b-group", "a-group"}))
+	assert.NoError(t, repo.Create(view))
+
+	got, err := repo.FindView(view.Id)
+	assert.NoError(t, err)
+	view.Groups = []string{"a-group", "b-group"}
+	assert.Equal(t, view, *got)
+}
+
+func TestFindMissingView(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	_, err := setup(t, db).FindView(vw.NewViewId())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestShareViewWithMissingGroupShouldFail(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	view := vw.NewView(vw.NewViewId(), "cars", "alice@mail.com", vw.WithGroups([]string{"missing"}))
+	assert.ErrorIs(t, setup(t, db).Create(view), e.ErrInternal)
+}
+
+func TestUpdateView(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := setup(t, db)
+	view := vw.NewView(vw.NewViewId(), "cars", "alice@mail.com", vw.WithGroups([]string{"a-group"}))
+	repo.Create(view)
+
+	assert.NoError(t, repo.Update(vw.UpdateModel{Id: view.Id, Name: "trucks", Filter: `label:"truck"`,
+		Order: "collection", Groups: []string{"b-group"}}))
+	got, _ := repo.FindView(view.Id)
+	assert.Equal(t, vw.NewView(view.Id, "trucks", "alice@mail.com", vw.WithFilter(`label:"truck"`),
+		vw.WithOrder("collection"), vw.WithGroups([]string{"b-group"})), *got)
+}
+
+func TestDeleteView(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := setup(t, db)
+	view := vw.NewView(vw.NewViewId(), "cars", "alice@mail.com", vw.WithGroups([]string{"a-group"}))
+	repo.Create(view)
+
+	assert.NoError(t, repo.Delete(view.Id))
+	_, err := repo.FindView(view.Id)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestListVisibleViews(t *testing.T) {
+	db := s.NewInMemory()
+	defer db.Close()
+	repo := setup(t, db)
+	own := vw.NewView(vw.NewViewId(), "a-own", "bob@mail.com")
+	shared := vw.NewView(vw.NewViewId(), "b-shared", "alice@mail.com", vw.WithGroups([]string{"a-group"}))
+	private := vw.NewView(vw.NewViewId(), "c-private", "alice@mail.com", vw.WithGroups([]string{"b-group"}))
+	for _, view := range []vw.View{own, shared, private} {
+		assert.NoError(t, repo.Create(view))
+	}
+	bob := u.NewUser("bob@mail.com", u.WithGroups([]string{"a-group"}))
+
+	views, err := repo.ListVisible(bob, pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, []vw.View{own, shared}, views)
+	count, err := repo.CountVisible(bob)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), *count)
+
+	views, _ = repo.ListVisible(bob, pag.PaginationParams{Page: 2, PageSize: 1})
+	assert.Equal(t, []vw.View{shared}, views)
+}
+
+func TestErrOnClosedDb(t *testing.T) {
+	db := s.NewInMemory()
+	repo := setup(t, db)
+	db.Close()
+	_, err := repo.FindView(vw.NewViewId())
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repo.ListVisible(u.NewUser("bob@mail.com"), pag.PaginationParams{Page: 1, PageSize: 10})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	_, err = repo.CountVisible(u.NewUser("bob@mail.com"))
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
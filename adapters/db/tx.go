@@ -0,0 +1,30 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// RunInTx runs fn in a transaction opened on q, committed when fn succeeds.
+// When q already is a transaction, fn runs in it.
+func RunInTx(q Querier, fn func(Querier) error) error {
+	db, ok := q.(*sqlx.DB)
+	if !ok {
+		return fn(q)
+	}
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %v: %w", err, e.ErrInternal)
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package admin
 
 import (
+	as "github.com/lejeunel/go-image-annotator/adapters/web/admin/assignment"
+	adt "github.com/lejeunel/go-image-annotator/adapters/web/admin/audit"
 	grp "github.com/lejeunel/go-image-annotator/adapters/web/admin/group"
 	pl "github.com/lejeunel/go-image-annotator/adapters/web/admin/policy"
 	rl "github.com/lejeunel/go-image-annotator/adapters/web/admin/role"
@@ -23,5 +25,7 @@ func NewPageBuilder(pb b.PageBuilder) b.PageBuilder {
 	pb.AddSidebarEntry(grp.PageName, icons.Group, rt.AdminGroupsUrl, false)
 	pb.AddSidebarEntry(rl.PageName, icons.Rocket, rt.AdminRolesUrl, false)
 	pb.AddSidebarEntry(pl.PageName, icons.Shield, rt.AdminPoliciesUrl, false)
+	pb.AddSidebarEntry(as.PageName, icons.Flag, rt.AdminAssignmentsUrl, false)
+	pb.AddSidebarEntry(adt.PageName, icons.Notepad, rt.AdminAuditUrl, false)
 	return pb
 }
@@ -0,0 +1,54 @@
+package assignment
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/create"
+)
+
+type CreateBatchPresenter struct {
+	writer        http.ResponseWriter
+	task          string
+	okMessageFunc func(create.Response) string
+	htmx.ErrorPresenter
+}
+
+func NewCreateBatchPresenter(w http.ResponseWriter) CreateBatchPresenter {
+	task := "Assigning images"
+	okMessageFunc := func(r create.Response) string {
+		return fmt.Sprintf("Successfully assigned %v images to %v users",
+			r.Batch.Progress().Total, len(r.Batch.Assignees))
+	}
+	return CreateBatchPresenter{w, task, okMessageFunc, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p CreateBatchPresenter) SuccessCreateBatch(r create.Response) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task, p.okMessageFunc(r))
+}
+
+// parseList splits a comma-separated list of names.
+func parseList(s string) []string {
+	names := []string{}
+	for _, n := range strings.Split(s, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			names = append(names, n)
+		}
+	}
+	return names
+}
+
+func (s *Server) Create(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "bad form data", http.StatusBadRequest)
+		return
+	}
+	s.Assignments.Create.Execute(r.Context(), create.Request{
+		Name:   r.FormValue(nameFieldName),
+		Filter: r.FormValue(filterFieldName),
+		Users:  parseList(r.FormValue(usersFieldName)),
+		Groups: parseList(r.FormValue(groupsFieldName)),
+	}, NewCreateBatchPresenter(w))
+}
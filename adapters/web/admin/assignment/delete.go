@@ -0,0 +1,28 @@
+package assignment
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+)
+
+type DeleteBatchPresenter struct {
+	writer http.ResponseWriter
+	task   string
+	htmx.ErrorPresenter
+}
+
+func NewDeleteBatchPresenter(w http.ResponseWriter) DeleteBatchPresenter {
+	task := "Deleting assignment batch"
+	return DeleteBatchPresenter{w, task, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p DeleteBatchPresenter) SuccessDeleteBatch(string) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task, "Successfully deleted assignment batch")
+}
+
+func (s *Server) Delete(w http.ResponseWriter, r *http.Request) {
+	s.Assignments.Delete.Execute(r.Context(),
+		r.URL.Query().Get(resourceUrlFieldName),
+		NewDeleteBatchPresenter(w))
+}
@@ -0,0 +1,45 @@
+package assignment
+
+import (
+	_ "embed"
+	"net/http"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	bf "github.com/lejeunel/go-image-annotator/adapters/web/builders/form"
+	pg "github.com/lejeunel/go-image-annotator/adapters/web/pagination"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+//go:embed preamble.md
+var preamble string
+
+func (s *Server) List(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.Assignments.List.Execute(r.Context(),
+		pag.PaginationParams{PageSize: s.DefaultPageSize, Page: pg.GetPageFromRequest(r)},
+		NewListPresenter(w, s.PageBuilder, s.RowUrl))
+}
+
+// TableRow renders the confirmation to delete a batch. Aborting reloads the
+// list, so that progress is up to date.
+func (s *Server) TableRow(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get(resourceUrlFieldName)
+	s.RowUrl.SetId(id)
+	switch r.URL.Query().Get("mode") {
+	case b.ModeConfirmDelete.String():
+		b.RenderConfirmDeleteRow(len(listBatchesFields),
+			r.URL.Query().Get(nameFieldName), "assignment batch", s.RowUrl.Url, w)
+	default:
+		w.Header().Set("HX-Refresh", "true")
+	}
+}
+
+func (s *Server) CreateForm(w http.ResponseWriter, r *http.Request) {
+	b := bf.NewHTMXCreateFormBuilder(BatchRowUrl, createBatchTargetDiv)
+	b.AddTitle("Assign images")
+	b.AddTextField(nameFieldName, "Name", bf.WithRequired())
+	b.AddTextField(filterFieldName, "Filters")
+	b.AddTextField(usersFieldName, "Users (comma-separated)")
+	b.AddTextField(groupsFieldName, "Groups (comma-separated)")
+	b.Render(w)
+}
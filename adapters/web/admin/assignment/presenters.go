@@ -0,0 +1,67 @@
+package assignment
+
+import (
+	"io"
+	"net/http"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/list"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+var listBatchesFields = []string{"name", "filter", "assignees", "progress", "created", "actions"}
+
+type ListPresenter struct {
+	b.PaginatedListBuilder
+	b.RowURL
+	Writer io.Writer
+	e.ErrorPresenter
+}
+
+func NewListPresenter(w http.ResponseWriter, p b.PageBuilder, u b.RowURL) ListPresenter {
+	p.SetTitle(PageName)
+	p.SetHTMLTitle(PageName)
+	p.AddMarkdownPreamble(preamble)
+	lb := b.NewPaginatedListBuilder(p, listBatchesFields)
+	lb.AddCreationButton("Assign", CreateBatchFormUrl, createBatchTargetDiv)
+	return ListPresenter{lb, u, w, e.NewErrorPresenter(w)}
+}
+
+func (p ListPresenter) SuccessListBatches(r list.Response) {
+	for _, batch := range r.Batches {
+		p.AddRow(MakeRow(p.RowURL, batch))
+	}
+	p.SetPagination(r.Pagination, rt.AdminAssignmentsUrl)
+	p.Render(p.Writer)
+}
+
+func makeAssignees(batch a.Batch) Node {
+	return Div(Class("flex flex-col gap-1"),
+		Map(batch.Assignees, func(p a.AssigneeProgress) Node {
+			return Div(Class("flex items-center justify-between gap-2"),
+				Span(Class("text-xs"), Text(p.Assignee)),
+				cmp.MakeProgressBar(p.Finished, p.Total))
+		}))
+}
+
+func MakeRow(url b.RowURL, batch a.Batch) tb.Row {
+	url.SetId(batch.Id.String())
+	url.Set(nameFieldName, batch.Name)
+	actions := b.NewActionsPanelBuilder()
+	actions.SetConfirmDelete(url.SetMode(b.ModeConfirmDelete).Url)
+	progress := batch.Progress()
+	row := tb.NewRow()
+	row.AddCell(tb.NewCell(Text(batch.Name)))
+	row.AddCell(tb.NewCell(Code(Text(batch.Filter))))
+	row.AddCell(tb.NewCell(makeAssignees(batch)))
+	row.AddCell(tb.NewCell(cmp.MakeProgressBar(progress.Finished, progress.Total)))
+	row.AddCell(tb.NewCell(Text(cmp.DateTimeToStr(batch.CreatedAt))))
+	row.AddCell(tb.NewCell(actions.Build()))
+	return row
+}
@@ -0,0 +1,19 @@
+package assignment
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+)
+
+func (s *Server) Route(r chi.Router, mws ...func(http.Handler) http.Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(mws...)
+		r.Get(rt.AdminAssignmentsUrl, s.List)
+		r.Get(BatchRowUrl, s.TableRow)
+		r.Delete(BatchRowUrl, s.Delete)
+		r.Get(CreateBatchFormUrl, s.CreateForm)
+		r.Post(BatchRowUrl, s.Create)
+	})
+}
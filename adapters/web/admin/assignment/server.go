@@ -0,0 +1,18 @@
+package assignment
+
+import (
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	as "github.com/lejeunel/go-image-annotator/use-cases/assignment"
+)
+
+type Server struct {
+	b.PageBuilder
+	RowUrl          b.RowURL
+	Assignments     as.Interactors
+	DefaultPageSize int
+}
+
+func New(pb b.PageBuilder, assignments as.Interactors, defaultPageSize int) Server {
+	pb.ActivateSidebarEntry(PageName)
+	return Server{pb, b.NewRowURLWithId(BatchRowUrl, resourceUrlFieldName), assignments, defaultPageSize}
+}
@@ -0,0 +1,13 @@
+package assignment
+
+const (
+	PageName             = "Assignments"
+	nameFieldName        = "name"
+	filterFieldName      = "filter"
+	usersFieldName       = "users"
+	groupsFieldName      = "groups"
+	createBatchTargetDiv = "create-assignment-batch"
+	resourceUrlFieldName = "id"
+	BatchRowUrl          = "/ui/assignment"
+	CreateBatchFormUrl   = "/ui/assignment/new"
+)
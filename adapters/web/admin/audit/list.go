@@ -0,0 +1,36 @@
+package audit
+
+import (
+	_ "embed"
+	"net/http"
+
+	pg "github.com/lejeunel/go-image-annotator/adapters/web/pagination"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+)
+
+//go:embed preamble.md
+var preamble string
+
+func searchFromRequest(r *http.Request) list.Search {
+	q := r.URL.Query()
+	return list.Search{
+		Actor:  q.Get(actorFieldName),
+		Action: q.Get(actionFieldName),
+		From:   q.Get(fromFieldName),
+		To:     q.Get(toFieldName),
+	}
+}
+
+func (s *Server) List(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	search := searchFromRequest(r)
+	s.Audit.List.Execute(r.Context(), list.Request{
+		Search:           search,
+		PaginationParams: pag.PaginationParams{PageSize: s.DefaultPageSize, Page: pg.GetPageFromRequest(r)},
+	}, NewListPresenter(w, s.PageBuilder, search))
+}
+
+func (s *Server) Export(w http.ResponseWriter, r *http.Request) {
+	s.Audit.Export.Execute(r.Context(), searchFromRequest(r), NewExportPresenter(w))
+}
@@ -0,0 +1,112 @@
+package audit
+
+import (
+	"io"
+	"net/http"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	st "github.com/lejeunel/go-image-annotator/adapters/web/styles"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/export"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+var listEntriesFields = []string{"time", "actor", "action", "target", "outcome", "request"}
+
+type ListPresenter struct {
+	b.PaginatedListBuilder
+	Writer io.Writer
+	search list.Search
+	e.ErrorPresenter
+}
+
+func NewListPresenter(w http.ResponseWriter, p b.PageBuilder, search list.Search) ListPresenter {
+	p.SetTitle(PageName)
+	p.SetHTMLTitle(PageName)
+	p.AddMarkdownPreamble(preamble)
+	lb := b.NewPaginatedListBuilder(p, listEntriesFields)
+	lb.SetHeader(makeSearchForm(search))
+	return ListPresenter{lb, w, search, e.NewErrorPresenter(w)}
+}
+
+func (p ListPresenter) SuccessListAuditLog(r list.Response) {
+	for _, entry := range r.Entries {
+		p.AddRow(MakeRow(entry))
+	}
+	url := searchURL(rt.AdminAuditUrl, p.search)
+	p.SetPagination(r.Pagination, url)
+	p.Render(p.Writer)
+}
+
+func searchURL(base string, s list.Search) string {
+	params := []string{}
+	for _, f := range [][2]string{
+		{actorFieldName, s.Actor}, {actionFieldName, s.Action},
+		{fromFieldName, s.From}, {toFieldName, s.To},
+	} {
+		if f[1] != "" {
+			params = append(params, f[0], f[1])
+		}
+	}
+	u := rt.AddQueryParams(base, params...)
+	return u.String()
+}
+
+func makeSearchField(name, label, inputType, value string) Node {
+	return Div(Class("flex flex-col"),
+		Label(For(name), Text(label), Class(st.FormLabel)),
+		Input(Type(inputType), ID(name), Name(name), Value(value), Class(st.FormInput)))
+}
+
+func makeSearchForm(s list.Search) Node {
+	return Form(Method("get"), Action(rt.AdminAuditUrl), Class("flex items-end gap-2"),
+		makeSearchField(actorFieldName, "Actor", "text", s.Actor),
+		makeSearchField(actionFieldName, "Action", "text", s.Action),
+		makeSearchField(fromFieldName, "From", "date", s.From),
+		makeSearchField(toFieldName, "To", "date", s.To),
+		Div(Class("flex gap-2 mb-6"),
+			Button(Type("submit"), Text("Search"), Class(st.PrimaryButton)),
+			A(Href(searchURL(ExportUrl, s)), Text("Download CSV"), Class(st.InactiveButton))),
+	)
+}
+
+func makeOutcome(entry au.Entry) Node {
+	color := "text-success"
+	if entry.Outcome != au.Success {
+		color = "text-danger"
+	}
+	return Span(Class(color), If(entry.Error != "", Title(entry.Error)), Text(entry.Outcome.String()))
+}
+
+func MakeRow(entry au.Entry) tb.Row {
+	row := tb.NewRow()
+	row.AddCell(tb.NewCell(Text(cmp.DateTimeToStr(entry.Time))))
+	row.AddCell(tb.NewCell(Text(entry.Actor)))
+	row.AddCell(tb.NewCell(Code(Text(entry.Action))))
+	row.AddCell(tb.NewCell(Text(entry.Target.Type + " " + entry.Target.Id)))
+	row.AddCell(tb.NewCell(makeOutcome(entry)))
+	row.AddCell(tb.NewCell(Span(Class("text-xs"), Text(entry.RequestId))))
+	return row
+}
+
+type ExportPresenter struct {
+	writer http.ResponseWriter
+	e.ErrorPresenter
+}
+
+func NewExportPresenter(w http.ResponseWriter) ExportPresenter {
+	return ExportPresenter{w, e.NewErrorPresenter(w)}
+}
+
+func (p ExportPresenter) SuccessExportAuditLog(r export.Response) {
+	p.writer.Header().Set("Content-Type", "text/csv")
+	p.writer.Header().Set("Content-Disposition", `attachment; filename="audit-log.csv"`)
+	p.writer.WriteHeader(http.StatusOK)
+	r.WriteCSV(p.writer)
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+)
+
+func (s *Server) Route(r chi.Router, mws ...func(http.Handler) http.Handler) {
+	r.Group(func(r chi.Router) {
+		r.Use(mws...)
+		r.Get(rt.AdminAuditUrl, s.List)
+		r.Get(ExportUrl, s.Export)
+	})
+}
@@ -0,0 +1,17 @@
+package audit
+
+import (
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	au "github.com/lejeunel/go-image-annotator/use-cases/audit"
+)
+
+type Server struct {
+	b.PageBuilder
+	Audit           au.Interactors
+	DefaultPageSize int
+}
+
+func New(pb b.PageBuilder, audit au.Interactors, defaultPageSize int) Server {
+	pb.ActivateSidebarEntry(PageName)
+	return Server{pb, audit, defaultPageSize}
+}
@@ -0,0 +1,10 @@
+package audit
+
+const (
+	PageName        = "Audit log"
+	actorFieldName  = "actor"
+	actionFieldName = "action"
+	fromFieldName   = "from"
+	toFieldName     = "to"
+	ExportUrl       = "/ui/audit/export"
+)
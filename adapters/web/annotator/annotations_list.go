@@ -11,6 +11,8 @@ type AnnotationsListView struct{}
 func (v *AnnotationsListView) makeRegionList(
 	boxes []view.BoundingBox,
 	polygons []view.Polygon,
+	polylines []view.Polyline,
+	poses []view.Keypoints,
 	availableLabels []string,
 ) Node {
 	table := RegionTable{AvailableLabels: availableLabels}
@@ -20,6 +22,12 @@ func (v *AnnotationsListView) makeRegionList(
 	for _, p := range polygons {
 		table.AddPolygon(p)
 	}
+	for _, l := range polylines {
+		table.AddPolyline(l)
+	}
+	for _, k := range poses {
+		table.AddKeypoints(k)
+	}
 	return table.Build("Regions")
 }
 
@@ -28,7 +36,7 @@ func (v *AnnotationsListView) makeImageLabelList(imageLabels []view.ImageLabel)
 	for _, l := range imageLabels {
 		table.Rows = append(
 			table.Rows,
-			ImageLabelRow{Label: l.Label, Id: l.Id, Author: l.Author, Time: l.Time},
+			ImageLabelRow{Label: l.Label, Id: l.Id, Author: l.Author, Time: l.Time, Status: l.Status},
 		)
 	}
 	return table.Build()
@@ -37,15 +45,18 @@ func (v *AnnotationsListView) makeImageLabelList(imageLabels []view.ImageLabel)
 func (v *AnnotationsListView) Build(
 	boxes []view.BoundingBox,
 	polygons []view.Polygon,
+	polylines []view.Polyline,
+	poses []view.Keypoints,
 	imageLabels []view.ImageLabel,
 	availableLabels []string,
 ) Node {
-	regionsList := v.makeRegionList(boxes, polygons, availableLabels)
+	regionsList := v.makeRegionList(boxes, polygons, polylines, poses, availableLabels)
 	imageLabelsTable := v.makeImageLabelList(imageLabels)
 
 	fullTable := Div(
 		Class("min-w-80"),
 		imageLabelsTable,
-		regionsList)
+		regionsList,
+		Div(ID(HistoryPanelId)))
 	return fullTable
 }
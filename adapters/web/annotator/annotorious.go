@@ -23,7 +23,16 @@ type AnnotatorURLs = struct {
 	SubmitPolygon    string
 	UpdateBox        string
 	UpdatePolygon    string
+	FetchPolylines   string
+	SubmitPolyline   string
+	UpdatePolyline   string
+	FetchKeypoints   string
+	FetchSkeleton    string
+	SubmitKeypoints  string
+	UpdateKeypoints  string
 	RemoveAnnotation string
+	RevertAnnotation string
+	AcceptPrediction string
 	AnnotationPanel  string
 }
 
@@ -35,7 +44,16 @@ var annotatorURLs = AnnotatorURLs{
 	SubmitPolygon,
 	UpdateBox,
 	UpdatePolygon,
+	Polylines,
+	SubmitPolyline,
+	UpdatePolyline,
+	Keypoints,
+	Skeleton,
+	SubmitKeypoints,
+	UpdateKeypoints,
 	RemoveAnnotation,
+	RevertAnnotation,
+	AcceptPrediction,
 	AnnotationPanel,
 }
 
@@ -0,0 +1,101 @@
+package annotator
+
+import (
+	"errors"
+	"net/http"
+
+	we "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	st "github.com/lejeunel/go-image-annotator/adapters/web/styles"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/finish"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/next"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+func MakeAssignedImageURL(imageId, collection string) string {
+	u := rt.AddQueryParams(AnnotateImage, "id", imageId, "collection", collection, rt.AssignedArgName, "true")
+	return u.String()
+}
+
+func MakeNextAssignmentURL(skipImageId, skipCollection string) string {
+	u := rt.AddQueryParams(rt.NextAssignmentUrl, "id", skipImageId, "collection", skipCollection)
+	return u.String()
+}
+
+// NextAssignmentPresenter redirects to the next assigned image, or to the
+// assignments of the user once they are all finished.
+type NextAssignmentPresenter struct {
+	writer  http.ResponseWriter
+	request *http.Request
+	we.ErrorPresenter
+}
+
+func NewNextAssignmentPresenter(w http.ResponseWriter, r *http.Request) NextAssignmentPresenter {
+	return NextAssignmentPresenter{w, r, we.NewErrorPresenter(w)}
+}
+
+func (p NextAssignmentPresenter) SuccessNextAssignment(r next.Response) {
+	http.Redirect(p.writer, p.request,
+		MakeAssignedImageURL(r.Assignment.Image.ImageId.String(), r.Assignment.Image.Collection),
+		http.StatusSeeOther)
+}
+
+func (p NextAssignmentPresenter) Error(err error) {
+	if errors.Is(err, e.ErrNotFound) {
+		http.Redirect(p.writer, p.request, rt.MyAssignmentsUrl, http.StatusSeeOther)
+		return
+	}
+	p.ErrorPresenter.Error(err)
+}
+
+func (s *Server) NextAssignment(w http.ResponseWriter, r *http.Request) {
+	s.Next.Execute(r.Context(),
+		next.Request{
+			ImageId:    r.URL.Query().Get("id"),
+			Collection: r.URL.Query().Get("collection"),
+		},
+		NewNextAssignmentPresenter(w, r))
+}
+
+type FinishAssignmentPresenter struct {
+	writer http.ResponseWriter
+	task   string
+	htmx.ErrorPresenter
+}
+
+func NewFinishAssignmentPresenter(w http.ResponseWriter) FinishAssignmentPresenter {
+	task := "Finishing image"
+	return FinishAssignmentPresenter{w, task, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p FinishAssignmentPresenter) SuccessFinishAssignment(r finish.Request) {
+	htmx.NotifySuccessPayloadAndRedirect(p.writer, p.task, "Image marked as done",
+		MakeNextAssignmentURL(r.ImageId, r.Collection))
+}
+
+func (s *Server) FinishAssignment(w http.ResponseWriter, r *http.Request) {
+	s.Finish.Execute(r.Context(),
+		finish.Request{
+			ImageId:    r.URL.Query().Get("id"),
+			Collection: r.URL.Query().Get("collection"),
+		},
+		NewFinishAssignmentPresenter(w))
+}
+
+// MakeAssignmentButtons lets annotators mark the image as done, or skip it
+// for now, both leading to their next assigned image.
+func MakeAssignmentButtons(imageId, collection string) Node {
+	u := rt.AddQueryParams(FinishAssignment, "id", imageId, "collection", collection)
+	return Div(Class("flex gap-2"),
+		Button(
+			Attr("hx-post", u.String()),
+			Attr("hx-swap", "none"),
+			Class(st.SuccessButton),
+			Text("Done, next image"),
+		),
+		A(Href(MakeNextAssignmentURL(imageId, collection)), Class(st.InactiveButton), Text("Skip")),
+	)
+}
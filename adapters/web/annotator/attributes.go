@@ -0,0 +1,117 @@
+package annotator
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	setattr "github.com/lejeunel/go-image-annotator/use-cases/annotate/set-attributes"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+var attributeInputClass = "w-full px-1 py-0.5 border border-outline dark:border-outline-dark rounded-sm text-xs bg-surface-alt dark:bg-surface-dark-alt"
+
+type AttributesPresenter struct {
+	writer http.ResponseWriter
+	htmx.ErrorPresenter
+}
+
+func NewAttributesPresenter(w http.ResponseWriter) AttributesPresenter {
+	return AttributesPresenter{w, htmx.NewErrorPresenter("setting attributes", w)}
+}
+
+func (p AttributesPresenter) SuccessSetAttributes(setattr.Response) {
+	p.writer.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) SetAttributes(w http.ResponseWriter, r *http.Request) {
+	p := NewAttributesPresenter(w)
+	if err := r.ParseForm(); err != nil {
+		p.Error(fmt.Errorf("parsing form: %w", err))
+		return
+	}
+	attributes, err := parseAttributes(r.PostForm)
+	if err != nil {
+		p.Error(err)
+		return
+	}
+	s.SetAttr.Execute(r.Context(),
+		setattr.Request{AnnotationId: r.URL.Query().Get("id"), Attributes: attributes}, p)
+}
+
+// Fields are named after the type and name of their attribute, as in
+// number.speed, so that values are parsed without fetching the label.
+func attributeField(def lbl.Attribute) string {
+	return def.Type.String() + "." + def.Name
+}
+
+func parseAttributes(form url.Values) (an.Attributes, error) {
+	attributes := an.Attributes{}
+	for field, values := range form {
+		typ, name, ok := strings.Cut(field, ".")
+		if !ok || len(values) == 0 {
+			continue
+		}
+		// unchecked boxes send only the hidden value that precedes them
+		value, err := at.Parse(lbl.Attribute{Name: name, Type: lbl.AttributeType(typ)},
+			values[len(values)-1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing attribute %q: %w", name, err)
+		}
+		attributes[name] = value
+	}
+	return attributes, nil
+}
+
+func makeAttributeInput(def lbl.Attribute, value any) Node {
+	field := attributeField(def)
+	switch def.Type {
+	case lbl.BoolAttribute:
+		return Group{
+			Input(Type("hidden"), Name(field), Value("false")),
+			Input(Type("checkbox"), Name(field), Value("true"), If(value == true, Checked())),
+		}
+	case lbl.EnumAttribute:
+		return Select(Name(field), Class(attributeInputClass),
+			Option(Value(""), Text("")),
+			Map(def.Options, func(o string) Node {
+				return Option(Value(o), Text(o), If(value == o, Selected()))
+			}),
+		)
+	case lbl.NumberAttribute:
+		return Input(Type("number"), Step("any"), Name(field), Class(attributeInputClass),
+			If(value != nil, Value(fmt.Sprint(value))))
+	default:
+		return Input(Type("text"), Name(field), Class(attributeInputClass),
+			If(value != nil, Value(fmt.Sprint(value))))
+	}
+}
+
+// MakeAttributesForm edits the attributes of a region, saving them as soon
+// as one changes. Regions whose label defines no attribute have none.
+func MakeAttributesForm(annotationId string, schema []lbl.Attribute, values an.Attributes) Node {
+	if len(schema) == 0 {
+		return nil
+	}
+	u := rt.AddQueryParams(SetAttributes, "id", annotationId)
+	return Form(
+		Attr("hx-put", u.String()),
+		Attr("hx-trigger", "change"),
+		Attr("hx-swap", "none"),
+		Attr("hx-on::after-request", "Annotator.refreshList()"),
+		Class("grid grid-cols-2 gap-x-2 gap-y-1 items-center px-2 pb-2 text-xs"),
+		Map(schema, func(def lbl.Attribute) Node {
+			return Group{
+				Label(Class("text-gray-500"), Text(def.Name), If(def.Required, Text(" *"))),
+				makeAttributeInput(def, values[def.Name]),
+			}
+		}),
+	)
+}
@@ -0,0 +1,121 @@
+package annotator
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	ap "github.com/lejeunel/go-image-annotator/adapters/web/annotator/presenters"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	ic "github.com/lejeunel/go-image-annotator/adapters/web/icons"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/history"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+var HistoryPanelId = "annotation-history"
+
+type HistoryPresenter struct {
+	writer http.ResponseWriter
+	htmx.ErrorPresenter
+}
+
+func NewHistoryPresenter(w http.ResponseWriter) HistoryPresenter {
+	return HistoryPresenter{w, htmx.NewErrorPresenter("Fetching history of annotation", w)}
+}
+
+func (p HistoryPresenter) SuccessListRevisions(r history.Response) {
+	MakeHistoryPanel(r.Revisions).Render(p.writer)
+}
+
+func (s *Server) AnnotationHistory(w http.ResponseWriter, r *http.Request) {
+	s.History.Execute(r.Context(), history.Request{AnnotationId: r.URL.Query().Get("id")},
+		NewHistoryPresenter(w))
+}
+
+func (s *Server) RevertAnnotation(w http.ResponseWriter, r *http.Request) {
+	p := ap.NewAnnotoriousPresenter(w)
+	s.Revert.Execute(r.Context(), revert.Request{RevisionId: r.URL.Query().Get("id")}, &p)
+}
+
+func MakeHistoryButton(annotationId string) Node {
+	u := rt.AddQueryParams(AnnotationHistory, "id", annotationId)
+	return cmp.MakeIconizedButton(ic.History, "history",
+		Attr("hx-get", u.String()),
+		Attr("hx-target", "#"+HistoryPanelId),
+	)
+}
+
+func describeShape(s *a.Shape) string {
+	var desc string
+	switch {
+	case s == nil:
+		return "none"
+	case s.Box != nil:
+		desc = fmt.Sprintf("%v (%.0f, %.0f, %.0fx%.0f)", s.Label.Name, s.Box.Xc, s.Box.Yc, s.Box.Width, s.Box.Height)
+	case s.Points != nil:
+		desc = fmt.Sprintf("%v (%v points)", s.Label.Name, len(s.Points.Coordinates))
+	case s.Keypoints != nil:
+		desc = fmt.Sprintf("%v (%v keypoints)", s.Label.Name, len(s.Keypoints))
+	case s.Mask != nil:
+		desc = fmt.Sprintf("%v (%v pixels)", s.Label.Name, s.Mask.Area())
+	default:
+		desc = s.Label.Name
+	}
+	if s.Source.IsPrediction() {
+		desc += fmt.Sprintf(" predicted by %v", s.Source.Model)
+	}
+	return desc
+}
+
+func describeRevision(r a.Revision) string {
+	switch r.Action {
+	case a.Created:
+		return describeShape(r.After)
+	case a.Deleted:
+		return describeShape(r.Before)
+	default:
+		return fmt.Sprintf("%v → %v", describeShape(r.Before), describeShape(r.After))
+	}
+}
+
+// MakeHistoryPanel lists the revisions of an annotation, newest first.
+// Every version but the current one can be reverted to.
+func MakeHistoryPanel(revisions []a.Revision) Node {
+	rows := []RegionRow{}
+	for n, r := range slices.Backward(revisions) {
+		author, time := "", ""
+		if r.Author != nil {
+			author = string(*r.Author)
+		}
+		if r.Time != nil {
+			time = cmp.DateTimeToStr(*r.Time)
+		}
+		var revertButton Node
+		if r.IsRestorable() && n < len(revisions)-1 {
+			revertButton = cmp.MakeIconizedButton(ic.History, "revert to this version",
+				Attr("onclick", fmt.Sprintf("Annotator.revert('%v')", r.Id)))
+		}
+		rows = append(rows, RegionRow{Values: []Node{
+			Div(Class("flex flex-col"),
+				Div(Class(authorInfo), Text(author)),
+				Div(Class(authorInfo), Text(time)),
+			),
+			Div(Class("font-medium"), Text(r.Action.String())),
+			Text(describeRevision(r)),
+			Div(Class("flex justify-end items-center pr-1"), revertButton),
+		}})
+	}
+	return Div(Class("pt-2"),
+		Div(
+			Class("w-full rounded-radius border border-outline dark:border-outline-dark"),
+			Table(Class("w-full text-left text-sm text-on-surface dark:text-on-surface-dark"),
+				RegionTableBody("History", rows),
+			),
+		),
+	)
+}
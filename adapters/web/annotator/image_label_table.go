@@ -15,6 +15,7 @@ type ImageLabelRow struct {
 	Id     string
 	Author string
 	Time   string
+	Status string
 }
 
 func (r ImageLabelRow) Render() Node {
@@ -23,6 +24,7 @@ func (r ImageLabelRow) Render() Node {
 			Div(Class("flex flex-col"),
 				Div(Class(authorInfo), Text(r.Author)),
 				Div(Class(authorInfo), Text(r.Time)),
+				Div(Class(authorInfo), Text(r.Status)),
 			),
 		),
 		Td(Class("ps-2 py-3 min-w-0 break-words"),
@@ -52,7 +54,7 @@ type ImageLabelTable struct {
 }
 
 func (t *ImageLabelTable) AddImageLabel(l view.ImageLabel) {
-	t.Rows = append(t.Rows, ImageLabelRow{Label: l.Label, Id: l.Id, Author: l.Author, Time: l.Time})
+	t.Rows = append(t.Rows, ImageLabelRow{Label: l.Label, Id: l.Id, Author: l.Author, Time: l.Time, Status: l.Status})
 }
 
 func (t *ImageLabelTable) Build() Node {
@@ -1,11 +1,11 @@
 package annotator
 
 import (
-	"encoding/base64"
 	"fmt"
 	"io"
 
 	"github.com/lejeunel/go-image-annotator/modules/annotator/view"
+	rt "github.com/lejeunel/go-image-annotator/routes"
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
 )
@@ -14,16 +14,14 @@ type ImageView struct {
 	result Node
 }
 
+// Build points to the raw-data endpoint rather than inlining the image, so
+// that browsers can cache it while scrolling.
 func (p *ImageView) Build(image view.Image) Node {
-	if image.Reader == nil {
-		return Text("presenting image: got no reader")
+	if closer, ok := image.Reader.(io.Closer); ok {
+		closer.Close()
 	}
-	bytes, err := io.ReadAll(image.Reader)
-	if err != nil {
-		return Text(err.Error())
+	if image.Id == "" {
+		return Text("presenting image: got no image")
 	}
-
-	b64Image := base64.StdEncoding.EncodeToString(bytes)
-	return Img(ID("image"), Src(fmt.Sprintf("data:%v;base64,%s",
-		image.MIMEType, b64Image)))
+	return Img(ID("image"), Src(fmt.Sprintf("%v/raw/%v", rt.APIRootUrl, image.Id)))
 }
@@ -18,7 +18,7 @@ const (
 	ImageLabelModal
 )
 
-func makeLabelModal(labels []string) string {
+func makeLabelModal(labels []string, selected *string) string {
 	tModal := template.New("")
 	template.Must(tModal.ParseFS(templatesFiles, "templates/label_modal_search_combobox.html"))
 	template.Must(tModal.ParseFS(templatesFiles, "templates/label_modal.html"))
@@ -27,7 +27,7 @@ func makeLabelModal(labels []string) string {
 	if err := tModal.ExecuteTemplate(
 		&buf,
 		"label_modal",
-		NewLabelModal{Labels: labels},
+		NewLabelModal{Labels: labels, Selected: selected},
 	); err != nil {
 		panic(err)
 	}
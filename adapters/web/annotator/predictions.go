@@ -0,0 +1,64 @@
+package annotator
+
+import (
+	"net/http"
+
+	ap "github.com/lejeunel/go-image-annotator/adapters/web/annotator/presenters"
+	"github.com/lejeunel/go-image-annotator/modules/annotator/view"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+var defaultConfidenceThreshold = "0.5"
+
+func hasPredictions(boxes []view.BoundingBox, polygons []view.Polygon, polylines []view.Polyline,
+	poses []view.Keypoints) bool {
+	for _, b := range boxes {
+		if b.IsPrediction() {
+			return true
+		}
+	}
+	for _, p := range polygons {
+		if p.IsPrediction() {
+			return true
+		}
+	}
+	for _, l := range polylines {
+		if l.IsPrediction() {
+			return true
+		}
+	}
+	for _, k := range poses {
+		if k.IsPrediction() {
+			return true
+		}
+	}
+	return false
+}
+
+// MakePredictionFilter lets annotators hide predictions, or only those whose
+// confidence is below a threshold.
+func MakePredictionFilter() Node {
+	return Div(
+		Attr("x-data", "{ show: true, threshold: "+defaultConfidenceThreshold+" }"),
+		Attr("x-effect", "Annotator.filterPredictions(show, threshold)"),
+		Class("flex flex-col gap-1 rounded-radius border border-outline dark:border-outline-dark p-2 text-sm"),
+		Div(Class("font-bold"), Text("Predictions")),
+		Label(Class("flex items-center gap-2"),
+			Input(Type("checkbox"), Attr("x-model", "show")),
+			Span(Text("show")),
+		),
+		Label(Class("flex items-center gap-2"),
+			Span(Text("min. confidence")),
+			Input(Type("range"), Min("0"), Max("1"), Step("0.05"),
+				Attr("x-model.number", "threshold"), Attr("x-bind:disabled", "!show")),
+			Span(Attr("x-text", "threshold.toFixed(2)")),
+		),
+	)
+}
+
+func (s *Server) AcceptPrediction(w http.ResponseWriter, r *http.Request) {
+	p := ap.NewAnnotoriousPresenter(w)
+	s.Accept.Execute(r.Context(), accpred.Request{Id: r.URL.Query().Get("id")}, &p)
+}
@@ -1,27 +1,34 @@
 package presenters
 
 import (
-	"encoding/json"
 	"net/http"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	v "github.com/lejeunel/go-image-annotator/modules/annotator/view"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
 	addbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-bbox"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
 	addpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polygon"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
 	addlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/assign-label"
 	updbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-bbox"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
 	updpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polygon"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
 	del "github.com/lejeunel/go-image-annotator/use-cases/annotate/remove"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
 	updlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/update-label"
 )
 
 type AnnotoriousPresenter struct {
 	Colorizer
-	Writer   http.ResponseWriter
-	Err      error
-	image    *v.Image
-	boxes    []v.BoundingBox
-	polygons []v.Polygon
+	Writer    http.ResponseWriter
+	Err       error
+	image     *v.Image
+	boxes     []v.BoundingBox
+	polygons  []v.Polygon
+	polylines []v.Polyline
+	poses     []v.Keypoints
 }
 
 func NewAnnotoriousPresenter(w http.ResponseWriter) AnnotoriousPresenter {
@@ -35,14 +42,23 @@ func (p *AnnotoriousPresenter) Error(err error) {
 func (p *AnnotoriousPresenter) SuccessReadImage(r im.Image) {
 	p.boxes = MakeBoundingBoxes(r.BoundingBoxes, p.Colorizer)
 	p.polygons = MakePolygons(r.Polygons, p.Colorizer)
+	p.polylines = MakePolylines(r.Polylines, p.Colorizer)
+	p.poses = MakePoses(r.Keypoints, p.Colorizer)
 }
-func (p AnnotoriousPresenter) SuccessAddLabel(r addlbl.Response)       {}
-func (p AnnotoriousPresenter) SuccessAddBox(r addbox.Response)         {}
-func (p AnnotoriousPresenter) SuccessAddPolygon(r addpoly.Response)    {}
-func (p AnnotoriousPresenter) SuccessUpdatePolygon(r updpoly.Response) {}
-func (p AnnotoriousPresenter) SuccessUpdateBox(r updbox.Response)      {}
-func (p AnnotoriousPresenter) SuccessUpdateLabel(r updlbl.Response)    {}
-func (p AnnotoriousPresenter) SuccessDeleteAnnotation(r del.Response)  {}
+func (p AnnotoriousPresenter) SuccessAddLabel(r addlbl.Response)        {}
+func (p AnnotoriousPresenter) SuccessAddBox(r addbox.Response)          {}
+func (p AnnotoriousPresenter) SuccessAddPolygon(r addpoly.Response)     {}
+func (p AnnotoriousPresenter) SuccessUpdatePolygon(r updpoly.Response)  {}
+func (p AnnotoriousPresenter) SuccessAddPolyline(r addline.Response)    {}
+func (p AnnotoriousPresenter) SuccessUpdatePolyline(r updline.Response) {}
+func (p AnnotoriousPresenter) SuccessUpdateBox(r updbox.Response)       {}
+func (p AnnotoriousPresenter) SuccessAddKeypoints(r addkp.Response)     {}
+func (p AnnotoriousPresenter) SuccessUpdateKeypoints(r updkp.Response)  {}
+func (p AnnotoriousPresenter) SuccessUpdateLabel(r updlbl.Response)     {}
+func (p AnnotoriousPresenter) SuccessDeleteAnnotation(r del.Response)   {}
+func (p AnnotoriousPresenter) SuccessRevert(r revert.Response)          {}
+
+func (p AnnotoriousPresenter) SuccessAcceptPrediction(r accpred.Response) {}
 
 func (p *AnnotoriousPresenter) RenderRegionAnnotationsAsJSON(w http.ResponseWriter) {
 	boxes := ConvertBoxesToAnnotorious(p.boxes)
@@ -55,16 +71,13 @@ func (p *AnnotoriousPresenter) RenderRegionAnnotationsAsJSON(w http.ResponseWrit
 		mergedRegions = append(mergedRegions, p)
 	}
 
-	data, err := json.Marshal(mergedRegions)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, err = w.Write(data)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
+	writeJSON(w, mergedRegions)
+}
+
+func (p *AnnotoriousPresenter) RenderPolylinesAsJSON(w http.ResponseWriter) {
+	writeJSON(w, ConvertPolylines(p.polylines))
+}
+
+func (p *AnnotoriousPresenter) RenderKeypointsAsJSON(w http.ResponseWriter) {
+	writeJSON(w, ConvertKeypoints(p.poses))
 }
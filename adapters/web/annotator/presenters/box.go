@@ -81,7 +81,7 @@ func ConvertBoxesToAnnotorious(boxes []v.BoundingBox) []AnnotoriousBoxModel {
 		result = append(result,
 			AnnotoriousBoxModel{
 				AnnotationId: b.Id,
-				Properties:   Properties{Color: b.Color},
+				Properties:   NewProperties(b.Color, b.Source),
 				Bodies:       []AnnotoriousBody{{Purpose: "label", Value: b.Label}},
 				Target: BoxTarget{BoxSelector{
 					Type: "RECTANGLE",
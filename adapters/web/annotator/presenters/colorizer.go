@@ -1,5 +1,9 @@
 package presenters
 
+import (
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+)
+
 type Colorizer interface {
 	Colorize(string) string
 }
@@ -22,3 +26,12 @@ func (c CyclicColorizer) Colorize(key string) string {
 	c.ColorMap[key] = newColor
 	return newColor
 }
+
+// colorOf gives the color of the label of a region, or one picked by c for
+// the region when the label has none.
+func colorOf(l lbl.Label, region string, c Colorizer) string {
+	if l.Color != "" {
+		return l.Color
+	}
+	return c.Colorize(region)
+}
@@ -1,5 +1,9 @@
 package presenters
 
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
 type BaseAnnotoriousRequest struct {
 	ImageId    string `json:"image_id"`
 	Collection string `json:"collection"`
@@ -14,8 +18,18 @@ type Bounds struct {
 }
 
 type Properties struct {
-	Color string `json:"color"`
-	Label string `json:"label"`
+	Color      string   `json:"color"`
+	Label      string   `json:"label"`
+	Model      string   `json:"model,omitempty"`
+	Confidence *float32 `json:"confidence,omitempty"`
+}
+
+func NewProperties(color string, s a.Source) Properties {
+	p := Properties{Color: color, Model: s.Model}
+	if s.IsPrediction() {
+		p.Confidence = &s.Confidence
+	}
+	return p
 }
 
 type AnnotoriousBody struct {
@@ -0,0 +1,123 @@
+package presenters
+
+import (
+	"encoding/json"
+	"net/http"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	v "github.com/lejeunel/go-image-annotator/modules/annotator/view"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
+)
+
+type KeypointModel struct {
+	Name       string  `json:"name"`
+	X          float32 `json:"x"`
+	Y          float32 `json:"y"`
+	Visibility int     `json:"v"`
+}
+
+// KeypointsModel is drawn by the annotator itself, as Annotorious has no
+// shape for poses.
+type KeypointsModel struct {
+	AnnotationId string          `json:"id"`
+	Label        string          `json:"label"`
+	Properties   Properties      `json:"properties"`
+	Points       []KeypointModel `json:"points"`
+	Edges        [][2]string     `json:"edges"`
+}
+
+type KeypointsRequest struct {
+	BaseAnnotoriousRequest
+	Points []KeypointModel `json:"points"`
+}
+
+type SkeletonModel struct {
+	Points []string    `json:"points"`
+	Edges  [][2]string `json:"edges"`
+}
+
+func toKeypoints(points []KeypointModel) []a.Keypoint {
+	res := make([]a.Keypoint, len(points))
+	for n, p := range points {
+		res[n] = a.Keypoint{X: p.X, Y: p.Y, Visibility: a.Visibility(p.Visibility)}
+	}
+	return res
+}
+
+func ToAddKeypointsRequest(r KeypointsRequest) addkp.Request {
+	return addkp.Request{
+		ImageId: r.ImageId, Collection: r.Collection,
+		Label:  r.Label,
+		Points: toKeypoints(r.Points),
+	}
+}
+
+func ToUpdateKeypointsRequest(r KeypointsModel) updkp.Request {
+	return updkp.Request{
+		AnnotationId: r.AnnotationId,
+		Label:        r.Label,
+		Points:       toKeypoints(r.Points),
+	}
+}
+
+func ConvertKeypoints(poses []v.Keypoints) []KeypointsModel {
+	result := []KeypointsModel{}
+	for _, k := range poses {
+		points := make([]KeypointModel, len(k.Points))
+		for n, p := range k.Points {
+			points[n] = KeypointModel{X: p.X, Y: p.Y, Visibility: int(p.Visibility)}
+			if n < len(k.Names) {
+				points[n].Name = k.Names[n]
+			}
+		}
+		edges := k.Edges
+		if edges == nil {
+			edges = [][2]string{}
+		}
+		result = append(result, KeypointsModel{
+			AnnotationId: k.Id,
+			Label:        k.Label,
+			Properties:   NewProperties(k.Color, k.Source),
+			Points:       points,
+			Edges:        edges,
+		})
+	}
+	return result
+}
+
+// SkeletonPresenter tells the annotator which points to place, and in which
+// order, once a label is picked.
+type SkeletonPresenter struct {
+	Writer http.ResponseWriter
+}
+
+func (p SkeletonPresenter) SuccessFindLabel(l lbl.Label) {
+	if l.Skeleton == nil {
+		http.Error(p.Writer, "label "+l.Name+" has no skeleton", http.StatusBadRequest)
+		return
+	}
+	edges := l.Skeleton.Edges
+	if edges == nil {
+		edges = [][2]string{}
+	}
+	writeJSON(p.Writer, SkeletonModel{Points: l.Skeleton.Points, Edges: edges})
+}
+
+func (p SkeletonPresenter) Error(err error) {
+	http.Error(p.Writer, err.Error(), http.StatusBadRequest)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
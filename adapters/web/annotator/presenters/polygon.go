@@ -54,7 +54,7 @@ func ConvertPolygonsToAnnotorious(polygons []v.Polygon) []AnnotoriousPolygonMode
 	for _, p := range polygons {
 		result = append(result, AnnotoriousPolygonModel{
 			AnnotationId: p.Id,
-			Properties:   Properties{Color: p.Color},
+			Properties:   NewProperties(p.Color, p.Source),
 			Bodies:       []AnnotoriousBody{{Purpose: "label", Value: p.Label}},
 			Target: PolygonTarget{
 				PolygonSelector{
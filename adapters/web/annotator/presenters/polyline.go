@@ -0,0 +1,51 @@
+package presenters
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	v "github.com/lejeunel/go-image-annotator/modules/annotator/view"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
+)
+
+// PolylineModel is drawn by the annotator itself, as Annotorious only knows
+// closed shapes.
+type PolylineModel struct {
+	AnnotationId string       `json:"id"`
+	Label        string       `json:"label"`
+	Properties   Properties   `json:"properties"`
+	Points       [][2]float32 `json:"points"`
+}
+
+type PolylineRequest struct {
+	BaseAnnotoriousRequest
+	Points [][2]float32 `json:"points"`
+}
+
+func ToAddPolylineRequest(r PolylineRequest) addline.Request {
+	return addline.Request{
+		ImageId: r.ImageId, Collection: r.Collection,
+		Label:  r.Label,
+		Points: a.Points{Coordinates: r.Points},
+	}
+}
+
+func ToUpdatePolylineRequest(r PolylineModel) updline.Request {
+	return updline.Request{
+		AnnotationId: r.AnnotationId,
+		Label:        r.Label,
+		Points:       a.Points{Coordinates: r.Points},
+	}
+}
+
+func ConvertPolylines(polylines []v.Polyline) []PolylineModel {
+	result := []PolylineModel{}
+	for _, l := range polylines {
+		result = append(result, PolylineModel{
+			AnnotationId: l.Id,
+			Label:        l.Label,
+			Properties:   NewProperties(l.Color, l.Source),
+			Points:       l.Points.Coordinates,
+		})
+	}
+	return result
+}
@@ -31,12 +31,15 @@ func (p AnnotationPagePresenter) SuccessReadImage(im im.Image) {
 	p.View.SetImage(v.NewImage(im.Id, im.Reader, im.Collection.Name, im.Specs.MIMEType))
 	p.View.SetAnnotations(MakeBoundingBoxes(im.BoundingBoxes, p.Colorizer),
 		MakePolygons(im.Polygons, p.Colorizer),
+		MakePolylines(im.Polylines, p.Colorizer),
+		MakePoses(im.Keypoints, p.Colorizer),
 		MakeImageLabels(im.Labels))
 	p.View.SetMetaData(im.Meta)
 }
 
 func (p AnnotationPagePresenter) SuccessFetchLabels(r fetchlbl.Response) {
 	p.View.SetAvailableLabels(r.Labels)
+	p.View.SetDefaultLabel(r.Default)
 }
 
 func (p AnnotationPagePresenter) Error(err error) {
@@ -3,7 +3,9 @@ package presenters
 import (
 	"testing"
 
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 )
 
 func TestScrollerButtonsWithNoPrevImage(t *testing.T) {
@@ -25,3 +27,19 @@ func TestScrollerButtonsNextIdAndCollection(t *testing.T) {
 		t.Fatalf("expected to have next id %v, got %v", id, buttons.Next.ImageId)
 	}
 }
+
+func TestBoxTakesColorOfItsLabel(t *testing.T) {
+	c := NewCyclicColorizer(Palette)
+	colored := lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithColor("#123456"))
+	plain := lbl.NewLabel(lbl.NewLabelId(), "bus")
+	boxes := MakeBoundingBoxes([]an.BoundingBox{
+		an.NewBoundingBox(an.NewAnnotationId(), 1, 1, 1, 1, colored),
+		an.NewBoundingBox(an.NewAnnotationId(), 1, 1, 1, 1, plain),
+	}, c)
+	if boxes[0].Color != "#123456" {
+		t.Fatalf("expected color of label, got %v", boxes[0].Color)
+	}
+	if boxes[1].Color != Palette[0] {
+		t.Fatalf("expected first color of palette, got %v", boxes[1].Color)
+	}
+}
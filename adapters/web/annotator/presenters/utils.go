@@ -9,14 +9,18 @@ import (
 
 func MakeBoundingBox(b a.BoundingBox, c Colorizer) v.BoundingBox {
 	res := v.BoundingBox{
-		Id:     b.Id.String(),
-		Label:  b.Label.Name,
-		Color:  c.Colorize(b.Id.String()),
-		Xc:     b.Xc,
-		Yc:     b.Yc,
-		Width:  b.Width,
-		Height: b.Height,
-		Angle:  b.Angle,
+		Id:         b.Id.String(),
+		Label:      b.Label.Name,
+		Color:      colorOf(b.Label, b.Id.String(), c),
+		Xc:         b.Xc,
+		Yc:         b.Yc,
+		Width:      b.Width,
+		Height:     b.Height,
+		Angle:      b.Angle,
+		Status:     b.Review.CurrentStatus().String(),
+		Source:     b.Source,
+		Attributes: b.Attributes,
+		Schema:     b.Label.Attributes,
 	}
 	if b.Author != nil {
 		res.Author = *b.Author
@@ -33,10 +37,14 @@ func MakeBoundingBox(b a.BoundingBox, c Colorizer) v.BoundingBox {
 
 func MakePolygon(p a.Polygon, c Colorizer) v.Polygon {
 	res := v.Polygon{
-		Id:     p.Id.String(),
-		Label:  p.Label.Name,
-		Color:  c.Colorize(p.Id.String()),
-		Points: p.Points,
+		Id:         p.Id.String(),
+		Label:      p.Label.Name,
+		Color:      colorOf(p.Label, p.Id.String(), c),
+		Points:     p.Points,
+		Status:     p.Review.CurrentStatus().String(),
+		Source:     p.Source,
+		Attributes: p.Attributes,
+		Schema:     p.Label.Attributes,
 	}
 	if p.Author != nil {
 		res.Author = *p.Author
@@ -51,12 +59,65 @@ func MakePolygon(p a.Polygon, c Colorizer) v.Polygon {
 	return res
 }
 
+func MakePolyline(l a.Polyline, c Colorizer) v.Polyline {
+	res := v.Polyline{
+		Id:         l.Id.String(),
+		Label:      l.Label.Name,
+		Color:      colorOf(l.Label, l.Id.String(), c),
+		Points:     l.Points,
+		Status:     l.Review.CurrentStatus().String(),
+		Source:     l.Source,
+		Attributes: l.Attributes,
+		Schema:     l.Label.Attributes,
+	}
+	if l.Author != nil {
+		res.Author = *l.Author
+	} else {
+		res.Author = "anonymous"
+	}
+
+	if l.Time != nil {
+		t := *l.Time
+		res.Time = t.Format(time.DateTime)
+	}
+	return res
+}
+
+func MakeKeypoints(k a.Keypoints, c Colorizer) v.Keypoints {
+	res := v.Keypoints{
+		Id:         k.Id.String(),
+		Label:      k.Label.Name,
+		Color:      colorOf(k.Label, k.Id.String(), c),
+		Points:     k.Points,
+		Status:     k.Review.CurrentStatus().String(),
+		Source:     k.Source,
+		Attributes: k.Attributes,
+		Schema:     k.Label.Attributes,
+	}
+	if k.Label.Skeleton != nil {
+		res.Names = k.Label.Skeleton.Points
+		res.Edges = k.Label.Skeleton.Edges
+	}
+	if k.Author != nil {
+		res.Author = *k.Author
+	} else {
+		res.Author = "anonymous"
+	}
+
+	if k.Time != nil {
+		t := *k.Time
+		res.Time = t.Format(time.DateTime)
+	}
+	return res
+}
+
 func MakeImageLabels(labels []a.ImageLabel) []v.ImageLabel {
 	result := []v.ImageLabel{}
 	for _, l := range labels {
 		row := v.ImageLabel{
-			Id:    l.Id.String(),
-			Label: l.Label.Name,
+			Id:     l.Id.String(),
+			Label:  l.Label.Name,
+			Status: l.Review.CurrentStatus().String(),
 		}
 		if l.Author != nil {
 			row.Author = *l.Author
@@ -87,3 +148,19 @@ func MakePolygons(polygons []a.Polygon, c Colorizer) []v.Polygon {
 	}
 	return result
 }
+
+func MakePolylines(polylines []a.Polyline, c Colorizer) []v.Polyline {
+	result := []v.Polyline{}
+	for _, l := range polylines {
+		result = append(result, MakePolyline(l, c))
+	}
+	return result
+}
+
+func MakePoses(poses []a.Keypoints, c Colorizer) []v.Keypoints {
+	result := []v.Keypoints{}
+	for _, k := range poses {
+		result = append(result, MakeKeypoints(k, c))
+	}
+	return result
+}
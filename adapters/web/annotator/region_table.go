@@ -5,6 +5,8 @@ import (
 
 	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
 	ic "github.com/lejeunel/go-image-annotator/adapters/web/icons"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	"github.com/lejeunel/go-image-annotator/modules/annotator/view"
 	. "maragu.dev/gomponents"
 	. "maragu.dev/gomponents/html"
@@ -22,6 +24,8 @@ type RegionKind int
 const (
 	RegionBox RegionKind = iota
 	RegionPolygon
+	RegionPolyline
+	RegionKeypoints
 )
 
 var authorInfo = "text-xs italic text-gray-500 dark:gray-500 ml-1"
@@ -31,27 +35,55 @@ type RegionTable struct {
 	AvailableLabels []string
 }
 
-func (t *RegionTable) addRow(author, time, id, label, color string, regionKind RegionKind) {
+// makePredictionBadge tells which model predicted a region, and with which
+// confidence.
+func makePredictionBadge(s an.Source) Node {
+	return Div(Class("text-xs rounded-radius w-fit px-1 bg-surface-alt dark:bg-surface-dark-alt"),
+		Text(fmt.Sprintf("%v %.2f", s.Model, s.Confidence)))
+}
+
+// predictionAttrs lets the annotator hide predictions below the confidence
+// threshold.
+func predictionAttrs(s an.Source) []Node {
+	if !s.IsPrediction() {
+		return nil
+	}
+	return []Node{Data("confidence", fmt.Sprint(s.Confidence))}
+}
+
+func (t *RegionTable) addRow(author, time, status, id, label, color string, source an.Source,
+	schema []lbl.Attribute, attributes an.Attributes, regionKind RegionKind) {
 	var regionIcon string
 	switch regionKind {
 	case RegionBox:
 		regionIcon = ic.MakeColoredRectangleIcon(color)
 	case RegionPolygon:
 		regionIcon = ic.MakeColoredHexagonIcon(color)
+	case RegionPolyline:
+		regionIcon = ic.MakeColoredPolylineIcon(color)
+	case RegionKeypoints:
+		regionIcon = ic.MakeColoredKeypointsIcon(color)
 	}
 
 	t.Rows = append(t.Rows,
-		RegionRow{Values: []Node{
+		RegionRow{Attrs: predictionAttrs(source), Details: MakeAttributesForm(id, schema, attributes), Values: []Node{
 			Div(Class("flex flex-col"),
 				Div(Class(authorInfo), Text(author)),
 				Div(Class(authorInfo), Text(time)),
+				Div(Class(authorInfo), Text(status)),
 			),
 			Div(Class("ps-1 py-3"),
 				Raw(regionIcon),
 			),
-			Text(label),
+			Div(Class("flex flex-col gap-1"),
+				Text(label),
+				If(source.IsPrediction(), makePredictionBadge(source)),
+			),
 			Div(
 				Class("flex  justify-end items-center pr-1 gap-1"),
+				If(source.IsPrediction(), cmp.MakeIconizedButton(ic.Check, "accept prediction",
+					Attr("onclick", fmt.Sprintf("Annotator.acceptPrediction('%v')", id)))),
+				MakeHistoryButton(id),
 				cmp.MakeIconizedButton(ic.Edit, "edit",
 					Attr(fmt.Sprintf(
 						`onclick="
@@ -69,11 +101,23 @@ func (t *RegionTable) addRow(author, time, id, label, color string, regionKind R
 }
 
 func (t *RegionTable) AddPolygon(p view.Polygon) {
-	t.addRow(p.Author, p.Time, p.Id, p.Label, p.Color, RegionPolygon)
+	t.addRow(p.Author, p.Time, p.Status, p.Id, p.Label, p.Color, p.Source, p.Schema, p.Attributes,
+		RegionPolygon)
+}
+
+func (t *RegionTable) AddPolyline(l view.Polyline) {
+	t.addRow(l.Author, l.Time, l.Status, l.Id, l.Label, l.Color, l.Source, l.Schema, l.Attributes,
+		RegionPolyline)
+}
+
+func (t *RegionTable) AddKeypoints(k view.Keypoints) {
+	t.addRow(k.Author, k.Time, k.Status, k.Id, k.Label, k.Color, k.Source, k.Schema, k.Attributes,
+		RegionKeypoints)
 }
 
 func (t *RegionTable) AddBox(b view.BoundingBox) {
-	t.addRow(b.Author, b.Time, b.Id, b.Label, b.Color, RegionBox)
+	t.addRow(b.Author, b.Time, b.Status, b.Id, b.Label, b.Color, b.Source, b.Schema, b.Attributes,
+		RegionBox)
 }
 
 func (t *RegionTable) Build(title string) Node {
@@ -89,13 +133,21 @@ func (t *RegionTable) Build(title string) Node {
 
 type RegionRow struct {
 	Values []Node
+	Attrs  []Node
+	// Details spans a second row below the values, if any
+	Details Node
 }
 
 func (r RegionRow) Render() Node {
-	return Tr(
+	row := Tr(
+		Group(r.Attrs),
 		Map(r.Values, func(node Node) Node {
 			return Td(node)
 		}))
+	if r.Details == nil {
+		return row
+	}
+	return Group{row, Tr(Group(r.Attrs), Td(ColSpan(fmt.Sprint(len(r.Values))), r.Details))}
 }
 
 func RegionTableBody(title string, rows []RegionRow) Node {
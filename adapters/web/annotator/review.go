@@ -0,0 +1,48 @@
+package annotator
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	st "github.com/lejeunel/go-image-annotator/adapters/web/styles"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/submit"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+type SubmitForReviewPresenter struct {
+	writer http.ResponseWriter
+	task   string
+	htmx.ErrorPresenter
+}
+
+func NewSubmitForReviewPresenter(w http.ResponseWriter) SubmitForReviewPresenter {
+	task := "Submitting for review"
+	return SubmitForReviewPresenter{w, task, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p SubmitForReviewPresenter) SuccessSubmit(r submit.Response) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task,
+		fmt.Sprintf("Submitted %v annotation(s) for review", r.NumSubmitted))
+}
+
+func (s *Server) SubmitForReview(w http.ResponseWriter, r *http.Request) {
+	s.Submit.Execute(r.Context(),
+		submit.Request{
+			ImageId:    r.URL.Query().Get("id"),
+			Collection: r.URL.Query().Get("collection"),
+		},
+		NewSubmitForReviewPresenter(w))
+}
+
+func MakeSubmitForReviewButton(imageId, collection string) Node {
+	u := rt.AddQueryParams(SubmitForReview, "id", imageId, "collection", collection)
+	return Button(
+		Attr("hx-post", u.String()),
+		Attr("hx-swap", "none"),
+		Class(st.PrimaryButton),
+		Text("Submit for review"),
+	)
+}
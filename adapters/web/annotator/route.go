@@ -4,21 +4,35 @@ import (
 	"net/http"
 
 	"github.com/go-chi/chi/v5"
+	rt "github.com/lejeunel/go-image-annotator/routes"
 )
 
 var (
-	AnnotateImage    = "/ui/annotate/image"
-	SubmitBox        = "/ui/annotate/submit-box"
-	UpdateBox        = "/ui/annotate/update-box"
-	SubmitPolygon    = "/ui/annotate/submit-polygon"
-	UpdatePolygon    = "/ui/annotate/update-polygon"
-	SubmitImageLabel = "/ui/annotate/submit-label"
-	AnnotationPanel  = "/ui/annotate/annotation-panel"
-	Annotations      = "/ui/annotate/annotations"
-	RemoveAnnotation = "/ui/annotate/remove-annotation"
-	SetLabel         = "/ui/annotate/set-label"
-	MetaUrl          = "/ui/annotate/meta"
-	MetaRowUrl       = "/ui/annotate/meta/row"
+	AnnotateImage     = "/ui/annotate/image"
+	SubmitBox         = "/ui/annotate/submit-box"
+	UpdateBox         = "/ui/annotate/update-box"
+	SubmitPolygon     = "/ui/annotate/submit-polygon"
+	UpdatePolygon     = "/ui/annotate/update-polygon"
+	SubmitPolyline    = "/ui/annotate/submit-polyline"
+	UpdatePolyline    = "/ui/annotate/update-polyline"
+	Polylines         = "/ui/annotate/polylines"
+	SubmitKeypoints   = "/ui/annotate/submit-keypoints"
+	UpdateKeypoints   = "/ui/annotate/update-keypoints"
+	Keypoints         = "/ui/annotate/keypoints"
+	Skeleton          = "/ui/annotate/skeleton"
+	SubmitImageLabel  = "/ui/annotate/submit-label"
+	AnnotationPanel   = "/ui/annotate/annotation-panel"
+	Annotations       = "/ui/annotate/annotations"
+	RemoveAnnotation  = "/ui/annotate/remove-annotation"
+	SetLabel          = "/ui/annotate/set-label"
+	SetAttributes     = "/ui/annotate/attributes"
+	MetaUrl           = "/ui/annotate/meta"
+	MetaRowUrl        = "/ui/annotate/meta/row"
+	SubmitForReview   = "/ui/annotate/submit-for-review"
+	FinishAssignment  = "/ui/annotate/finish-assignment"
+	AnnotationHistory = "/ui/annotate/history"
+	RevertAnnotation  = "/ui/annotate/revert"
+	AcceptPrediction  = "/ui/annotate/accept-prediction"
 )
 
 func (s *Server) Route(r chi.Router,
@@ -32,11 +46,25 @@ func (s *Server) Route(r chi.Router,
 		r.Put(UpdateBox, s.UpdateBox)
 		r.Post(SubmitPolygon, s.SubmitPolygon)
 		r.Put(UpdatePolygon, s.UpdatePolygon)
+		r.Post(SubmitPolyline, s.SubmitPolyline)
+		r.Put(UpdatePolyline, s.UpdatePolyline)
+		r.Get(Polylines, s.GetPolylinesAsJSON)
+		r.Post(SubmitKeypoints, s.SubmitKeypoints)
+		r.Put(UpdateKeypoints, s.UpdateKeypoints)
+		r.Get(Keypoints, s.GetKeypointsAsJSON)
+		r.Get(Skeleton, s.Skeleton)
 		r.Post(SubmitImageLabel, s.SubmitLabel)
 		r.Get(AnnotationPanel, s.MakeAnnotationPanel)
 		r.Get(Annotations, s.GetRegionsAsJSON)
 		r.Delete(RemoveAnnotation, s.DeleteAnnotation)
 		r.Post(SetLabel, s.SetLabel)
+		r.Put(SetAttributes, s.SetAttributes)
+		r.Get(AnnotationHistory, s.AnnotationHistory)
+		r.Post(RevertAnnotation, s.RevertAnnotation)
+		r.Post(AcceptPrediction, s.AcceptPrediction)
+		r.Post(SubmitForReview, s.SubmitForReview)
+		r.Get(rt.NextAssignmentUrl, s.NextAssignment)
+		r.Post(FinishAssignment, s.FinishAssignment)
 
 		r.Get(MetaUrl, s.MetaDataForm)
 		r.Post(MetaUrl, s.AddMetaData)
@@ -12,38 +12,88 @@ import (
 	a "github.com/lejeunel/go-image-annotator/modules/annotator"
 	rt "github.com/lejeunel/go-image-annotator/routes"
 	s "github.com/lejeunel/go-image-annotator/shared/session"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
 	assign_label "github.com/lejeunel/go-image-annotator/use-cases/annotate/assign-label"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/history"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/remove"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
+	setattr "github.com/lejeunel/go-image-annotator/use-cases/annotate/set-attributes"
 	updlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/update-label"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/finish"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/next"
+	findlbl "github.com/lejeunel/go-image-annotator/use-cases/label/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/submit"
 )
 
 type Server struct {
 	b.PageBuilder
 	a.Annotator
 	s.SessionManager
+	Submit     submit.Interactor
+	Next       next.Interactor
+	Finish     finish.Interactor
+	History    history.Interactor
+	Revert     revert.Interactor
+	Accept     accpred.Interactor
+	SetAttr    setattr.Interactor
+	AddPose    addkp.Interface
+	UpdatePose updkp.Interface
+	FindLabel  findlbl.Interactor
+	AddLine    addline.Interface
+	UpdateLine updline.Interface
 }
 
 func NewServer(
 	annotator a.Annotator,
 	pageBuilder b.PageBuilder,
 	sessionManager s.SessionManager,
+	submitter submit.Interactor,
+	nextAssignment next.Interactor,
+	finishAssignment finish.Interactor,
+	annotationHistory history.Interactor,
+	revertAnnotation revert.Interactor,
+	acceptPrediction accpred.Interactor,
+	setAttributes setattr.Interactor,
+	keypointsAdder addkp.Interface,
+	keypointsUpdater updkp.Interface,
+	labelFinder findlbl.Interactor,
+	polylineAdder addline.Interface,
+	polylineUpdater updline.Interface,
 ) *Server {
 	return &Server{
 		Annotator:      annotator,
 		SessionManager: sessionManager,
 		PageBuilder:    *pageBuilder.SetActiveSection(cmp.NoPageActive),
+		Submit:         submitter,
+		Next:           nextAssignment,
+		Finish:         finishAssignment,
+		History:        annotationHistory,
+		Revert:         revertAnnotation,
+		Accept:         acceptPrediction,
+		SetAttr:        setAttributes,
+		AddPose:        keypointsAdder,
+		UpdatePose:     keypointsUpdater,
+		FindLabel:      labelFinder,
+		AddLine:        polylineAdder,
+		UpdateLine:     polylineUpdater,
 	}
 }
 
 func (s *Server) AnnotateImage(w http.ResponseWriter, r *http.Request) {
 	s.PageBuilder.SetUserIdentity(r.Context())
 	view := NewAnnotationView(s.PageBuilder)
+	view.SetAssigned(r.URL.Query().Get(rt.AssignedArgName) != "")
 	p := ap.NewAnnotationPagePresenter(ap.NewCyclicColorizer(ap.Palette))
 	p.SetView(view)
 	collection := r.URL.Query().Get("collection")
 	filters := r.URL.Query().Get(rt.FilterQueryArgName)
 	ordering := r.URL.Query().Get(rt.OrderingQueryArgName)
-	s.Annotator.Init(r.Context(), r.URL.Query().Get("id"), collection, filters, ordering, p, p, p)
+	savedView := r.URL.Query().Get(rt.ViewArgName)
+	s.Annotator.Init(r.Context(), r.URL.Query().Get("id"), collection, filters, ordering, savedView, p, p, p)
 	view.Render(w)
 }
 
@@ -53,7 +103,7 @@ func (s *Server) MakeAnnotationPanel(w http.ResponseWriter, r *http.Request) {
 	p.SetView(view)
 	collection := r.URL.Query().Get("collection")
 	filter := fmt.Sprintf("collection:\"%v\"", collection)
-	s.Annotator.Init(r.Context(), r.URL.Query().Get("id"), collection, filter, "ingested_at", p, p, p)
+	s.Annotator.Init(r.Context(), r.URL.Query().Get("id"), collection, filter, "ingested_at", "", p, p, p)
 	view.RenderAnnotationList(w)
 }
 
@@ -100,6 +150,76 @@ func (s *Server) UpdatePolygon(w http.ResponseWriter, r *http.Request) {
 	s.Annotator.UpdatePolygon.Execute(r.Context(), ap.ToUpdatePolygonRequest(polyreq), &p)
 }
 
+func (s *Server) SubmitPolyline(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, _ := io.ReadAll(r.Body)
+	var linereq ap.PolylineRequest
+	err := json.Unmarshal(bodyBytes, &linereq)
+	if err != nil {
+		http.Error(
+			w,
+			fmt.Errorf("submit polyline: unmarshalling body: %w", err).Error(),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	p := ap.NewAnnotoriousPresenter(w)
+	s.AddLine.Execute(r.Context(), ap.ToAddPolylineRequest(linereq), &p)
+}
+
+func (s *Server) UpdatePolyline(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, _ := io.ReadAll(r.Body)
+
+	var linereq ap.PolylineModel
+	err := json.Unmarshal(bodyBytes, &linereq)
+	if err != nil {
+		http.Error(
+			w,
+			fmt.Errorf("updating polyline: unmarshalling body: %w", err).Error(),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	p := ap.NewAnnotoriousPresenter(w)
+	s.UpdateLine.Execute(r.Context(), ap.ToUpdatePolylineRequest(linereq), &p)
+}
+
+func (s *Server) SubmitKeypoints(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, _ := io.ReadAll(r.Body)
+	var kpreq ap.KeypointsRequest
+	err := json.Unmarshal(bodyBytes, &kpreq)
+	if err != nil {
+		http.Error(
+			w,
+			fmt.Errorf("submit keypoints: unmarshalling body: %w", err).Error(),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	p := ap.NewAnnotoriousPresenter(w)
+	s.AddPose.Execute(r.Context(), ap.ToAddKeypointsRequest(kpreq), &p)
+}
+
+func (s *Server) UpdateKeypoints(w http.ResponseWriter, r *http.Request) {
+	bodyBytes, _ := io.ReadAll(r.Body)
+
+	var kpreq ap.KeypointsModel
+	err := json.Unmarshal(bodyBytes, &kpreq)
+	if err != nil {
+		http.Error(
+			w,
+			fmt.Errorf("updating keypoints: unmarshalling body: %w", err).Error(),
+			http.StatusBadRequest,
+		)
+		return
+	}
+	p := ap.NewAnnotoriousPresenter(w)
+	s.UpdatePose.Execute(r.Context(), ap.ToUpdateKeypointsRequest(kpreq), &p)
+}
+
+func (s *Server) Skeleton(w http.ResponseWriter, r *http.Request) {
+	s.FindLabel.Execute(r.Context(), r.URL.Query().Get("label"), ap.SkeletonPresenter{Writer: w})
+}
+
 func (s *Server) SubmitBox(w http.ResponseWriter, r *http.Request) {
 	bodyBytes, _ := io.ReadAll(r.Body)
 
@@ -170,6 +290,18 @@ func (s *Server) SetLabel(w http.ResponseWriter, r *http.Request) {
 
 func (s *Server) GetRegionsAsJSON(w http.ResponseWriter, r *http.Request) {
 	p := ap.NewAnnotoriousPresenter(w)
-	s.Annotator.ReadImage(r.URL.Query().Get("id"), r.URL.Query().Get("collection"), &p)
+	s.Annotator.ReadImage(r.Context(), r.URL.Query().Get("id"), r.URL.Query().Get("collection"), &p)
 	p.RenderRegionAnnotationsAsJSON(w)
 }
+
+func (s *Server) GetPolylinesAsJSON(w http.ResponseWriter, r *http.Request) {
+	p := ap.NewAnnotoriousPresenter(w)
+	s.Annotator.ReadImage(r.Context(), r.URL.Query().Get("id"), r.URL.Query().Get("collection"), &p)
+	p.RenderPolylinesAsJSON(w)
+}
+
+func (s *Server) GetKeypointsAsJSON(w http.ResponseWriter, r *http.Request) {
+	p := ap.NewAnnotoriousPresenter(w)
+	s.Annotator.ReadImage(r.Context(), r.URL.Query().Get("id"), r.URL.Query().Get("collection"), &p)
+	p.RenderKeypointsAsJSON(w)
+}
@@ -24,8 +24,12 @@ const Annotator = (() => {
     const BOX_MODE = 'rectangle';
     const IMAGE_MODE = 'image';
     const EDIT_LABEL_MODE = 'edit';
+    const KEYPOINTS_MODE = 'keypoints';
+    const POLYLINE_MODE = 'polyline';
 
     let currentMode = BOX_MODE;
+    let predictionFilter = { show: true, threshold: 0 };
+    let lastTracedPolyline = null;
 
 
 
@@ -36,8 +40,17 @@ const Annotator = (() => {
         submitBox : "{{.URLs.SubmitBox}}",
         submitPolygon : "{{.URLs.SubmitPolygon}}",
         removeAnnotation : "{{.URLs.RemoveAnnotation}}",
+        revertAnnotation : "{{.URLs.RevertAnnotation}}",
+        acceptPrediction : "{{.URLs.AcceptPrediction}}",
         updateBox : "{{.URLs.UpdateBox}}",
         updatePolygon : "{{.URLs.UpdatePolygon}}",
+        fetchPolylines : "{{.URLs.FetchPolylines}}",
+        submitPolyline : "{{.URLs.SubmitPolyline}}",
+        updatePolyline : "{{.URLs.UpdatePolyline}}",
+        fetchKeypoints : "{{.URLs.FetchKeypoints}}",
+        fetchSkeleton : "{{.URLs.FetchSkeleton}}",
+        submitKeypoints : "{{.URLs.SubmitKeypoints}}",
+        updateKeypoints : "{{.URLs.UpdateKeypoints}}",
     };
 
     function newURLFromString(urlString) {
@@ -92,11 +105,86 @@ const Annotator = (() => {
                 body: JSON.stringify({ image_id: "{{.ImageId}}", collection: "{{.Collection}}", label, annotation })
             }, "Could not submit polygon");
         },
+        async fetchAllPolylines() {
+            const url = newURLFromString(endpoints.fetchPolylines);
+            url.searchParams.set("id", "{{.ImageId}}");
+            url.searchParams.set("collection", "{{.Collection}}");
+            const res = await apiFetch(url.toString(), {}, "Could not fetch polylines");
+            return res.json();
+        },
+        async submitPolyline(label, points) {
+            await apiFetch(endpoints.submitPolyline, {
+                method: "POST",
+                headers: { "Content-Type": "application/json" },
+                body: JSON.stringify({ image_id: "{{.ImageId}}", collection: "{{.Collection}}", label, points })
+            }, "Could not submit polyline");
+        },
+        async updatePolyline(line) {
+            await apiFetch(endpoints.updatePolyline, {
+                method: "PUT",
+                headers: { "Content-type": "application/json; charset=UTF-8" },
+                body: JSON.stringify(line),
+            }, "Could not update polyline");
+        },
+        async fetchAllKeypoints() {
+            const url = newURLFromString(endpoints.fetchKeypoints);
+            url.searchParams.set("id", "{{.ImageId}}");
+            url.searchParams.set("collection", "{{.Collection}}");
+            const res = await apiFetch(url.toString(), {}, "Could not fetch keypoints");
+            return res.json();
+        },
+        async fetchSkeleton(label) {
+            const url = newURLFromString(endpoints.fetchSkeleton);
+            url.searchParams.set("label", label);
+            const res = await apiFetch(url.toString(), {}, "Could not fetch skeleton");
+            return res.json();
+        },
+        async submitKeypoints(label, points) {
+            await apiFetch(endpoints.submitKeypoints, {
+                method: "POST",
+                headers: { "Content-Type": "application/json" },
+                body: JSON.stringify({ image_id: "{{.ImageId}}", collection: "{{.Collection}}", label, points })
+            }, "Could not submit keypoints");
+        },
+        async updateKeypoints(pose) {
+            await apiFetch(endpoints.updateKeypoints, {
+                method: "PUT",
+                headers: { "Content-type": "application/json; charset=UTF-8" },
+                body: JSON.stringify(pose),
+            }, "Could not update keypoints");
+        },
         async remove(id) {
             const url = newURLFromString(endpoints.removeAnnotation);
             url.searchParams.set("id", id);
             await apiFetch(url.toString(), {method: 'DELETE'}, "Could not remove annotation");
         },
+        async acceptPrediction(id) {
+            try {
+                await AnnotationAPI.acceptPrediction(id);
+                await this.refreshUI();
+            } catch (err) {
+                notify("danger", "accepting prediction", err.message);
+            }
+        },
+
+        filterPredictions(show, threshold) {
+            predictionFilter = { show, threshold };
+            instance?.setFilter(isVisible);
+            Poses.render();
+            Polylines.render();
+            filterRows();
+        },
+
+        async revert(revisionId) {
+            const url = newURLFromString(endpoints.revertAnnotation);
+            url.searchParams.set("id", revisionId);
+            await apiFetch(url.toString(), {method: 'POST'}, "Could not revert annotation");
+        },
+        async acceptPrediction(id) {
+            const url = newURLFromString(endpoints.acceptPrediction);
+            url.searchParams.set("id", id);
+            await apiFetch(url.toString(), {method: 'POST'}, "Could not accept prediction");
+        },
         async updateBox(annotation) {
             await apiFetch(endpoints.updateBox, {
                 method: "PUT",
@@ -119,6 +207,356 @@ const Annotator = (() => {
         return { fill: '#ffff', fillOpacity: 0.1, stroke: color, strokeOpacity: 1, strokeWidth: 2 };
     }
 
+    function isShown(confidence) {
+        return predictionFilter.show && confidence >= predictionFilter.threshold;
+    }
+
+    function isVisible(annotation) {
+        const properties = annotation?.properties;
+        return !properties?.model || isShown(properties.confidence ?? 0);
+    }
+
+    function filterRows() {
+        document.querySelectorAll('#annotation-list tr[data-confidence]').forEach(row => {
+            row.hidden = !isShown(parseFloat(row.dataset.confidence));
+        });
+    }
+
+    function node(name, attrs) {
+        const el = document.createElementNS("http://www.w3.org/2000/svg", name);
+        Object.entries(attrs).forEach(([k, v]) => el.setAttribute(k, v));
+        return el;
+    }
+
+    // Annotorious has no shape for poses nor open lines: they are drawn on
+    // SVG layers laid over the image, whose coordinates are those of the image.
+    function newLayer() {
+        const image = document.getElementById("image");
+        const container = image.parentElement;
+        if (getComputedStyle(container).position === "static") {
+            container.style.position = "relative";
+        }
+        const svg = node("svg", {});
+        Object.assign(svg.style, { position: "absolute", zIndex: 10, pointerEvents: "none" });
+        container.appendChild(svg);
+
+        const layer = {
+            svg,
+            fit() {
+                Object.assign(svg.style, {
+                    left: `${image.offsetLeft}px`,
+                    top: `${image.offsetTop}px`,
+                    width: `${image.clientWidth}px`,
+                    height: `${image.clientHeight}px`,
+                });
+                svg.setAttribute("viewBox", `0 0 ${image.naturalWidth} ${image.naturalHeight}`);
+            },
+            radius() {
+                return Math.max(image.naturalWidth, image.naturalHeight) / 150;
+            },
+            toImageCoordinates(evt) {
+                const rect = image.getBoundingClientRect();
+                return {
+                    x: (evt.clientX - rect.left) * image.naturalWidth / rect.width,
+                    y: (evt.clientY - rect.top) * image.naturalHeight / rect.height,
+                };
+            },
+            hint(text) {
+                const r = layer.radius();
+                const el = node("text", {
+                    x: r, y: 4 * r, fill: "#ffffff", stroke: "#000000",
+                    "stroke-width": r / 6, "font-size": 3 * r,
+                });
+                el.textContent = text;
+                svg.appendChild(el);
+            },
+        };
+        window.addEventListener("resize", layer.fit);
+        if (image.complete) layer.fit(); else image.addEventListener("load", layer.fit);
+        return layer;
+    }
+
+    const Poses = (() => {
+        const NOT_LABELED = 0, OCCLUDED = 1, VISIBLE = 2;
+        const SKIP_KEY = "s";
+
+        let layer = null;
+        let svg = null;
+        let poses = [];
+        let placing = null;
+        let dragging = null;
+
+        function drawPose(pose, editable) {
+            const color = pose.properties?.color ?? "#ff0000";
+            const r = layer.radius();
+            const byName = Object.fromEntries(pose.points.map(p => [p.name, p]));
+            const group = node("g", {});
+            pose.edges.forEach(([from, to]) => {
+                const a = byName[from], b = byName[to];
+                if (!a || !b || a.v === NOT_LABELED || b.v === NOT_LABELED) return;
+                group.appendChild(node("line", {
+                    x1: a.x, y1: a.y, x2: b.x, y2: b.y,
+                    stroke: color, "stroke-width": r / 2,
+                    "stroke-dasharray": a.v === OCCLUDED || b.v === OCCLUDED ? `${r} ${r}` : "none",
+                }));
+            });
+            pose.points.forEach((p, n) => {
+                if (p.v === NOT_LABELED) return;
+                const circle = node("circle", {
+                    cx: p.x, cy: p.y, r,
+                    fill: p.v === VISIBLE ? color : "#ffffff",
+                    stroke: color, "stroke-width": r / 3,
+                });
+                const title = node("title", {});
+                title.textContent = `${pose.label}: ${p.name}`;
+                circle.appendChild(title);
+                if (editable) {
+                    circle.style.pointerEvents = "all";
+                    circle.style.cursor = "move";
+                    circle.addEventListener("mousedown", (evt) => {
+                        evt.stopPropagation();
+                        evt.preventDefault();
+                        dragging = { pose, index: n, moved: false };
+                    });
+                    circle.addEventListener("dblclick", (evt) => {
+                        evt.stopPropagation();
+                        p.v = p.v === VISIBLE ? OCCLUDED : VISIBLE;
+                        Poses.onChange(pose);
+                    });
+                    circle.addEventListener("contextmenu", (evt) => {
+                        evt.preventDefault();
+                        p.v = NOT_LABELED;
+                        Poses.onChange(pose);
+                    });
+                }
+                group.appendChild(circle);
+            });
+            svg.appendChild(group);
+        }
+
+        function render() {
+            if (!svg) return;
+            svg.replaceChildren();
+            poses.filter(isVisible).forEach(p => drawPose(p, !placing));
+            if (placing) {
+                drawPose(placing, false);
+                layer.hint(`${placing.label}: ${placing.names[placing.points.length]}` +
+                    ` (shift: occluded, ${SKIP_KEY}: skip)`);
+            }
+            svg.style.pointerEvents = placing ? "all" : "none";
+            svg.style.cursor = placing ? "crosshair" : "default";
+        }
+
+        function place(point) {
+            const name = placing.names[placing.points.length];
+            placing.points.push({ name, ...point });
+            if (placing.points.length === placing.names.length) {
+                const { label, points } = placing;
+                placing = null;
+                render();
+                Poses.onComplete(label, points.map(({ x, y, v }) => ({ x, y, v })));
+                return;
+            }
+            render();
+        }
+
+        return {
+            onChange: async () => {},
+            onComplete: async () => {},
+
+            init() {
+                layer = newLayer();
+                svg = layer.svg;
+
+                svg.addEventListener("click", (evt) => {
+                    if (!placing) return;
+                    place({ ...layer.toImageCoordinates(evt), v: evt.shiftKey ? OCCLUDED : VISIBLE });
+                });
+                window.addEventListener("mousemove", (evt) => {
+                    if (!dragging) return;
+                    Object.assign(dragging.pose.points[dragging.index], layer.toImageCoordinates(evt));
+                    dragging.moved = true;
+                    render();
+                });
+                window.addEventListener("mouseup", () => {
+                    if (dragging?.moved) Poses.onChange(dragging.pose);
+                    dragging = null;
+                });
+                window.addEventListener("keydown", (evt) => {
+                    if (!placing) return;
+                    if (evt.key === SKIP_KEY) place({ x: 0, y: 0, v: NOT_LABELED });
+                    if (evt.key === "Escape") Poses.cancel();
+                });
+            },
+
+            set(data) {
+                poses = data;
+                layer.fit();
+                render();
+            },
+
+            start(label, skeleton) {
+                placing = { label, names: skeleton.points, edges: skeleton.edges, points: [] };
+                render();
+            },
+
+            cancel() {
+                placing = null;
+                render();
+            },
+
+            render,
+        };
+    })();
+
+    // Polylines are traced vertex by vertex, then labelled.
+    const Polylines = (() => {
+        let layer = null;
+        let svg = null;
+        let lines = [];
+        let tracing = null;
+        let dragging = null;
+
+        function isNear(a, b) {
+            return Math.hypot(a[0] - b[0], a[1] - b[1]) < layer.radius();
+        }
+
+        function drawLine(line, editable) {
+            const color = line.properties?.color ?? "#ff0000";
+            const r = layer.radius();
+            const group = node("g", {});
+            const path = node("polyline", {
+                points: line.points.map(p => p.join(",")).join(" "),
+                fill: "none", stroke: color, "stroke-width": r / 2,
+                "stroke-linecap": "round", "stroke-linejoin": "round",
+            });
+            if (line.label) {
+                const title = node("title", {});
+                title.textContent = line.label;
+                path.appendChild(title);
+            }
+            group.appendChild(path);
+            line.points.forEach(([x, y], n) => {
+                const vertex = node("circle", { cx: x, cy: y, r: editable ? r : r / 2, fill: color });
+                if (editable) {
+                    vertex.style.pointerEvents = "all";
+                    vertex.style.cursor = "move";
+                    vertex.addEventListener("mousedown", (evt) => {
+                        evt.stopPropagation();
+                        evt.preventDefault();
+                        dragging = { line, index: n, moved: false };
+                    });
+                    vertex.addEventListener("contextmenu", (evt) => {
+                        evt.preventDefault();
+                        if (line.points.length <= 2) return;
+                        line.points.splice(n, 1);
+                        Polylines.onChange(line);
+                    });
+                }
+                group.appendChild(vertex);
+            });
+            svg.appendChild(group);
+        }
+
+        function render() {
+            if (!svg) return;
+            svg.replaceChildren();
+            lines.filter(isVisible).forEach(l => drawLine(l, !tracing));
+            if (tracing) {
+                const points = tracing.cursor ? [...tracing.points, tracing.cursor] : tracing.points;
+                drawLine({ points }, false);
+                layer.hint("double-click or Enter: finish, Backspace: undo, Esc: cancel");
+            }
+            svg.style.pointerEvents = tracing ? "all" : "none";
+            svg.style.cursor = tracing ? "crosshair" : "default";
+        }
+
+        function finish() {
+            const { points } = tracing;
+            if (points.length < 2) return;
+            tracing = null;
+            render();
+            Polylines.onComplete(points);
+        }
+
+        return {
+            onChange: async () => {},
+            onComplete: async () => {},
+
+            init() {
+                layer = newLayer();
+                svg = layer.svg;
+
+                // the clicks of a double-click land on the last vertex and are
+                // dropped
+                svg.addEventListener("click", (evt) => {
+                    if (!tracing) return;
+                    const { x, y } = layer.toImageCoordinates(evt);
+                    const last = tracing.points[tracing.points.length - 1];
+                    if (last && isNear(last, [x, y])) return;
+                    tracing.points.push([x, y]);
+                    render();
+                });
+                svg.addEventListener("dblclick", (evt) => {
+                    if (!tracing) return;
+                    evt.preventDefault();
+                    finish();
+                });
+                svg.addEventListener("mousemove", (evt) => {
+                    if (!tracing) return;
+                    const { x, y } = layer.toImageCoordinates(evt);
+                    tracing.cursor = [x, y];
+                    render();
+                });
+                window.addEventListener("mousemove", (evt) => {
+                    if (!dragging) return;
+                    const { x, y } = layer.toImageCoordinates(evt);
+                    dragging.line.points[dragging.index] = [x, y];
+                    dragging.moved = true;
+                    render();
+                });
+                window.addEventListener("mouseup", () => {
+                    if (dragging?.moved) Polylines.onChange(dragging.line);
+                    dragging = null;
+                });
+                window.addEventListener("keydown", (evt) => {
+                    if (!tracing || LabelPicker.isOpen()) return;
+                    switch (evt.key) {
+                    case "Enter":
+                        finish();
+                        break;
+                    case "Backspace":
+                        evt.preventDefault();
+                        tracing.points.pop();
+                        render();
+                        break;
+                    case "Escape":
+                        Polylines.cancel();
+                        break;
+                    }
+                });
+            },
+
+            set(data) {
+                lines = data;
+                layer.fit();
+                render();
+            },
+
+            start() {
+                tracing = { points: [], cursor: null };
+                render();
+            },
+
+            cancel() {
+                tracing = null;
+                render();
+            },
+
+            render,
+        };
+    })();
+
     return {
         init() {
             instance = Annotorious.createImageAnnotator('image', {
@@ -126,6 +564,27 @@ const Annotator = (() => {
                 drawingEnabled: {{if .EnableAnnotation}} true {{else}} false {{end}}
             });
             instance.setStyle(styler);
+            instance.setFilter(isVisible);
+            Poses.init();
+            Poses.onComplete = async (label, points) => {
+                try { await AnnotationAPI.submitKeypoints(label, points); }
+                catch (err) { notify("danger", "submitting keypoints", err.message); }
+                await this.refreshUI();
+            };
+            Poses.onChange = async (pose) => {
+                try { await AnnotationAPI.updateKeypoints(pose); await this.refreshList(); }
+                catch (err) { notify("danger", "updating keypoints", err.message); await this.draw(); }
+            };
+            Polylines.init();
+            Polylines.onComplete = async (points) => {
+                currentMode = POLYLINE_MODE;
+                lastTracedPolyline = points;
+                LabelPicker.open();
+            };
+            Polylines.onChange = async (line) => {
+                try { await AnnotationAPI.updatePolyline(line); await this.refreshList(); }
+                catch (err) { notify("danger", "updating polyline", err.message); await this.draw(); }
+            };
             this.registerEvents(instance);
             this.draw();
             return instance;
@@ -137,13 +596,33 @@ const Annotator = (() => {
             currentMode = IMAGE_MODE;
         },
         polygonMode() {
+            Poses.cancel();
+            Polylines.cancel();
+            instance.setDrawingEnabled(true);
             instance.setDrawingTool('polygon');
             currentMode = POLYGON_MODE;
         },
         rectangleMode() {
+            Poses.cancel();
+            Polylines.cancel();
+            instance.setDrawingEnabled(true);
             instance.setDrawingTool('rectangle');
             currentMode = BOX_MODE;
         },
+        // Poses are placed point by point once their label, and hence their
+        // skeleton, is known.
+        keypointsMode() {
+            Polylines.cancel();
+            instance.setDrawingEnabled(false);
+            currentMode = KEYPOINTS_MODE;
+            LabelPicker.open();
+        },
+        polylineMode() {
+            Poses.cancel();
+            instance.setDrawingEnabled(false);
+            currentMode = POLYLINE_MODE;
+            Polylines.start();
+        },
 
         registerEvents(instance) {
             instance.on('createAnnotation', (annotation) => {
@@ -183,6 +662,8 @@ const Annotator = (() => {
             try {
                 const data = await AnnotationAPI.fetchAllAnnotations();
                 instance.setAnnotations(data, true);
+                Poses.set(await AnnotationAPI.fetchAllKeypoints());
+                Polylines.set(await AnnotationAPI.fetchAllPolylines());
             } catch (err) {
                 notify("danger", "drawing annotator", err.message);
             }
@@ -213,6 +694,14 @@ const Annotator = (() => {
                 case IMAGE_MODE:
                     await AnnotationAPI.addImageLabel(label);
                     break;
+                case POLYLINE_MODE:
+                    await AnnotationAPI.submitPolyline(label, lastTracedPolyline);
+                    Polylines.start();
+                    break;
+                case KEYPOINTS_MODE:
+                    Poses.start(label, await AnnotationAPI.fetchSkeleton(label));
+                    LabelPicker.close();
+                    return;
                 case EDIT_LABEL_MODE:
                     await this.relabel(currentAnnotationId, label);
                     break;
@@ -236,19 +725,30 @@ const Annotator = (() => {
             }
         },
 
+        async revert(revisionId) {
+            try {
+                await AnnotationAPI.revert(revisionId);
+                await this.refreshUI();
+            } catch (err) {
+                notify("danger", "reverting annotation", err.message);
+            }
+        },
+
         async refreshUI() {
             await this.refreshList();
             await this.draw();
         },
 
         async refreshList() {
-            htmx.ajax('GET',
+            await htmx.ajax('GET',
                 `{{.URLs.AnnotationPanel}}?id={{.ImageId}}&collection={{.Collection}}`,
                 '#annotation-list');
+            filterRows();
         },
 
         abort() {
             LabelPicker.close();
+            if (currentMode === POLYLINE_MODE) Polylines.start();
             this.draw();
         }
     };
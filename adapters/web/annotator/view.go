@@ -27,15 +27,19 @@ type AnnotationView struct {
 	image                *v.Image
 	boxes                []v.BoundingBox
 	polygons             []v.Polygon
+	polylines            []v.Polyline
+	poses                []v.Keypoints
 	imageLabels          []v.ImageLabel
 	metadata             []m.MetaData
 	imageInfo            *v.ImageInfo
 	availableLabels      []string
 	availableImageLabels []string
+	defaultLabel         *string
 	scrollerButtons      v.ScrollerButtons
 	err                  error
 	filters              im.FilterStr
 	ordering             im.OrderStr
+	assigned             bool
 	PageBuilder          b.PageBuilder
 }
 
@@ -46,10 +50,14 @@ func (v *AnnotationView) SetScroller(buttons v.ScrollerButtons) {
 func (v *AnnotationView) SetAnnotations(
 	boxes []v.BoundingBox,
 	polygons []v.Polygon,
+	polylines []v.Polyline,
+	poses []v.Keypoints,
 	imageLabels []v.ImageLabel,
 ) {
 	v.boxes = boxes
 	v.polygons = polygons
+	v.polylines = polylines
+	v.poses = poses
 	v.imageLabels = imageLabels
 }
 
@@ -58,6 +66,12 @@ func (v *AnnotationView) SetQuery(f im.FilterStr, o im.OrderStr) {
 	v.ordering = o
 }
 
+// SetAssigned shows the buttons to move through the images assigned to the
+// user.
+func (v *AnnotationView) SetAssigned(assigned bool) {
+	v.assigned = assigned
+}
+
 func (v *AnnotationView) SetMetaData(m []m.MetaData) {
 	v.metadata = m
 }
@@ -66,6 +80,11 @@ func (v *AnnotationView) SetAvailableLabels(labels []string) {
 	v.availableLabels = labels
 }
 
+// SetDefaultLabel preselects a label in the label picker.
+func (v *AnnotationView) SetDefaultLabel(label *string) {
+	v.defaultLabel = label
+}
+
 func (v *AnnotationView) SetAvailableImageLabels(labels []string) {
 	v.availableImageLabels = labels
 }
@@ -83,7 +102,7 @@ func (v *AnnotationView) Error(err error) {
 }
 
 func (v *AnnotationView) RenderAnnotationList(w http.ResponseWriter) {
-	v.AnnotationsListView.Build(v.boxes, v.polygons, v.imageLabels, v.availableLabels).Render(w)
+	v.AnnotationsListView.Build(v.boxes, v.polygons, v.polylines, v.poses, v.imageLabels, v.availableLabels).Render(w)
 }
 
 func (v *AnnotationView) Render(w http.ResponseWriter) {
@@ -132,6 +151,38 @@ func (v *AnnotationView) ShapeSelector() Node {
 				Raw(ic.Polygon),
 				Div(Class("ml-1"), Text("Polygon")),
 			),
+		),
+		Button(
+			Attr(
+				"x-bind:class",
+				fmt.Sprintf(
+					`{'%v': active === 'polyline', '%v': active !== 'polyline'}`,
+					s.PrimaryButton,
+					s.InactiveButton,
+				),
+			),
+			Attr("@click", "Annotator.polylineMode(); active = 'polyline';"),
+			Div(
+				Class("flex items-center gap-1"),
+				Raw(ic.Polyline),
+				Div(Class("ml-1"), Text("Polyline")),
+			),
+		),
+		Button(
+			Attr(
+				"x-bind:class",
+				fmt.Sprintf(
+					`{'%v': active === 'keypoints', '%v': active !== 'keypoints'}`,
+					s.PrimaryButton,
+					s.InactiveButton,
+				),
+			),
+			Attr("@click", "Annotator.keypointsMode(); active = 'keypoints';"),
+			Div(
+				Class("flex items-center gap-1"),
+				Raw(ic.Keypoints),
+				Div(Class("ml-1"), Text("Keypoints")),
+			),
 		))
 }
 
@@ -153,7 +204,7 @@ func (v *AnnotationView) render(w http.ResponseWriter) {
 	pb.AddScripts(AnnotoriousLib()...)
 	pb.AddScripts(*script)
 
-	labelModal := makeLabelModal(v.availableLabels)
+	labelModal := makeLabelModal(v.availableLabels, v.defaultLabel)
 
 	pb.SetContent(
 		Group([]Node{
@@ -174,11 +225,16 @@ func (v *AnnotationView) render(w http.ResponseWriter) {
 					Div(Class("align-top pl-2"),
 						Div(Class("pb-2"), v.QueryView.Build(v.filters, v.ordering)),
 						Div(Class("pb-2"), v.ImageInfosView.Build(*v.imageInfo)),
+						Div(Class("pb-2"), MakeSubmitForReviewButton(v.image.Id, v.image.Collection)),
+						If(v.assigned, Div(Class("pb-2"), MakeAssignmentButtons(v.image.Id, v.image.Collection))),
+						If(hasPredictions(v.boxes, v.polygons, v.polylines, v.poses), Div(Class("pb-2"), MakePredictionFilter())),
 						Div(
 							ID("annotation-list"),
 							v.AnnotationsListView.Build(
 								v.boxes,
 								v.polygons,
+								v.polylines,
+								v.poses,
 								v.imageLabels,
 								v.availableLabels,
 							),
@@ -16,6 +16,7 @@ type CreationButton struct {
 
 type PaginatedListBuilder struct {
 	hasCreationButton bool
+	header            Node
 	CreationButton
 	PaginableTableBuilder
 	PageBuilder
@@ -43,6 +44,7 @@ func (b *PaginatedListBuilder) Render(w io.Writer) {
 		creationPanel = Div(button, formPlaceholder)
 	}
 	content := Div(
+		b.header,
 		creationPanel,
 		Div(Class("py-2"), paginator),
 		b.PaginableTableBuilder.Build())
@@ -60,3 +62,9 @@ func (b *PaginatedListBuilder) AddCreationButton(
 	b.hasCreationButton = true
 	return b
 }
+
+// SetHeader sets a node rendered above the table, such as a search form.
+func (b *PaginatedListBuilder) SetHeader(n Node) *PaginatedListBuilder {
+	b.header = n
+	return b
+}
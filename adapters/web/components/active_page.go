@@ -5,6 +5,8 @@ type ActivePage int
 const (
 	CollectionsPageActive ActivePage = iota
 	LabelsPageActive
+	ViewsPageActive
+	ReviewsPageActive
 	HomePageActive
 	APIDocsPageActive
 	NoPageActive
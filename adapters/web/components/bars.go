@@ -170,6 +170,12 @@ func MakeNavBar(
 			Li(
 				MakeMenuItem("Labels", rt.LabelsUrl, isActivated == LabelsPageActive),
 			),
+			Li(
+				MakeMenuItem("Views", rt.ViewsUrl, isActivated == ViewsPageActive),
+			),
+			Li(
+				MakeMenuItem("Reviews", rt.ReviewsUrl, isActivated == ReviewsPageActive),
+			),
 			Li(
 				MakeMenuItem("API", rt.APIDocsUrl, isActivated == APIDocsPageActive),
 			),
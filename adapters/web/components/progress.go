@@ -0,0 +1,22 @@
+package components
+
+import (
+	"fmt"
+
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+// MakeProgressBar renders the ratio of finished items over total items as a
+// bar followed by counts.
+func MakeProgressBar(finished, total int64) Node {
+	percent := int64(0)
+	if total > 0 {
+		percent = 100 * finished / total
+	}
+	return Div(Class("flex items-center gap-2"),
+		Div(Class("h-2 w-24 overflow-hidden rounded-radius bg-surface-alt dark:bg-surface-dark-alt"),
+			Div(Class("h-2 bg-success"), Style(fmt.Sprintf("width: %v%%", percent)))),
+		Span(Class("text-xs whitespace-nowrap"), Text(fmt.Sprintf("%v / %v", finished, total))),
+	)
+}
@@ -0,0 +1,22 @@
+package components
+
+import (
+	"fmt"
+
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+const ThumbnailSize = 128
+
+// MakeThumbnail shows a downscaled version of an image, fetched once it
+// scrolls into view.
+func MakeThumbnail(imageId string) Node {
+	return Img(
+		Src(fmt.Sprintf("%v/raw/%v?size=%v", rt.APIRootUrl, imageId, ThumbnailSize)),
+		Alt(imageId),
+		Loading("lazy"),
+		Style("max-width: 4rem; max-height: 4rem"),
+	)
+}
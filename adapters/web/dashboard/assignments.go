@@ -0,0 +1,64 @@
+package dashboard
+
+import (
+	_ "embed"
+	"io"
+	"net/http"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	st "github.com/lejeunel/go-image-annotator/adapters/web/styles"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/mine"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+//go:embed assignments-preamble.md
+var assignmentsPreamble string
+
+var listAssignmentsFields = []string{"batch", "filter", "assigned", "progress"}
+
+func (s *Server) ListAssignments(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.MyAssignmentsItr.Execute(r.Context(), NewAssignmentsPresenter(w, s.PageBuilder))
+}
+
+type AssignmentsPresenter struct {
+	b.PageBuilder
+	Writer io.Writer
+	e.ErrorPresenter
+}
+
+func NewAssignmentsPresenter(w http.ResponseWriter, p b.PageBuilder) AssignmentsPresenter {
+	p.SetTitle(AssignmentsPageName)
+	p.SetHTMLTitle(AssignmentsPageName)
+	p.SetActiveSection(cmp.NoPageActive)
+	p.ActivateSidebarEntry(AssignmentsPageName)
+	p.AddMarkdownPreamble(assignmentsPreamble)
+	return AssignmentsPresenter{p, w, e.NewErrorPresenter(w)}
+}
+
+func (p AssignmentsPresenter) SuccessListMyBatches(r mine.Response) {
+	table := tb.NewTableBuilder(listAssignmentsFields)
+	remaining := a.Progress{}
+	for _, batch := range r.Batches {
+		progress := batch.Progress()
+		remaining = remaining.Add(progress)
+		row := tb.NewRow()
+		row.AddCell(tb.NewCell(Text(batch.Name)))
+		row.AddCell(tb.NewCell(Code(Text(batch.Filter))))
+		row.AddCell(tb.NewCell(Text(cmp.DateTimeToStr(batch.CreatedAt))))
+		row.AddCell(tb.NewCell(cmp.MakeProgressBar(progress.Finished, progress.Total)))
+		table.AddRow(row)
+	}
+	var next Node
+	if remaining.Finished < remaining.Total {
+		next = Div(Class("py-2"), A(Href(rt.NextAssignmentUrl), Class(st.PrimaryButton), Text("Next image")))
+	}
+	p.SetContent(Div(next, table.Build()))
+	p.Render(p.Writer)
+}
@@ -0,0 +1,179 @@
+package dashboard
+
+import (
+	_ "embed"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	pg "github.com/lejeunel/go-image-annotator/adapters/web/pagination"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/list"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+//go:embed evaluations-preamble.md
+var evaluationsPreamble string
+
+var listEvaluationsFields = []string{"id", "ground truth", "predictions", "images", "mAP", "mAP@.5", "created"}
+var labelMetricsFields = []string{"label", "ground truth", "predictions", "AP", "AP@.5", "AP@.75"}
+
+func formatScore(v float64) string {
+	return fmt.Sprintf("%.3f", v)
+}
+
+func (s *Server) ListEvaluations(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.ListEvaluationsItr.Execute(r.Context(),
+		pa.PaginationParams{PageSize: s.DefaultPageSize, Page: pg.GetPageFromRequest(r)},
+		NewEvaluationListPresenter(w, s.PageBuilder))
+}
+
+func (s *Server) Evaluation(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.FindEvaluationItr.Execute(r.Context(),
+		find.Request{Id: r.URL.Query().Get(TaskIdQueryArg)},
+		NewEvaluationPresenter(w, s.PageBuilder))
+}
+
+type EvaluationListPresenter struct {
+	b.PaginatedListBuilder
+	Writer io.Writer
+	e.ErrorPresenter
+}
+
+func NewEvaluationListPresenter(w http.ResponseWriter, p b.PageBuilder) EvaluationListPresenter {
+	p.SetTitle(EvaluationsPageName)
+	p.SetHTMLTitle(EvaluationsPageName)
+	p.SetActiveSection(cmp.NoPageActive)
+	p.ActivateSidebarEntry(EvaluationsPageName)
+	p.AddMarkdownPreamble(evaluationsPreamble)
+	b := b.NewPaginatedListBuilder(p, listEvaluationsFields)
+	return EvaluationListPresenter{b, w, e.NewErrorPresenter(w)}
+}
+
+func (p EvaluationListPresenter) SuccessListEvaluations(r list.Response) {
+	for _, v := range r.Evaluations {
+		url := rt.AddQueryParams(EvaluationUrl, TaskIdQueryArg, v.Id.String())
+		row := tb.NewRow()
+		row.AddCell(tb.NewCell(cmp.MakeTextLink(url.String(), v.Id.String())))
+		row.AddCell(tb.NewCell(Text(v.GroundTruth)))
+		row.AddCell(tb.NewCell(Text(v.Predictions)))
+		row.AddCell(tb.NewCell(Text(fmt.Sprint(v.Report.NumImages))))
+		row.AddCell(tb.NewCell(Text(formatScore(v.Report.MAP))))
+		row.AddCell(tb.NewCell(Text(formatScore(v.Report.MAP50))))
+		row.AddCell(tb.NewCell(Text(cmp.DateTimeToStr(v.CreatedAt))))
+		p.AddRow(row)
+	}
+	p.SetPagination(r.Pagination, EvaluationsUrl)
+	p.Render(p.Writer)
+}
+
+type EvaluationPresenter struct {
+	b.PageBuilder
+	Writer io.Writer
+	e.ErrorPresenter
+}
+
+func NewEvaluationPresenter(w http.ResponseWriter, p b.PageBuilder) EvaluationPresenter {
+	p.SetTitle(EvaluationsPageName)
+	p.SetHTMLTitle(EvaluationsPageName)
+	p.SetActiveSection(cmp.NoPageActive)
+	p.ActivateSidebarEntry(EvaluationsPageName)
+	return EvaluationPresenter{p, w, e.NewErrorPresenter(w)}
+}
+
+func (p EvaluationPresenter) SuccessFindEvaluation(r find.Response) {
+	v := r.Evaluation
+	rows := []UserInfoRow{
+		{Name: "Ground truth", Value: v.GroundTruth},
+		{Name: "Predictions", Value: v.Predictions},
+		{Name: "Filter", Value: v.Filter},
+		{Name: "Minimum confidence", Value: fmt.Sprint(v.MinConfidence)},
+		{Name: "Created", Value: cmp.DateTimeToStr(v.CreatedAt)},
+		{Name: "Images", Value: fmt.Sprint(v.Report.NumImages)},
+		{Name: "Ground truth regions", Value: fmt.Sprint(v.Report.NumGroundTruth)},
+		{Name: "Predicted regions", Value: fmt.Sprint(v.Report.NumPredictions)},
+		{Name: "mAP@[.5:.95]", Value: formatScore(v.Report.MAP)},
+		{Name: "mAP@.5", Value: formatScore(v.Report.MAP50)},
+		{Name: "mAP@.75", Value: formatScore(v.Report.MAP75)},
+	}
+	summary := Table(Class("text-left text-sm text-on-surface dark:text-on-surface-dark"),
+		Map(rows, func(r UserInfoRow) Node { return r.Render() }))
+
+	metrics := tb.NewTableBuilder(labelMetricsFields, tb.WithSimplePlaceHolder())
+	curves := []Node{}
+	for _, l := range v.Report.Labels {
+		row := tb.NewRow()
+		row.AddCell(tb.NewCell(Text(l.Label)))
+		row.AddCell(tb.NewCell(Text(fmt.Sprint(l.NumGroundTruth))))
+		row.AddCell(tb.NewCell(Text(fmt.Sprint(l.NumPredictions))))
+		row.AddCell(tb.NewCell(Text(formatScore(l.AP))))
+		row.AddCell(tb.NewCell(Text(formatScore(l.AP50))))
+		row.AddCell(tb.NewCell(Text(formatScore(l.AP75))))
+		metrics.AddRow(row)
+		if len(l.Curve) > 0 {
+			curves = append(curves, Figure(Class("flex flex-col items-center"),
+				Raw(precisionRecallCurve(l.Curve)),
+				FigCaption(Class("text-sm"), Text(l.Label))))
+		}
+	}
+
+	content := Div(Class("flex flex-col gap-4"),
+		Div(Class("w-120"), cmp.MakeCard(summary)),
+		H3(Class("font-bold"), Text("Per-label metrics")),
+		metrics.Build(),
+		H3(Class("font-bold"), Text("Precision-recall curves at IoU .5")),
+		Div(Class("flex flex-wrap gap-4"), Group(curves)),
+		H3(Class("font-bold"), Text("Confusion matrix at IoU .5")),
+		Div(Class("text-sm"), Text("Rows are ground truth, columns are predictions.")),
+		confusionMatrix(v.Report.Confusion),
+	)
+	p.SetContent(content)
+	p.Render(p.Writer)
+}
+
+// precisionRecallCurve draws a curve as an inline SVG, with recall along x
+// and precision along y.
+func precisionRecallCurve(curve []ev.PRPoint) string {
+	const size, margin = 200.0, 20.0
+	points := make([]string, len(curve))
+	for i, pt := range curve {
+		points[i] = fmt.Sprintf("%.1f,%.1f", margin+pt.Recall*size, margin+(1-pt.Precision)*size)
+	}
+	return fmt.Sprintf(`<svg width="%[1]v" height="%[1]v" viewBox="0 0 %[1]v %[1]v" class="text-primary dark:text-primary-dark">`+
+		`<rect x="%[2]v" y="%[2]v" width="%[3]v" height="%[3]v" fill="none" stroke="currentColor" stroke-opacity="0.3"/>`+
+		`<polyline points="%[4]v" fill="none" stroke="currentColor" stroke-width="2"/>`+
+		`<text x="%[5]v" y="%[6]v" font-size="10" text-anchor="middle" fill="currentColor">recall</text>`+
+		`<text x="10" y="%[5]v" font-size="10" text-anchor="middle" fill="currentColor" transform="rotate(-90 10 %[5]v)">precision</text>`+
+		`</svg>`,
+		size+2*margin, margin, size, strings.Join(points, " "), margin+size/2, size+2*margin-5)
+}
+
+func confusionMatrix(m ev.ConfusionMatrix) Node {
+	labels := append(append([]string{}, m.Labels...), "background")
+	fields := append([]string{""}, labels...)
+	table := tb.NewTableBuilder(fields, tb.WithSimplePlaceHolder())
+	for i, counts := range m.Counts {
+		row := tb.NewRow()
+		row.AddCell(tb.NewCell(Text(labels[i]), tb.WithCellClass("font-bold")))
+		for j, count := range counts {
+			class := ""
+			if i == j && i < len(m.Labels) {
+				class = "text-success"
+			}
+			row.AddCell(tb.NewCell(Text(fmt.Sprint(count)), tb.WithCellClass(class)))
+		}
+		table.AddRow(row)
+	}
+	return table.Build()
+}
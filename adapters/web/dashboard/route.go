@@ -13,6 +13,9 @@ func (s *Server) Route(r chi.Router, mws ...func(http.Handler) http.Handler) {
 		r.Get(rt.DashboardUrl, s.Profile)
 		r.Get(CredentialsUrl, s.Credentials)
 		r.Get(rt.ListTasksUrl, s.ListTasks)
+		r.Get(rt.MyAssignmentsUrl, s.ListAssignments)
+		r.Get(EvaluationsUrl, s.ListEvaluations)
+		r.Get(EvaluationUrl, s.Evaluation)
 		r.Get(TaskRowUrl, s.TaskRow)
 		r.Get(TaskDetailsUrl, s.TaskDetails)
 		r.Get(NewAPITokenUrl, s.NewAPIToken)
@@ -4,6 +4,9 @@ import (
 	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
 	"github.com/lejeunel/go-image-annotator/adapters/web/icons"
 	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/mine"
+	fe "github.com/lejeunel/go-image-annotator/use-cases/evaluation/find"
+	le "github.com/lejeunel/go-image-annotator/use-cases/evaluation/list"
 	ft "github.com/lejeunel/go-image-annotator/use-cases/log/find"
 	lt "github.com/lejeunel/go-image-annotator/use-cases/log/list"
 	cpw "github.com/lejeunel/go-image-annotator/use-cases/user/change-password"
@@ -12,11 +15,14 @@ import (
 
 type Server struct {
 	b.PageBuilder
-	RenewAPITokenItr  rat.Interactor
-	ChangePasswordItr cpw.Interactor
-	ListTasksItr      lt.Interactor
-	FindTaskItr       ft.Interactor
-	DefaultPageSize   int
+	RenewAPITokenItr   rat.Interactor
+	ChangePasswordItr  cpw.Interactor
+	ListTasksItr       lt.Interactor
+	FindTaskItr        ft.Interactor
+	MyAssignmentsItr   mine.Interactor
+	ListEvaluationsItr le.Interactor
+	FindEvaluationItr  fe.Interactor
+	DefaultPageSize    int
 }
 
 func New(
@@ -26,9 +32,14 @@ func New(
 	c cpw.Interactor,
 	lt lt.Interactor,
 	ft ft.Interactor,
+	mine mine.Interactor,
+	le le.Interactor,
+	fe fe.Interactor,
 ) Server {
 	pb.AddSidebarEntry(ProfilePageName, icons.Info, rt.DashboardUrl, false)
 	pb.AddSidebarEntry(CredentialsPageName, icons.Key, CredentialsUrl, false)
 	pb.AddSidebarEntry(LogsPageName, icons.Notepad, rt.ListTasksUrl, false)
-	return Server{pb, i, c, lt, ft, defaultPageSize}
+	pb.AddSidebarEntry(AssignmentsPageName, icons.Flag, rt.MyAssignmentsUrl, false)
+	pb.AddSidebarEntry(EvaluationsPageName, icons.Percent, EvaluationsUrl, false)
+	return Server{pb, i, c, lt, ft, mine, le, fe, defaultPageSize}
 }
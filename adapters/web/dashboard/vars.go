@@ -5,6 +5,10 @@ const (
 	CredentialsUrl      = "/dashboard/credentials"
 	ProfilePageName     = "Profile"
 	LogsPageName        = "Logs"
+	AssignmentsPageName = "Assignments"
+	EvaluationsPageName = "Evaluations"
+	EvaluationsUrl      = "/dashboard/evaluations"
+	EvaluationUrl       = "/dashboard/evaluation"
 	TaskDetailsUrl      = "/ui/dashboard/logs/detail"
 	TaskRowUrl          = "/ui/dashboard/logs/row"
 	TaskIdQueryArg      = "task_id"
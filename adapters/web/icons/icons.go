@@ -86,9 +86,21 @@ var BoundingBox string
 //go:embed svg/polygon.svg
 var Polygon string
 
+//go:embed svg/polyline.svg
+var Polyline string
+
+//go:embed svg/keypoints.svg
+var Keypoints string
+
 //go:embed svg/book.svg
 var Book string
 
+//go:embed svg/history.svg
+var History string
+
+//go:embed svg/check.svg
+var Check string
+
 func MakeColoredRectangleIcon(color string) string {
 	return fmt.Sprintf(
 		`<svg width="22" height="22" viewBox="0 0 100 100" xmlns="http://www.w3.org/2000/svg">
@@ -106,3 +118,25 @@ func MakeColoredHexagonIcon(color string) string {
 		color,
 	)
 }
+
+func MakeColoredPolylineIcon(color string) string {
+	return fmt.Sprintf(
+		`<svg width="22" height="22" viewBox="0 0 100 100" xmlns="http://www.w3.org/2000/svg">
+  <polyline points="12,82 38,30 62,62 88,18" stroke="%v" stroke-width="12" stroke-linecap="round" stroke-linejoin="round" fill="none" />
+</svg>`,
+		color,
+	)
+}
+
+func MakeColoredKeypointsIcon(color string) string {
+	return fmt.Sprintf(
+		`<svg width="22" height="22" viewBox="0 0 100 100" xmlns="http://www.w3.org/2000/svg">
+  <path d="M20 80L50 50L80 80M50 50V15" stroke="%[1]v" stroke-width="8" fill="none" />
+  <circle cx="50" cy="15" r="12" fill="%[1]v" />
+  <circle cx="50" cy="50" r="12" fill="%[1]v" />
+  <circle cx="20" cy="80" r="12" fill="%[1]v" />
+  <circle cx="80" cy="80" r="12" fill="%[1]v" />
+</svg>`,
+		color,
+	)
+}
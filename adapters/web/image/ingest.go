@@ -41,6 +41,7 @@ type IngestionPanelData struct {
 	ArchiveIngestUrl      string
 	PythonIngestionScript string
 	InputName             string
+	CreateLabelsInputName string
 	MaxMB                 int
 }
 
@@ -56,6 +57,7 @@ func (s *Server) IngestionPanel(w http.ResponseWriter, r *http.Request) {
 			ArchiveIngestUrl:      endpoint.String(),
 			MaxMB:                 s.maxArchiveMB,
 			InputName:             ingestFormInputName,
+			CreateLabelsInputName: createLabelsInputName,
 			PythonIngestionScript: PythonIngestionScript,
 		}); err != nil {
 		panic(err)
@@ -72,8 +74,9 @@ func (s *Server) IngestArchive(w http.ResponseWriter, r *http.Request) {
 
 	s.IngestArchiveItr.Execute(r.Context(),
 		ia.Request{
-			Collection: r.URL.Query().Get(rt.CollectionArgName),
-			Reader:     file,
+			Collection:          r.URL.Query().Get(rt.CollectionArgName),
+			Reader:              file,
+			CreateMissingLabels: r.FormValue(createLabelsInputName) == "true",
 		},
 		NewIngestArchivePresenter(w),
 	)
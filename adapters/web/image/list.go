@@ -20,6 +20,7 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/image/list"
 	list_im "github.com/lejeunel/go-image-annotator/use-cases/image/list"
 	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
 )
 
 type ListImagesPresenter struct {
@@ -29,7 +30,7 @@ type ListImagesPresenter struct {
 	collection string
 }
 
-var listImagesFields = []string{"id", "collection", "ingested", "n. annot.", "actions"}
+var listImagesFields = []string{"preview", "id", "collection", "ingested", "n. annot.", "actions"}
 
 func NewListImagesPresenter(
 	w http.ResponseWriter,
@@ -69,6 +70,7 @@ func makeImageRow(image im.Image, urlFunc ImageURLFunc) tb.Row {
 		"collection", image.Collection.Name,
 		"mode", "confirm-delete"))
 	row := tb.NewRow()
+	row.AddCell(tb.NewCell(A(Href(link), cmp.MakeThumbnail(image.Id.String()))))
 	row.AddCell(tb.NewCell(cmp.MakeTextLink(link, uuid.ShortenUUID(image.Id.String()))))
 	row.AddCell(tb.NewCell(Text(image.Collection.Name)))
 	row.AddCell(tb.NewCell(Text(cmp.DateTimeToStr(image.Specs.IngestedAt))))
@@ -86,7 +88,7 @@ func (s *Server) List(w http.ResponseWriter, r *http.Request) {
 		)
 		s.PageBuilder.Render(w)
 	}
-	s.ListItr.Execute(list_im.Request{
+	s.ListItr.Execute(r.Context(), list_im.Request{
 		FilterStr:        fmt.Sprintf("collection=\"%v\"", collection),
 		PaginationParams: pa.PaginationParams{Page: pg.GetPageFromRequest(r)},
 		OrderStr:         "ingested_at:asc",
@@ -105,7 +107,7 @@ func (s *Server) TableRow(w http.ResponseWriter, r *http.Request) {
 			rt.AddQueryParams(ImageRow, "id", id, "collection", collection),
 			w)
 	default:
-		s.FindItr.Execute(
+		s.FindItr.Execute(r.Context(),
 			find_im.Request{
 				ImageId:    id,
 				Collection: collection,
@@ -23,6 +23,7 @@ func (s *Server) Route(r chi.Router,
 		r.Get(ingestPanelUrl, s.IngestionPanel)
 		r.Post(archiveIngestUrl, s.IngestArchive)
 
+		r.Get(rt.SliceUrl, s.Slice)
 		r.Post(rt.SliceUrl, s.Slice)
 	})
 }
@@ -37,12 +38,15 @@ func BaseAnnotateImageURLFunc(image im.Image) string {
 	return withQuery.String()
 }
 
-func MakeAnnotateImageURLFunc(image im.Image, filters, ordering string) ImageURLFunc {
+func MakeAnnotateImageURLFunc(image im.Image, filters, ordering, view string) ImageURLFunc {
 	return func(image im.Image) string {
 		base := MakeAnnotateImageURL(an.AnnotateImage, image.Id.String(), image.Collection.Name)
 		withQuery := rt.AddQueryParams(base,
 			rt.FilterQueryArgName, filters,
 			rt.OrderingQueryArgName, ordering)
+		if view != "" {
+			withQuery = rt.AddQueryParams(base, rt.ViewArgName, view)
+		}
 		return withQuery.String()
 	}
 }
@@ -7,8 +7,10 @@ import (
 	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
 	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
 	htmx "github.com/lejeunel/go-image-annotator/adapters/web/htmx"
-	im "github.com/lejeunel/go-image-annotator/entities/image"
+	pg "github.com/lejeunel/go-image-annotator/adapters/web/pagination"
+	vw "github.com/lejeunel/go-image-annotator/adapters/web/view"
 	rt "github.com/lejeunel/go-image-annotator/routes"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/list"
 )
 
@@ -16,33 +18,34 @@ type SlicePresenter struct {
 	b.PaginatedListBuilder
 	Writer http.ResponseWriter
 	htmx.ErrorPresenter
-	im.FilterStr
-	im.OrderStr
 }
 
-func NewSlicePresenter(
-	w http.ResponseWriter,
-	p b.PageBuilder,
-	filters im.FilterStr,
-	ordering im.OrderStr,
-) SlicePresenter {
+func NewSlicePresenter(w http.ResponseWriter, p b.PageBuilder) SlicePresenter {
 	p.SetTitle("Slice").SetHTMLTitle("Slice")
 	p.SetActiveSection(cmp.NoPageActive)
-	p.AddMarkdownPreamble(fmt.Sprintf(`
-**filters**: %v\
-**ordering**: %v
-`, filters, ordering))
 	b := b.NewPaginatedListBuilder(p, listImagesFields)
-	return SlicePresenter{b, w, htmx.NewErrorPresenter("querying", w), filters, ordering}
+	return SlicePresenter{b, w, htmx.NewErrorPresenter("querying", w)}
 }
 
 func (p SlicePresenter) SuccessListImages(r list.Response) {
+	p.AddMarkdownPreamble(fmt.Sprintf(`
+**filters**: %v\
+**ordering**: %v
+`, r.FilterStr, r.OrderStr))
 	baseURL := rt.AddQueryParams(rt.SliceUrl,
 		rt.FilterQueryArgName, r.FilterStr,
 		rt.OrderingQueryArgName, r.OrderStr)
+	if r.View != "" {
+		baseURL = rt.AddQueryParams(rt.SliceUrl, rt.ViewArgName, r.View)
+	} else {
+		saveURL := rt.AddQueryParams(vw.CreateViewFormUrl,
+			rt.FilterQueryArgName, r.FilterStr,
+			rt.OrderingQueryArgName, r.OrderStr)
+		p.AddCreationButton("Save as view", saveURL.String(), "save-view")
+	}
 	p.SetPagination(r.Pagination, baseURL.String())
 	for _, im := range r.Images {
-		p.AddRow(makeImageRow(im, MakeAnnotateImageURLFunc(im, p.FilterStr, p.OrderStr)))
+		p.AddRow(makeImageRow(im, MakeAnnotateImageURLFunc(im, r.FilterStr, r.OrderStr, r.View)))
 	}
 	p.Writer.Header().Set("HX-Push-Url", baseURL.String())
 
@@ -55,8 +58,11 @@ func (s *Server) Slice(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "bad form data", http.StatusBadRequest)
 		return
 	}
-	filters := r.FormValue(rt.FilterQueryArgName)
-	ordering := r.FormValue(rt.OrderingQueryArgName)
-	s.ListItr.Execute(list.Request{FilterStr: filters,
-		OrderStr: ordering}, NewSlicePresenter(w, s.PageBuilder, filters, ordering))
+	req := list.Request{
+		FilterStr:        r.FormValue(rt.FilterQueryArgName),
+		PaginationParams: pa.PaginationParams{Page: pg.GetPageFromRequest(r)},
+		OrderStr:         r.FormValue(rt.OrderingQueryArgName),
+		View:             r.FormValue(rt.ViewArgName),
+	}
+	s.ListItr.Execute(r.Context(), req, NewSlicePresenter(w, s.PageBuilder))
 }
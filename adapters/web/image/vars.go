@@ -1,9 +1,10 @@
 package image
 
 const (
-	ImageRow            = "/ui/image"
-	ingestTargetDiv     = "ingest"
-	ingestPanelUrl      = "/ui/image/ingest"
-	archiveIngestUrl    = "/ui/image/ingest-archive"
-	ingestFormInputName = "archive"
+	ImageRow              = "/ui/image"
+	ingestTargetDiv       = "ingest"
+	ingestPanelUrl        = "/ui/image/ingest"
+	archiveIngestUrl      = "/ui/image/ingest-archive"
+	ingestFormInputName   = "archive"
+	createLabelsInputName = "create-missing-labels"
 )
@@ -0,0 +1,64 @@
+package label
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// attributeJSON is how attribute definitions are typed in forms, e.g.
+// [{"name": "occluded", "type": "bool", "default": false}]
+type attributeJSON struct {
+	Name     string   `json:"name"`
+	Type     string   `json:"type"`
+	Required bool     `json:"required,omitempty"`
+	Default  any      `json:"default,omitempty"`
+	Options  []string `json:"options,omitempty"`
+}
+
+func attributesToJSON(defs []lbl.Attribute) string {
+	if len(defs) == 0 {
+		return ""
+	}
+	records := []attributeJSON{}
+	for _, d := range defs {
+		records = append(records, attributeJSON{Name: d.Name, Type: d.Type.String(),
+			Required: d.Required, Default: d.Default, Options: d.Options})
+	}
+	bytes, _ := json.Marshal(records)
+	return string(bytes)
+}
+
+func attributesFromForm(r *http.Request) ([]lbl.Attribute, error) {
+	str := strings.TrimSpace(r.FormValue(attributesFieldName))
+	if str == "" {
+		return nil, nil
+	}
+	records := []attributeJSON{}
+	if err := json.Unmarshal([]byte(str), &records); err != nil {
+		return nil, fmt.Errorf("parsing attributes: %v: %w", err, e.ErrValidation)
+	}
+	defs := []lbl.Attribute{}
+	for _, r := range records {
+		defs = append(defs, lbl.Attribute{Name: r.Name, Type: lbl.AttributeType(r.Type),
+			Required: r.Required, Default: r.Default, Options: r.Options})
+	}
+	return defs, nil
+}
+
+// describeAttributes lists attributes as name:type, starring required ones.
+func describeAttributes(defs []lbl.Attribute) string {
+	descs := []string{}
+	for _, d := range defs {
+		desc := d.Name + ":" + d.Type.String()
+		if d.Required {
+			desc += "*"
+		}
+		descs = append(descs, desc)
+	}
+	return strings.Join(descs, ", ")
+}
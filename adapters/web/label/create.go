@@ -3,6 +3,7 @@ package label
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	bf "github.com/lejeunel/go-image-annotator/adapters/web/builders/form"
 	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
@@ -33,9 +34,18 @@ func (s *Server) Create(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "bad form data", http.StatusBadRequest)
 		return
 	}
+	attributes, err := attributesFromForm(r)
+	if err != nil {
+		NewCreateLabelPresenter(w).Error(err)
+		return
+	}
 	s.CreateItr.Execute(r.Context(), create.Request{
 		Name:        r.FormValue(createNameFieldName),
 		Description: r.FormValue(createDescriptionFieldName),
+		Parent:      parentFromForm(r),
+		Color:       strings.TrimSpace(r.FormValue(colorFieldName)),
+		Aliases:     aliasesFromForm(r),
+		Attributes:  attributes,
 	}, NewCreateLabelPresenter(w))
 }
 
@@ -44,5 +54,21 @@ func (s *Server) CreateForm(w http.ResponseWriter, r *http.Request) {
 	b.AddTitle("Create a new label")
 	b.AddTextField(createNameFieldName, "Name", bf.WithRequired())
 	b.AddTextField(createDescriptionFieldName, "Description")
+	b.AddTextField(parentFieldName, "Parent")
+	b.AddTextField(colorFieldName, "Color (#rrggbb)")
+	b.AddTextField(aliasesFieldName, "Aliases (comma-separated)")
+	b.AddTextField(attributesFieldName, "Attributes (JSON)")
 	b.Render(w)
 }
+
+func parentFromForm(r *http.Request) *string {
+	parent := strings.TrimSpace(r.FormValue(parentFieldName))
+	if parent == "" {
+		return nil
+	}
+	return &parent
+}
+
+func aliasesFromForm(r *http.Request) []string {
+	return strings.Split(r.FormValue(aliasesFieldName), ",")
+}
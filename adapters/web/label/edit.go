@@ -2,6 +2,7 @@ package label
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/update"
@@ -31,11 +32,25 @@ func (s *Server) Edit(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "bad form data", http.StatusBadRequest)
 		return
 	}
+	attributes, err := attributesFromForm(r)
+	if err != nil {
+		NewEditLabelPresenter(w).Error(err)
+		return
+	}
 
+	// the form holds every field but the skeleton, which is left unchanged
+	description := r.FormValue(createDescriptionFieldName)
+	parent := strings.TrimSpace(r.FormValue(parentFieldName))
+	color := strings.TrimSpace(r.FormValue(colorFieldName))
+	aliases := aliasesFromForm(r)
 	s.UpdateItr.Execute(r.Context(),
 		update.Request{
 			Name:           r.URL.Query().Get(resourceUrlFieldName),
-			NewDescription: r.FormValue("description"),
+			NewDescription: &description,
+			NewParent:      &parent,
+			NewColor:       &color,
+			NewAliases:     &aliases,
+			NewAttributes:  &attributes,
 		},
 		NewEditLabelPresenter(w))
 }
@@ -4,19 +4,22 @@ import (
 	_ "embed"
 	"io"
 	"net/http"
+	"strings"
 
 	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
 	bf "github.com/lejeunel/go-image-annotator/adapters/web/builders/form"
 	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
 	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
 	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	ic "github.com/lejeunel/go-image-annotator/adapters/web/icons"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	rt "github.com/lejeunel/go-image-annotator/routes"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/list"
 	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
 )
 
-var listLabelsFields = []string{"name", "description", "actions"}
+var listLabelsFields = []string{"name", "parent", "color", "aliases", "attributes", "description", "actions"}
 
 type ListPresenter struct {
 	b.PaginatedListBuilder
@@ -39,6 +42,7 @@ func (p ListPresenter) SuccessListLabels(r list.Response) {
 	}
 
 	p.AddCreationButton("Create", CreateLabelFormUrl, createLabelTargetDiv)
+	p.SetHeader(Div(Class("py-2"), cmp.MakeTextLink(LabelTreeUrl, "Show taxonomy")))
 	p.PaginatedListBuilder.AddMarkdownPreamble(preamble)
 	p.Render(p.Writer)
 }
@@ -70,7 +74,15 @@ func NewEditPresenter(w http.ResponseWriter, u b.RowURL) EditPresenter {
 func (p EditPresenter) SuccessFindLabel(l lbl.Label) {
 	b := bf.NewHTMXInlineFormBuilder(len(listLabelsFields), p.Url)
 	b.SetResourceName(l.Name)
-	b.AddTextField("description", "Description", bf.WithDefault(l.Description))
+	parent := ""
+	if l.Parent != nil {
+		parent = *l.Parent
+	}
+	b.AddTextField(createDescriptionFieldName, "Description", bf.WithDefault(l.Description))
+	b.AddTextField(parentFieldName, "Parent", bf.WithDefault(parent))
+	b.AddTextField(colorFieldName, "Color", bf.WithDefault(l.Color))
+	b.AddTextField(aliasesFieldName, "Aliases", bf.WithDefault(strings.Join(l.Aliases, ", ")))
+	b.AddTextField(attributesFieldName, "Attributes", bf.WithDefault(attributesToJSON(l.Attributes)))
 	b.Render(p.Writer)
 }
 
@@ -95,8 +107,23 @@ func MakeRow(u b.RowURL, l lbl.Label) tb.Row {
 	actions.SetEdit(u.SetMode(b.ModeEdit).Url)
 	actions.SetConfirmDelete(u.SetMode(b.ModeConfirmDelete).Url)
 	row := tb.NewRow()
+	parent := ""
+	if l.Parent != nil {
+		parent = *l.Parent
+	}
 	row.AddCell(tb.NewCell(Text(l.Name)))
+	row.AddCell(tb.NewCell(Text(parent)))
+	row.AddCell(tb.NewCell(colorSwatch(l.Color)))
+	row.AddCell(tb.NewCell(Text(strings.Join(l.Aliases, ", "))))
+	row.AddCell(tb.NewCell(Text(describeAttributes(l.Attributes))))
 	row.AddCell(tb.NewCell(Text(l.Description)))
 	row.AddCell(tb.NewCell(actions.Build()))
 	return row
 }
+
+func colorSwatch(color string) Node {
+	if color == "" {
+		return Text("")
+	}
+	return Div(Class("flex items-center gap-1"), Raw(ic.MakeColoredRectangleIcon(color)), Text(color))
+}
@@ -13,6 +13,7 @@ func (s *Server) Route(r chi.Router,
 	r.Group(func(r chi.Router) {
 		r.Use(mws...)
 		r.Get(rt.LabelsUrl, s.List)
+		r.Get(LabelTreeUrl, s.Tree)
 		r.Get(LabelUrl, s.TableRow)
 		r.Post(LabelUrl, s.Create)
 		r.Delete(LabelUrl, s.Delete)
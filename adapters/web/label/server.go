@@ -6,6 +6,7 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/label/delete"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/tree"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/update"
 )
 
@@ -18,6 +19,7 @@ type Server struct {
 	UpdateItr       update.Interactor
 	DeleteItr       delete.Interactor
 	FindItr         find.Interactor
+	TreeItr         tree.Interactor
 }
 
 func New(
@@ -28,6 +30,7 @@ func New(
 	u update.Interactor,
 	d delete.Interactor,
 	f find.Interactor,
+	t tree.Interactor,
 ) Server {
 	return Server{
 		pb,
@@ -38,5 +41,6 @@ func New(
 		u,
 		d,
 		f,
+		t,
 	}
 }
@@ -0,0 +1,67 @@
+package label
+
+import (
+	_ "embed"
+	"io"
+	"net/http"
+	"strings"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/tree"
+	. "maragu.dev/gomponents"
+	. "maragu.dev/gomponents/html"
+)
+
+//go:embed tree-preamble.md
+var treePreamble string
+
+type TreePresenter struct {
+	b.PageBuilder
+	io.Writer
+	e.ErrorPresenter
+}
+
+func NewTreePresenter(w http.ResponseWriter, p b.PageBuilder) TreePresenter {
+	p.SetTitle("Label taxonomy").SetHTMLTitle("Label taxonomy").SetActiveSection(cmp.LabelsPageActive)
+	return TreePresenter{p, w, e.NewErrorPresenter(w)}
+}
+
+func (p TreePresenter) SuccessLabelTree(r tree.Response) {
+	p.AddMarkdownPreamble(treePreamble)
+	p.SetContent(Div(
+		Div(Class("py-2"), cmp.MakeTextLink(rt.LabelsUrl, "Back to labels")),
+		treeNodes(r.Roots),
+	))
+	p.Render(p.Writer)
+}
+
+func treeNodes(nodes []lbl.Node) Node {
+	if len(nodes) == 0 {
+		return nil
+	}
+	return Ul(Class("ml-4 border-l border-outline pl-4 dark:border-outline-dark"),
+		Map(nodes, func(n lbl.Node) Node {
+			var aliases Node
+			if len(n.Aliases) > 0 {
+				aliases = Span(Class("text-sm opacity-70"), Text("("+strings.Join(n.Aliases, ", ")+")"))
+			}
+			return Li(Class("py-1"),
+				Div(Class("flex items-center gap-2"),
+					colorSwatch(n.Color),
+					Span(Class("font-bold"), Text(n.Name)),
+					aliases,
+					Span(Class("text-sm"), Text(n.Description)),
+				),
+				treeNodes(n.Children),
+			)
+		}))
+}
+
+func (s *Server) Tree(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.TreeItr.Execute(r.Context(), NewTreePresenter(w, s.PageBuilder))
+}
@@ -4,7 +4,12 @@ const (
 	createLabelTargetDiv       = "create-label"
 	createNameFieldName        = "name"
 	createDescriptionFieldName = "description"
+	parentFieldName            = "parent"
+	colorFieldName             = "color"
+	aliasesFieldName           = "aliases"
+	attributesFieldName        = "attributes"
 	LabelUrl                   = "/ui/label"
 	CreateLabelFormUrl         = "/ui/label/new"
+	LabelTreeUrl               = "/labels/tree"
 	resourceUrlFieldName       = "name"
 )
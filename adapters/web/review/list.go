@@ -0,0 +1,36 @@
+package review
+
+import (
+	_ "embed"
+	"net/http"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	pg "github.com/lejeunel/go-image-annotator/adapters/web/pagination"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/queue"
+)
+
+//go:embed preamble.md
+var preamble string
+
+// TableRow renders the review form of a submission. Since the queue is
+// ordered, aborting the review reloads the whole list.
+func (s *Server) TableRow(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get(resourceUrlFieldName)
+	s.RowURL.SetId(id)
+	switch r.URL.Query().Get("mode") {
+	case b.ModeEdit.String():
+		RenderReviewForm(w, s.RowURL, id)
+	default:
+		w.Header().Set("HX-Refresh", "true")
+	}
+}
+
+func (s *Server) List(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.Interactors.Queue.Execute(r.Context(),
+		queue.Request{
+			PaginationParams: pag.PaginationParams{PageSize: s.DefaultPageSize, Page: pg.GetPageFromRequest(r)},
+		},
+		NewListPresenter(w, s.PageBuilder, s.RowURL))
+}
@@ -0,0 +1,86 @@
+package review
+
+import (
+	"io"
+	"net/http"
+
+	wan "github.com/lejeunel/go-image-annotator/adapters/web/annotator"
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	bf "github.com/lejeunel/go-image-annotator/adapters/web/builders/form"
+	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	uuid "github.com/lejeunel/go-image-annotator/shared/uuid"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/queue"
+	. "maragu.dev/gomponents"
+)
+
+var listSubmissionsFields = []string{"image", "collection", "type", "label", "author", "submitted", "actions"}
+
+type ListPresenter struct {
+	b.PaginatedListBuilder
+	b.RowURL
+	io.Writer
+	e.ErrorPresenter
+}
+
+func NewListPresenter(w http.ResponseWriter, p b.PageBuilder, u b.RowURL) ListPresenter {
+	p.SetTitle("Reviews").SetHTMLTitle("Reviews").SetActiveSection(cmp.ReviewsPageActive)
+	pb := b.NewPaginatedListBuilder(p, listSubmissionsFields)
+	return ListPresenter{pb, u, w, e.NewErrorPresenter(w)}
+}
+
+func (p ListPresenter) SuccessListQueue(r queue.Response) {
+	p.SetPagination(r.Pagination, rt.ReviewsUrl)
+	for _, s := range r.Submissions {
+		p.AddRow(MakeRow(p.RowURL, s))
+	}
+
+	p.PaginatedListBuilder.AddMarkdownPreamble(preamble)
+	p.Render(p.Writer)
+}
+
+func RenderReviewForm(w io.Writer, u b.RowURL, id string) {
+	f := bf.NewHTMXInlineFormBuilder(len(listSubmissionsFields), u.Url)
+	f.SetResourceName(id)
+	cb := f.AddCombobox("Decision", decisionFieldName, a.Accepted.String())
+	cb.AddField(a.Accepted.String())
+	cb.AddField(a.Rejected.String())
+	f.AddTextField(commentFieldName, "Comment")
+	f.Render(w)
+}
+
+func MakeAnnotateURL(s rv.Submission) string {
+	u := rt.AddQueryParams(wan.AnnotateImage,
+		"id", s.ImageId.String(),
+		"collection", s.Collection,
+		rt.FilterQueryArgName, `review_status:"submitted"`,
+		rt.OrderingQueryArgName, "ingested_at")
+	return u.String()
+}
+
+func MakeRow(u b.RowURL, s rv.Submission) tb.Row {
+	u.SetId(s.AnnotationId.String())
+	actions := b.NewActionsPanelBuilder()
+	actions.SetEdit(u.SetMode(b.ModeEdit).Url)
+	author := "anonymous"
+	if s.Author != nil {
+		author = *s.Author
+	}
+	var submitted string
+	if s.Time != nil {
+		submitted = cmp.DateTimeToStr(*s.Time)
+	}
+	row := tb.NewRow()
+	row.AddCell(tb.NewCell(cmp.MakeTextLink(MakeAnnotateURL(s), uuid.ShortenUUID(s.ImageId.String()))))
+	row.AddCell(tb.NewCell(Text(s.Collection)))
+	row.AddCell(tb.NewCell(Text(s.Type)))
+	row.AddCell(tb.NewCell(Text(s.Label)))
+	row.AddCell(tb.NewCell(Text(author)))
+	row.AddCell(tb.NewCell(Text(submitted)))
+	row.AddCell(tb.NewCell(actions.Build()))
+	return row
+}
@@ -0,0 +1,40 @@
+package review
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/review"
+)
+
+type ReviewPresenter struct {
+	writer http.ResponseWriter
+	task   string
+	htmx.ErrorPresenter
+}
+
+func NewReviewPresenter(w http.ResponseWriter) ReviewPresenter {
+	task := "Reviewing annotation"
+	return ReviewPresenter{w, task, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p ReviewPresenter) SuccessReview(r review.Response) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task,
+		fmt.Sprintf("Annotation %v is %v", r.AnnotationId, r.Review.Status))
+}
+
+func (s *Server) Review(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "bad form data", http.StatusBadRequest)
+		return
+	}
+
+	s.Interactors.Review.Execute(r.Context(),
+		review.Request{
+			AnnotationId: r.URL.Query().Get(resourceUrlFieldName),
+			Status:       r.FormValue(decisionFieldName),
+			Comment:      r.FormValue(commentFieldName),
+		},
+		NewReviewPresenter(w))
+}
@@ -0,0 +1,19 @@
+package review
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+)
+
+func (s *Server) Route(r chi.Router,
+	mws ...func(http.Handler) http.Handler,
+) {
+	r.Group(func(r chi.Router) {
+		r.Use(mws...)
+		r.Get(rt.ReviewsUrl, s.List)
+		r.Get(ReviewUrl, s.TableRow)
+		r.Put(ReviewUrl, s.Review)
+	})
+}
@@ -0,0 +1,22 @@
+package review
+
+import (
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	rv "github.com/lejeunel/go-image-annotator/use-cases/review"
+)
+
+type Server struct {
+	b.PageBuilder
+	b.RowURL
+	DefaultPageSize int
+	rv.Interactors
+}
+
+func New(pb b.PageBuilder, defaultPageSize int, itrs rv.Interactors) Server {
+	return Server{
+		pb,
+		b.NewRowURLWithId(ReviewUrl, resourceUrlFieldName),
+		defaultPageSize,
+		itrs,
+	}
+}
@@ -0,0 +1,8 @@
+package review
+
+const (
+	ReviewUrl            = "/ui/review"
+	decisionFieldName    = "status"
+	commentFieldName     = "comment"
+	resourceUrlFieldName = "id"
+)
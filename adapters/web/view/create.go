@@ -0,0 +1,69 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	bf "github.com/lejeunel/go-image-annotator/adapters/web/builders/form"
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/create"
+)
+
+type CreateViewPresenter struct {
+	writer        http.ResponseWriter
+	task          string
+	okMessageFunc func(create.Response) string
+	htmx.ErrorPresenter
+}
+
+func NewCreateViewPresenter(w http.ResponseWriter) CreateViewPresenter {
+	task := "Saving view"
+	okMessageFunc := func(r create.Response) string {
+		return fmt.Sprintf("Successfully saved view %v", r.View.Name)
+	}
+	return CreateViewPresenter{w, task, okMessageFunc, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p CreateViewPresenter) SuccessCreateView(r create.Response) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task, p.okMessageFunc(r))
+}
+
+// parseGroups splits a comma-separated list of group names.
+func parseGroups(s string) []string {
+	groups := []string{}
+	for _, g := range strings.Split(s, ",") {
+		if g = strings.TrimSpace(g); g != "" {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+func (s *Server) Create(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "bad form data", http.StatusBadRequest)
+		return
+	}
+	s.Interactors.Create.Execute(r.Context(), create.Request{
+		Name:   r.FormValue(nameFieldName),
+		Filter: r.FormValue(rt.FilterQueryArgName),
+		Order:  r.FormValue(rt.OrderingQueryArgName),
+		Groups: parseGroups(r.FormValue(groupsFieldName)),
+	}, NewCreateViewPresenter(w))
+}
+
+// CreateForm renders the form to save a view, pre-filled with the filtering
+// and ordering expressions found in the query, if any.
+func (s *Server) CreateForm(w http.ResponseWriter, r *http.Request) {
+	b := bf.NewHTMXCreateFormBuilder(ViewUrl, createViewTargetDiv)
+	b.AddTitle("Save a new view")
+	b.AddTextField(nameFieldName, "Name", bf.WithRequired())
+	b.AddTextField(rt.FilterQueryArgName, "Filters",
+		bf.WithDefault(r.URL.Query().Get(rt.FilterQueryArgName)))
+	b.AddTextField(rt.OrderingQueryArgName, "Ordering",
+		bf.WithDefault(r.URL.Query().Get(rt.OrderingQueryArgName)))
+	b.AddTextField(groupsFieldName, "Shared with groups (comma-separated)")
+	b.Render(w)
+}
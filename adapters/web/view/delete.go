@@ -0,0 +1,33 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+)
+
+type DeleteViewPresenter struct {
+	writer        http.ResponseWriter
+	task          string
+	okMessageFunc func(string) string
+	htmx.ErrorPresenter
+}
+
+func NewDeleteViewPresenter(w http.ResponseWriter) DeleteViewPresenter {
+	task := "Deleting view"
+	okMessageFunc := func(name string) string {
+		return fmt.Sprintf("Successfully deleted view %v", name)
+	}
+	return DeleteViewPresenter{w, task, okMessageFunc, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p DeleteViewPresenter) SuccessDeleteView(name string) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task, p.okMessageFunc(name))
+}
+
+func (s *Server) Delete(w http.ResponseWriter, r *http.Request) {
+	s.Interactors.Delete.Execute(r.Context(),
+		r.URL.Query().Get(resourceUrlFieldName),
+		NewDeleteViewPresenter(w))
+}
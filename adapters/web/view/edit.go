@@ -0,0 +1,45 @@
+package view
+
+import (
+	"net/http"
+
+	"github.com/lejeunel/go-image-annotator/adapters/web/htmx"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/update"
+)
+
+type EditViewPresenter struct {
+	writer        http.ResponseWriter
+	task          string
+	okMessageFunc func(update.Response) string
+	htmx.ErrorPresenter
+}
+
+func NewEditViewPresenter(w http.ResponseWriter) EditViewPresenter {
+	task := "Updating view"
+	okMessageFunc := func(r update.Response) string {
+		return "Successfully updated view"
+	}
+	return EditViewPresenter{w, task, okMessageFunc, htmx.NewErrorPresenter(task, w)}
+}
+
+func (p EditViewPresenter) SuccessUpdateView(r update.Response) {
+	htmx.NotifySuccessPayloadAndReload(p.writer, p.task, p.okMessageFunc(r))
+}
+
+func (s *Server) Edit(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "bad form data", http.StatusBadRequest)
+		return
+	}
+
+	s.Interactors.Update.Execute(r.Context(),
+		update.Request{
+			Id:     r.URL.Query().Get(resourceUrlFieldName),
+			Name:   r.FormValue(nameFieldName),
+			Filter: r.FormValue(rt.FilterQueryArgName),
+			Order:  r.FormValue(rt.OrderingQueryArgName),
+			Groups: parseGroups(r.FormValue(groupsFieldName)),
+		},
+		NewEditViewPresenter(w))
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	_ "embed"
+	"net/http"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	pg "github.com/lejeunel/go-image-annotator/adapters/web/pagination"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+//go:embed preamble.md
+var preamble string
+
+func (s *Server) TableRow(w http.ResponseWriter, r *http.Request) {
+	id := r.URL.Query().Get(resourceUrlFieldName)
+	s.RowURL.SetId(id)
+	switch r.URL.Query().Get("mode") {
+	case b.ModeEdit.String():
+		s.Find.Execute(r.Context(), id, NewEditPresenter(w, s.RowURL))
+	case b.ModeConfirmDelete.String():
+		s.Find.Execute(r.Context(), id, NewDeletePresenter(w, s.RowURL))
+	default:
+		s.Find.Execute(r.Context(), id, NewViewPresenter(w, s.RowURL))
+	}
+}
+
+func (s *Server) List(w http.ResponseWriter, r *http.Request) {
+	s.PageBuilder.SetUserIdentity(r.Context())
+	s.Interactors.List.Execute(r.Context(),
+		pag.PaginationParams{PageSize: s.DefaultPageSize, Page: pg.GetPageFromRequest(r)},
+		NewListPresenter(w, s.PageBuilder, s.RowURL))
+}
@@ -0,0 +1,112 @@
+package view
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	bf "github.com/lejeunel/go-image-annotator/adapters/web/builders/form"
+	tb "github.com/lejeunel/go-image-annotator/adapters/web/builders/table"
+	cmp "github.com/lejeunel/go-image-annotator/adapters/web/components"
+	e "github.com/lejeunel/go-image-annotator/adapters/web/error"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/list"
+	. "maragu.dev/gomponents"
+)
+
+var listViewsFields = []string{"name", "filters", "ordering", "groups", "owner", "actions"}
+
+type ListPresenter struct {
+	b.PaginatedListBuilder
+	b.RowURL
+	io.Writer
+	e.ErrorPresenter
+}
+
+func NewListPresenter(w http.ResponseWriter, p b.PageBuilder, u b.RowURL) ListPresenter {
+	p.SetTitle("Views").SetHTMLTitle("Views").SetActiveSection(cmp.ViewsPageActive)
+	pb := b.NewPaginatedListBuilder(p, listViewsFields)
+	return ListPresenter{pb, u, w, e.NewErrorPresenter(w)}
+}
+
+func (p ListPresenter) SuccessListViews(r list.Response) {
+	p.SetPagination(r.Pagination, rt.ViewsUrl)
+	for _, v := range r.Views {
+		p.AddRow(MakeRow(p.RowURL, v))
+	}
+
+	p.AddCreationButton("Create", CreateViewFormUrl, createViewTargetDiv)
+	p.PaginatedListBuilder.AddMarkdownPreamble(preamble)
+	p.Render(p.Writer)
+}
+
+type ViewPresenter struct {
+	io.Writer
+	b.RowURL
+	e.ErrorPresenter
+}
+
+func NewViewPresenter(w http.ResponseWriter, u b.RowURL) ViewPresenter {
+	return ViewPresenter{w, u, e.NewErrorPresenter(w)}
+}
+
+func (p ViewPresenter) SuccessFindView(v vw.View) {
+	MakeRow(p.RowURL, v).Render(p.Writer)
+}
+
+type EditPresenter struct {
+	io.Writer
+	b.RowURL
+	e.ErrorPresenter
+}
+
+func NewEditPresenter(w http.ResponseWriter, u b.RowURL) EditPresenter {
+	return EditPresenter{w, u, e.NewErrorPresenter(w)}
+}
+
+func (p EditPresenter) SuccessFindView(v vw.View) {
+	b := bf.NewHTMXInlineFormBuilder(len(listViewsFields), p.Url)
+	b.SetResourceName(v.Name)
+	b.AddTextField(nameFieldName, "Name", bf.WithDefault(v.Name))
+	b.AddTextField(rt.FilterQueryArgName, "Filters", bf.WithDefault(v.Filter))
+	b.AddTextField(rt.OrderingQueryArgName, "Ordering", bf.WithDefault(v.Order))
+	b.AddTextField(groupsFieldName, "Groups", bf.WithDefault(strings.Join(v.Groups, ", ")))
+	b.Render(p.Writer)
+}
+
+type DeletePresenter struct {
+	io.Writer
+	b.RowURL
+	e.ErrorPresenter
+}
+
+func NewDeletePresenter(w http.ResponseWriter, u b.RowURL) DeletePresenter {
+	return DeletePresenter{w, u, e.NewErrorPresenter(w)}
+}
+
+func (p DeletePresenter) SuccessFindView(v vw.View) {
+	b.RenderConfirmDeleteRow(len(listViewsFields),
+		v.Name, "view", p.Url, p.Writer)
+}
+
+func MakeSliceURL(v vw.View) string {
+	u := rt.AddQueryParams(rt.SliceUrl, rt.ViewArgName, v.Id.String())
+	return u.String()
+}
+
+func MakeRow(u b.RowURL, v vw.View) tb.Row {
+	u.SetId(v.Id.String())
+	actions := b.NewActionsPanelBuilder()
+	actions.SetEdit(u.SetMode(b.ModeEdit).Url)
+	actions.SetConfirmDelete(u.SetMode(b.ModeConfirmDelete).Url)
+	row := tb.NewRow()
+	row.AddCell(tb.NewCell(cmp.MakeTextLink(MakeSliceURL(v), v.Name)))
+	row.AddCell(tb.NewCell(Text(v.Filter)))
+	row.AddCell(tb.NewCell(Text(v.Order)))
+	row.AddCell(tb.NewCell(Text(strings.Join(v.Groups, ", "))))
+	row.AddCell(tb.NewCell(Text(v.Owner)))
+	row.AddCell(tb.NewCell(actions.Build()))
+	return row
+}
@@ -0,0 +1,22 @@
+package view
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	rt "github.com/lejeunel/go-image-annotator/routes"
+)
+
+func (s *Server) Route(r chi.Router,
+	mws ...func(http.Handler) http.Handler,
+) {
+	r.Group(func(r chi.Router) {
+		r.Use(mws...)
+		r.Get(rt.ViewsUrl, s.List)
+		r.Get(ViewUrl, s.TableRow)
+		r.Post(ViewUrl, s.Create)
+		r.Delete(ViewUrl, s.Delete)
+		r.Put(ViewUrl, s.Edit)
+		r.Get(CreateViewFormUrl, s.CreateForm)
+	})
+}
@@ -0,0 +1,22 @@
+package view
+
+import (
+	b "github.com/lejeunel/go-image-annotator/adapters/web/builders"
+	vw "github.com/lejeunel/go-image-annotator/use-cases/view"
+)
+
+type Server struct {
+	b.PageBuilder
+	b.RowURL
+	DefaultPageSize int
+	vw.Interactors
+}
+
+func New(pb b.PageBuilder, defaultPageSize int, itrs vw.Interactors) Server {
+	return Server{
+		pb,
+		b.NewRowURLWithId(ViewUrl, resourceUrlFieldName),
+		defaultPageSize,
+		itrs,
+	}
+}
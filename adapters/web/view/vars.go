@@ -0,0 +1,10 @@
+package view
+
+const (
+	createViewTargetDiv  = "create-view"
+	nameFieldName        = "name"
+	groupsFieldName      = "groups"
+	ViewUrl              = "/ui/view"
+	CreateViewFormUrl    = "/ui/view/new"
+	resourceUrlFieldName = "id"
+)
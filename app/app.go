@@ -12,17 +12,25 @@ import (
 	bst "github.com/lejeunel/go-image-annotator/use-cases/bootstrap"
 )
 
+// JobRunner runs the background tasks submitted by interactors.
+type JobRunner interface {
+	Start() error
+	Shutdown(context.Context) error
+}
+
 type App struct {
 	Itrs itrs.Interactors
 	s.SessionManager
 	a.Annotator
+	Jobs JobRunner
 }
 
-func NewApp(itrs itrs.Interactors, sm s.SessionManager, an a.Annotator) App {
+func NewApp(itrs itrs.Interactors, sm s.SessionManager, an a.Annotator, jobs JobRunner) App {
 	return App{
 		Itrs:           itrs,
 		SessionManager: sm,
 		Annotator:      an,
+		Jobs:           jobs,
 	}
 }
 
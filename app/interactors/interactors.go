@@ -1,17 +1,23 @@
 package interactors
 
 import (
+	agr "github.com/lejeunel/go-image-annotator/use-cases/agreement"
 	an "github.com/lejeunel/go-image-annotator/use-cases/annotate"
+	as "github.com/lejeunel/go-image-annotator/use-cases/assignment"
+	au "github.com/lejeunel/go-image-annotator/use-cases/audit"
 	bst "github.com/lejeunel/go-image-annotator/use-cases/bootstrap"
 	clc "github.com/lejeunel/go-image-annotator/use-cases/collection"
+	evl "github.com/lejeunel/go-image-annotator/use-cases/evaluation"
 	grp "github.com/lejeunel/go-image-annotator/use-cases/group"
 	im "github.com/lejeunel/go-image-annotator/use-cases/image"
 	lbl "github.com/lejeunel/go-image-annotator/use-cases/label"
 	lg "github.com/lejeunel/go-image-annotator/use-cases/log"
 	md "github.com/lejeunel/go-image-annotator/use-cases/metadata"
 	pl "github.com/lejeunel/go-image-annotator/use-cases/policy"
+	rv "github.com/lejeunel/go-image-annotator/use-cases/review"
 	rl "github.com/lejeunel/go-image-annotator/use-cases/role"
 	usr "github.com/lejeunel/go-image-annotator/use-cases/user"
+	vw "github.com/lejeunel/go-image-annotator/use-cases/view"
 )
 
 type Interactors struct {
@@ -26,4 +32,10 @@ type Interactors struct {
 	Policy     pl.Interactors
 	Metadata   md.Interactors
 	Log        lg.Interactors
+	View       vw.Interactors
+	Review     rv.Interactors
+	Assignment as.Interactors
+	Audit      au.Interactors
+	Evaluation evl.Interactors
+	Agreement  agr.Interactors
 }
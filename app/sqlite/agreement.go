@@ -0,0 +1,44 @@
+package sqlite
+
+import (
+	"log/slog"
+
+	infra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/agreement"
+	anrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
+	clrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
+	grrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
+	imrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
+	agm "github.com/lejeunel/go-image-annotator/modules/agreement"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	ag "github.com/lejeunel/go-image-annotator/use-cases/agreement"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/analyze"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/list"
+)
+
+func NewAgreementInteractors(
+	repo infra.AgreementRepo,
+	collections clrepo.CollectionRepo,
+	groups grrepo.GroupRepo,
+	fv analyze.FilterValidator,
+	images imrepo.ImageRepo,
+	annotations anrepo.AnnotationRepo,
+	analyzer agm.Analyzer,
+	events el.IEventLogger,
+	logger slog.Logger,
+	jobs jq.JobQueue,
+	defaultPageSize int,
+	maxPageSize int,
+	auth auth.Interface,
+	audit al.AuditLogger,
+) ag.Interactors {
+	return ag.Interactors{
+		Analyze: analyze.New(collections, groups, fv, images, annotations, repo, analyzer, events, logger, jobs,
+			analyze.WithAuth(auth), analyze.WithAudit(audit)),
+		Find: find.New(repo),
+		List: list.New(repo, defaultPageSize, maxPageSize),
+	}
+}
@@ -2,17 +2,33 @@ package sqlite
 
 import (
 	anr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
+	clrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
 	imr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
 	lbr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	ims "github.com/lejeunel/go-image-annotator/modules/image-store"
 	an "github.com/lejeunel/go-image-annotator/use-cases/annotate"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
 	addbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-bbox"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
+	addmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-mask"
 	addpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polygon"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
 	addlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/assign-label"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/history"
+	imppred "github.com/lejeunel/go-image-annotator/use-cases/annotate/import-predictions"
+	mask2poly "github.com/lejeunel/go-image-annotator/use-cases/annotate/mask-to-polygons"
 	updbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-bbox"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
+	updmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-mask"
 	updpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polygon"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
+	paint "github.com/lejeunel/go-image-annotator/use-cases/annotate/paint-mask"
+	poly2mask "github.com/lejeunel/go-image-annotator/use-cases/annotate/polygon-to-mask"
 	remano "github.com/lejeunel/go-image-annotator/use-cases/annotate/remove"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
+	setattr "github.com/lejeunel/go-image-annotator/use-cases/annotate/set-attributes"
 	updlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/update-label"
 )
 
@@ -20,15 +36,33 @@ func NewAnnotationInteractors(ims ims.ImageStore,
 	imr imr.ImageRepo,
 	lbr lbr.LabelRepo,
 	anr anr.AnnotationRepo,
+	clr clrepo.CollectionRepo,
+	pt imppred.Transactor,
 	auth auth.Interface,
+	audit al.AuditLogger,
 ) an.Interactors {
 	return an.Interactors{
-		AddPolygon:    addpoly.New(ims, anr, lbr, addpoly.WithAuth(auth)),
-		UpdatePolygon: updpoly.New(anr, lbr, updpoly.WithAuth(auth)),
-		AddBox:        addbox.New(ims, anr, lbr, addbox.WithAuth(auth)),
-		UpdateBox:     updbox.New(anr, lbr, updbox.WithAuth(auth)),
-		Delete:        remano.New(anr, remano.WithAuth(auth)),
-		UpdateLabel:   updlbl.New(anr, lbr, updlbl.WithAuth(auth)),
-		AddImageLabel: addlbl.New(anr, lbr, ims, addlbl.WithAuth(auth)),
+		AddPolygon:      addpoly.New(ims, anr, lbr, addpoly.WithAuth(auth), addpoly.WithAudit(audit)),
+		UpdatePolygon:   updpoly.New(anr, lbr, updpoly.WithAuth(auth), updpoly.WithAudit(audit)),
+		AddPolyline:     addline.New(ims, anr, lbr, addline.WithAuth(auth), addline.WithAudit(audit)),
+		UpdatePolyline:  updline.New(anr, lbr, updline.WithAuth(auth), updline.WithAudit(audit)),
+		AddKeypoints:    addkp.New(ims, anr, lbr, addkp.WithAuth(auth), addkp.WithAudit(audit)),
+		UpdateKeypoints: updkp.New(anr, lbr, updkp.WithAuth(auth), updkp.WithAudit(audit)),
+		AddMask:         addmask.New(ims, anr, lbr, addmask.WithAuth(auth), addmask.WithAudit(audit)),
+		UpdateMask:      updmask.New(anr, lbr, updmask.WithAuth(auth), updmask.WithAudit(audit)),
+		PaintMask:       paint.New(anr, paint.WithAuth(auth), paint.WithAudit(audit)),
+		PolygonToMask:   poly2mask.New(ims, anr, poly2mask.WithAuth(auth), poly2mask.WithAudit(audit)),
+		MaskToPolygons:  mask2poly.New(ims, anr, mask2poly.WithAuth(auth), mask2poly.WithAudit(audit)),
+		AddBox:          addbox.New(ims, anr, lbr, addbox.WithAuth(auth), addbox.WithAudit(audit)),
+		UpdateBox:       updbox.New(anr, lbr, updbox.WithAuth(auth), updbox.WithAudit(audit)),
+		Delete:          remano.New(anr, remano.WithAuth(auth), remano.WithAudit(audit)),
+		UpdateLabel:     updlbl.New(anr, lbr, updlbl.WithAuth(auth), updlbl.WithAudit(audit)),
+		SetAttributes:   setattr.New(anr, setattr.WithAuth(auth), setattr.WithAudit(audit)),
+		AddImageLabel:   addlbl.New(anr, lbr, ims, addlbl.WithAuth(auth), addlbl.WithAudit(audit)),
+		History:         history.New(anr, history.WithAuth(auth)),
+		Revert:          revert.New(anr, revert.WithAuth(auth), revert.WithAudit(audit)),
+		ImportPredictions: imppred.New(pt, ims, lbr, clr,
+			imppred.WithAuth(auth), imppred.WithAudit(audit)),
+		AcceptPrediction: accpred.New(anr, accpred.WithAuth(auth), accpred.WithAudit(audit)),
 	}
 }
@@ -12,9 +12,11 @@ import (
 
 func NewApp(cfg config.Config, auth auth.Interface, logger slog.Logger) app.App {
 
-	infra := BuildInfra(cfg.ArtefactPath)
+	infra := BuildInfra(cfg)
 	apiTokenGen := tk.New(cfg.ApiTokenLength)
-	itrs := BuildInteractors(infra, auth, logger, cfg, apiTokenGen)
+	jobs := NewJobQueue(infra, logger, cfg)
+	itrs := BuildInteractors(infra, auth, logger, cfg, apiTokenGen, jobs)
+	RegisterJobHandlers(jobs, itrs)
 	sessionManager := NewSessionManager(infra.DB.DB, infra.UserRepo, apiTokenGen)
 
 	annotator := a.NewAnnotator(itrs.Image.Scroll, itrs.Image.Find,
@@ -26,5 +28,5 @@ func NewApp(cfg config.Config, auth auth.Interface, logger slog.Logger) app.App
 		itrs.Metadata.Read, itrs.Metadata.Delete,
 	)
 
-	return app.NewApp(itrs, sessionManager, annotator)
+	return app.NewApp(itrs, sessionManager, annotator, jobs)
 }
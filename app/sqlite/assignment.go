@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	infra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/assignment"
+	grrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
+	imrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
+	usrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	as "github.com/lejeunel/go-image-annotator/use-cases/assignment"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/delete"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/finish"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/mine"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/next"
+)
+
+func NewAssignmentInteractors(
+	repo infra.AssignmentRepo,
+	images imrepo.ImageRepo,
+	users usrepo.UserRepo,
+	groups grrepo.GroupRepo,
+	fv create.FilterValidator,
+	defaultPageSize int,
+	maxPageSize int,
+	auth auth.Interface,
+	audit al.AuditLogger,
+) as.Interactors {
+	return as.Interactors{
+		Create: create.New(repo, images, users, groups, fv, create.WithAuth(auth), create.WithAudit(audit)),
+		List:   list.New(repo, defaultPageSize, maxPageSize, list.WithAuth(auth)),
+		Delete: delete.New(repo, delete.WithAuth(auth), delete.WithAudit(audit)),
+		Mine:   mine.New(repo),
+		Next:   next.New(repo),
+		Finish: finish.New(repo, finish.WithAudit(audit)),
+	}
+}
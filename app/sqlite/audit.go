@@ -0,0 +1,21 @@
+package sqlite
+
+import (
+	infra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/audit"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	au "github.com/lejeunel/go-image-annotator/use-cases/audit"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/export"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+)
+
+func NewAuditInteractors(
+	repo infra.AuditRepo,
+	defaultPageSize int,
+	maxPageSize int,
+	auth auth.Interface,
+) au.Interactors {
+	return au.Interactors{
+		List:   list.New(repo, defaultPageSize, maxPageSize, list.WithAuth(auth)),
+		Export: export.New(repo, export.WithAuth(auth)),
+	}
+}
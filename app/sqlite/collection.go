@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	"log/slog"
 
 	"github.com/jmoiron/sqlx"
@@ -9,8 +10,11 @@ import (
 	cr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
 	gr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
 	ir "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
+	lr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
+	fs "github.com/lejeunel/go-image-annotator/modules/file-store"
 	ims "github.com/lejeunel/go-image-annotator/modules/image-store"
 	q "github.com/lejeunel/go-image-annotator/modules/job-queue"
 	v "github.com/lejeunel/go-image-annotator/modules/string-validator"
@@ -18,8 +22,12 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/clone"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/create"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/delete"
+	download_export "github.com/lejeunel/go-image-annotator/use-cases/collection/download-export"
+	"github.com/lejeunel/go-image-annotator/use-cases/collection/export"
+	export_task "github.com/lejeunel/go-image-annotator/use-cases/collection/export-task"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/list"
+	set_labels "github.com/lejeunel/go-image-annotator/use-cases/collection/set-labels"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/update"
 )
 
@@ -29,19 +37,27 @@ func NewCollectionInteractors(
 	ir ir.ImageRepo,
 	ar ar.AnnotationRepo,
 	gr gr.GroupRepo,
+	lr lr.LabelRepo,
 	ims ims.ImageStore,
+	exporter ax.ArchiveExporter,
+	exportStore fs.FileStore,
+	fv export.FilterValidator,
 	el el.EventLogger,
 	logger slog.Logger,
+	jobs q.JobQueue,
 	pageSize int, auth auth.Interface,
+	audit al.AuditLogger,
 ) clc.Interactors {
 	return clc.Interactors{
 		Find: find.New(cr),
 		Create: create.New(cr, gr, create.WithNameValidator(v.NewNameValidator()),
-			create.WithClock(clockwork.NewRealClock()), create.WithAuth(auth)),
+			create.WithClock(clockwork.NewRealClock()), create.WithAuth(auth), create.WithAudit(audit)),
 		Delete: delete.New(ims, ir, cr,
-			q.NewAsyncJobQueue(), el, logger, delete.WithAuth(auth)),
+			jobs, el, logger, delete.WithAuth(auth), delete.WithAudit(audit)),
 		List:   list.New(cr),
-		Update: update.New(cr, gr, update.WithAuth(auth)),
+		Update: update.New(cr, gr, update.WithAuth(auth), update.WithAudit(audit)),
+		SetLabels: set_labels.New(cr, lr, set_labels.WithAuth(auth),
+			set_labels.WithAudit(audit)),
 		Clone: clone.New(
 			ims,
 			ir,
@@ -49,7 +65,12 @@ func NewCollectionInteractors(
 			gr,
 			el,
 			logger,
-			q.NewAsyncJobQueue(),
+			jobs,
+			clone.WithAudit(audit),
 		),
+		Export: export.New(cr, fv, exporter, export.WithAuth(auth), export.WithAudit(audit)),
+		ExportTask: export_task.New(cr, fv, exportStore, exporter, el, logger,
+			jobs, export_task.WithAuth(auth), export_task.WithAudit(audit)),
+		DownloadExport: download_export.New(el, exportStore),
 	}
 }
@@ -0,0 +1,38 @@
+package sqlite
+
+import (
+	"log/slog"
+
+	clrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
+	infra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/evaluation"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	evr "github.com/lejeunel/go-image-annotator/modules/evaluator"
+	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	ev "github.com/lejeunel/go-image-annotator/use-cases/evaluation"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/evaluate"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/list"
+)
+
+func NewEvaluationInteractors(
+	repo infra.EvaluationRepo,
+	collections clrepo.CollectionRepo,
+	fv evaluate.FilterValidator,
+	evaluator evr.Evaluator,
+	events el.IEventLogger,
+	logger slog.Logger,
+	jobs jq.JobQueue,
+	defaultPageSize int,
+	maxPageSize int,
+	auth auth.Interface,
+	audit al.AuditLogger,
+) ev.Interactors {
+	return ev.Interactors{
+		Evaluate: evaluate.New(collections, fv, repo, evaluator, events, logger, jobs,
+			evaluate.WithAuth(auth), evaluate.WithAudit(audit)),
+		Find: find.New(repo),
+		List: list.New(repo, defaultPageSize, maxPageSize),
+	}
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	gi "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	grp "github.com/lejeunel/go-image-annotator/use-cases/group"
 	"github.com/lejeunel/go-image-annotator/use-cases/group/create"
@@ -11,12 +12,12 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/group/update"
 )
 
-func NewGroupInteractors(repo gi.GroupRepo, a auth.Interface) grp.Interactors {
+func NewGroupInteractors(repo gi.GroupRepo, a auth.Interface, audit al.AuditLogger) grp.Interactors {
 	return grp.Interactors{
 		Find:   find.New(repo),
-		Create: create.New(repo, create.WithAuth(a)),
-		Delete: delete.New(repo, delete.WithAuth(a)),
+		Create: create.New(repo, create.WithAuth(a), create.WithAudit(audit)),
+		Delete: delete.New(repo, delete.WithAuth(a), delete.WithAudit(audit)),
 		List:   list.New(repo),
-		Update: update.New(repo, update.WithAuth(a)),
+		Update: update.New(repo, update.WithAuth(a), update.WithAudit(audit)),
 	}
 }
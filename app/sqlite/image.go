@@ -1,23 +1,28 @@
 package sqlite
 
 import (
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	"log/slog"
 
 	anrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
 	clrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
 	imrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
+	vwrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/view"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
 	fs "github.com/lejeunel/go-image-annotator/modules/file-store"
 	ims "github.com/lejeunel/go-image-annotator/modules/image-store"
 	q "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	th "github.com/lejeunel/go-image-annotator/modules/thumbnailer"
 	im "github.com/lejeunel/go-image-annotator/use-cases/image"
+	bt "github.com/lejeunel/go-image-annotator/use-cases/image/backfill-thumbnails"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/delete"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/find"
 	ing "github.com/lejeunel/go-image-annotator/use-cases/image/ingest"
 	ia "github.com/lejeunel/go-image-annotator/use-cases/image/ingest-archive"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/list"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/raw"
+	rawmask "github.com/lejeunel/go-image-annotator/use-cases/image/raw-mask"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/scroll"
 )
 
@@ -25,8 +30,9 @@ func NewImageInteractors(
 	imr imrepo.ImageRepo,
 	clr clrepo.CollectionRepo,
 	anr anrepo.AnnotationRepo,
+	vwr vwrepo.ViewRepo,
 	ims ims.ImageStore,
-	imfs fs.FileStore,
+	imfs fs.RandomAccessFileStore,
 	tmpfs fs.LocalFileStore,
 	imageIngester ing.Ingester,
 	archiveIngester ia.ArchiveIngester,
@@ -35,26 +41,31 @@ func NewImageInteractors(
 	maxArchiveMB int64,
 	el el.EventLogger,
 	logger slog.Logger,
+	jobs q.JobQueue,
 	defaultPageSize int,
 	maxPageSize int,
 	auth auth.Interface,
+	audit al.AuditLogger,
 ) im.Interactors {
 	return im.Interactors{
-		Ingest: *ing.New(imageIngester, clr, ing.WithAuth(auth)),
+		Ingest: *ing.New(imageIngester, clr, ing.WithAuth(auth), ing.WithAudit(audit)),
 		IngestArchive: ia.New(
 			archiveIngester,
 			clr,
 			tmpfs,
 			el,
 			logger,
-			q.NewAsyncJobQueue(),
+			jobs,
 			maxArchiveMB,
 			ia.WithAuth(auth),
+			ia.WithAudit(audit),
 		),
-		Find:   find.New(ims),
-		Raw:    raw.New(imfs, imr),
-		List:   list.New(imr, fv, ov, ims, defaultPageSize, maxPageSize),
-		Scroll: scroll.New(imr, fv, ov),
-		Delete: delete.New(ims),
+		Find:           find.New(ims, find.WithAuth(auth)),
+		Raw:            raw.New(imfs, imr, th.New(imfs), raw.WithAuth(auth)),
+		RawMask:        rawmask.New(ims, rawmask.WithAuth(auth)),
+		List:           list.New(imr, fv, ov, ims, clr, vwr, defaultPageSize, maxPageSize, list.WithAuth(auth)),
+		Scroll:         scroll.New(imr, fv, ov, clr, vwr, scroll.WithAuth(auth)),
+		Delete:         delete.New(ims, delete.WithAudit(audit)),
+		BackfillThumbs: bt.New(imr, imfs, th.New(imfs)),
 	}
 }
@@ -5,15 +5,22 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	db "github.com/lejeunel/go-image-annotator/adapters/db/sqlite"
+	agr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/agreement"
 	an "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
+	as "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/assignment"
+	au "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/audit"
 	clc "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
+	evl "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/evaluation"
 	ev "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/event"
 	grp "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
 	im "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
+	jb "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/job"
 	lbl "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
 	md "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/metadata"
 	r "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/role"
 	usr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	vw "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/view"
+	"github.com/lejeunel/go-image-annotator/config"
 	fs "github.com/lejeunel/go-image-annotator/modules/file-store"
 	qu "github.com/lejeunel/go-image-annotator/modules/query"
 )
@@ -27,16 +34,45 @@ type Infra struct {
 	r.RoleRepo
 	usr.UserRepo
 	ev.EventRepo
+	jb.JobRepo
 	md.MetaRepo
-	ImageFileStore  fs.FileStore
+	vw.ViewRepo
+	as.AssignmentRepo
+	au.AuditRepo
+	evl.EvaluationRepo
+	agr.AgreementRepo
+	ImageFileStore  fs.RandomAccessFileStore
 	TempFileStore   fs.LocalFileStore
 	PolicyFileStore fs.FileStore
+	ExportFileStore fs.FileStore
 	qu.IFilterParser
 	qu.OrderParser
 	*sqlx.DB
 }
 
-func BuildInfra(path string) Infra {
+// NewFileStore builds the store of artefacts of a given kind. Temporary
+// files are always kept on local disk.
+func NewFileStore(cfg config.Config, kind string) fs.RandomAccessFileStore {
+	switch cfg.FileStoreBackend {
+	case "", "local":
+		return fs.NewLocalFileStore(fmt.Sprintf("%v/%v", cfg.ArtefactPath, kind))
+	case "s3":
+		return fs.NewS3FileStore(fs.S3Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyId:     cfg.S3AccessKeyId,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			Prefix:          cfg.S3Prefix,
+			PathStyle:       cfg.S3PathStyle,
+		}).WithPrefix(kind)
+	default:
+		panic(fmt.Sprintf("unsupported file store backend: %v", cfg.FileStoreBackend))
+	}
+}
+
+func BuildInfra(cfg config.Config) Infra {
+	path := cfg.ArtefactPath
 	filterParser, orderingParser := im.MakeQueryParsers()
 	db := db.NewSQLiteDB(fmt.Sprintf("%v/%v", path, "db.sqlite"))
 	return Infra{
@@ -48,10 +84,17 @@ func BuildInfra(path string) Infra {
 		r.NewRoleRepo(db),
 		usr.NewUserRepo(db),
 		ev.NewEventRepo(db),
+		jb.NewJobRepo(db),
 		md.NewMetaRepo(db),
-		fs.NewLocalFileStore(fmt.Sprintf("%v/%v", path, "images")),
+		vw.NewViewRepo(db),
+		as.NewAssignmentRepo(db),
+		au.NewAuditRepo(db),
+		evl.NewEvaluationRepo(db),
+		agr.NewAgreementRepo(db),
+		NewFileStore(cfg, "images"),
 		fs.NewLocalFileStore(fmt.Sprintf("%v/%v", path, "tmp")),
-		fs.NewLocalFileStore(fmt.Sprintf("%v/%v", path, "assets")),
+		NewFileStore(cfg, "assets"),
+		NewFileStore(cfg, "exports"),
 		filterParser,
 		orderingParser,
 		db,
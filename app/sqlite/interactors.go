@@ -4,20 +4,25 @@ import (
 	"crypto/sha256"
 	itr "github.com/lejeunel/go-image-annotator/app/interactors"
 	cfg "github.com/lejeunel/go-image-annotator/config"
+	agm "github.com/lejeunel/go-image-annotator/modules/agreement"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	"log/slog"
 
 	tra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/transactors"
+	axp "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
 	aig "github.com/lejeunel/go-image-annotator/modules/archive-ingester"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	evr "github.com/lejeunel/go-image-annotator/modules/evaluator"
 	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
 	iig "github.com/lejeunel/go-image-annotator/modules/image-ingester"
 	ims "github.com/lejeunel/go-image-annotator/modules/image-store"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
 	pv "github.com/lejeunel/go-image-annotator/modules/password-validator"
 	rea "github.com/lejeunel/go-image-annotator/modules/reader"
 	tk "github.com/lejeunel/go-image-annotator/modules/token"
 )
 
-func BuildInteractors(infra Infra, auth auth.Interface, logger slog.Logger, cfg cfg.Config, ts tk.TokenService) itr.Interactors {
+func BuildInteractors(infra Infra, auth auth.Interface, logger slog.Logger, cfg cfg.Config, ts tk.TokenService, jobs jq.JobQueue) itr.Interactors {
 	passwordTokenizer := tk.New(cfg.RandomPasswordLength)
 	forgottenPasswordGen := tk.New(cfg.RandomPasswordLength)
 	passwordValidator := pv.New(cfg.PasswordMinEntropy)
@@ -32,30 +37,40 @@ func BuildInteractors(infra Infra, auth auth.Interface, logger slog.Logger, cfg
 		tra.NewStoreTransactor(infra.DB, infra.IFilterParser, infra.OrderStrParser),
 		infra.ImageFileStore)
 	eventlogger := el.New(infra.EventRepo, el.WithMaxNumTasksPerUser(cfg.MaxNumTasksPerUser))
+	auditLogger := al.New(infra.AuditRepo, logger)
 
 	imageIngester := iig.New(infra.ImageRepo, infra.CollectionRepo, infra.LabelRepo, infra.AnnotationRepo,
 		tra.NewIngestionTransactor(infra.DB),
 		infra.ImageFileStore, sha256.New(), rea.NewImageSpecsDetector(cfg.AllowedImageMIMETypes))
-	archiveIngester := aig.New(imstore, imageIngester)
+	archiveIngester := aig.New(imstore, imageIngester, infra.LabelRepo)
+	archiveExporter := axp.New(infra.ImageRepo, imstore, infra.LabelRepo)
+	predictionTransactor := tra.NewPredictionTransactor(infra.DB)
 
 	return itr.Interactors{
-		Label: NewLabelInteractors(infra.LabelRepo, cfg.DefaultPageSize, cfg.MaxPageSize, auth),
+		Label: NewLabelInteractors(infra.LabelRepo, infra.CollectionRepo, cfg.DefaultPageSize, cfg.MaxPageSize, auth, auditLogger),
 		Collection: NewCollectionInteractors(
 			infra.DB,
 			infra.CollectionRepo,
 			infra.ImageRepo,
 			infra.AnnotationRepo,
 			infra.GroupRepo,
+			infra.LabelRepo,
 			imstore,
+			archiveExporter,
+			infra.ExportFileStore,
+			infra.IFilterParser,
 			eventlogger,
 			logger,
+			jobs,
 			cfg.DefaultPageSize,
 			auth,
+			auditLogger,
 		),
 		Image: NewImageInteractors(
 			infra.ImageRepo,
 			infra.CollectionRepo,
 			infra.AnnotationRepo,
+			infra.ViewRepo,
 			imstore,
 			infra.ImageFileStore,
 			infra.TempFileStore,
@@ -66,9 +81,11 @@ func BuildInteractors(infra Infra, auth auth.Interface, logger slog.Logger, cfg
 			int64(cfg.MaxArchiveMB),
 			eventlogger,
 			logger,
+			jobs,
 			cfg.DefaultPageSize,
 			cfg.MaxPageSize,
 			auth,
+			auditLogger,
 		),
 		User: NewUserInteractors(infra.UserRepo, infra.GroupRepo, infra.RoleRepo,
 			ts,
@@ -77,10 +94,10 @@ func BuildInteractors(infra Infra, auth auth.Interface, logger slog.Logger, cfg
 			passwordTokenizer,
 			passwordTokenizer,
 			cfg.ForgotPasswordTokenExpirationMinutes,
-			forgottenPasswordGen, auth),
-		Annotation: NewAnnotationInteractors(imstore, infra.ImageRepo, infra.LabelRepo, infra.AnnotationRepo, auth),
-		Group:      NewGroupInteractors(infra.GroupRepo, auth),
-		Role:       NewRoleInteractors(infra.RoleRepo, auth),
+			forgottenPasswordGen, auth, auditLogger),
+		Annotation: NewAnnotationInteractors(imstore, infra.ImageRepo, infra.LabelRepo, infra.AnnotationRepo, infra.CollectionRepo, predictionTransactor, auth, auditLogger),
+		Group:      NewGroupInteractors(infra.GroupRepo, auth, auditLogger),
+		Role:       NewRoleInteractors(infra.RoleRepo, auth, auditLogger),
 		Bootstrap: NewBootstrapInteractor(
 			infra.UserRepo,
 			infra.RoleRepo,
@@ -88,9 +105,23 @@ func BuildInteractors(infra Infra, auth auth.Interface, logger slog.Logger, cfg
 			passwordTokenizer,
 			passwordValidator,
 		),
-		Policy:   NewPolicyInteractors(infra.PolicyFileStore, auth),
-		Metadata: NewMetadataInteractors(infra.MetaRepo, infra.CollectionRepo, infra.ImageRepo, auth),
+		Policy:   NewPolicyInteractors(infra.PolicyFileStore, auth, auditLogger),
+		Metadata: NewMetadataInteractors(infra.MetaRepo, infra.CollectionRepo, infra.ImageRepo, auth, auditLogger),
 		Log:      NewLogInteractors(eventlogger),
+		View: NewViewInteractors(infra.ViewRepo, infra.GroupRepo, infra.IFilterParser, infra.OrderParser,
+			cfg.DefaultPageSize, cfg.MaxPageSize, auditLogger),
+		Review: NewReviewInteractors(imstore, infra.AnnotationRepo, infra.CollectionRepo, auth, auditLogger,
+			cfg.DefaultPageSize, cfg.MaxPageSize),
+		Assignment: NewAssignmentInteractors(infra.AssignmentRepo, infra.ImageRepo, infra.UserRepo, infra.GroupRepo,
+			infra.IFilterParser, cfg.DefaultPageSize, cfg.MaxPageSize, auth, auditLogger),
+		Audit: NewAuditInteractors(infra.AuditRepo, cfg.DefaultPageSize, cfg.MaxPageSize, auth),
+		Evaluation: NewEvaluationInteractors(infra.EvaluationRepo, infra.CollectionRepo, infra.IFilterParser,
+			evr.New(infra.ImageRepo, infra.AnnotationRepo), eventlogger, logger, jobs,
+			cfg.DefaultPageSize, cfg.MaxPageSize, auth, auditLogger),
+		Agreement: NewAgreementInteractors(infra.AgreementRepo, infra.CollectionRepo, infra.GroupRepo,
+			infra.IFilterParser, infra.ImageRepo, infra.AnnotationRepo,
+			agm.New(infra.ImageRepo, infra.AnnotationRepo), eventlogger, logger, jobs,
+			cfg.DefaultPageSize, cfg.MaxPageSize, auth, auditLogger),
 	}
 
 }
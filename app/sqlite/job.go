@@ -0,0 +1,28 @@
+package sqlite
+
+import (
+	"log/slog"
+	"time"
+
+	itr "github.com/lejeunel/go-image-annotator/app/interactors"
+	"github.com/lejeunel/go-image-annotator/config"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+)
+
+func NewJobQueue(infra Infra, logger slog.Logger, cfg config.Config) *jq.PersistentQueue {
+	return jq.New(infra.JobRepo, infra.EventRepo, logger,
+		jq.WithWorkers(cfg.NumJobWorkers),
+		jq.WithMaxAttempts(cfg.JobMaxAttempts),
+		jq.WithBackoff(10*time.Second, 10*time.Minute),
+	)
+}
+
+func RegisterJobHandlers(q *jq.PersistentQueue, itrs itr.Interactors) {
+	q.Register(t.CollectionCloneTask, itrs.Collection.Clone.Run)
+	q.Register(t.CollectionDeleteTask, itrs.Collection.Delete.Run)
+	q.Register(t.CollectionExportTask, itrs.Collection.ExportTask.Run)
+	q.Register(t.IngestArchiveTask, itrs.Image.IngestArchive.Run)
+	q.Register(t.EvaluationTask, itrs.Evaluation.Evaluate.Run)
+	q.Register(t.AgreementTask, itrs.Agreement.Analyze.Run)
+}
@@ -1,7 +1,9 @@
 package sqlite
 
 import (
+	cr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
 	infra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/label"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	lbl "github.com/lejeunel/go-image-annotator/use-cases/label"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/create"
@@ -9,21 +11,25 @@ import (
 	fetchall "github.com/lejeunel/go-image-annotator/use-cases/label/fetch-all"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/tree"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/update"
 )
 
 func NewLabelInteractors(
 	repo infra.LabelRepo,
+	collections cr.CollectionRepo,
 	defaultPageSize int,
 	maxPageSize int,
 	auth auth.Interface,
+	audit al.AuditLogger,
 ) lbl.Interactors {
 	return lbl.Interactors{
 		Find:     *find.New(repo),
-		Create:   *create.New(repo, create.WithAuth(auth)),
-		Delete:   *delete.New(repo, delete.WithAuth(auth)),
+		Create:   *create.New(repo, create.WithAuth(auth), create.WithAudit(audit)),
+		Delete:   *delete.New(repo, delete.WithAuth(auth), delete.WithAudit(audit)),
 		List:     *list.New(repo, defaultPageSize, maxPageSize),
-		Update:   *update.New(repo),
-		FetchAll: *fetchall.New(repo),
+		Update:   *update.New(repo, update.WithAuth(auth), update.WithAudit(audit)),
+		FetchAll: *fetchall.New(repo, collections),
+		Tree:     *tree.New(repo),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	cr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
 	ir "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/image"
 	mr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/metadata"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	kv "github.com/lejeunel/go-image-annotator/modules/string-validator"
 	vv "github.com/lejeunel/go-image-annotator/modules/value-validator"
@@ -20,6 +21,7 @@ func NewMetadataInteractors(
 	cr cr.CollectionRepo,
 	ir ir.ImageRepo,
 	auth auth.Interface,
+	audit al.AuditLogger,
 ) mu.Interactors {
 	return mu.Interactors{
 		Add: add.New(
@@ -29,10 +31,11 @@ func NewMetadataInteractors(
 			kv.NewNameValidator(),
 			vv.BaseTypeValidator{},
 			add.WithAuth(auth),
+			add.WithAudit(audit),
 		),
-		Delete: delete.New(cr, mr, delete.WithAuth(auth)),
-		Update: update.New(cr, ir, mr, update.WithAuth(auth)),
-		List:   list.New(mr),
-		Read:   read.New(cr, ir, mr),
+		Delete: delete.New(cr, mr, delete.WithAuth(auth), delete.WithAudit(audit)),
+		Update: update.New(cr, ir, mr, update.WithAuth(auth), update.WithAudit(audit)),
+		List:   list.New(cr, mr, list.WithAuth(auth)),
+		Read:   read.New(cr, ir, mr, read.WithAuth(auth)),
 	}
 }
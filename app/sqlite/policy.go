@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	fs "github.com/lejeunel/go-image-annotator/modules/file-store"
 	pl "github.com/lejeunel/go-image-annotator/use-cases/policy"
@@ -8,9 +9,9 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/policy/set"
 )
 
-func NewPolicyInteractors(fs fs.FileStore, auth auth.Interface) pl.Interactors {
+func NewPolicyInteractors(fs fs.FileStore, auth auth.Interface, audit al.AuditLogger) pl.Interactors {
 	return pl.Interactors{
 		Read: read.New(fs, read.WithAuth(auth)),
-		Set:  set.New(fs, auth),
+		Set:  set.New(fs, auth, set.WithAudit(audit)),
 	}
 }
@@ -0,0 +1,29 @@
+package sqlite
+
+import (
+	anr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/annotation"
+	clr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/collection"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	ims "github.com/lejeunel/go-image-annotator/modules/image-store"
+	rv "github.com/lejeunel/go-image-annotator/use-cases/review"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/queue"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/review"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/submit"
+)
+
+func NewReviewInteractors(
+	ims ims.ImageStore,
+	anr anr.AnnotationRepo,
+	clr clr.CollectionRepo,
+	auth auth.Interface,
+	audit al.AuditLogger,
+	defaultPageSize int,
+	maxPageSize int,
+) rv.Interactors {
+	return rv.Interactors{
+		Submit: submit.New(anr, ims, submit.WithAuth(auth), submit.WithAudit(audit)),
+		Review: review.New(anr, review.WithAuth(auth), review.WithAudit(audit)),
+		Queue:  queue.New(anr, clr, defaultPageSize, maxPageSize, queue.WithAuth(auth)),
+	}
+}
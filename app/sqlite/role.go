@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	ri "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/role"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	r "github.com/lejeunel/go-image-annotator/use-cases/role"
 	"github.com/lejeunel/go-image-annotator/use-cases/role/create"
@@ -11,12 +12,12 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/role/update"
 )
 
-func NewRoleInteractors(repo ri.RoleRepo, a auth.Interface) r.Interactors {
+func NewRoleInteractors(repo ri.RoleRepo, a auth.Interface, audit al.AuditLogger) r.Interactors {
 	return r.Interactors{
 		Find:   find.New(repo),
-		Create: create.New(repo, create.WithAuth(a)),
-		Delete: delete.New(repo, delete.WithAuth(a)),
+		Create: create.New(repo, create.WithAuth(a), create.WithAudit(audit)),
+		Delete: delete.New(repo, delete.WithAuth(a), delete.WithAudit(audit)),
 		List:   list.New(repo),
-		Update: update.New(repo, update.WithAuth(a)),
+		Update: update.New(repo, update.WithAuth(a), update.WithAudit(audit)),
 	}
 }
@@ -4,6 +4,7 @@ import (
 	sqlitegrp "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
 	sqliterol "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/role"
 	sqliteusr "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	pw "github.com/lejeunel/go-image-annotator/modules/password-validator"
 	tk "github.com/lejeunel/go-image-annotator/modules/token"
@@ -31,20 +32,22 @@ func NewUserInteractors(
 	forgotPassworkTokenExpirationMinutes int,
 	pwGen create.PasswordGenerator,
 	auth auth.Interface,
+	audit al.AuditLogger,
 ) usr.Interactors {
 	return usr.Interactors{
 		Find:             find.New(userRepo, find.WithAuth(auth)),
-		Create:           create.New(userRepo, ApitokenGen, pwGen, create.WithAuth(auth)),
-		Delete:           delete.New(userRepo, delete.WithAuth(auth)),
+		Create:           create.New(userRepo, ApitokenGen, pwGen, create.WithAuth(auth), create.WithAudit(audit)),
+		Delete:           delete.New(userRepo, delete.WithAuth(auth), delete.WithAudit(audit)),
 		List:             list.New(userRepo, list.WithAuth(auth)),
-		RenewToken:       rt.New(userRepo, ApitokenGen),
-		UpdatePrivileges: upr.New(userRepo, grpRepo, roleRepo, upr.WithAuth(auth)),
+		RenewToken:       rt.New(userRepo, ApitokenGen, rt.WithAudit(audit)),
+		UpdatePrivileges: upr.New(userRepo, grpRepo, roleRepo, upr.WithAuth(auth), upr.WithAudit(audit)),
 		RequestForgottenPassword: fp.New(
 			userRepo,
 			forgotPassworkTokenExpirationMinutes,
 			forgotPasswordTokenGen,
+			fp.WithAudit(audit),
 		),
-		ResetForgottenPassword: rfpw.New(userRepo, passwordHasher, passwordValidator),
-		ChangePassword:         cpw.New(userRepo, passwordVerifier, passwordValidator),
+		ResetForgottenPassword: rfpw.New(userRepo, passwordHasher, passwordValidator, rfpw.WithAudit(audit)),
+		ChangePassword:         cpw.New(userRepo, passwordVerifier, passwordValidator, cpw.WithAudit(audit)),
 	}
 }
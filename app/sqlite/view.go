@@ -0,0 +1,31 @@
+package sqlite
+
+import (
+	grrepo "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/group"
+	infra "github.com/lejeunel/go-image-annotator/adapters/db/sqlite/view"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	vw "github.com/lejeunel/go-image-annotator/use-cases/view"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/delete"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/update"
+)
+
+func NewViewInteractors(
+	repo infra.ViewRepo,
+	groups grrepo.GroupRepo,
+	fv create.FilterValidator,
+	ov create.OrderingValidator,
+	defaultPageSize int,
+	maxPageSize int,
+	audit al.AuditLogger,
+) vw.Interactors {
+	return vw.Interactors{
+		Find:   find.New(repo),
+		Create: create.New(repo, groups, fv, ov, create.WithAudit(audit)),
+		Delete: delete.New(repo, delete.WithAudit(audit)),
+		List:   list.New(repo, defaultPageSize, maxPageSize),
+		Update: update.New(repo, groups, fv, ov, update.WithAudit(audit)),
+	}
+}
@@ -26,6 +26,17 @@ type Config struct {
 	SMTPPort                             int      `                split_words:"true"`
 	GoogleClientId                       string   `                split_words:"true"`
 	GoogleClientSecret                   string   `                split_words:"true"`
+	FileStoreBackend                     string   `                split_words:"true" default:"local"`
+	S3Endpoint                           string   `                split_words:"true"`
+	S3Region                             string   `                split_words:"true" default:"us-east-1"`
+	S3Bucket                             string   `                split_words:"true"`
+	S3AccessKeyId                        string   `                split_words:"true"`
+	S3SecretAccessKey                    string   `                split_words:"true"`
+	S3Prefix                             string   `                split_words:"true"`
+	S3PathStyle                          bool     `                split_words:"true" default:"true"`
+	NumJobWorkers                        int      `                split_words:"true" default:"2"`
+	JobMaxAttempts                       int      `                split_words:"true" default:"3"`
+	JobDrainTimeoutSeconds               int      `                split_words:"true" default:"30"`
 }
 
 func Parse() Config {
@@ -0,0 +1,95 @@
+package agreement
+
+import (
+	"time"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+// DefaultIoUThreshold is the overlap above which regions of two raters
+// match, when none is given.
+const DefaultIoUThreshold = 0.5
+
+type Method string
+
+const (
+	// MajorityVote keeps the region of a cluster that overlaps the others
+	// most.
+	MajorityVote Method = "majority"
+	// WeightedBoxFusion averages the boxes of a cluster, weighted by their
+	// confidence.
+	WeightedBoxFusion Method = "wbf"
+)
+
+func (m Method) IsValid() bool {
+	return m == MajorityVote || m == WeightedBoxFusion
+}
+
+// ImageAgreement holds the agreement of raters on the regions of an image.
+// RegionF1 is the mean, over pairs of raters, of the F1 score of their
+// regions matched by IoU and label.
+type ImageAgreement struct {
+	Image      im.ImageId
+	NumRegions int
+	RegionF1   float64
+	MeanIoU    float64
+}
+
+type LabelAgreement struct {
+	Label      string
+	NumRegions int
+	RegionF1   float64
+	MeanIoU    float64
+}
+
+// ImageLabelAgreement holds the Fleiss kappa of raters on whether images
+// carry a label.
+type ImageLabelAgreement struct {
+	Label string
+	Count int
+	Kappa float64
+}
+
+// PairAgreement holds the Cohen kappa of two raters on image labels, and the
+// F1 score of their regions.
+type PairAgreement struct {
+	Raters   [2]string
+	Kappa    float64
+	RegionF1 float64
+}
+
+type ConsensusSummary struct {
+	Collection     string
+	Method         Method
+	MinVotes       int
+	NumRegions     int
+	NumImageLabels int
+}
+
+// Report holds the agreement of raters on the images they share. Raters are
+// collections, or authors when some are given.
+type Report struct {
+	Raters      []string
+	NumImages   int
+	RegionF1    float64
+	MeanIoU     float64
+	Kappa       float64
+	Pairs       []PairAgreement
+	Images      []ImageAgreement
+	Labels      []LabelAgreement
+	ImageLabels []ImageLabelAgreement
+	Consensus   *ConsensusSummary
+}
+
+type Agreement struct {
+	Id           t.TaskId
+	Collections  []string
+	Authors      []u.UserId
+	Filter       im.FilterStr
+	IoUThreshold float64
+	Issuer       u.UserId
+	CreatedAt    time.Time
+	Report       Report
+}
@@ -96,6 +96,7 @@ type ImageLabel struct {
 	Label  lbl.Label
 	Author *u.UserId
 	Time   *time.Time
+	Review Review
 }
 
 type Annotation struct {
@@ -103,24 +104,34 @@ type Annotation struct {
 	Label string
 }
 
+// Attributes are the values a region holds for the attributes of its
+// label, by attribute name.
+type Attributes map[string]any
+
 type BoundingBox struct {
-	Id     AnnotationId
-	Label  lbl.Label
-	Xc     float32
-	Yc     float32
-	Width  float32
-	Height float32
-	Angle  float32
-	Author *u.UserId
-	Time   *time.Time
+	Id         AnnotationId
+	Label      lbl.Label
+	Xc         float32
+	Yc         float32
+	Width      float32
+	Height     float32
+	Angle      float32
+	Author     *u.UserId
+	Time       *time.Time
+	Review     Review
+	Source     Source
+	Attributes Attributes
 }
 
 type Polygon struct {
-	Id     AnnotationId
-	Label  lbl.Label
-	Points Points
-	Author *u.UserId
-	Time   *time.Time
+	Id         AnnotationId
+	Label      lbl.Label
+	Points     Points
+	Author     *u.UserId
+	Time       *time.Time
+	Review     Review
+	Source     Source
+	Attributes Attributes
 }
 
 type PolygonRequest struct {
@@ -129,8 +140,33 @@ type PolygonRequest struct {
 }
 
 type PolygonUpdatables struct {
-	LabelId lbl.LabelId
-	Points  Points
+	LabelId    lbl.LabelId
+	Points     Points
+	Attributes Attributes
+}
+
+// Polyline is an open line, such as a lane marking or a crack, whose last
+// point is not joined to its first.
+type Polyline struct {
+	Id         AnnotationId
+	Label      lbl.Label
+	Points     Points
+	Author     *u.UserId
+	Time       *time.Time
+	Review     Review
+	Source     Source
+	Attributes Attributes
+}
+
+type PolylineRequest struct {
+	Label  string
+	Points Points
+}
+
+type PolylineUpdatables struct {
+	LabelId    lbl.LabelId
+	Points     Points
+	Attributes Attributes
 }
 
 type BoundingBoxResponse struct {
@@ -150,12 +186,13 @@ type BoundingBoxRequest struct {
 }
 
 type BoundingBoxUpdatables struct {
-	LabelId lbl.LabelId
-	Xc      float32
-	Yc      float32
-	Width   float32
-	Height  float32
-	Angle   float32
+	LabelId    lbl.LabelId
+	Xc         float32
+	Yc         float32
+	Width      float32
+	Height     float32
+	Angle      float32
+	Attributes Attributes
 }
 
 type Option func(*BoundingBox)
@@ -166,6 +203,12 @@ func WithAngle(ang float32) Option {
 	}
 }
 
+func WithAttributes(attrs Attributes) Option {
+	return func(c *BoundingBox) {
+		c.Attributes = attrs
+	}
+}
+
 func NewBoundingBox(id AnnotationId, xc float32, yc float32,
 	width float32, height float32, label lbl.Label, opts ...Option,
 ) BoundingBox {
@@ -202,3 +245,23 @@ func NewImageLabel(label lbl.Label) ImageLabel {
 func NewPolygon(id AnnotationId, points Points, label lbl.Label) Polygon {
 	return Polygon{Id: id, Points: points, Label: label}
 }
+
+func NewPolyline(id AnnotationId, points Points, label lbl.Label) Polyline {
+	return Polyline{Id: id, Points: points, Label: label}
+}
+
+func ValidatePolygon(points Points) error {
+	if len(points.Coordinates) < 3 {
+		return fmt.Errorf("validating polygon: got %v points, need at least 3: %w",
+			len(points.Coordinates), e.ErrValidation)
+	}
+	return nil
+}
+
+func ValidatePolyline(points Points) error {
+	if len(points.Coordinates) < 2 {
+		return fmt.Errorf("validating polyline: got %v points, need at least 2: %w",
+			len(points.Coordinates), e.ErrValidation)
+	}
+	return nil
+}
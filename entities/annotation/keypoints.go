@@ -0,0 +1,71 @@
+package annotation
+
+import (
+	"fmt"
+	"time"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// Visibility flags a keypoint the way COCO does.
+type Visibility int
+
+const (
+	NotLabeled Visibility = iota
+	Occluded
+	Visible
+)
+
+func (v Visibility) IsValid() bool {
+	return v >= NotLabeled && v <= Visible
+}
+
+type Keypoint struct {
+	X          float32
+	Y          float32
+	Visibility Visibility
+}
+
+// Keypoints is a pose: one keypoint per point of the skeleton of its
+// label, in the same order.
+type Keypoints struct {
+	Id         AnnotationId
+	Label      lbl.Label
+	Points     []Keypoint
+	Author     *u.UserId
+	Time       *time.Time
+	Review     Review
+	Source     Source
+	Attributes Attributes
+}
+
+type KeypointsUpdatables struct {
+	LabelId    lbl.LabelId
+	Points     []Keypoint
+	Attributes Attributes
+}
+
+func NewKeypoints(id AnnotationId, points []Keypoint, label lbl.Label) Keypoints {
+	return Keypoints{Id: id, Points: points, Label: label}
+}
+
+// ValidateKeypoints checks that points fit the skeleton of label.
+func ValidateKeypoints(points []Keypoint, label lbl.Label) error {
+	errCtx := "validating keypoints"
+	if label.Skeleton == nil {
+		return fmt.Errorf("%v: label %v has no skeleton: %w", errCtx, label.Name, e.ErrValidation)
+	}
+	if len(points) != len(label.Skeleton.Points) {
+		return fmt.Errorf("%v: got %v points, skeleton of label %v has %v: %w",
+			errCtx, len(points), label.Name, len(label.Skeleton.Points), e.ErrValidation)
+	}
+	for i, p := range points {
+		if !p.Visibility.IsValid() {
+			return fmt.Errorf("%v: point %v: invalid visibility %v: %w",
+				errCtx, label.Skeleton.Points[i], p.Visibility, e.ErrValidation)
+		}
+	}
+	return nil
+}
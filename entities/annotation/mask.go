@@ -0,0 +1,81 @@
+package annotation
+
+import (
+	"fmt"
+	"time"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// RLE is a binary mask, run-length encoded the way COCO does: counts
+// alternate between runs of background and foreground pixels, starting with
+// background, and go down the columns of the image from left to right.
+type RLE struct {
+	Height int
+	Width  int
+	Counts []int
+}
+
+// EmptyRLE is a mask of the given size with no foreground pixel.
+func EmptyRLE(width, height int) RLE {
+	return RLE{Height: height, Width: width, Counts: []int{width * height}}
+}
+
+// Area is the number of foreground pixels.
+func (r RLE) Area() int {
+	area := 0
+	for n := 1; n < len(r.Counts); n += 2 {
+		area += r.Counts[n]
+	}
+	return area
+}
+
+// Mask is a pixel-level region, which unlike a polygon may have holes.
+type Mask struct {
+	Id         AnnotationId
+	Label      lbl.Label
+	RLE        RLE
+	Author     *u.UserId
+	Time       *time.Time
+	Review     Review
+	Source     Source
+	Attributes Attributes
+}
+
+type MaskUpdatables struct {
+	LabelId    lbl.LabelId
+	RLE        RLE
+	Attributes Attributes
+}
+
+func NewMask(id AnnotationId, rle RLE, label lbl.Label) Mask {
+	return Mask{Id: id, RLE: rle, Label: label}
+}
+
+// ValidateMask checks that rle covers an image of the given size.
+func ValidateMask(rle RLE, width, height int) error {
+	errCtx := "validating mask"
+	if rle.Width != width || rle.Height != height {
+		return fmt.Errorf("%v: mask is %vx%v, image is %vx%v: %w",
+			errCtx, rle.Width, rle.Height, width, height, e.ErrValidation)
+	}
+	total := 0
+	for _, c := range rle.Counts {
+		if c < 0 {
+			return fmt.Errorf("%v: negative count %v: %w", errCtx, c, e.ErrValidation)
+		}
+		// compared before adding, so that huge counts cannot wrap around
+		if c > width*height-total {
+			return fmt.Errorf("%v: counts exceed the %v pixels of the image: %w",
+				errCtx, width*height, e.ErrValidation)
+		}
+		total += c
+	}
+	if total != width*height {
+		return fmt.Errorf("%v: counts sum to %v pixels, image has %v: %w",
+			errCtx, total, width*height, e.ErrValidation)
+	}
+	return nil
+}
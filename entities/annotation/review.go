@@ -0,0 +1,76 @@
+package annotation
+
+import (
+	"fmt"
+	"time"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type ReviewStatus string
+
+const (
+	Draft     ReviewStatus = "draft"
+	Submitted ReviewStatus = "submitted"
+	Accepted  ReviewStatus = "accepted"
+	Rejected  ReviewStatus = "rejected"
+)
+
+func (s ReviewStatus) String() string {
+	return string(s)
+}
+
+func ParseReviewStatus(s string) (ReviewStatus, error) {
+	switch status := ReviewStatus(s); status {
+	case Draft, Submitted, Accepted, Rejected:
+		return status, nil
+	default:
+		return "", fmt.Errorf("invalid review status %q: %w", s, e.ErrValidation)
+	}
+}
+
+// ParseDecision parses the outcome of a review, which is either accepted or
+// rejected.
+func ParseDecision(s string) (ReviewStatus, error) {
+	switch status := ReviewStatus(s); status {
+	case Accepted, Rejected:
+		return status, nil
+	default:
+		return "", fmt.Errorf("invalid review decision %q, expected %v or %v: %w",
+			s, Accepted, Rejected, e.ErrValidation)
+	}
+}
+
+// Review tracks the quality assessment of an annotation. Annotations start
+// as drafts, are submitted by annotators, then accepted or rejected by a
+// reviewer. Modifying an annotation brings it back to draft.
+type Review struct {
+	Status   ReviewStatus
+	Reviewer *u.UserId
+	Comment  string
+	Time     *time.Time
+}
+
+func (r Review) CurrentStatus() ReviewStatus {
+	if r.Status == "" {
+		return Draft
+	}
+	return r.Status
+}
+
+func (r Review) IsSubmittable() bool {
+	s := r.CurrentStatus()
+	return s == Draft || s == Rejected
+}
+
+func (r Review) IsReviewable() bool {
+	return r.CurrentStatus() == Submitted
+}
+
+func NewDecision(status ReviewStatus, reviewer *u.UserId, comment string, t *time.Time) (Review, error) {
+	if status == Rejected && comment == "" {
+		return Review{}, fmt.Errorf("rejecting annotation without comment: %w", e.ErrValidation)
+	}
+	return Review{Status: status, Reviewer: reviewer, Comment: comment, Time: t}, nil
+}
@@ -0,0 +1,86 @@
+package annotation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	uuidw "github.com/lejeunel/go-image-annotator/shared/uuid"
+)
+
+type RevisionId struct {
+	uuidw.UUIDWrapper[RevisionId]
+}
+
+func NewRevisionId() RevisionId {
+	return RevisionId{uuidw.UUIDWrapper[RevisionId]{UUID: uuid.New()}}
+}
+
+func NewRevisionIdFromString(s string) (*RevisionId, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid RevisionId: %w: %w", err, e.ErrValidation)
+	}
+
+	return &RevisionId{
+		UUIDWrapper: uuidw.FromUUID[RevisionId](id),
+	}, nil
+}
+
+type RevisionAction string
+
+const (
+	Created  RevisionAction = "created"
+	Modified RevisionAction = "modified"
+	Deleted  RevisionAction = "deleted"
+	Reverted RevisionAction = "reverted"
+)
+
+func (a RevisionAction) String() string {
+	return string(a)
+}
+
+type BoxCoordinates struct {
+	Xc     float32
+	Yc     float32
+	Width  float32
+	Height float32
+	Angle  float32
+}
+
+// Shape is the state of an annotation at some point of its history. Box is
+// set on bounding boxes, Points on polygons and polylines, Keypoints on
+// poses and Mask on masks.
+type Shape struct {
+	Type       string
+	Label      lbl.Label
+	Box        *BoxCoordinates
+	Points     *Points
+	Keypoints  []Keypoint
+	Mask       *RLE
+	Source     Source
+	Attributes Attributes
+}
+
+// Revision is an immutable record of a change to an annotation. Before is
+// nil on creation, and After on deletion.
+type Revision struct {
+	Id           RevisionId
+	AnnotationId AnnotationId
+	Action       RevisionAction
+	Before       *Shape
+	After        *Shape
+	Author       *u.UserId
+	Time         *time.Time
+	// Restores is the revision that a revert brought the annotation back to.
+	Restores *RevisionId
+}
+
+// IsRestorable tells whether the annotation can be brought back to its
+// state right after this revision.
+func (r Revision) IsRestorable() bool {
+	return r.After != nil
+}
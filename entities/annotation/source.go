@@ -0,0 +1,32 @@
+package annotation
+
+import (
+	"fmt"
+	"strings"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// Source tells who produced an annotation. Annotations drawn by people have
+// an empty source, while predictions name the model run they come from
+// along with its confidence, between 0 and 1.
+type Source struct {
+	Model      string
+	Confidence float32
+}
+
+func (s Source) IsPrediction() bool {
+	return s.Model != ""
+}
+
+func NewPrediction(model string, confidence float32) (Source, error) {
+	model = strings.TrimSpace(model)
+	if model == "" {
+		return Source{}, fmt.Errorf("validating prediction: missing model run: %w", e.ErrValidation)
+	}
+	if confidence < 0 || confidence > 1 {
+		return Source{}, fmt.Errorf("validating prediction: confidence %v is not between 0 and 1: %w",
+			confidence, e.ErrValidation)
+	}
+	return Source{Model: model, Confidence: confidence}, nil
+}
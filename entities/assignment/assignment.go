@@ -0,0 +1,95 @@
+package assignment
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	uuidw "github.com/lejeunel/go-image-annotator/shared/uuid"
+)
+
+type BatchId struct{ uuidw.UUIDWrapper[BatchId] }
+
+func NewBatchId() BatchId {
+	return BatchId{uuidw.UUIDWrapper[BatchId]{UUID: uuid.New()}}
+}
+
+func NewBatchIdFromString(s string) (*BatchId, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid BatchId: %w: %w", err, e.ErrValidation)
+	}
+
+	return &BatchId{
+		UUIDWrapper: uuidw.FromUUID[BatchId](id),
+	}, nil
+}
+
+// Progress counts the images of a batch, and those that are finished.
+type Progress struct {
+	Total    int64
+	Finished int64
+}
+
+func (p Progress) Add(o Progress) Progress {
+	return Progress{Total: p.Total + o.Total, Finished: p.Finished + o.Finished}
+}
+
+func (p Progress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return int(100 * p.Finished / p.Total)
+}
+
+type AssigneeProgress struct {
+	Assignee u.UserId
+	Progress
+}
+
+// Batch is a set of images, selected by a filter, distributed across
+// annotators so that each image is annotated by a single person.
+type Batch struct {
+	Id        BatchId
+	Name      string
+	Filter    im.FilterStr
+	Creator   u.UserId
+	CreatedAt time.Time
+	Assignees []AssigneeProgress
+}
+
+func (b Batch) Progress() Progress {
+	p := Progress{}
+	for _, a := range b.Assignees {
+		p = p.Add(a.Progress)
+	}
+	return p
+}
+
+// Assignment is an image of a collection that an annotator must work on.
+type Assignment struct {
+	Batch      BatchId
+	Image      im.BaseImage
+	Assignee   u.UserId
+	FinishedAt *time.Time
+}
+
+// Distribute deals images to assignees in turn, so that they receive as
+// many images as possible.
+func Distribute(batch BatchId, images []im.BaseImage, assignees []u.UserId) []Assignment {
+	assignments := []Assignment{}
+	if len(assignees) == 0 {
+		return assignments
+	}
+	for n, image := range images {
+		assignments = append(assignments, Assignment{
+			Batch:    batch,
+			Image:    image,
+			Assignee: assignees[n%len(assignees)],
+		})
+	}
+	return assignments
+}
@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Outcome string
+
+const (
+	Success Outcome = "success"
+	Denied  Outcome = "denied"
+	Failure Outcome = "failure"
+)
+
+func (o Outcome) String() string {
+	return string(o)
+}
+
+// OutcomeOf tells how an operation that ended with err went.
+func OutcomeOf(err error) Outcome {
+	switch {
+	case err == nil:
+		return Success
+	case errors.Is(err, e.ErrAuthorization), errors.Is(err, e.ErrAuthentication):
+		return Denied
+	default:
+		return Failure
+	}
+}
+
+// Target is the entity an operation applies to, e.g. a label and its name.
+type Target struct {
+	Type string
+	Id   string
+}
+
+// ImageTarget designates an image within a collection.
+func ImageTarget(collection, imageId string) Target {
+	return Target{Type: "image", Id: collection + "/" + imageId}
+}
+
+// Entry records an operation that modified, or attempted to modify, the
+// state of the application. Actions are named after the methods of the
+// authorizer.
+type Entry struct {
+	Actor     string
+	Action    string
+	Target    Target
+	Time      time.Time
+	RequestId string
+	Outcome   Outcome
+	Error     string
+}
+
+type Filter struct {
+	Actor  *string
+	Action *string
+	From   *time.Time
+	To     *time.Time
+}
+
+var RequestIdContextKey = "request_id"
+
+func AppendRequestIdToContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, RequestIdContextKey, id)
+}
+
+func RequestIdFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(RequestIdContextKey).(string)
+	return id
+}
@@ -1,9 +1,12 @@
 package collection
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	uuidw "github.com/lejeunel/go-image-annotator/shared/uuid"
 )
 
@@ -15,6 +18,12 @@ type Collection struct {
 	Description string
 	CreatedAt   time.Time
 	Group       *string
+	// Labels are the names of the labels that annotations of the
+	// collection may carry, all labels when empty
+	Labels []string
+	// DefaultLabel, one of Labels, is given to regions ingested without a
+	// label
+	DefaultLabel *string
 }
 
 func NewCollection(id CollectionId, name string, opts ...Option) Collection {
@@ -45,6 +54,27 @@ func WithGroup(g string) Option {
 	}
 }
 
+func WithLabels(labels []string) Option {
+	return func(c *Collection) {
+		c.Labels = labels
+	}
+}
+
+func WithDefaultLabel(l string) Option {
+	return func(c *Collection) {
+		c.DefaultLabel = &l
+	}
+}
+
+// CheckLabel tells whether annotations of the collection may carry the
+// label of the given name.
+func (c Collection) CheckLabel(name string) error {
+	if len(c.Labels) > 0 && !slices.Contains(c.Labels, name) {
+		return fmt.Errorf("label %v is not allowed in collection %v: %w", name, c.Name, e.ErrValidation)
+	}
+	return nil
+}
+
 type UpdateModel struct {
 	Name           string
 	NewName        string
@@ -0,0 +1,69 @@
+package evaluation
+
+import (
+	"time"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+// IoUThresholds are the overlaps above which a prediction matches a ground
+// truth region, over which average precision is averaged as in COCO.
+var IoUThresholds = []float64{0.5, 0.55, 0.6, 0.65, 0.7, 0.75, 0.8, 0.85, 0.9, 0.95}
+
+// ConfusionIoU is the overlap above which a prediction counts as matching a
+// ground truth region in the confusion matrix, whatever their labels.
+const ConfusionIoU = 0.5
+
+type PRPoint struct {
+	Recall    float64
+	Precision float64
+}
+
+type LabelMetrics struct {
+	Label          string
+	NumGroundTruth int
+	NumPredictions int
+	// AP is the average precision averaged over IoUThresholds.
+	AP   float64
+	AP50 float64
+	AP75 float64
+	// Curve holds the interpolated precision at 101 recall levels, at an IoU
+	// of 0.5.
+	Curve []PRPoint
+}
+
+// ConfusionMatrix counts ground truth regions (rows) against the predictions
+// that match them (columns). The last row and column stand for background:
+// predictions that match nothing, and ground truth that no prediction found.
+type ConfusionMatrix struct {
+	Labels []string
+	Counts [][]int
+}
+
+// Report holds the metrics of predictions against ground truth. Means are
+// taken over labels that have ground truth.
+type Report struct {
+	NumImages      int
+	NumGroundTruth int
+	NumPredictions int
+	MAP            float64
+	MAP50          float64
+	MAP75          float64
+	Labels         []LabelMetrics
+	Confusion      ConfusionMatrix
+}
+
+// Evaluation scores the annotations of a collection of predictions against
+// those of a collection of ground truth, on the images they share.
+type Evaluation struct {
+	Id            t.TaskId
+	GroundTruth   string
+	Predictions   string
+	Filter        im.FilterStr
+	MinConfidence float32
+	Issuer        u.UserId
+	CreatedAt     time.Time
+	Report        Report
+}
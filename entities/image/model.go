@@ -43,6 +43,9 @@ type Image struct {
 	Labels        []an.ImageLabel
 	BoundingBoxes []an.BoundingBox
 	Polygons      []an.Polygon
+	Polylines     []an.Polyline
+	Keypoints     []an.Keypoints
+	Masks         []an.Mask
 	Meta          []m.MetaData
 	Reader        io.Reader
 	Hash          string
@@ -71,12 +74,40 @@ func (i *Image) AddBoundingBox(box a.BoundingBox) error {
 }
 
 func (i *Image) AddPolygon(polygon a.Polygon) error {
+	if err := a.ValidatePolygon(polygon.Points); err != nil {
+		return fmt.Errorf("adding polygon to image: %w", err)
+	}
 	i.Polygons = append(i.Polygons, polygon)
 	return nil
 }
 
+func (i *Image) AddPolyline(polyline a.Polyline) error {
+	if err := a.ValidatePolyline(polyline.Points); err != nil {
+		return fmt.Errorf("adding polyline to image: %w", err)
+	}
+	i.Polylines = append(i.Polylines, polyline)
+	return nil
+}
+
+func (i *Image) AddKeypoints(k a.Keypoints) error {
+	if err := a.ValidateKeypoints(k.Points, k.Label); err != nil {
+		return fmt.Errorf("adding keypoints to image: %w", err)
+	}
+	i.Keypoints = append(i.Keypoints, k)
+	return nil
+}
+
+func (i *Image) AddMask(mask a.Mask) error {
+	if err := a.ValidateMask(mask.RLE, i.Specs.Width, i.Specs.Height); err != nil {
+		return fmt.Errorf("adding mask to image: %w", err)
+	}
+	i.Masks = append(i.Masks, mask)
+	return nil
+}
+
 func (i *Image) NumAnnotations() int {
-	return len(i.Labels) + len(i.BoundingBoxes) + len(i.Polygons)
+	return len(i.Labels) + len(i.BoundingBoxes) + len(i.Polygons) + len(i.Polylines) +
+		len(i.Keypoints) + len(i.Masks)
 }
 
 func (i *Image) LabelNames() []string {
@@ -0,0 +1,34 @@
+package label
+
+type AttributeType string
+
+const (
+	BoolAttribute   AttributeType = "bool"
+	EnumAttribute   AttributeType = "enum"
+	NumberAttribute AttributeType = "number"
+	TextAttribute   AttributeType = "text"
+)
+
+func (t AttributeType) String() string {
+	return string(t)
+}
+
+func (t AttributeType) IsValid() bool {
+	switch t {
+	case BoolAttribute, EnumAttribute, NumberAttribute, TextAttribute:
+		return true
+	}
+	return false
+}
+
+// Attribute defines a property that regions of a label carry on top of
+// their geometry, such as whether the object is occluded. Values of bool,
+// number and text attributes are bool, float64 and string. Those of enum
+// attributes are one of Options.
+type Attribute struct {
+	Name     string
+	Type     AttributeType
+	Required bool
+	Default  any
+	Options  []string
+}
@@ -1,9 +1,16 @@
 package label
 
+import "regexp"
+
 type Label struct {
 	Id          LabelId
 	Name        string
 	Description string
+	Parent      *string
+	Color       string
+	Aliases     []string
+	Attributes  []Attribute
+	Skeleton    *Skeleton
 }
 
 func NewLabel(id LabelId, name string, opts ...Option) Label {
@@ -22,7 +29,56 @@ func WithDescription(d string) Option {
 	}
 }
 
+func WithParent(p *string) Option {
+	return func(l *Label) {
+		l.Parent = p
+	}
+}
+
+func WithColor(c string) Option {
+	return func(l *Label) {
+		l.Color = c
+	}
+}
+
+func WithAliases(a []string) Option {
+	return func(l *Label) {
+		l.Aliases = a
+	}
+}
+
+func WithAttributes(a []Attribute) Option {
+	return func(l *Label) {
+		l.Attributes = a
+	}
+}
+
+func WithSkeleton(s *Skeleton) Option {
+	return func(l *Label) {
+		l.Skeleton = s
+	}
+}
+
+var validColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// IsValidColor tells whether c is a color of the form #rrggbb. Labels
+// without a color are given one by the annotator.
+func IsValidColor(c string) bool {
+	return c == "" || validColor.MatchString(c)
+}
+
 type UpdatableModel struct {
 	Name           string
 	NewDescription string
+	NewParent      *string
+	NewColor       string
+	NewAliases     []string
+	NewAttributes  []Attribute
+	NewSkeleton    *Skeleton
+}
+
+// Node is a label along with the labels it is the parent of.
+type Node struct {
+	Label
+	Children []Node
 }
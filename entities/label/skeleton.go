@@ -0,0 +1,54 @@
+package label
+
+import (
+	"fmt"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// Skeleton defines the keypoints that pose annotations of a label are
+// made of. Keypoints are given in the order of Points, and each edge joins
+// two of them by name.
+type Skeleton struct {
+	Points []string
+	Edges  [][2]string
+}
+
+func (s Skeleton) Validate() error {
+	errCtx := "validating skeleton"
+	if len(s.Points) == 0 {
+		return fmt.Errorf("%v: no points: %w", errCtx, e.ErrValidation)
+	}
+	names := map[string]bool{}
+	for _, p := range s.Points {
+		if p == "" {
+			return fmt.Errorf("%v: empty point name: %w", errCtx, e.ErrValidation)
+		}
+		if names[p] {
+			return fmt.Errorf("%v: duplicate point %v: %w", errCtx, p, e.ErrValidation)
+		}
+		names[p] = true
+	}
+	for _, edge := range s.Edges {
+		for _, p := range edge {
+			if !names[p] {
+				return fmt.Errorf("%v: edge %v-%v joins unknown point %v: %w",
+					errCtx, edge[0], edge[1], p, e.ErrValidation)
+			}
+		}
+		if edge[0] == edge[1] {
+			return fmt.Errorf("%v: edge joins point %v to itself: %w", errCtx, edge[0], e.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// Index gives the position of the named point, or -1.
+func (s Skeleton) Index(name string) int {
+	for i, p := range s.Points {
+		if p == name {
+			return i
+		}
+	}
+	return -1
+}
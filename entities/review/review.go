@@ -0,0 +1,21 @@
+package review
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+// Submission is an annotation awaiting review.
+type Submission struct {
+	AnnotationId a.AnnotationId
+	ImageId      im.ImageId
+	Collection   clc.CollectionName
+	Type         string
+	Label        string
+	Author       *u.UserId
+	Time         *time.Time
+}
@@ -9,6 +9,7 @@ type DefaultRole struct {
 
 var DefaultRoleNames = []DefaultRole{
 	{"annotator", "can annotate images"},
+	{"reviewer", "can accept or reject submitted annotations"},
 	{"image-contributor", "can create collections and add images"},
 	{AdminRoleName, "can do anything"},
 }
@@ -12,8 +12,11 @@ type TaskType string
 const (
 	CollectionCloneTask  TaskType = "collection-clone"
 	CollectionDeleteTask TaskType = "collection-delete"
+	CollectionExportTask TaskType = "collection-export"
 	IngestDirTask        TaskType = "ingest-dir"
 	IngestArchiveTask    TaskType = "ingest-archive"
+	EvaluationTask       TaskType = "evaluation"
+	AgreementTask        TaskType = "agreement"
 )
 
 func (r TaskType) String() string {
@@ -22,7 +25,7 @@ func (r TaskType) String() string {
 
 func (r TaskType) Valid() bool {
 	switch r {
-	case CollectionCloneTask, IngestDirTask:
+	case CollectionCloneTask, CollectionExportTask, IngestDirTask:
 		return true
 	default:
 		return false
@@ -0,0 +1,94 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	uuidw "github.com/lejeunel/go-image-annotator/shared/uuid"
+)
+
+type ViewId struct{ uuidw.UUIDWrapper[ViewId] }
+
+func NewViewId() ViewId {
+	return ViewId{uuidw.UUIDWrapper[ViewId]{UUID: uuid.New()}}
+}
+
+func NewViewIdFromString(s string) (*ViewId, error) {
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ViewId: %w: %w", err, e.ErrValidation)
+	}
+
+	return &ViewId{
+		UUIDWrapper: uuidw.FromUUID[ViewId](id),
+	}, nil
+}
+
+// View is a named query on images, i.e. a filter and an ordering, that its
+// owner may share with groups of users.
+type View struct {
+	Id     ViewId
+	Name   string
+	Owner  u.UserId
+	Filter im.FilterStr
+	Order  im.OrderStr
+	Groups []string
+}
+
+func NewView(id ViewId, name string, owner u.UserId, opts ...Option) View {
+	v := &View{Id: id, Name: name, Owner: owner, Groups: []string{}}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return *v
+}
+
+type Option func(*View)
+
+func WithFilter(f im.FilterStr) Option {
+	return func(v *View) {
+		v.Filter = f
+	}
+}
+
+func WithOrder(o im.OrderStr) Option {
+	return func(v *View) {
+		v.Order = o
+	}
+}
+
+func WithGroups(groups []string) Option {
+	return func(v *View) {
+		v.Groups = groups
+	}
+}
+
+// IsVisibleTo tells whether user may open the view, i.e. whether they own
+// it, are an administrator, or belong to a group it is shared with.
+func (v View) IsVisibleTo(user u.User) bool {
+	if v.IsEditableBy(user) {
+		return true
+	}
+	for _, group := range user.Groups {
+		if slices.Contains(v.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func (v View) IsEditableBy(user u.User) bool {
+	return v.Owner == user.Id || user.IsAdmin()
+}
+
+type UpdateModel struct {
+	Id     ViewId
+	Name   string
+	Filter im.FilterStr
+	Order  im.OrderStr
+	Groups []string
+}
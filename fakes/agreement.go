@@ -0,0 +1,51 @@
+package fake
+
+import (
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type AgreementRepo struct {
+	ErrOnCreate error
+	ErrOnList   error
+	ErrOnCount  error
+	Agreements  []ag.Agreement
+	Created     *ag.Agreement
+	GotUser     u.UserId
+}
+
+func (r *AgreementRepo) Create(v ag.Agreement) error {
+	if r.ErrOnCreate != nil {
+		return r.ErrOnCreate
+	}
+	r.Created = &v
+	return nil
+}
+
+func (r *AgreementRepo) Find(id t.TaskId) (*ag.Agreement, error) {
+	for _, v := range r.Agreements {
+		if v.Id == id {
+			return &v, nil
+		}
+	}
+	return nil, e.ErrNotFound
+}
+
+func (r *AgreementRepo) ListOf(user u.UserId, p pag.PaginationParams) ([]ag.Agreement, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	r.GotUser = user
+	return r.Agreements, nil
+}
+
+func (r *AgreementRepo) CountOf(user u.UserId) (*int64, error) {
+	if r.ErrOnCount != nil {
+		return nil, r.ErrOnCount
+	}
+	count := int64(len(r.Agreements))
+	return &count, nil
+}
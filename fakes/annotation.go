@@ -1,22 +1,35 @@
 package fake
 
 import (
+	"fmt"
 	"time"
 
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 )
 
 type AnnotationRepo struct {
 	Err                       error
 	ErrOnAddPoly              error
+	ErrOnAddPolyline          error
+	ErrOnAddKeypoints         error
+	ErrOnAddMask              error
 	ErrOnAddLabel             error
 	ErrOnAddBoundingBox       error
 	ErrOnUpdate               error
 	ErrOnFindPolygons         error
+	ErrOnFindPolylines        error
+	ErrOnFindKeypoints        error
+	ErrOnFindMasks            error
+	ErrOnFindMask             error
+	ErrOnFindPolygon          error
+	ErrOnFindImage            error
 	ErrOnFindBoundingBoxes    error
 	ErrOnFindImageLabels      error
 	ErrOnRemoveAllAnnotations error
@@ -27,12 +40,18 @@ type AnnotationRepo struct {
 	GotTime                   *time.Time
 	GotBox                    a.BoundingBox
 	GotPolygon                a.Polygon
+	GotPolyline               a.Polyline
+	GotKeypoints              a.Keypoints
+	GotMask                   a.Mask
 	AddedAnnotationId         *a.AnnotationId
 	AddedLabelId              lbl.LabelId
 	AddedOnImageId            im.ImageId
 	AddedOnCollection         clc.CollectionName
 	GotUpdatableBox           a.BoundingBoxUpdatables
 	GotUpdatablePoly          a.PolygonUpdatables
+	GotUpdatablePolyline      a.PolylineUpdatables
+	GotUpdatableKeypoints     a.KeypointsUpdatables
+	GotUpdatableMask          a.MaskUpdatables
 	GotRemovedAnnotation      a.AnnotationId
 	ErrOnRemoveAnnotation     error
 	UpdatedAnnotationId       a.AnnotationId
@@ -40,11 +59,41 @@ type AnnotationRepo struct {
 	NumBoundingBoxesAdded     int
 	NumImageLabelsAdded       int
 	NumPolygonsAdded          int
+	NumPolylinesAdded         int
+	NumKeypointsAdded         int
+	NumMasksAdded             int
 	RemovedAllAnnotations     bool
 	Labels                    []a.ImageLabel
 	BoundingBoxes             []a.BoundingBox
 	Polygons                  []a.Polygon
+	Polylines                 []a.Polyline
+	Keypoints                 []a.Keypoints
+	Masks                     []a.Mask
+	Mask                      *a.Mask
+	Polygon                   *a.Polygon
+	Image                     im.BaseImage
 	Specs                     im.Specs
+	Review                    *a.Review
+	ErrOnFindReview           error
+	ErrOnSetReview            error
+	SetReviews                map[a.AnnotationId]a.Review
+	Submissions               []rv.Submission
+	ErrOnListSubmissions      error
+	GotCollections            []clc.CollectionName
+	Revisions                 []a.Revision
+	ErrOnListRevisions        error
+	ErrOnRestoreRevision      error
+	Restored                  *a.RevisionId
+	Source                    *a.Source
+	ErrOnFindSource           error
+	ErrOnAcceptPrediction     error
+	AcceptedPrediction        *a.AnnotationId
+	Attributes                a.Attributes
+	ErrOnFindAttributes       error
+	GotAttributes             a.Attributes
+	AnnotationLabel           lbl.Label
+	Collection                clc.Collection
+	ErrOnFindCollection       error
 
 	NoGroup bool
 }
@@ -89,6 +138,66 @@ func (r *AnnotationRepo) AddPolygon(
 	return nil
 }
 
+func (r *AnnotationRepo) AddPolyline(
+	imageId im.ImageId,
+	collection clc.CollectionName,
+	line a.Polyline,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	if r.ErrOnAddPolyline != nil {
+		return r.ErrOnAddPolyline
+	}
+	r.GotImageId = imageId
+	r.GotCollection = collection
+	r.GotPolyline = line
+	r.AddedAnnotationId = &line.Id
+	r.GotUserId = userId
+	r.GotTime = t
+	r.NumPolylinesAdded += 1
+	return nil
+}
+
+func (r *AnnotationRepo) AddKeypoints(
+	imageId im.ImageId,
+	collection clc.CollectionName,
+	k a.Keypoints,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	if r.ErrOnAddKeypoints != nil {
+		return r.ErrOnAddKeypoints
+	}
+	r.GotImageId = imageId
+	r.GotCollection = collection
+	r.GotKeypoints = k
+	r.AddedAnnotationId = &k.Id
+	r.GotUserId = userId
+	r.GotTime = t
+	r.NumKeypointsAdded += 1
+	return nil
+}
+
+func (r *AnnotationRepo) AddMask(
+	imageId im.ImageId,
+	collection clc.CollectionName,
+	mask a.Mask,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	if r.ErrOnAddMask != nil {
+		return r.ErrOnAddMask
+	}
+	r.GotImageId = imageId
+	r.GotCollection = collection
+	r.GotMask = mask
+	r.AddedAnnotationId = &mask.Id
+	r.GotUserId = userId
+	r.GotTime = t
+	r.NumMasksAdded += 1
+	return nil
+}
+
 func (r *AnnotationRepo) AddImageLabel(
 	imageId im.ImageId,
 	collection clc.CollectionName,
@@ -132,6 +241,13 @@ func (r *AnnotationRepo) GroupOfAnnotation(id a.AnnotationId) (*string, error) {
 	return &group, nil
 }
 
+func (r *AnnotationRepo) CollectionOfAnnotation(id a.AnnotationId) (*clc.Collection, error) {
+	if r.ErrOnFindCollection != nil {
+		return nil, r.ErrOnFindCollection
+	}
+	return &r.Collection, nil
+}
+
 func (r *AnnotationRepo) UpdatePolygon(
 	id a.AnnotationId,
 	u a.PolygonUpdatables,
@@ -147,17 +263,65 @@ func (r *AnnotationRepo) UpdatePolygon(
 	return nil
 }
 
-func (r *AnnotationRepo) RemoveAnnotation(annotationId a.AnnotationId) error {
+func (r *AnnotationRepo) UpdatePolyline(
+	id a.AnnotationId,
+	u a.PolylineUpdatables,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	if r.ErrOnUpdate != nil {
+		return r.ErrOnUpdate
+	}
+	r.GotUpdatablePolyline = u
+	r.GotUserId = userId
+	r.GotTime = t
+	return nil
+}
+
+func (r *AnnotationRepo) UpdateKeypoints(
+	id a.AnnotationId,
+	u a.KeypointsUpdatables,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	if r.ErrOnUpdate != nil {
+		return r.ErrOnUpdate
+	}
+	r.GotUpdatableKeypoints = u
+	r.GotUserId = userId
+	r.GotTime = t
+	return nil
+}
+
+func (r *AnnotationRepo) UpdateMask(
+	id a.AnnotationId,
+	u a.MaskUpdatables,
+	userId *u.UserId,
+	t *time.Time,
+) error {
+	if r.ErrOnUpdate != nil {
+		return r.ErrOnUpdate
+	}
+	r.GotUpdatableMask = u
+	r.GotUserId = userId
+	r.GotTime = t
+	return nil
+}
+
+func (r *AnnotationRepo) RemoveAnnotation(annotationId a.AnnotationId, userId *u.UserId, t *time.Time) error {
 	if r.ErrOnRemoveAnnotation != nil {
 		return r.ErrOnRemoveAnnotation
 	}
 	r.GotRemovedAnnotation = annotationId
+	r.GotUserId = userId
+	r.GotTime = t
 	return nil
 }
 
 func (r *AnnotationRepo) UpdateLabelOfAnnotation(
 	annotationId a.AnnotationId,
 	labelId lbl.LabelId,
+	attributes a.Attributes,
 	userId *u.UserId,
 	t *time.Time,
 ) error {
@@ -166,6 +330,7 @@ func (r *AnnotationRepo) UpdateLabelOfAnnotation(
 	}
 	r.UpdatedAnnotationId = annotationId
 	r.UpdatedLabelId = labelId
+	r.GotAttributes = attributes
 	r.GotUserId = userId
 	r.GotTime = t
 	return nil
@@ -197,6 +362,72 @@ func (r *AnnotationRepo) FindPolygons(
 	return nil, nil
 }
 
+func (r *AnnotationRepo) FindPolylines(
+	imageId im.ImageId,
+	collection clc.CollectionName,
+) ([]a.Polyline, error) {
+	if r.ErrOnFindPolylines != nil {
+		return nil, r.ErrOnFindPolylines
+	}
+	if r.Polylines != nil {
+		return r.Polylines, nil
+	}
+	return nil, nil
+}
+
+func (r *AnnotationRepo) FindKeypoints(
+	imageId im.ImageId,
+	collection clc.CollectionName,
+) ([]a.Keypoints, error) {
+	if r.ErrOnFindKeypoints != nil {
+		return nil, r.ErrOnFindKeypoints
+	}
+	if r.Keypoints != nil {
+		return r.Keypoints, nil
+	}
+	return nil, nil
+}
+
+func (r *AnnotationRepo) FindMasks(
+	imageId im.ImageId,
+	collection clc.CollectionName,
+) ([]a.Mask, error) {
+	if r.ErrOnFindMasks != nil {
+		return nil, r.ErrOnFindMasks
+	}
+	if r.Masks != nil {
+		return r.Masks, nil
+	}
+	return nil, nil
+}
+
+func (r *AnnotationRepo) FindMask(id a.AnnotationId) (*a.Mask, error) {
+	if r.ErrOnFindMask != nil {
+		return nil, r.ErrOnFindMask
+	}
+	if r.Mask == nil {
+		return nil, fmt.Errorf("fetching mask %v: %w", id, e.ErrNotFound)
+	}
+	return r.Mask, nil
+}
+
+func (r *AnnotationRepo) FindPolygon(id a.AnnotationId) (*a.Polygon, error) {
+	if r.ErrOnFindPolygon != nil {
+		return nil, r.ErrOnFindPolygon
+	}
+	if r.Polygon == nil {
+		return nil, fmt.Errorf("fetching polygon %v: %w", id, e.ErrNotFound)
+	}
+	return r.Polygon, nil
+}
+
+func (r *AnnotationRepo) ImageOfAnnotation(id a.AnnotationId) (*im.BaseImage, error) {
+	if r.ErrOnFindImage != nil {
+		return nil, r.ErrOnFindImage
+	}
+	return &r.Image, nil
+}
+
 func (r *AnnotationRepo) FindImageLabels(
 	imageId im.ImageId,
 	collection clc.CollectionName,
@@ -210,10 +441,144 @@ func (r *AnnotationRepo) FindImageLabels(
 	return nil, nil
 }
 
-func (r *AnnotationRepo) RemoveAllAnnotations(imageId im.ImageId, collection string) error {
+func (r *AnnotationRepo) RemoveAllAnnotations(imageId im.ImageId, collection string, userId *u.UserId, t *time.Time) error {
 	if r.ErrOnRemoveAllAnnotations != nil {
 		return r.ErrOnRemoveAllAnnotations
 	}
 	r.RemovedAllAnnotations = true
 	return nil
 }
+
+func (r *AnnotationRepo) FindReview(id a.AnnotationId) (*a.Review, error) {
+	if r.ErrOnFindReview != nil {
+		return nil, r.ErrOnFindReview
+	}
+	if r.Review != nil {
+		return r.Review, nil
+	}
+	return &a.Review{Status: a.Draft}, nil
+}
+
+func (r *AnnotationRepo) SetReview(id a.AnnotationId, review a.Review) error {
+	if r.ErrOnSetReview != nil {
+		return r.ErrOnSetReview
+	}
+	if r.SetReviews == nil {
+		r.SetReviews = map[a.AnnotationId]a.Review{}
+	}
+	r.SetReviews[id] = review
+	return nil
+}
+
+func (r *AnnotationRepo) ListSubmissions(
+	collections []clc.CollectionName,
+	p pa.PaginationParams,
+) ([]rv.Submission, error) {
+	if r.ErrOnListSubmissions != nil {
+		return nil, r.ErrOnListSubmissions
+	}
+	r.GotCollections = collections
+	return r.Submissions, nil
+}
+
+func (r *AnnotationRepo) CountSubmissions(collections []clc.CollectionName) (*int64, error) {
+	if r.ErrOnListSubmissions != nil {
+		return nil, r.ErrOnListSubmissions
+	}
+	count := int64(len(r.Submissions))
+	return &count, nil
+}
+
+func (r *AnnotationRepo) ListRevisions(id a.AnnotationId) ([]a.Revision, error) {
+	if r.ErrOnListRevisions != nil {
+		return nil, r.ErrOnListRevisions
+	}
+	revisions := []a.Revision{}
+	for _, rev := range r.Revisions {
+		if rev.AnnotationId == id {
+			revisions = append(revisions, rev)
+		}
+	}
+	return revisions, nil
+}
+
+func (r *AnnotationRepo) FindRevision(id a.RevisionId) (*a.Revision, error) {
+	for _, rev := range r.Revisions {
+		if rev.Id == id {
+			return &rev, nil
+		}
+	}
+	return nil, fmt.Errorf("fetching revision %v: %w", id, e.ErrNotFound)
+}
+
+func (r *AnnotationRepo) RestoreRevision(id a.RevisionId, userId *u.UserId, t *time.Time) (*a.Revision, error) {
+	if r.ErrOnRestoreRevision != nil {
+		return nil, r.ErrOnRestoreRevision
+	}
+	target, err := r.FindRevision(id)
+	if err != nil {
+		return nil, err
+	}
+	r.Restored = &id
+	r.GotUserId = userId
+	r.GotTime = t
+	return &a.Revision{
+		Id:           a.NewRevisionId(),
+		AnnotationId: target.AnnotationId,
+		Action:       a.Reverted,
+		After:        target.After,
+		Author:       userId,
+		Time:         t,
+		Restores:     &id,
+	}, nil
+}
+
+func (r *AnnotationRepo) FindSource(id a.AnnotationId) (*a.Source, error) {
+	if r.ErrOnFindSource != nil {
+		return nil, r.ErrOnFindSource
+	}
+	if r.Source != nil {
+		return r.Source, nil
+	}
+	return &a.Source{}, nil
+}
+
+func (r *AnnotationRepo) AcceptPrediction(id a.AnnotationId, userId *u.UserId, t *time.Time) error {
+	if r.ErrOnAcceptPrediction != nil {
+		return r.ErrOnAcceptPrediction
+	}
+	r.AcceptedPrediction = &id
+	r.GotUserId = userId
+	r.GotTime = t
+	return nil
+}
+
+func (r *AnnotationRepo) FindAttributes(id a.AnnotationId) (a.Attributes, error) {
+	if r.ErrOnFindAttributes != nil {
+		return nil, r.ErrOnFindAttributes
+	}
+	if r.Attributes == nil {
+		return a.Attributes{}, nil
+	}
+	return r.Attributes, nil
+}
+
+func (r *AnnotationRepo) LabelOfAnnotation(id a.AnnotationId) (*lbl.Label, error) {
+	if r.ErrOnFindAttributes != nil {
+		return nil, r.ErrOnFindAttributes
+	}
+	return &r.AnnotationLabel, nil
+}
+
+func (r *AnnotationRepo) UpdateAttributes(id a.AnnotationId, attributes a.Attributes,
+	userId *u.UserId, t *time.Time,
+) error {
+	if r.ErrOnUpdate != nil {
+		return r.ErrOnUpdate
+	}
+	r.UpdatedAnnotationId = id
+	r.GotAttributes = attributes
+	r.GotUserId = userId
+	r.GotTime = t
+	return nil
+}
@@ -0,0 +1,115 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type AssignmentRepo struct {
+	ErrOnCreate     error
+	ErrOnIsAssigned error
+	ErrOnFind       error
+	ErrOnList       error
+	ErrOnCount      error
+	ErrOnDelete     error
+	ErrOnNext       error
+	ErrOnFinish     error
+	Batches         []a.Batch
+	Assigned        []im.BaseImage
+	Created         *a.Batch
+	GotAssignments  []a.Assignment
+	Deleted         *a.BatchId
+	Next            *a.Assignment
+	GotSkip         *im.BaseImage
+	GotUser         u.UserId
+	Finished        *im.BaseImage
+	FinishedAt      time.Time
+}
+
+func (r *AssignmentRepo) CreateBatch(b a.Batch, assignments []a.Assignment) error {
+	if r.ErrOnCreate != nil {
+		return r.ErrOnCreate
+	}
+	r.Created = &b
+	r.GotAssignments = assignments
+	return nil
+}
+
+func (r *AssignmentRepo) IsAssigned(image im.BaseImage) (bool, error) {
+	if r.ErrOnIsAssigned != nil {
+		return false, r.ErrOnIsAssigned
+	}
+	return slices.Contains(r.Assigned, image), nil
+}
+
+func (r *AssignmentRepo) FindBatch(id a.BatchId) (*a.Batch, error) {
+	if r.ErrOnFind != nil {
+		return nil, r.ErrOnFind
+	}
+	for _, b := range r.Batches {
+		if b.Id == id {
+			return &b, nil
+		}
+	}
+	return nil, fmt.Errorf("finding assignment batch %v: %w", id, e.ErrNotFound)
+}
+
+func (r *AssignmentRepo) ListBatches(p pag.PaginationParams) ([]a.Batch, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	return r.Batches, nil
+}
+
+func (r *AssignmentRepo) CountBatches() (*int64, error) {
+	if r.ErrOnCount != nil {
+		return nil, r.ErrOnCount
+	}
+	count := int64(len(r.Batches))
+	return &count, nil
+}
+
+func (r *AssignmentRepo) ListBatchesOf(user u.UserId) ([]a.Batch, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	r.GotUser = user
+	return r.Batches, nil
+}
+
+func (r *AssignmentRepo) DeleteBatch(id a.BatchId) error {
+	if r.ErrOnDelete != nil {
+		return r.ErrOnDelete
+	}
+	r.Deleted = &id
+	return nil
+}
+
+func (r *AssignmentRepo) NextAssignment(user u.UserId, skip *im.BaseImage) (*a.Assignment, error) {
+	if r.ErrOnNext != nil {
+		return nil, r.ErrOnNext
+	}
+	r.GotUser = user
+	r.GotSkip = skip
+	if r.Next == nil {
+		return nil, fmt.Errorf("fetching next image assigned to %v: %w", user, e.ErrNotFound)
+	}
+	return r.Next, nil
+}
+
+func (r *AssignmentRepo) FinishAssignment(user u.UserId, image im.BaseImage, t time.Time) error {
+	if r.ErrOnFinish != nil {
+		return r.ErrOnFinish
+	}
+	r.GotUser = user
+	r.Finished = &image
+	r.FinishedAt = t
+	return nil
+}
@@ -0,0 +1,46 @@
+package fake
+
+import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type AuditRepo struct {
+	ErrOnAdd   error
+	ErrOnList  error
+	ErrOnCount error
+	Entries    []au.Entry
+	GotFilter  au.Filter
+}
+
+func (r *AuditRepo) AddEntry(entry au.Entry) error {
+	if r.ErrOnAdd != nil {
+		return r.ErrOnAdd
+	}
+	r.Entries = append(r.Entries, entry)
+	return nil
+}
+
+func (r *AuditRepo) ListEntries(f au.Filter, p pag.PaginationParams) ([]au.Entry, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	r.GotFilter = f
+	return r.Entries, nil
+}
+
+func (r *AuditRepo) AllEntries(f au.Filter) ([]au.Entry, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	r.GotFilter = f
+	return r.Entries, nil
+}
+
+func (r *AuditRepo) CountEntries(f au.Filter) (*int64, error) {
+	if r.ErrOnCount != nil {
+		return nil, r.ErrOnCount
+	}
+	count := int64(len(r.Entries))
+	return &count, nil
+}
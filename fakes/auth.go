@@ -32,10 +32,22 @@ func (f Auth) CloneCollection(ctx context.Context, g string) error {
 	return f.Err
 }
 
+func (f Auth) ExportCollection(ctx context.Context, g string) error {
+	return f.Err
+}
+
+func (f Auth) ReadImage(ctx context.Context, g string) error {
+	return f.Err
+}
+
 func (f Auth) Annotate(ctx context.Context, g string) error {
 	return f.Err
 }
 
+func (f Auth) Review(ctx context.Context, g string) error {
+	return f.Err
+}
+
 func (f Auth) DeleteImage(ctx context.Context, g string) error {
 	return f.Err
 }
@@ -48,6 +60,10 @@ func (f Auth) IngestImage(ctx context.Context, group string) error {
 	return f.Err
 }
 
+func (f Auth) ImportPredictions(ctx context.Context, group string) error {
+	return f.Err
+}
+
 func (f Auth) CreateLabel(ctx context.Context) error {
 	return f.Err
 }
@@ -128,6 +144,14 @@ func (f Auth) SetPolicies(ctx context.Context) error {
 	return f.Err
 }
 
+func (f Auth) AssignImages(ctx context.Context) error {
+	return f.Err
+}
+
+func (f Auth) ReadAuditLog(ctx context.Context) error {
+	return f.Err
+}
+
 func (f Auth) AddMetadata(ctx context.Context, group string) error {
 	return f.Err
 }
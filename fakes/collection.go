@@ -4,6 +4,7 @@ import (
 	"slices"
 
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
 )
 
@@ -16,6 +17,7 @@ type CollectionRepo struct {
 	ErrOnList      error
 	ErrOnUpdate    error
 	ErrOnGetGroup  error
+	ErrOnSetLabels error
 	ExistingNames  []string
 	IsPopulated_   bool
 	Return         clc.Collection
@@ -23,6 +25,9 @@ type CollectionRepo struct {
 	Got            clc.Collection
 	GotUpdateModel clc.UpdateModel
 	ReturnGroup    string
+	ReturnList     []clc.Collection
+	GotLabels      []lbl.LabelId
+	GotDefault     *lbl.LabelId
 }
 
 func (r *CollectionRepo) Create(c clc.Collection) error {
@@ -83,6 +88,14 @@ func (r *CollectionRepo) List(req pa.PaginationParams) ([]*clc.Collection, error
 	}
 
 	result := []*clc.Collection{}
+	if r.ReturnList != nil {
+		start := min(int(req.Page-1)*req.PageSize, len(r.ReturnList))
+		end := min(start+req.PageSize, len(r.ReturnList))
+		for i := start; i < end; i++ {
+			result = append(result, &r.ReturnList[i])
+		}
+		return result, nil
+	}
 	for range req.PageSize {
 		result = append(result, &r.Return)
 	}
@@ -103,3 +116,12 @@ func (r *CollectionRepo) GetGroup(name string) (*string, error) {
 	}
 	return &r.ReturnGroup, nil
 }
+
+func (r *CollectionRepo) SetLabels(name string, labels []lbl.LabelId, defaultLabel *lbl.LabelId) error {
+	if r.ErrOnSetLabels != nil {
+		return r.ErrOnSetLabels
+	}
+	r.GotLabels = labels
+	r.GotDefault = defaultLabel
+	return nil
+}
@@ -0,0 +1,51 @@
+package fake
+
+import (
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type EvaluationRepo struct {
+	ErrOnCreate error
+	ErrOnList   error
+	ErrOnCount  error
+	Evaluations []ev.Evaluation
+	Created     *ev.Evaluation
+	GotUser     u.UserId
+}
+
+func (r *EvaluationRepo) Create(v ev.Evaluation) error {
+	if r.ErrOnCreate != nil {
+		return r.ErrOnCreate
+	}
+	r.Created = &v
+	return nil
+}
+
+func (r *EvaluationRepo) Find(id t.TaskId) (*ev.Evaluation, error) {
+	for _, v := range r.Evaluations {
+		if v.Id == id {
+			return &v, nil
+		}
+	}
+	return nil, e.ErrNotFound
+}
+
+func (r *EvaluationRepo) ListOf(user u.UserId, p pag.PaginationParams) ([]ev.Evaluation, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	r.GotUser = user
+	return r.Evaluations, nil
+}
+
+func (r *EvaluationRepo) CountOf(user u.UserId) (*int64, error) {
+	if r.ErrOnCount != nil {
+		return nil, r.ErrOnCount
+	}
+	count := int64(len(r.Evaluations))
+	return &count, nil
+}
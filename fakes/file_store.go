@@ -9,6 +9,7 @@ type FileStore struct {
 	ErrOnStore      error
 	ErrOnGet        error
 	NumDeletedItems int
+	NumGets         int
 	Data            []byte
 	GotData         []byte
 }
@@ -26,6 +27,9 @@ func (r *FileStore) Store(path string, reader io.Reader) error {
 }
 
 func (r *FileStore) GetReaderAt(string) (io.ReaderAt, int64, error) {
+	if r.ErrOnGet != nil {
+		return nil, 0, r.ErrOnGet
+	}
 	return bytes.NewReader(r.Data), int64(len(r.Data)), nil
 }
 
@@ -34,9 +38,10 @@ func (r *FileStore) Delete(string) error {
 	return nil
 }
 
-func (r *FileStore) Get(string) (io.Reader, error) {
+func (r *FileStore) Get(string) (io.ReadCloser, error) {
+	r.NumGets += 1
 	if r.ErrOnGet != nil {
 		return nil, r.ErrOnGet
 	}
-	return bytes.NewBuffer(r.Data), nil
+	return io.NopCloser(bytes.NewBuffer(r.Data)), nil
 }
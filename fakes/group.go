@@ -12,10 +12,12 @@ type GroupRepo struct {
 	ErrOnFind                 error
 	ErrOnList                 error
 	ErrOnUpdate               error
+	ErrOnMembers              error
 	Return                    grp.Group
 	ReturnList                []grp.Group
 	ExistingNames             []string
 	GotUpdate                 grp.UpdateModel
+	GroupMembers              map[string][]string
 }
 
 func (r *GroupRepo) Find(name string) (*grp.Group, error) {
@@ -54,3 +56,10 @@ func (r *GroupRepo) Update(m grp.UpdateModel) error {
 	r.GotUpdate = m
 	return nil
 }
+
+func (r *GroupRepo) Members(name string) ([]string, error) {
+	if r.ErrOnMembers != nil {
+		return nil, r.ErrOnMembers
+	}
+	return r.GroupMembers[name], nil
+}
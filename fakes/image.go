@@ -3,10 +3,13 @@ package fake
 import (
 	"iter"
 	"slices"
+	"strings"
 
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
 	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"go.tomakado.io/dumbql/schema"
 )
 
 type ImageRepo struct {
@@ -15,6 +18,7 @@ type ImageRepo struct {
 	ErrOnImageExistsInCollection error
 	ErrOnImageExists             error
 	ErrOnGetSpecs                error
+	ErrOnGetHash                 error
 	ErrOnAddToCollection         error
 	ErrOnSlice                   error
 	ErrOnAddImage                error
@@ -22,6 +26,7 @@ type ImageRepo struct {
 	ErrOnFindHash                error
 	ErrOnCount                   error
 	ErrOnIterate                 error
+	ErrOnGetGroups               error
 	ErrOnIsUsed                  error
 	ErrOnGetAdjacent             error
 	AddedImageId                 im.ImageId
@@ -34,10 +39,12 @@ type ImageRepo struct {
 	GotHash                      []byte
 	GotSpecs                     im.Specs
 	ReturnSpecs                  *im.Specs
+	ReturnHash                   string
 	NumDeletedImages             int
 	HashAlreadyExists            bool
 	Count_                       int64
 	IterateBaseImages            []im.BaseImage
+	ReturnGroups                 []*string
 	Adjacent                     im.AdjacentImages
 	ImageMissing                 bool
 }
@@ -153,9 +160,33 @@ func (r ImageRepo) GetSpecs(im.ImageId) (*im.Specs, error) {
 	return r.ReturnSpecs, nil
 }
 
+func (r ImageRepo) GetHash(im.ImageId) (string, error) {
+	if r.ErrOnGetHash != nil {
+		return "", r.ErrOnGetHash
+	}
+	return r.ReturnHash, nil
+}
+
+// Iterate yields the images of the collection that a filter starting with a
+// condition on the collection selects, as the filter language reads it, and
+// all images otherwise.
 func (r ImageRepo) Iterate(f im.FilterStr, pageSize int) iter.Seq2[im.BaseImage, error] {
 	return func(yield func(im.BaseImage, error) bool) {
+		var collection any
+		scoped := strings.HasPrefix(f, "collection=")
+		if scoped {
+			expr, err := qu.NewFilterParser(schema.Schema{}).Parse(f)
+			if err != nil {
+				yield(im.BaseImage{}, err)
+				return
+			}
+			_, args, _ := expr.ToSql()
+			collection = args[0]
+		}
 		for img := range slices.Values(r.IterateBaseImages) {
+			if scoped && img.Collection != "" && img.Collection != collection {
+				continue
+			}
 			if !yield(img, nil) {
 				return
 			}
@@ -180,6 +211,17 @@ func (r *ImageRepo) GetAdjacent(
 	if r.ErrOnGetAdjacent != nil {
 		return nil, r.ErrOnGetAdjacent
 	}
+	r.GotFilters = f
 	return &r.Adjacent, nil
 
 }
+
+func (r ImageRepo) GetGroups(im.ImageId) ([]*string, error) {
+	if r.ErrOnGetGroups != nil {
+		return nil, r.ErrOnGetGroups
+	}
+	if r.ReturnGroups == nil {
+		return []*string{nil}, nil
+	}
+	return r.ReturnGroups, nil
+}
@@ -1,8 +1,11 @@
 package fake
 
 import (
+	"time"
+
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 )
 
 type ImageStore struct {
@@ -11,8 +14,10 @@ type ImageStore struct {
 	Return             *im.Image
 	DeletedAssetId     *im.ImageId
 	DeletedId          *im.ImageId
+	DeletedBy          *u.UserId
 	DeletedBatch       bool
 	CopiedToCollection string
+	NumCopied          int
 }
 
 func (s *ImageStore) Find(baseImage im.BaseImage) (*im.Image, error) {
@@ -30,15 +35,16 @@ func (s *ImageStore) DeleteAsset(id im.ImageId) error {
 	return nil
 }
 
-func (s *ImageStore) Delete(id im.ImageId, collection clc.CollectionName) error {
+func (s *ImageStore) Delete(id im.ImageId, collection clc.CollectionName, userId *u.UserId, t *time.Time) error {
 	if s.ErrOnDelete != nil {
 		return s.ErrOnDelete
 	}
 	s.DeletedId = &id
+	s.DeletedBy = userId
 	return nil
 }
 
-func (s *ImageStore) DeleteBatch([]im.ImageId, clc.CollectionName) error {
+func (s *ImageStore) DeleteBatch([]im.ImageId, clc.CollectionName, *u.UserId, *time.Time) error {
 	s.DeletedBatch = true
 	return nil
 }
@@ -50,5 +56,6 @@ func (s *ImageStore) Copy(
 	deep bool,
 ) error {
 	s.CopiedToCollection = dst
+	s.NumCopied++
 	return nil
 }
@@ -1,7 +1,33 @@
 package fake
 
-type JobQueue struct{}
+import (
+	"context"
+	"errors"
 
-func (q *JobQueue) Submit(f func()) {
-	f()
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+)
+
+type JobQueue struct {
+	ErrOnSubmit error
+	Jobs        []jq.Job
+}
+
+func (q *JobQueue) Submit(job jq.Job) error {
+	if q.ErrOnSubmit != nil {
+		return q.ErrOnSubmit
+	}
+	q.Jobs = append(q.Jobs, job)
+	return nil
+}
+
+// Run runs the submitted jobs in order with a single attempt each, and
+// returns their errors.
+func (q *JobQueue) Run(ctx context.Context, h jq.Handler) error {
+	var errs error
+	for _, job := range q.Jobs {
+		job.Attempt, job.MaxAttempts = 1, 1
+		errs = errors.Join(errs, h(ctx, job))
+	}
+	q.Jobs = nil
+	return errs
 }
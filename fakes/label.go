@@ -1,9 +1,11 @@
 package fake
 
 import (
+	"fmt"
 	"slices"
 
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
 )
 
@@ -17,6 +19,7 @@ type LabelRepo struct {
 	ErrOnList     error
 	ErrOnUpdate   error
 	ErrOnCount    error
+	HasKeypoints_ bool
 	Return        lbl.Label
 	FetchedName   string
 	ExistingNames []string
@@ -24,6 +27,9 @@ type LabelRepo struct {
 	IsUsed_       bool
 	Count_        int64
 	GotUpdatable  lbl.UpdatableModel
+	Aliases       map[string]string
+	Parents       map[string]string
+	Labels        []lbl.Label
 }
 
 func (r *LabelRepo) FindLabel(name string) (*lbl.Label, error) {
@@ -31,7 +37,18 @@ func (r *LabelRepo) FindLabel(name string) (*lbl.Label, error) {
 		return nil, r.ErrOnFind
 	}
 	r.FetchedName = name
-	return &r.Return, nil
+	if r.Labels == nil {
+		return &r.Return, nil
+	}
+	if target, ok := r.Aliases[name]; ok {
+		name = target
+	}
+	for _, l := range r.Labels {
+		if l.Name == name {
+			return &l, nil
+		}
+	}
+	return nil, fmt.Errorf("finding label %v: %w", name, e.ErrNotFound)
 }
 
 func (r *LabelRepo) Create(l lbl.Label) error {
@@ -71,6 +88,13 @@ func (r *LabelRepo) IsUsed(n string) (*bool, error) {
 	return &res, nil
 }
 
+func (r *LabelRepo) HasKeypoints(string) (bool, error) {
+	if r.ErrOnIsUsed != nil {
+		return false, r.ErrOnIsUsed
+	}
+	return r.HasKeypoints_, nil
+}
+
 func (r *LabelRepo) Count() (int64, error) {
 	if r.ErrOnCount != nil {
 		return 0, r.ErrOnCount
@@ -105,3 +129,34 @@ func (r *LabelRepo) Update(m lbl.UpdatableModel) error {
 	r.GotUpdatable = m
 	return nil
 }
+
+func (r *LabelRepo) Canonical(name string) (*string, error) {
+	if r.ErrOnExists != nil {
+		return nil, r.ErrOnExists
+	}
+	if slices.Contains(r.ExistingNames, name) {
+		return &name, nil
+	}
+	if target, ok := r.Aliases[name]; ok {
+		return &target, nil
+	}
+	return nil, nil
+}
+
+func (r *LabelRepo) Lineage(name string) ([]string, error) {
+	if r.ErrOnFind != nil {
+		return nil, r.ErrOnFind
+	}
+	lineage := []string{name}
+	for parent, ok := r.Parents[name]; ok && !slices.Contains(lineage, parent); parent, ok = r.Parents[parent] {
+		lineage = append(lineage, parent)
+	}
+	return lineage, nil
+}
+
+func (r *LabelRepo) All() ([]lbl.Label, error) {
+	if r.ErrOnFetch != nil {
+		return nil, r.ErrOnFetch
+	}
+	return r.Labels, nil
+}
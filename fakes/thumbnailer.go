@@ -0,0 +1,45 @@
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Thumbnailer struct {
+	Thumbnails    map[string][]byte
+	ErrOnGenerate error
+	GotSource     []byte
+	GotHashes     []string
+}
+
+func (t *Thumbnailer) GetReaderAt(hash string, size int) (io.ReaderAt, int64, error) {
+	data, ok := t.Thumbnails[thumbnailKey(hash, size)]
+	if !ok {
+		return nil, 0, e.ErrNotFound
+	}
+	return bytes.NewReader(data), int64(len(data)), nil
+}
+
+func (t *Thumbnailer) Generate(hash string, src io.Reader, size int) error {
+	if t.ErrOnGenerate != nil {
+		return t.ErrOnGenerate
+	}
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+	if t.Thumbnails == nil {
+		t.Thumbnails = map[string][]byte{}
+	}
+	t.GotSource = data
+	t.GotHashes = append(t.GotHashes, hash)
+	t.Thumbnails[thumbnailKey(hash, size)] = []byte("thumbnail")
+	return nil
+}
+
+func thumbnailKey(hash string, size int) string {
+	return fmt.Sprintf("%v_%v", hash, size)
+}
@@ -0,0 +1,76 @@
+package fake
+
+import (
+	"fmt"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type ViewRepo struct {
+	ErrOnFind   error
+	ErrOnCreate error
+	ErrOnUpdate error
+	ErrOnDelete error
+	ErrOnList   error
+	ErrOnCount  error
+	Views       []vw.View
+	Created     vw.View
+	GotUpdate   vw.UpdateModel
+	Deleted     *vw.ViewId
+	GotUser     u.User
+}
+
+func (r *ViewRepo) FindView(id vw.ViewId) (*vw.View, error) {
+	if r.ErrOnFind != nil {
+		return nil, r.ErrOnFind
+	}
+	for _, v := range r.Views {
+		if v.Id == id {
+			return &v, nil
+		}
+	}
+	return nil, fmt.Errorf("finding view %v: %w", id, e.ErrNotFound)
+}
+
+func (r *ViewRepo) Create(v vw.View) error {
+	if r.ErrOnCreate != nil {
+		return r.ErrOnCreate
+	}
+	r.Created = v
+	return nil
+}
+
+func (r *ViewRepo) Update(m vw.UpdateModel) error {
+	if r.ErrOnUpdate != nil {
+		return r.ErrOnUpdate
+	}
+	r.GotUpdate = m
+	return nil
+}
+
+func (r *ViewRepo) Delete(id vw.ViewId) error {
+	if r.ErrOnDelete != nil {
+		return r.ErrOnDelete
+	}
+	r.Deleted = &id
+	return nil
+}
+
+func (r *ViewRepo) ListVisible(user u.User, p pag.PaginationParams) ([]vw.View, error) {
+	if r.ErrOnList != nil {
+		return nil, r.ErrOnList
+	}
+	r.GotUser = user
+	return r.Views, nil
+}
+
+func (r *ViewRepo) CountVisible(user u.User) (*int64, error) {
+	if r.ErrOnCount != nil {
+		return nil, r.ErrOnCount
+	}
+	count := int64(len(r.Views))
+	return &count, nil
+}
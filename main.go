@@ -31,5 +31,7 @@ var rootCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(server.Cmd)
 	rootCmd.AddCommand(image.IngestDirCmd)
+	rootCmd.AddCommand(image.BackfillThumbnailsCmd)
 	rootCmd.AddCommand(collection.CreateCmd)
+	rootCmd.AddCommand(collection.ExportCmd)
 }
@@ -0,0 +1,226 @@
+package agreement
+
+import (
+	"testing"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func box(label string, xc, yc, w, h float32) an.BoundingBox {
+	return an.NewBoundingBox(an.NewAnnotationId(), xc, yc, w, h, lbl.Label{Name: label})
+}
+
+func labels(names ...string) []lbl.Label {
+	r := []lbl.Label{}
+	for _, n := range names {
+		r = append(r, lbl.Label{Name: n})
+	}
+	return r
+}
+
+func TestCohenKappa(t *testing.T) {
+	a := []bool{true, true, false, false}
+	assert.InDelta(t, 1.0, Cohen(a, a), 1e-9)
+	assert.InDelta(t, -1.0, Cohen(a, []bool{false, false, true, true}), 1e-9)
+	// observed .75, expected .5
+	assert.InDelta(t, 0.5, Cohen(a, []bool{true, false, false, false}), 1e-9)
+	assert.InDelta(t, 1.0, Cohen([]bool{true, true}, []bool{true, true}), 1e-9)
+}
+
+func TestFleissKappa(t *testing.T) {
+	assert.InDelta(t, 1.0, Fleiss([]int{3, 0, 3, 0}, 3), 1e-9)
+	// observed .75, expected (3/8)^2+(5/8)^2
+	assert.InDelta(t, 0.21875/0.46875, Fleiss([]int{2, 1, 0, 0}, 2), 1e-9)
+	assert.Less(t, Fleiss([]int{1, 1, 1, 1}, 2), 0.0)
+}
+
+func TestPerfectAgreement(t *testing.T) {
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Boxes: []an.BoundingBox{box("cat", 5, 5, 4, 4)}, Labels: labels("indoor")},
+		{Boxes: []an.BoundingBox{box("cat", 5, 5, 4, 4)}, Labels: labels("indoor")},
+	}}
+	report := Compute([]Image{image}, []string{"alice", "bob"}, 0.5)
+	assert.Equal(t, 1, report.NumImages)
+	assert.InDelta(t, 1.0, report.RegionF1, 1e-9)
+	assert.InDelta(t, 1.0, report.MeanIoU, 1e-9)
+	assert.Equal(t, []ag.LabelAgreement{{Label: "cat", NumRegions: 2, RegionF1: 1, MeanIoU: 1}}, report.Labels)
+	assert.Equal(t, [2]string{"alice", "bob"}, report.Pairs[0].Raters)
+	assert.InDelta(t, 1.0, report.Pairs[0].Kappa, 1e-9)
+}
+
+func TestRegionsMustShareLabelAndOverlap(t *testing.T) {
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Boxes: []an.BoundingBox{box("cat", 5, 5, 4, 4), box("dog", 20, 20, 4, 4)}},
+		// IoU of 1/3 with the first box of alice, and a dog labeled as cat
+		{Boxes: []an.BoundingBox{box("cat", 7, 5, 4, 4), box("cat", 20, 20, 4, 4)}},
+	}}
+	report := Compute([]Image{image}, []string{"alice", "bob"}, 0.5)
+	assert.InDelta(t, 0.0, report.RegionF1, 1e-9)
+	loose := Compute([]Image{image}, []string{"alice", "bob"}, 0.3)
+	assert.InDelta(t, 0.5, loose.RegionF1, 1e-9)
+	assert.InDelta(t, 1.0/3, loose.MeanIoU, 1e-6)
+	assert.Equal(t, "cat", loose.Labels[0].Label)
+	assert.InDelta(t, 2.0/3, loose.Labels[0].RegionF1, 1e-9)
+	assert.InDelta(t, 0.0, loose.Labels[1].RegionF1, 1e-9)
+}
+
+func TestImageAgreementAveragesPairs(t *testing.T) {
+	cat := box("cat", 5, 5, 4, 4)
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Boxes: []an.BoundingBox{cat}},
+		{Boxes: []an.BoundingBox{cat}},
+		{},
+	}}
+	empty := Image{Id: im.NewImageId(), Ratings: []Rating{{}, {}, {}}}
+	report := Compute([]Image{image, empty}, []string{"a", "b", "c"}, 0.5)
+	assert.InDelta(t, 1.0/3, report.Images[0].RegionF1, 1e-9)
+	assert.Equal(t, 2, report.Images[0].NumRegions)
+	assert.InDelta(t, 1.0, report.Images[1].RegionF1, 1e-9)
+	assert.Equal(t, 3, len(report.Pairs))
+	assert.InDelta(t, 1.0, report.Pairs[0].RegionF1, 1e-9)
+	assert.InDelta(t, 0.0, report.Pairs[1].RegionF1, 1e-9)
+}
+
+func TestImageLabelKappa(t *testing.T) {
+	images := []Image{
+		{Id: im.NewImageId(), Ratings: []Rating{{Labels: labels("indoor")}, {Labels: labels("indoor")}}},
+		{Id: im.NewImageId(), Ratings: []Rating{{Labels: labels("indoor")}, {}}},
+		{Id: im.NewImageId(), Ratings: []Rating{{}, {}}},
+		{Id: im.NewImageId(), Ratings: []Rating{{}, {}}},
+	}
+	report := Compute(images, []string{"alice", "bob"}, 0.5)
+	assert.Equal(t, "indoor", report.ImageLabels[0].Label)
+	assert.Equal(t, 3, report.ImageLabels[0].Count)
+	assert.InDelta(t, 0.5, report.Pairs[0].Kappa, 1e-9)
+	assert.InDelta(t, report.ImageLabels[0].Kappa, report.Kappa, 1e-9)
+}
+
+func TestMajorityVote(t *testing.T) {
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Boxes: []an.BoundingBox{box("cat", 5, 5, 4, 4), box("dog", 40, 40, 4, 4)}, Labels: labels("indoor")},
+		{Boxes: []an.BoundingBox{box("cat", 5.2, 5, 4, 4)}, Labels: labels("indoor", "night")},
+		{Boxes: []an.BoundingBox{box("cat", 5.4, 5, 4, 4)}},
+	}}
+	c := Merge(image, ag.MajorityVote, MajorityOf(3), 0.5)
+	assert.Equal(t, 1, len(c.Boxes))
+	// the box in the middle overlaps the others most
+	assert.Equal(t, float32(5.2), c.Boxes[0].Xc)
+	assert.NotEqual(t, image.Ratings[1].Boxes[0].Id, c.Boxes[0].Id)
+	assert.Equal(t, labels("indoor"), c.Labels)
+	assert.Empty(t, c.Polygons)
+}
+
+func TestWeightedBoxFusion(t *testing.T) {
+	low := box("cat", 4, 5, 4, 4)
+	low.Source = an.Source{Model: "m", Confidence: 0.25}
+	high := box("cat", 6, 5, 4, 4)
+	high.Source = an.Source{Model: "m", Confidence: 0.75}
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Boxes: []an.BoundingBox{low}},
+		{Boxes: []an.BoundingBox{high}},
+	}}
+	c := Merge(image, ag.WeightedBoxFusion, 2, 0.3)
+	assert.Equal(t, 1, len(c.Boxes))
+	assert.InDelta(t, 5.5, c.Boxes[0].Xc, 1e-6)
+	assert.False(t, c.Boxes[0].Source.IsPrediction())
+}
+
+func TestFusionOfPolygonsKeepsMedoid(t *testing.T) {
+	square := an.Points{Coordinates: [][2]float32{{0, 0}, {4, 0}, {4, 4}, {0, 4}}}
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Polygons: []an.Polygon{an.NewPolygon(an.NewAnnotationId(), square, lbl.Label{Name: "cat"})}},
+		{Boxes: []an.BoundingBox{box("cat", 2, 2, 4, 4)}},
+	}}
+	c := Merge(image, ag.WeightedBoxFusion, 2, 0.5)
+	assert.Equal(t, 1, len(c.Boxes)+len(c.Polygons))
+}
+
+func TestOneRegionPerRaterInCluster(t *testing.T) {
+	image := Image{Id: im.NewImageId(), Ratings: []Rating{
+		{Boxes: []an.BoundingBox{box("cat", 5, 5, 4, 4), box("cat", 5, 5, 4, 4)}},
+		{},
+	}}
+	assert.Empty(t, Merge(image, ag.MajorityVote, 2, 0.5).Boxes)
+}
+
+type annotationsPerCollection map[string][]an.BoundingBox
+
+func (a annotationsPerCollection) FindBoundingBoxes(_ im.ImageId, c clc.CollectionName) ([]an.BoundingBox, error) {
+	return a[c], nil
+}
+
+func (a annotationsPerCollection) FindPolygons(im.ImageId, clc.CollectionName) ([]an.Polygon, error) {
+	return nil, nil
+}
+
+func (a annotationsPerCollection) FindImageLabels(im.ImageId, clc.CollectionName) ([]an.ImageLabel, error) {
+	return nil, nil
+}
+
+func TestCollectSharedImagesByCollection(t *testing.T) {
+	shared, other := im.NewImageId(), im.NewImageId()
+	images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{
+		{ImageId: shared, Collection: "a"},
+		{ImageId: other, Collection: "a"},
+		{ImageId: shared, Collection: "b"},
+	}}
+	cat := box("cat", 5, 5, 4, 4)
+	annotations := annotationsPerCollection{"a": {cat}}
+
+	got, err := New(images, annotations).Collect(Request{Collections: []string{"a", "b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(got))
+	assert.Equal(t, shared, got[0].Id)
+	assert.Equal(t, []an.BoundingBox{cat}, got[0].Ratings[0].Boxes)
+	assert.Empty(t, got[0].Ratings[1].Boxes)
+}
+
+func TestCollectCollectionsOfAnyName(t *testing.T) {
+	for _, names := range [][]string{{"rater-1", "rater-2"}, {"2024", "1e5"}} {
+		for _, filter := range []im.FilterStr{"", "width>10"} {
+			shared := im.NewImageId()
+			images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{
+				{ImageId: shared, Collection: names[0]},
+				{ImageId: shared, Collection: names[1]},
+			}}
+			cat := box("cat", 5, 5, 4, 4)
+			annotations := annotationsPerCollection{names[0]: {cat}, names[1]: {cat}}
+
+			got, err := New(images, annotations).Collect(Request{Collections: names, Filter: filter})
+			assert.NoError(t, err)
+			if assert.Equal(t, 1, len(got), names) {
+				assert.Equal(t, []an.BoundingBox{cat}, got[0].Ratings[1].Boxes)
+			}
+		}
+	}
+}
+
+func TestCollectByAuthor(t *testing.T) {
+	alice, bob, carol := u.UserId("alice"), u.UserId("bob"), u.UserId("carol")
+	ofAlice, ofBob, ofCarol := box("cat", 5, 5, 4, 4), box("cat", 6, 5, 4, 4), box("cat", 7, 5, 4, 4)
+	ofAlice.Author, ofBob.Author, ofCarol.Author = &alice, &bob, &carol
+	images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{{ImageId: im.NewImageId(), Collection: "a"}}}
+	annotations := annotationsPerCollection{"a": {ofAlice, ofBob, ofCarol, box("cat", 1, 1, 1, 1)}}
+
+	r := Request{Collections: []string{"a"}, Authors: []u.UserId{bob, alice}}
+	got, err := New(images, annotations).Collect(r)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"bob", "alice"}, r.Raters())
+	assert.Equal(t, []an.BoundingBox{ofBob}, got[0].Ratings[0].Boxes)
+	assert.Equal(t, []an.BoundingBox{ofAlice}, got[0].Ratings[1].Boxes)
+}
+
+func TestCollectWithFailingRepo(t *testing.T) {
+	images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{{ImageId: im.NewImageId()}}}
+	annotations := &fk.AnnotationRepo{ErrOnFindImageLabels: e.ErrInternal}
+	_, err := New(images, annotations).Collect(Request{Collections: []string{"a", "b"}})
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
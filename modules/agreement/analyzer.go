@@ -0,0 +1,152 @@
+package agreement
+
+import (
+	"fmt"
+	"iter"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
+)
+
+type ImageRepo interface {
+	Iterate(im.FilterStr, int) iter.Seq2[im.BaseImage, error]
+}
+
+type AnnotationRepo interface {
+	FindBoundingBoxes(im.ImageId, clc.CollectionName) ([]an.BoundingBox, error)
+	FindPolygons(im.ImageId, clc.CollectionName) ([]an.Polygon, error)
+	FindImageLabels(im.ImageId, clc.CollectionName) ([]an.ImageLabel, error)
+}
+
+// Request selects the images that all collections share and match the
+// filter. Raters are the collections, unless authors are given, in which
+// case each author rates with what they annotated in any of the
+// collections.
+type Request struct {
+	Collections []string
+	Authors     []u.UserId
+	Filter      im.FilterStr
+}
+
+func (r Request) Raters() []string {
+	if len(r.Authors) > 0 {
+		return r.Authors
+	}
+	return r.Collections
+}
+
+type Analyzer struct {
+	ImageRepo
+	AnnotationRepo
+}
+
+func New(ir ImageRepo, ar AnnotationRepo) Analyzer {
+	return Analyzer{ir, ar}
+}
+
+// Collect fetches the ratings of every shared image.
+func (x Analyzer) Collect(r Request) ([]Image, error) {
+	errCtx := fmt.Errorf("collecting annotations of collections %v", r.Collections)
+	ids, err := x.shared(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+	var raterOf map[u.UserId]int
+	if len(r.Authors) > 0 {
+		raterOf = map[u.UserId]int{}
+	}
+	for k, author := range r.Authors {
+		raterOf[author] = k
+	}
+	images := []Image{}
+	for _, id := range ids {
+		image := Image{Id: id, Ratings: make([]Rating, len(r.Raters()))}
+		for k, collection := range r.Collections {
+			boxes, polygons, labels, err := x.fetch(id, collection)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			for _, b := range boxes {
+				if j, ok := rater(raterOf, k, b.Author); ok {
+					image.Ratings[j].Boxes = append(image.Ratings[j].Boxes, b)
+				}
+			}
+			for _, p := range polygons {
+				if j, ok := rater(raterOf, k, p.Author); ok {
+					image.Ratings[j].Polygons = append(image.Ratings[j].Polygons, p)
+				}
+			}
+			for _, l := range labels {
+				if j, ok := rater(raterOf, k, l.Author); ok {
+					image.Ratings[j].Labels = append(image.Ratings[j].Labels, l.Label)
+				}
+			}
+		}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+// rater finds who rated an annotation of the collection of index k: the
+// collection itself, or its author when raters are authors.
+func rater(raterOf map[u.UserId]int, k int, author *u.UserId) (int, bool) {
+	if raterOf == nil {
+		return k, true
+	}
+	if author == nil {
+		return 0, false
+	}
+	k, ok := raterOf[*author]
+	return k, ok
+}
+
+// shared fetches the images that match the filter in every collection, in
+// the order of the first one.
+func (x Analyzer) shared(r Request) ([]im.ImageId, error) {
+	seen := map[im.ImageId]int{}
+	ordered := []im.ImageId{}
+	for k, collection := range r.Collections {
+		for image, err := range x.ImageRepo.Iterate(qu.CollectionFilter(collection, r.Filter), 100) {
+			if err != nil {
+				return nil, fmt.Errorf("iterating images of collection %v: %w", collection, err)
+			}
+			if seen[image.ImageId] == k {
+				seen[image.ImageId]++
+				if k == 0 {
+					ordered = append(ordered, image.ImageId)
+				}
+			}
+		}
+	}
+	images := []im.ImageId{}
+	for _, id := range ordered {
+		if seen[id] == len(r.Collections) {
+			images = append(images, id)
+		}
+	}
+	return images, nil
+}
+
+func (x Analyzer) fetch(image im.ImageId, collection string) (
+	[]an.BoundingBox, []an.Polygon, []an.ImageLabel, error,
+) {
+	boxes, err := x.AnnotationRepo.FindBoundingBoxes(image, collection)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fetching bounding boxes of image %v in collection %v: %w",
+			image, collection, err)
+	}
+	polygons, err := x.AnnotationRepo.FindPolygons(image, collection)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fetching polygons of image %v in collection %v: %w",
+			image, collection, err)
+	}
+	labels, err := x.AnnotationRepo.FindImageLabels(image, collection)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("fetching image labels of image %v in collection %v: %w",
+			image, collection, err)
+	}
+	return boxes, polygons, labels, nil
+}
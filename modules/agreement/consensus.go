@@ -0,0 +1,139 @@
+package agreement
+
+import (
+	"slices"
+	"sort"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	evr "github.com/lejeunel/go-image-annotator/modules/evaluator"
+)
+
+// Consensus holds the annotations of an image that enough raters agree on.
+type Consensus struct {
+	Boxes    []an.BoundingBox
+	Polygons []an.Polygon
+	Labels   []lbl.Label
+}
+
+// MajorityOf is the smallest number of votes that makes a majority of
+// raters.
+func MajorityOf(raters int) int {
+	return raters/2 + 1
+}
+
+// Merge clusters the regions of raters that share a label and overlap by
+// at least threshold, at most one region per rater in a cluster, and keeps
+// those of at least minVotes raters. Image labels are kept likewise.
+func Merge(image Image, method ag.Method, minVotes int, threshold float64) Consensus {
+	regions := []region{}
+	for k, rating := range image.Ratings {
+		regions = append(regions, rating.regions(image.Id, k)...)
+	}
+	sort.SliceStable(regions, func(i, j int) bool { return regions[i].Score > regions[j].Score })
+
+	clusters := [][]region{}
+	for _, r := range regions {
+		best, bestIoU := -1, threshold
+		for c, cluster := range clusters {
+			if cluster[0].Label != r.Label ||
+				slices.ContainsFunc(cluster, func(o region) bool { return o.rater == r.rater }) {
+				continue
+			}
+			if v := evr.IoU(cluster[0].Region, r.Region); v >= bestIoU && v > 0 {
+				best, bestIoU = c, v
+			}
+		}
+		if best < 0 {
+			clusters = append(clusters, []region{r})
+			continue
+		}
+		clusters[best] = append(clusters[best], r)
+	}
+
+	consensus := Consensus{Boxes: []an.BoundingBox{}, Polygons: []an.Polygon{}, Labels: []lbl.Label{}}
+	for _, cluster := range clusters {
+		if len(cluster) < minVotes {
+			continue
+		}
+		if method == ag.WeightedBoxFusion && !slices.ContainsFunc(cluster, func(r region) bool { return r.box == nil }) {
+			consensus.Boxes = append(consensus.Boxes, fuse(cluster))
+			continue
+		}
+		m := medoid(cluster)
+		if m.box != nil {
+			b := *m.box
+			b.Id, b.Source, b.Review = an.NewAnnotationId(), an.Source{}, an.Review{}
+			consensus.Boxes = append(consensus.Boxes, b)
+			continue
+		}
+		p := *m.polygon
+		p.Id, p.Source, p.Review = an.NewAnnotationId(), an.Source{}, an.Review{}
+		consensus.Polygons = append(consensus.Polygons, p)
+	}
+
+	votes := map[string]int{}
+	labels := map[string]lbl.Label{}
+	for _, rating := range image.Ratings {
+		seen := map[string]bool{}
+		for _, l := range rating.Labels {
+			if !seen[l.Name] {
+				seen[l.Name] = true
+				votes[l.Name]++
+				labels[l.Name] = l
+			}
+		}
+	}
+	names := []string{}
+	for name, n := range votes {
+		if n >= minVotes {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		consensus.Labels = append(consensus.Labels, labels[name])
+	}
+	return consensus
+}
+
+// medoid is the region of a cluster that overlaps the others most.
+func medoid(cluster []region) region {
+	best, bestSum := 0, -1.0
+	for i := range cluster {
+		sum := 0.0
+		for j := range cluster {
+			if i != j {
+				sum += evr.IoU(cluster[i].Region, cluster[j].Region)
+			}
+		}
+		if sum > bestSum {
+			best, bestSum = i, sum
+		}
+	}
+	return cluster[best]
+}
+
+// fuse averages the boxes of a cluster, weighted by their score. Boxes all
+// scored 0 weigh the same.
+func fuse(cluster []region) an.BoundingBox {
+	var total float32
+	for _, r := range cluster {
+		total += r.Score
+	}
+	var xc, yc, w, h, angle float32
+	for _, r := range cluster {
+		weight := r.Score / total
+		if total == 0 {
+			weight = 1 / float32(len(cluster))
+		}
+		b := r.box
+		xc += weight * b.Xc
+		yc += weight * b.Yc
+		w += weight * b.Width
+		h += weight * b.Height
+		angle += weight * b.Angle
+	}
+	return an.NewBoundingBox(an.NewAnnotationId(), xc, yc, w, h, cluster[0].box.Label, an.WithAngle(angle))
+}
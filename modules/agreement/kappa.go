@@ -0,0 +1,51 @@
+package agreement
+
+// Cohen computes the kappa of two raters on binary ratings of the same
+// subjects. Raters that agree on every subject with a single rating have a
+// kappa of 1.
+func Cohen(a, b []bool) float64 {
+	if len(a) == 0 {
+		return 0
+	}
+	var agree, posA, posB float64
+	for i := range a {
+		if a[i] == b[i] {
+			agree++
+		}
+		if a[i] {
+			posA++
+		}
+		if b[i] {
+			posB++
+		}
+	}
+	n := float64(len(a))
+	observed := agree / n
+	expected := (posA/n)*(posB/n) + (1-posA/n)*(1-posB/n)
+	if expected == 1 {
+		return 1
+	}
+	return (observed - expected) / (1 - expected)
+}
+
+// Fleiss computes the kappa of raters on binary ratings, where positives
+// holds, for each subject, how many of the raters rated it positively.
+func Fleiss(positives []int, raters int) float64 {
+	if len(positives) == 0 || raters < 2 {
+		return 0
+	}
+	n := float64(raters)
+	var observed, total float64
+	for _, p := range positives {
+		yes, no := float64(p), n-float64(p)
+		observed += (yes*yes + no*no - n) / (n * (n - 1))
+		total += yes
+	}
+	observed /= float64(len(positives))
+	pYes := total / (n * float64(len(positives)))
+	expected := pYes*pYes + (1-pYes)*(1-pYes)
+	if expected == 1 {
+		return 1
+	}
+	return (observed - expected) / (1 - expected)
+}
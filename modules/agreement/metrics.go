@@ -0,0 +1,248 @@
+package agreement
+
+import (
+	"slices"
+	"sort"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	evr "github.com/lejeunel/go-image-annotator/modules/evaluator"
+)
+
+// Rating holds what a rater annotated on an image.
+type Rating struct {
+	Boxes    []an.BoundingBox
+	Polygons []an.Polygon
+	Labels   []lbl.Label
+}
+
+// Image holds the ratings of an image, one per rater.
+type Image struct {
+	Id      im.ImageId
+	Ratings []Rating
+}
+
+type region struct {
+	evr.Region
+	rater   int
+	box     *an.BoundingBox
+	polygon *an.Polygon
+}
+
+func (r Rating) regions(image im.ImageId, rater int) []region {
+	regions := []region{}
+	for _, b := range r.Boxes {
+		regions = append(regions, region{evr.BoxRegion(image, b), rater, &b, nil})
+	}
+	for _, p := range r.Polygons {
+		regions = append(regions, region{evr.PolygonRegion(image, p), rater, nil, &p})
+	}
+	return regions
+}
+
+type pair struct {
+	a, b int
+	iou  float64
+}
+
+// matchPair pairs the regions of two raters that share a label, from the
+// pair that overlaps most, provided their IoU reaches threshold.
+func matchPair(a, b []region, threshold float64) []pair {
+	candidates := []pair{}
+	for i := range a {
+		for j := range b {
+			if a[i].Label != b[j].Label {
+				continue
+			}
+			if v := evr.IoU(a[i].Region, b[j].Region); v >= threshold && v > 0 {
+				candidates = append(candidates, pair{i, j, v})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].iou > candidates[j].iou })
+	takenA, takenB := map[int]bool{}, map[int]bool{}
+	matched := []pair{}
+	for _, c := range candidates {
+		if takenA[c.a] || takenB[c.b] {
+			continue
+		}
+		takenA[c.a], takenB[c.b] = true, true
+		matched = append(matched, c)
+	}
+	return matched
+}
+
+// tally accumulates matches between pairs of raters. Raters that annotated
+// nothing agree perfectly.
+type tally struct {
+	matches int
+	regions int
+	iou     float64
+}
+
+func (t *tally) add(o tally) {
+	t.matches += o.matches
+	t.regions += o.regions
+	t.iou += o.iou
+}
+
+func (t tally) f1() float64 {
+	if t.regions == 0 {
+		return 1
+	}
+	return 2 * float64(t.matches) / float64(t.regions)
+}
+
+func (t tally) meanIoU() float64 {
+	if t.matches == 0 {
+		return 0
+	}
+	return t.iou / float64(t.matches)
+}
+
+// Compute measures the agreement of raters on regions matched at
+// threshold, and on image labels.
+func Compute(images []Image, raters []string, threshold float64) ag.Report {
+	report := ag.Report{Raters: raters, NumImages: len(images), Images: []ag.ImageAgreement{}}
+	overall := tally{}
+	perPair := make([]tally, len(raters)*len(raters))
+	perLabel := map[string]*tally{}
+	regionsPerLabel := map[string]int{}
+
+	for _, image := range images {
+		regions := make([][]region, len(image.Ratings))
+		numRegions := 0
+		for k, rating := range image.Ratings {
+			regions[k] = rating.regions(image.Id, k)
+			numRegions += len(regions[k])
+			for _, r := range regions[k] {
+				regionsPerLabel[r.Label]++
+				if perLabel[r.Label] == nil {
+					perLabel[r.Label] = &tally{}
+				}
+			}
+		}
+		ofImage, sumF1, numPairs := tally{}, 0.0, 0
+		for i := range regions {
+			for j := i + 1; j < len(regions); j++ {
+				matched := matchPair(regions[i], regions[j], threshold)
+				t := tally{matches: len(matched), regions: len(regions[i]) + len(regions[j])}
+				for label, l := range perLabel {
+					lt := tally{regions: countLabel(regions[i], label) + countLabel(regions[j], label)}
+					for _, m := range matched {
+						if regions[i][m.a].Label == label {
+							lt.matches++
+							lt.iou += m.iou
+						}
+					}
+					l.add(lt)
+				}
+				for _, m := range matched {
+					t.iou += m.iou
+				}
+				perPair[i*len(raters)+j].add(t)
+				ofImage.add(t)
+				sumF1 += t.f1()
+				numPairs++
+			}
+		}
+		overall.add(ofImage)
+		agreement := ag.ImageAgreement{Image: image.Id, NumRegions: numRegions, RegionF1: 1,
+			MeanIoU: ofImage.meanIoU()}
+		if numPairs > 0 {
+			agreement.RegionF1 = sumF1 / float64(numPairs)
+		}
+		report.Images = append(report.Images, agreement)
+	}
+	report.RegionF1 = overall.f1()
+	report.MeanIoU = overall.meanIoU()
+
+	labels := []string{}
+	for label := range perLabel {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+	report.Labels = []ag.LabelAgreement{}
+	for _, label := range labels {
+		report.Labels = append(report.Labels, ag.LabelAgreement{
+			Label: label, NumRegions: regionsPerLabel[label],
+			RegionF1: perLabel[label].f1(), MeanIoU: perLabel[label].meanIoU(),
+		})
+	}
+
+	kappa, pairs, imageLabels := imageLabelAgreement(images, len(raters))
+	report.Kappa = kappa
+	report.ImageLabels = imageLabels
+	report.Pairs = []ag.PairAgreement{}
+	for i := range raters {
+		for j := i + 1; j < len(raters); j++ {
+			report.Pairs = append(report.Pairs, ag.PairAgreement{
+				Raters:   [2]string{raters[i], raters[j]},
+				Kappa:    pairs[i*len(raters)+j],
+				RegionF1: perPair[i*len(raters)+j].f1(),
+			})
+		}
+	}
+	return report
+}
+
+func countLabel(regions []region, label string) int {
+	n := 0
+	for _, r := range regions {
+		if r.Label == label {
+			n++
+		}
+	}
+	return n
+}
+
+// imageLabelAgreement rates every image on every label that some rater
+// assigned. It returns the Fleiss kappa pooled over images and labels, the
+// Cohen kappa of each pair of raters indexed as i*raters+j, and the Fleiss
+// kappa of each label.
+func imageLabelAgreement(images []Image, raters int) (float64, []float64, []ag.ImageLabelAgreement) {
+	counts := map[string]int{}
+	for _, image := range images {
+		for _, rating := range image.Ratings {
+			for _, l := range rating.Labels {
+				counts[l.Name]++
+			}
+		}
+	}
+	labels := []string{}
+	for label := range counts {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+
+	pooled := []int{}
+	ratings := make([][]bool, raters)
+	perLabel := []ag.ImageLabelAgreement{}
+	for _, label := range labels {
+		positives := []int{}
+		for _, image := range images {
+			p := 0
+			for k, rating := range image.Ratings {
+				has := slices.ContainsFunc(rating.Labels, func(l lbl.Label) bool { return l.Name == label })
+				ratings[k] = append(ratings[k], has)
+				if has {
+					p++
+				}
+			}
+			positives = append(positives, p)
+		}
+		pooled = append(pooled, positives...)
+		perLabel = append(perLabel, ag.ImageLabelAgreement{
+			Label: label, Count: counts[label], Kappa: Fleiss(positives, raters),
+		})
+	}
+	pairs := make([]float64, raters*raters)
+	for i := range raters {
+		for j := i + 1; j < raters; j++ {
+			pairs[i*raters+j] = Cohen(ratings[i], ratings[j])
+		}
+	}
+	return Fleiss(pooled, raters), pairs, perLabel
+}
@@ -38,16 +38,17 @@ type Annotator struct {
 }
 
 func (a *Annotator) Init(ctx context.Context, imageId string, collection string, f im.FilterStr, ord im.OrderStr,
-	oim imread.OutputPort, olbl fetchlbl.OutputPort, oscr scroll.OutputPort,
+	view string, oim imread.OutputPort, olbl fetchlbl.OutputPort, oscr scroll.OutputPort,
 ) {
 	a.scroll.Execute(ctx,
-		scroll.Request{CurrentImageId: imageId, CurrentCollection: collection, FilterStr: f, OrderStr: ord}, oscr)
-	a.ReadImage(imageId, collection, oim)
-	a.FetchLabels.Execute(ctx, olbl)
+		scroll.Request{CurrentImageId: imageId, CurrentCollection: collection, FilterStr: f, OrderStr: ord,
+			View: view}, oscr)
+	a.ReadImage(ctx, imageId, collection, oim)
+	a.FetchLabels.Execute(ctx, fetchlbl.Request{Collection: collection}, olbl)
 }
 
-func (a *Annotator) ReadImage(imageId string, collection string, o imread.OutputPort) {
-	a.readImage.Execute(imread.Request{ImageId: imageId, Collection: collection}, o)
+func (a *Annotator) ReadImage(ctx context.Context, imageId string, collection string, o imread.OutputPort) {
+	a.readImage.Execute(ctx, imread.Request{ImageId: imageId, Collection: collection}, o)
 }
 
 func NewAnnotator(
@@ -43,7 +43,7 @@ func TestDrawScrollerOnStart(t *testing.T) {
 	a, image := createAnnotator()
 	p := &FakeScrollerPresenter{}
 	a.Init(t.Context(), image.Id.String(),
-		"a-collection", "", "", &FakeImageReadPresenter{}, &FakeLabelFetchPresenter{}, p)
+		"a-collection", "", "", "", &FakeImageReadPresenter{}, &FakeLabelFetchPresenter{}, p)
 	assert.True(t, p.Called)
 }
 
@@ -51,15 +51,22 @@ func TestFetchLabelsOnInit(t *testing.T) {
 	a, image := createAnnotator()
 	lp := FakeLabelFetchPresenter{}
 	a.Init(t.Context(), image.Id.String(),
-		"a-collection", "", "", &FakeImageReadPresenter{}, &lp, &FakeScrollerPresenter{})
+		"a-collection", "", "", "", &FakeImageReadPresenter{}, &lp, &FakeScrollerPresenter{})
 	assert.NotNil(t, lp.Called)
 }
 
+func TestFetchLabelsOfCollectionOnInit(t *testing.T) {
+	a, image := createAnnotator()
+	a.Init(t.Context(), image.Id.String(),
+		"a-collection", "", "", "", &FakeImageReadPresenter{}, &FakeLabelFetchPresenter{}, &FakeScrollerPresenter{})
+	assert.Equal(t, "a-collection", a.FetchLabels.(*FakeLabelFetcher).Got.Collection)
+}
+
 func TestDrawImageOnInit(t *testing.T) {
 	a, image := createAnnotator()
 	ip := &FakeImageReadPresenter{}
 	a.Init(t.Context(), image.Id.String(),
-		"a-collection", "", "", ip, &FakeLabelFetchPresenter{}, &FakeScrollerPresenter{})
+		"a-collection", "", "", "", ip, &FakeLabelFetchPresenter{}, &FakeScrollerPresenter{})
 	assert.True(t, ip.Called)
 }
 
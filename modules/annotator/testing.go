@@ -27,15 +27,18 @@ func (s *FakeScroller) Execute(ctx context.Context, r scroll.Request, o scroll.O
 	o.SuccessScroll(scroll.Response{})
 }
 
-type FakeLabelFetcher struct{}
+type FakeLabelFetcher struct {
+	Got fetchlbl.Request
+}
 
-func (f *FakeLabelFetcher) Execute(ctx context.Context, o fetchlbl.OutputPort) {
+func (f *FakeLabelFetcher) Execute(ctx context.Context, r fetchlbl.Request, o fetchlbl.OutputPort) {
+	f.Got = r
 	o.SuccessFetchLabels(fetchlbl.Response{Labels: []string{"a-label"}})
 }
 
 type FakeImageReader struct{}
 
-func (b *FakeImageReader) Execute(r imread.Request, o imread.OutputPort) {
+func (b *FakeImageReader) Execute(ctx context.Context, r imread.Request, o imread.OutputPort) {
 	o.SuccessReadImage(im.Image{})
 }
 
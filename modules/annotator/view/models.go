@@ -5,6 +5,7 @@ import (
 
 	an "github.com/lejeunel/go-image-annotator/entities/annotation"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 )
 
 type ScrollerButton struct {
@@ -24,6 +25,7 @@ type ImageLabel struct {
 	Label  string
 	Author string
 	Time   string
+	Status string
 }
 
 type ImageInfo struct {
@@ -48,6 +50,10 @@ type BoundingBox struct {
 	Angle  float32
 	Author string
 	Time   string
+	Status string
+	an.Source
+	Attributes an.Attributes
+	Schema     []lbl.Attribute
 }
 type Polygon struct {
 	Id     string
@@ -56,6 +62,39 @@ type Polygon struct {
 	Points an.Points
 	Author string
 	Time   string
+	Status string
+	an.Source
+	Attributes an.Attributes
+	Schema     []lbl.Attribute
+}
+
+type Polyline struct {
+	Id     string
+	Label  string
+	Color  string
+	Points an.Points
+	Author string
+	Time   string
+	Status string
+	an.Source
+	Attributes an.Attributes
+	Schema     []lbl.Attribute
+}
+
+// Keypoints carries the skeleton of the label so that poses can be drawn.
+type Keypoints struct {
+	Id     string
+	Label  string
+	Color  string
+	Points []an.Keypoint
+	Names  []string
+	Edges  [][2]string
+	Author string
+	Time   string
+	Status string
+	an.Source
+	Attributes an.Attributes
+	Schema     []lbl.Attribute
 }
 
 type Image struct {
@@ -10,9 +10,10 @@ type View interface {
 	SetScroller(ScrollerButtons)
 	Error(error)
 	SetAvailableLabels([]string)
+	SetDefaultLabel(*string)
 	SetAvailableImageLabels([]string)
 	SetImageInfo(ImageInfo)
 	SetImage(Image)
-	SetAnnotations([]BoundingBox, []Polygon, []ImageLabel)
+	SetAnnotations([]BoundingBox, []Polygon, []Polyline, []Keypoints, []ImageLabel)
 	SetMetaData([]m.MetaData)
 }
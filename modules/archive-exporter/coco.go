@@ -0,0 +1,188 @@
+package exporter
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"slices"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type COCOInfo struct {
+	Description string `json:"description"`
+}
+
+type COCOImage struct {
+	Id       int    `json:"id"`
+	FileName string `json:"file_name"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+}
+
+type COCOCategory struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name"`
+	Supercategory string `json:"supercategory"`
+}
+
+type COCOAnnotation struct {
+	Id           int         `json:"id"`
+	ImageId      int         `json:"image_id"`
+	CategoryId   int         `json:"category_id"`
+	BBox         [4]float32  `json:"bbox"`
+	Area         float32     `json:"area"`
+	Segmentation [][]float32 `json:"segmentation"`
+	IsCrowd      int         `json:"iscrowd"`
+	Score        *float32    `json:"score,omitempty"`
+	// Attributes holds the values of the attributes of the label, as
+	// exported by CVAT.
+	Attributes an.Attributes `json:"attributes,omitempty"`
+}
+
+type COCODataset struct {
+	Info        COCOInfo         `json:"info"`
+	Images      []COCOImage      `json:"images"`
+	Categories  []COCOCategory   `json:"categories"`
+	Annotations []COCOAnnotation `json:"annotations"`
+	categoryIds map[string]int
+}
+
+func NewCOCODataset(labels []string) *COCODataset {
+	labels = slices.Sorted(slices.Values(labels))
+	d := &COCODataset{
+		Info:        COCOInfo{Description: "exported by go-image-annotator"},
+		Images:      []COCOImage{},
+		Categories:  []COCOCategory{},
+		Annotations: []COCOAnnotation{},
+		categoryIds: map[string]int{},
+	}
+	for i, l := range labels {
+		d.categoryIds[l] = i + 1
+		d.Categories = append(d.Categories, COCOCategory{Id: i + 1, Name: l})
+	}
+	return d
+}
+
+func (d *COCODataset) AddImage(image im.Image, fileName string) error {
+	imageId := len(d.Images) + 1
+	d.Images = append(d.Images, COCOImage{
+		Id:       imageId,
+		FileName: fileName,
+		Width:    image.Specs.Width,
+		Height:   image.Specs.Height,
+	})
+
+	for _, box := range image.BoundingBoxes {
+		categoryId, err := d.categoryId(box.Label.Name)
+		if err != nil {
+			return fmt.Errorf("adding bounding box %v: %w", box.Id, err)
+		}
+		bbox := BoxToCOCO(box)
+		d.Annotations = append(d.Annotations, COCOAnnotation{
+			Id:           len(d.Annotations) + 1,
+			ImageId:      imageId,
+			CategoryId:   categoryId,
+			BBox:         bbox,
+			Area:         box.Width * box.Height,
+			Segmentation: [][]float32{},
+			Score:        score(box.Source),
+			Attributes:   box.Attributes,
+		})
+	}
+
+	for _, polygon := range image.Polygons {
+		categoryId, err := d.categoryId(polygon.Label.Name)
+		if err != nil {
+			return fmt.Errorf("adding polygon %v: %w", polygon.Id, err)
+		}
+		segmentation := make([]float32, 0, 2*len(polygon.Points.Coordinates))
+		for _, p := range polygon.Points.Coordinates {
+			segmentation = append(segmentation, p[0], p[1])
+		}
+		d.Annotations = append(d.Annotations, COCOAnnotation{
+			Id:           len(d.Annotations) + 1,
+			ImageId:      imageId,
+			CategoryId:   categoryId,
+			BBox:         PointsToCOCOBox(polygon.Points),
+			Area:         PolygonArea(polygon.Points),
+			Segmentation: [][]float32{segmentation},
+			Score:        score(polygon.Source),
+			Attributes:   polygon.Attributes,
+		})
+	}
+	return nil
+}
+
+func (d *COCODataset) Encode(w io.Writer) error {
+	return json.NewEncoder(w).Encode(d)
+}
+
+func (d *COCODataset) ImageDir() string {
+	return "images"
+}
+
+func (d *COCODataset) NumAnnotations() int {
+	return len(d.Annotations)
+}
+
+func (d *COCODataset) WriteAnnotations(zw *zip.Writer, dir string) error {
+	w, err := zw.Create(path.Join(dir, "annotations", "instances.json"))
+	if err != nil {
+		return err
+	}
+	return d.Encode(w)
+}
+
+func (d *COCODataset) categoryId(label string) (int, error) {
+	id, ok := d.categoryIds[label]
+	if !ok {
+		return 0, fmt.Errorf("unknown label %q: %w", label, e.ErrNotFound)
+	}
+	return id, nil
+}
+
+// BoxToCOCO converts a centre-based, possibly rotated, bounding box into
+// the axis-aligned [x, y, width, height] box of COCO, where (x, y) is the
+// top-left corner.
+func BoxToCOCO(b an.BoundingBox) [4]float32 {
+	width, height := b.Width, b.Height
+	if b.Angle != 0 {
+		sin := math.Abs(math.Sin(float64(b.Angle)))
+		cos := math.Abs(math.Cos(float64(b.Angle)))
+		width = float32(float64(b.Width)*cos + float64(b.Height)*sin)
+		height = float32(float64(b.Width)*sin + float64(b.Height)*cos)
+	}
+	return [4]float32{b.Xc - width/2, b.Yc - height/2, width, height}
+}
+
+// score is the confidence of a prediction, as found in COCO detection
+// results. Annotations drawn by people have none.
+func score(s an.Source) *float32 {
+	if !s.IsPrediction() {
+		return nil
+	}
+	return &s.Confidence
+}
+
+func PointsToCOCOBox(p an.Points) [4]float32 {
+	if len(p.Coordinates) == 0 {
+		return [4]float32{}
+	}
+	return [4]float32{p.MinX(), p.MinY(), p.MaxX() - p.MinX(), p.MaxY() - p.MinY()}
+}
+
+func PolygonArea(p an.Points) float32 {
+	var area float64
+	n := len(p.Coordinates)
+	for i := range n {
+		a, b := p.Coordinates[i], p.Coordinates[(i+1)%n]
+		area += float64(a[0])*float64(b[1]) - float64(b[0])*float64(a[1])
+	}
+	return float32(math.Abs(area) / 2)
+}
@@ -0,0 +1,341 @@
+package exporter
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"math"
+	"testing"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func Setup() (ArchiveExporter, *im.Image) {
+	cat := lbl.NewLabel(lbl.NewLabelId(), "cat")
+	dog := lbl.NewLabel(lbl.NewLabelId(), "dog")
+	image := &im.Image{
+		Id:     im.NewImageId(),
+		Specs:  im.Specs{MIMEType: "image/png", Width: 100, Height: 50},
+		Reader: bytes.NewReader(st.TestPNGImage),
+		BoundingBoxes: []an.BoundingBox{
+			an.NewBoundingBox(an.NewAnnotationId(), 20, 10, 10, 4, dog),
+		},
+		Polygons: []an.Polygon{
+			an.NewPolygon(an.NewAnnotationId(),
+				an.Points{Coordinates: [][2]float32{{0, 0}, {4, 0}, {4, 2}, {0, 2}}}, cat),
+		},
+	}
+	x := New(
+		&fk.ImageRepo{IterateBaseImages: []im.BaseImage{{ImageId: image.Id}}},
+		&fk.ImageStore{Return: image},
+		&fk.LabelRepo{ExistingNames: []string{"dog", "cat"}},
+	)
+	return x, image
+}
+
+func readDataset(t *testing.T, buf *bytes.Buffer) (*zip.Reader, COCODataset) {
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	f, err := zr.Open("annotations/instances.json")
+	assert.NoError(t, err)
+	defer f.Close()
+	var d COCODataset
+	assert.NoError(t, json.NewDecoder(f).Decode(&d))
+	return zr, d
+}
+
+func TestExportCOCO(t *testing.T) {
+	x, image := Setup()
+	var buf bytes.Buffer
+	r, err := x.Export(Request{Format: COCOFormat, Writer: &buf})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, r.NumImages)
+	assert.Equal(t, 2, r.NumAnnotations)
+
+	zr, d := readDataset(t, &buf)
+	_, err = zr.Open("images/" + image.Id.String() + ".png")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []COCOCategory{{Id: 1, Name: "cat"}, {Id: 2, Name: "dog"}}, d.Categories)
+	assert.Equal(t, 100, d.Images[0].Width)
+	assert.Equal(t, 50, d.Images[0].Height)
+	assert.Equal(t, [4]float32{15, 8, 10, 4}, d.Annotations[0].BBox)
+	assert.Equal(t, 2, d.Annotations[0].CategoryId)
+	assert.Equal(t, float32(8), d.Annotations[1].Area)
+	assert.Equal(t, [][]float32{{0, 0, 4, 0, 4, 2, 0, 2}}, d.Annotations[1].Segmentation)
+	assert.Equal(t, 1, d.Annotations[1].CategoryId)
+}
+
+func TestExportCOCOScoresPredictions(t *testing.T) {
+	x, image := Setup()
+	image.BoundingBoxes[0].Source = an.Source{Model: "a-model", Confidence: 0.75}
+	var buf bytes.Buffer
+	_, err := x.Export(Request{Format: COCOFormat, Writer: &buf})
+	assert.NoError(t, err)
+
+	_, d := readDataset(t, &buf)
+	assert.Equal(t, float32(0.75), *d.Annotations[0].Score)
+	assert.Nil(t, d.Annotations[1].Score)
+}
+
+func TestExportCOCOAttributes(t *testing.T) {
+	x, image := Setup()
+	image.BoundingBoxes[0].Attributes = an.Attributes{"occluded": true, "breed": "beagle"}
+	var buf bytes.Buffer
+	_, err := x.Export(Request{Format: COCOFormat, Writer: &buf})
+	assert.NoError(t, err)
+
+	_, d := readDataset(t, &buf)
+	assert.Equal(t, an.Attributes{"occluded": true, "breed": "beagle"}, d.Annotations[0].Attributes)
+	assert.Nil(t, d.Annotations[1].Attributes)
+}
+
+func TestExportAcceptedAnnotationsOnly(t *testing.T) {
+	x, image := Setup()
+	image.Polygons[0].Review.Status = an.Accepted
+	var buf bytes.Buffer
+	r, err := x.Export(Request{Format: COCOFormat, Status: an.Accepted, Writer: &buf})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, r.NumImages)
+	assert.Equal(t, 1, r.NumAnnotations)
+
+	_, d := readDataset(t, &buf)
+	assert.Equal(t, 1, d.Annotations[0].CategoryId)
+}
+
+func TestExportUnknownLabelShouldFail(t *testing.T) {
+	x, _ := Setup()
+	x.LabelRepo = &fk.LabelRepo{ExistingNames: []string{"cat"}}
+	_, err := x.Export(Request{Format: COCOFormat, Writer: &bytes.Buffer{}})
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestExportErrOnFindImage(t *testing.T) {
+	x, _ := Setup()
+	x.ImageStore = &fk.ImageStore{ErrOnFind: e.ErrInternal}
+	_, err := x.Export(Request{Format: COCOFormat, Writer: &bytes.Buffer{}})
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestRotatedBoxToCOCOIsEnvelope(t *testing.T) {
+	box := an.NewBoundingBox(an.NewAnnotationId(), 10, 10, 4, 2, lbl.Label{},
+		an.WithAngle(math.Pi/2))
+	b := BoxToCOCO(box)
+	assert.InDelta(t, 9, b[0], 1e-5)
+	assert.InDelta(t, 8, b[1], 1e-5)
+	assert.InDelta(t, 2, b[2], 1e-5)
+	assert.InDelta(t, 4, b[3], 1e-5)
+}
+
+func TestParseFormat(t *testing.T) {
+	_, err := ParseFormat("unknown")
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func readFile(t *testing.T, zr *zip.Reader, name string) string {
+	f, err := zr.Open(name)
+	if !assert.NoError(t, err) {
+		return ""
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+func TestExportYOLO(t *testing.T) {
+	x, image := Setup()
+	var buf bytes.Buffer
+	r, err := x.Export(Request{Format: YOLOFormat, Writer: &buf})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, r.NumAnnotations)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	_, err = zr.Open("images/" + image.Id.String() + ".png")
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"1 0.200000 0.200000 0.100000 0.080000\n0 0.000000 0.000000 0.040000 0.000000 0.040000 0.040000 0.000000 0.040000\n",
+		readFile(t, zr, "labels/"+image.Id.String()+".txt"))
+
+	var config YOLOConfig
+	assert.NoError(t, yaml.Unmarshal([]byte(readFile(t, zr, "data.yaml")), &config))
+	assert.Equal(t, map[int]string{0: "cat", 1: "dog"}, config.Names)
+	assert.Equal(t, "images", config.Train)
+}
+
+func TestExportVOC(t *testing.T) {
+	x, image := Setup()
+	var buf bytes.Buffer
+	r, err := x.Export(Request{Format: VOCFormat, Writer: &buf})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, r.NumAnnotations)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	_, err = zr.Open("JPEGImages/" + image.Id.String() + ".png")
+	assert.NoError(t, err)
+
+	var a VOCAnnotation
+	assert.NoError(t, xml.Unmarshal([]byte(readFile(t, zr, "Annotations/"+image.Id.String()+".xml")), &a))
+	assert.Equal(t, image.Id.String()+".png", a.Filename)
+	assert.Equal(t, VOCSize{Width: 100, Height: 50, Depth: 3}, a.Size)
+	assert.Equal(t, "dog", a.Objects[0].Name)
+	assert.Equal(t, VOCBox{XMin: 15, YMin: 8, XMax: 25, YMax: 12}, a.Objects[0].BndBox)
+	assert.Equal(t, VOCBox{XMin: 0, YMin: 0, XMax: 4, YMax: 2}, a.Objects[1].BndBox)
+	assert.Equal(t, image.Id.String()+"\n", readFile(t, zr, "ImageSets/Main/trainval.txt"))
+}
+
+func TestExportVOCAttributes(t *testing.T) {
+	x, image := Setup()
+	image.BoundingBoxes[0].Attributes = an.Attributes{"truncated": true, "breed": "beagle", "age": 3.5}
+	var buf bytes.Buffer
+	_, err := x.Export(Request{Format: VOCFormat, Writer: &buf})
+	assert.NoError(t, err)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	var a VOCAnnotation
+	assert.NoError(t, xml.Unmarshal([]byte(readFile(t, zr, "Annotations/"+image.Id.String()+".xml")), &a))
+	assert.Equal(t, 1, a.Objects[0].Truncated)
+	assert.Equal(t, 0, a.Objects[0].Difficult)
+	assert.Equal(t, []VOCAttribute{{"age", "3.5"}, {"breed", "beagle"}, {"truncated", "true"}},
+		a.Objects[0].Attributes)
+	assert.Empty(t, a.Objects[1].Attributes)
+}
+
+type imageStore map[im.ImageId]im.Image
+
+func (s imageStore) Find(base im.BaseImage) (*im.Image, error) {
+	image := s[base.ImageId]
+	image.Reader = bytes.NewReader(st.TestPNGImage)
+	return &image, nil
+}
+
+func SetupMany(labels ...string) (ArchiveExporter, []im.BaseImage) {
+	store := imageStore{}
+	baseImages := []im.BaseImage{}
+	for _, l := range labels {
+		image := im.Image{
+			Id:    im.NewImageId(),
+			Specs: im.Specs{MIMEType: "image/png", Width: 100, Height: 50},
+			BoundingBoxes: []an.BoundingBox{
+				an.NewBoundingBox(an.NewAnnotationId(), 20, 10, 10, 4, lbl.NewLabel(lbl.NewLabelId(), l)),
+			},
+		}
+		store[image.Id] = image
+		baseImages = append(baseImages, im.BaseImage{ImageId: image.Id})
+	}
+	x := New(
+		&fk.ImageRepo{IterateBaseImages: baseImages},
+		store,
+		&fk.LabelRepo{ExistingNames: []string{"dog", "cat"}},
+	)
+	return x, baseImages
+}
+
+func TestExportWithSplit(t *testing.T) {
+	x, baseImages := SetupMany("cat", "cat", "cat", "dog", "dog", "dog")
+	var buf bytes.Buffer
+	r, err := x.Export(Request{
+		Format: YOLOFormat,
+		Writer: &buf,
+		Split:  &Split{Train: 2, Test: 1, Seed: 4},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 6, r.NumImages)
+	assert.Equal(t, map[string]int{TrainSubset: 4, TestSubset: 2}, r.NumPerSubset)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.NoError(t, err)
+	numImages := map[string]int{}
+	for _, b := range baseImages {
+		for _, s := range []string{TrainSubset, ValSubset, TestSubset} {
+			if _, err := zr.Open(s + "/images/" + b.ImageId.String() + ".png"); err == nil {
+				numImages[s]++
+				_, err := zr.Open(s + "/labels/" + b.ImageId.String() + ".txt")
+				assert.NoError(t, err)
+			}
+		}
+	}
+	assert.Equal(t, map[string]int{TrainSubset: 4, TestSubset: 2}, numImages)
+
+	var config YOLOConfig
+	assert.NoError(t, yaml.Unmarshal([]byte(readFile(t, zr, "data.yaml")), &config))
+	assert.Equal(t, "train/images", config.Train)
+	assert.Equal(t, "", config.Val)
+	assert.Equal(t, "test/images", config.Test)
+}
+
+func TestInvalidSplitShouldFail(t *testing.T) {
+	x, _ := Setup()
+	_, err := x.Export(Request{Format: COCOFormat, Writer: &bytes.Buffer{}, Split: &Split{Train: -1, Val: 2}})
+	assert.ErrorIs(t, err, e.ErrValidation)
+	_, err = x.Export(Request{Format: COCOFormat, Writer: &bytes.Buffer{}, Split: &Split{}})
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestSplitIsDeterministic(t *testing.T) {
+	ids := make([]im.ImageId, 100)
+	for i := range ids {
+		ids[i] = im.NewImageId()
+	}
+	s := Split{Train: 0.8, Val: 0.1, Test: 0.1, Seed: 42}
+	a := s.Assign(ids, nil)
+	assert.Equal(t, a, s.Assign(ids, nil))
+
+	counts := map[string]int{}
+	for _, subset := range a {
+		counts[subset]++
+	}
+	assert.Equal(t, map[string]int{TrainSubset: 80, ValSubset: 10, TestSubset: 10}, counts)
+
+	s.Seed = 43
+	assert.NotEqual(t, a, s.Assign(ids, nil))
+}
+
+func TestStratifiedSplit(t *testing.T) {
+	ids := make([]im.ImageId, 20)
+	strata := make([]string, 20)
+	for i := range ids {
+		ids[i] = im.NewImageId()
+		strata[i] = []string{"cat", "dog"}[i%2]
+	}
+	s := Split{Train: 0.5, Val: 0.5, Stratify: true}
+	counts := map[string]int{}
+	for i, id := range ids {
+		counts[strata[i]+"/"+s.Assign(ids, strata)[id]]++
+	}
+	assert.Equal(t, map[string]int{"cat/train": 5, "cat/val": 5, "dog/train": 5, "dog/val": 5}, counts)
+}
+
+func TestApportion(t *testing.T) {
+	subsets := []subset{{TrainSubset, 0.7}, {ValSubset, 0.2}, {TestSubset, 0.1}}
+	assert.Equal(t, []int{1, 0, 0}, apportion(1, subsets))
+	assert.Equal(t, []int{3, 1, 0}, apportion(4, subsets))
+	assert.Equal(t, []int{7, 2, 1}, apportion(10, subsets))
+}
+
+func TestMainLabel(t *testing.T) {
+	dog := lbl.NewLabel(lbl.NewLabelId(), "dog")
+	cat := lbl.NewLabel(lbl.NewLabelId(), "cat")
+	assert.Equal(t, "", MainLabel(im.Image{}))
+	assert.Equal(t, "cat", MainLabel(im.Image{BoundingBoxes: []an.BoundingBox{
+		an.NewBoundingBox(an.NewAnnotationId(), 0, 0, 1, 1, dog),
+		an.NewBoundingBox(an.NewAnnotationId(), 0, 0, 1, 1, cat),
+	}}))
+	assert.Equal(t, "dog", MainLabel(im.Image{
+		BoundingBoxes: []an.BoundingBox{an.NewBoundingBox(an.NewAnnotationId(), 0, 0, 1, 1, dog)},
+		Polygons:      []an.Polygon{an.NewPolygon(an.NewAnnotationId(), an.Points{}, dog)},
+		Labels:        []an.ImageLabel{an.NewImageLabel(cat)},
+	}))
+}
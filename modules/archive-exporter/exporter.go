@@ -0,0 +1,237 @@
+package exporter
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"iter"
+	"path"
+	"strings"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type ImageRepo interface {
+	Iterate(im.FilterStr, int) iter.Seq2[im.BaseImage, error]
+}
+
+type ImageStore interface {
+	Find(base im.BaseImage) (*im.Image, error)
+}
+
+type LabelRepo interface {
+	FetchAll() ([]string, error)
+}
+
+type Dataset interface {
+	ImageDir() string
+	AddImage(image im.Image, fileName string) error
+	NumAnnotations() int
+	WriteAnnotations(zw *zip.Writer, dir string) error
+}
+
+func NewDataset(f Format, labels []string, name string) Dataset {
+	switch f {
+	case YOLOFormat:
+		return NewYOLODataset(labels)
+	case VOCFormat:
+		return NewVOCDataset(name)
+	default:
+		return NewCOCODataset(labels)
+	}
+}
+
+type ArchiveExporter struct {
+	ImageRepo
+	ImageStore
+	LabelRepo
+}
+
+func New(ir ImageRepo, is ImageStore, lr LabelRepo) ArchiveExporter {
+	return ArchiveExporter{ir, is, lr}
+}
+
+// Export writes matching images and their annotations to a zip archive.
+// Without split, images and annotations are written at the root of the
+// archive, otherwise each subset gets its own folder.
+func (x ArchiveExporter) Export(r Request) (*Response, error) {
+	errCtx := fmt.Errorf("exporting images to %v archive", r.Format)
+
+	if r.Split != nil {
+		if err := r.Split.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+	}
+
+	labels, err := x.LabelRepo.FetchAll()
+	if err != nil {
+		return nil, fmt.Errorf("%w: fetching labels: %w", errCtx, err)
+	}
+
+	baseImages, err := x.collect(r.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+
+	subsets := []string{""}
+	assignment := map[im.ImageId]string{}
+	if r.Split != nil {
+		subsets = []string{}
+		for _, s := range r.Split.subsets() {
+			subsets = append(subsets, s.name)
+		}
+		assignment, err = x.assign(baseImages, *r.Split)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+	}
+
+	datasets := map[string]Dataset{}
+	for _, s := range subsets {
+		name := s
+		if name == "" {
+			name = "trainval"
+		}
+		datasets[s] = NewDataset(r.Format, labels, name)
+	}
+
+	resp := Response{}
+	if r.Split != nil {
+		resp.NumPerSubset = map[string]int{}
+		for _, s := range subsets {
+			resp.NumPerSubset[s] = 0
+		}
+	}
+
+	zw := zip.NewWriter(r.Writer)
+	for _, baseImage := range baseImages {
+		subset := assignment[baseImage.ImageId]
+		dataset := datasets[subset]
+		image, err := x.ImageStore.Find(baseImage)
+		if err != nil {
+			return nil, fmt.Errorf("%w: fetching image %v: %w", errCtx, baseImage.ImageId, err)
+		}
+
+		if r.Status != "" {
+			*image = WithReviewStatus(*image, r.Status)
+		}
+
+		fileName := ImageFileName(*image)
+		if err := writeImage(zw, path.Join(subset, dataset.ImageDir(), fileName), image.Reader); err != nil {
+			return nil, fmt.Errorf("%w: writing image %v: %w", errCtx, baseImage.ImageId, err)
+		}
+		if err := dataset.AddImage(*image, fileName); err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		resp.NumImages++
+		if resp.NumPerSubset != nil {
+			resp.NumPerSubset[subset]++
+		}
+	}
+
+	for _, s := range subsets {
+		if err := datasets[s].WriteAnnotations(zw, s); err != nil {
+			return nil, fmt.Errorf("%w: writing annotations: %w", errCtx, err)
+		}
+		resp.NumAnnotations += datasets[s].NumAnnotations()
+	}
+	if r.Format == YOLOFormat {
+		if r.Split == nil {
+			subsets = nil
+		}
+		if err := WriteYOLOConfig(zw, labels, subsets); err != nil {
+			return nil, fmt.Errorf("%w: writing YOLO configuration: %w", errCtx, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("%w: closing archive: %w", errCtx, err)
+	}
+
+	return &resp, nil
+}
+
+// collect fetches matching images. An image that belongs to several
+// matching collections is exported once, with the annotations of the
+// first one.
+func (x ArchiveExporter) collect(f im.FilterStr) ([]im.BaseImage, error) {
+	baseImages := []im.BaseImage{}
+	seen := map[im.ImageId]bool{}
+	for baseImage, err := range x.ImageRepo.Iterate(f, 100) {
+		if err != nil {
+			return nil, fmt.Errorf("iterating images: %w", err)
+		}
+		if seen[baseImage.ImageId] {
+			continue
+		}
+		seen[baseImage.ImageId] = true
+		baseImages = append(baseImages, baseImage)
+	}
+	return baseImages, nil
+}
+
+func (x ArchiveExporter) assign(baseImages []im.BaseImage, s Split) (map[im.ImageId]string, error) {
+	ids := make([]im.ImageId, len(baseImages))
+	strata := make([]string, len(baseImages))
+	for i, baseImage := range baseImages {
+		ids[i] = baseImage.ImageId
+		if !s.Stratify {
+			continue
+		}
+		image, err := x.ImageStore.Find(baseImage)
+		if err != nil {
+			return nil, fmt.Errorf("fetching image %v: %w", baseImage.ImageId, err)
+		}
+		if closer, ok := image.Reader.(io.Closer); ok {
+			closer.Close()
+		}
+		strata[i] = MainLabel(*image)
+	}
+	return s.Assign(ids, strata), nil
+}
+
+// WithReviewStatus drops annotations of an image whose review status
+// differs from s.
+func WithReviewStatus(image im.Image, s an.ReviewStatus) im.Image {
+	labels := []an.ImageLabel{}
+	for _, l := range image.Labels {
+		if l.Review.CurrentStatus() == s {
+			labels = append(labels, l)
+		}
+	}
+	boxes := []an.BoundingBox{}
+	for _, b := range image.BoundingBoxes {
+		if b.Review.CurrentStatus() == s {
+			boxes = append(boxes, b)
+		}
+	}
+	polygons := []an.Polygon{}
+	for _, p := range image.Polygons {
+		if p.Review.CurrentStatus() == s {
+			polygons = append(polygons, p)
+		}
+	}
+	image.Labels, image.BoundingBoxes, image.Polygons = labels, boxes, polygons
+	return image
+}
+
+func ImageFileName(image im.Image) string {
+	_, ext, _ := strings.Cut(image.Specs.MIMEType, "/")
+	return fmt.Sprintf("%v.%v", image.Id, ext)
+}
+
+func writeImage(zw *zip.Writer, path string, reader io.Reader) error {
+	if reader == nil {
+		return fmt.Errorf("missing raw data: %w", e.ErrInternal)
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		defer closer.Close()
+	}
+	w, err := zw.Create(path)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, reader)
+	return err
+}
@@ -0,0 +1,47 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Format string
+
+const (
+	COCOFormat Format = "coco"
+	YOLOFormat Format = "yolo"
+	VOCFormat  Format = "voc"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case COCOFormat, YOLOFormat, VOCFormat:
+		return f, nil
+	default:
+		return "", fmt.Errorf("unsupported export format %q: %w", s, e.ErrValidation)
+	}
+}
+
+type Request struct {
+	Filter im.FilterStr
+	Format Format
+	Split  *Split
+	// Status restricts exported annotations to those of a given review
+	// status. All annotations are exported when empty.
+	Status an.ReviewStatus
+	Writer io.Writer
+}
+
+type Response struct {
+	NumImages      int
+	NumAnnotations int
+	NumPerSubset   map[string]int
+}
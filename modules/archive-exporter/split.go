@@ -0,0 +1,142 @@
+package exporter
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const (
+	TrainSubset = "train"
+	ValSubset   = "val"
+	TestSubset  = "test"
+)
+
+// Split distributes exported images into train, validation and test
+// subsets. Ratios are normalized by their sum, so 8/1/1 and 0.8/0.1/0.1
+// are equivalent. With Stratify set, each label is distributed
+// separately, using the most frequent label of an image.
+type Split struct {
+	Train    float64
+	Val      float64
+	Test     float64
+	Seed     uint64
+	Stratify bool
+}
+
+func (s Split) Validate() error {
+	for _, r := range []float64{s.Train, s.Val, s.Test} {
+		if r < 0 || math.IsNaN(r) || math.IsInf(r, 0) {
+			return fmt.Errorf("split ratios must be non-negative: %w", e.ErrValidation)
+		}
+	}
+	if s.Train+s.Val+s.Test == 0 {
+		return fmt.Errorf("at least one split ratio must be positive: %w", e.ErrValidation)
+	}
+	return nil
+}
+
+func (s Split) String() string {
+	return fmt.Sprintf("%v/%v/%v", s.Train, s.Val, s.Test)
+}
+
+type subset struct {
+	name  string
+	ratio float64
+}
+
+func (s Split) subsets() []subset {
+	r := []subset{}
+	for _, sb := range []subset{{TrainSubset, s.Train}, {ValSubset, s.Val}, {TestSubset, s.Test}} {
+		if sb.ratio > 0 {
+			r = append(r, sb)
+		}
+	}
+	return r
+}
+
+// Assign maps each image to a subset. Images are shuffled with a generator
+// seeded by Seed, so that the same images and seed always yield the same
+// assignment.
+func (s Split) Assign(ids []im.ImageId, strata []string) map[im.ImageId]string {
+	groups := map[string][]im.ImageId{}
+	for i, id := range ids {
+		stratum := ""
+		if s.Stratify {
+			stratum = strata[i]
+		}
+		groups[stratum] = append(groups[stratum], id)
+	}
+
+	subsets := s.subsets()
+	rng := rand.New(rand.NewPCG(s.Seed, s.Seed))
+	assignment := map[im.ImageId]string{}
+	for _, stratum := range slices.Sorted(maps.Keys(groups)) {
+		group := groups[stratum]
+		rng.Shuffle(len(group), func(i, j int) { group[i], group[j] = group[j], group[i] })
+		start := 0
+		for i, n := range apportion(len(group), subsets) {
+			for _, id := range group[start : start+n] {
+				assignment[id] = subsets[i].name
+			}
+			start += n
+		}
+	}
+	return assignment
+}
+
+// apportion splits n items along the given ratios with the largest
+// remainder method, so that counts always sum up to n.
+func apportion(n int, subsets []subset) []int {
+	var total float64
+	for _, s := range subsets {
+		total += s.ratio
+	}
+	counts := make([]int, len(subsets))
+	remainders := make([]float64, len(subsets))
+	assigned := 0
+	for i, s := range subsets {
+		quota := float64(n) * s.ratio / total
+		counts[i] = int(math.Floor(quota))
+		remainders[i] = quota - float64(counts[i])
+		assigned += counts[i]
+	}
+	order := make([]int, len(subsets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool { return remainders[order[a]] > remainders[order[b]] })
+	for i := 0; assigned < n; i++ {
+		counts[order[i%len(order)]]++
+		assigned++
+	}
+	return counts
+}
+
+// MainLabel returns the most frequent label of an image, ties being broken
+// alphabetically, or an empty string for images without annotations.
+func MainLabel(image im.Image) string {
+	counts := map[string]int{}
+	for _, l := range image.Labels {
+		counts[l.Label.Name]++
+	}
+	for _, b := range image.BoundingBoxes {
+		counts[b.Label.Name]++
+	}
+	for _, p := range image.Polygons {
+		counts[p.Label.Name]++
+	}
+	main := ""
+	for label, n := range counts {
+		if n > counts[main] || (n == counts[main] && label < main) {
+			main = label
+		}
+	}
+	return main
+}
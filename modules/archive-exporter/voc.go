@@ -0,0 +1,150 @@
+package exporter
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"maps"
+	"math"
+	"path"
+	"slices"
+	"strings"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+)
+
+type VOCBox struct {
+	XMin int `xml:"xmin"`
+	YMin int `xml:"ymin"`
+	XMax int `xml:"xmax"`
+	YMax int `xml:"ymax"`
+}
+
+type VOCAttribute struct {
+	Name  string `xml:"name"`
+	Value string `xml:"value"`
+}
+
+type VOCObject struct {
+	Name       string         `xml:"name"`
+	Pose       string         `xml:"pose"`
+	Truncated  int            `xml:"truncated"`
+	Difficult  int            `xml:"difficult"`
+	BndBox     VOCBox         `xml:"bndbox"`
+	Attributes []VOCAttribute `xml:"attributes>attribute,omitempty"`
+}
+
+type VOCSize struct {
+	Width  int `xml:"width"`
+	Height int `xml:"height"`
+	Depth  int `xml:"depth"`
+}
+
+type VOCAnnotation struct {
+	XMLName   xml.Name    `xml:"annotation"`
+	Folder    string      `xml:"folder"`
+	Filename  string      `xml:"filename"`
+	Size      VOCSize     `xml:"size"`
+	Segmented int         `xml:"segmented"`
+	Objects   []VOCObject `xml:"object"`
+}
+
+// VOCDataset writes one Pascal VOC XML file per image. VOC only supports
+// axis-aligned boxes, hence polygons are exported as their enclosing box.
+// The bool attributes "truncated" and "difficult" fill the fields of the
+// same name, and all attributes are listed as in the VOC exports of CVAT.
+type VOCDataset struct {
+	Name           string
+	annotations    []VOCAnnotation
+	numAnnotations int
+}
+
+func NewVOCDataset(name string) *VOCDataset {
+	return &VOCDataset{Name: name}
+}
+
+func (d *VOCDataset) ImageDir() string {
+	return "JPEGImages"
+}
+
+func (d *VOCDataset) NumAnnotations() int {
+	return d.numAnnotations
+}
+
+func (d *VOCDataset) AddImage(image im.Image, fileName string) error {
+	a := VOCAnnotation{
+		Folder:   d.ImageDir(),
+		Filename: fileName,
+		Size:     VOCSize{Width: image.Specs.Width, Height: image.Specs.Height, Depth: 3},
+		Objects:  []VOCObject{},
+	}
+	for _, box := range image.BoundingBoxes {
+		a.Objects = append(a.Objects, newVOCObject(box.Label.Name, BoxToCOCO(box), box.Attributes))
+	}
+	for _, polygon := range image.Polygons {
+		a.Objects = append(a.Objects, newVOCObject(polygon.Label.Name, PointsToCOCOBox(polygon.Points),
+			polygon.Attributes))
+	}
+	d.numAnnotations += len(a.Objects)
+	d.annotations = append(d.annotations, a)
+	return nil
+}
+
+func (d *VOCDataset) WriteAnnotations(zw *zip.Writer, dir string) error {
+	stems := []string{}
+	for _, a := range d.annotations {
+		stem := strings.TrimSuffix(a.Filename, path.Ext(a.Filename))
+		stems = append(stems, stem)
+		w, err := zw.Create(path.Join(dir, "Annotations", stem+".xml"))
+		if err != nil {
+			return err
+		}
+		enc := xml.NewEncoder(w)
+		enc.Indent("", "  ")
+		if err := enc.Encode(a); err != nil {
+			return fmt.Errorf("encoding annotation of %v: %w", a.Filename, err)
+		}
+	}
+
+	w, err := zw.Create(path.Join(dir, "ImageSets", "Main", d.Name+".txt"))
+	if err != nil {
+		return err
+	}
+	for _, s := range stems {
+		if _, err := fmt.Fprintln(w, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newVOCObject(label string, cocoBox [4]float32, attributes an.Attributes) VOCObject {
+	o := VOCObject{
+		Name:      label,
+		Pose:      "Unspecified",
+		Truncated: flag(attributes["truncated"]),
+		Difficult: flag(attributes["difficult"]),
+		BndBox: VOCBox{
+			XMin: round(cocoBox[0]),
+			YMin: round(cocoBox[1]),
+			XMax: round(cocoBox[0] + cocoBox[2]),
+			YMax: round(cocoBox[1] + cocoBox[3]),
+		},
+	}
+	for _, name := range slices.Sorted(maps.Keys(attributes)) {
+		o.Attributes = append(o.Attributes, VOCAttribute{Name: name, Value: fmt.Sprint(attributes[name])})
+	}
+	return o
+}
+
+func flag(v any) int {
+	if b, ok := v.(bool); ok && b {
+		return 1
+	}
+	return 0
+}
+
+func round(v float32) int {
+	return int(math.Round(float64(v)))
+}
@@ -0,0 +1,143 @@
+package exporter
+
+import (
+	"archive/zip"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"gopkg.in/yaml.v3"
+)
+
+type yoloLabelFile struct {
+	name  string
+	lines []string
+}
+
+// YOLODataset writes one text file per image, where bounding boxes are
+// given as "class xc yc width height" and polygons as "class x1 y1 x2 y2 ...",
+// all coordinates being normalized by the image dimensions.
+type YOLODataset struct {
+	files          []yoloLabelFile
+	classIds       map[string]int
+	numAnnotations int
+}
+
+type YOLOConfig struct {
+	Path  string         `yaml:"path"`
+	Train string         `yaml:"train,omitempty"`
+	Val   string         `yaml:"val,omitempty"`
+	Test  string         `yaml:"test,omitempty"`
+	Names map[int]string `yaml:"names"`
+}
+
+func NewYOLODataset(labels []string) *YOLODataset {
+	d := &YOLODataset{classIds: map[string]int{}}
+	for i, l := range slices.Sorted(slices.Values(labels)) {
+		d.classIds[l] = i
+	}
+	return d
+}
+
+func (d *YOLODataset) ImageDir() string {
+	return "images"
+}
+
+func (d *YOLODataset) NumAnnotations() int {
+	return d.numAnnotations
+}
+
+func (d *YOLODataset) AddImage(image im.Image, fileName string) error {
+	width, height := float32(image.Specs.Width), float32(image.Specs.Height)
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("image %v has no dimensions: %w", image.Id, e.ErrInternal)
+	}
+
+	file := yoloLabelFile{name: strings.TrimSuffix(fileName, path.Ext(fileName)) + ".txt"}
+	for _, box := range image.BoundingBoxes {
+		classId, err := d.classId(box.Label.Name)
+		if err != nil {
+			return fmt.Errorf("adding bounding box %v: %w", box.Id, err)
+		}
+		b := BoxToCOCO(box)
+		file.lines = append(file.lines, yoloLine(classId,
+			(b[0]+b[2]/2)/width, (b[1]+b[3]/2)/height, b[2]/width, b[3]/height))
+	}
+	for _, polygon := range image.Polygons {
+		classId, err := d.classId(polygon.Label.Name)
+		if err != nil {
+			return fmt.Errorf("adding polygon %v: %w", polygon.Id, err)
+		}
+		coords := make([]float32, 0, 2*len(polygon.Points.Coordinates))
+		for _, p := range polygon.Points.Coordinates {
+			coords = append(coords, p[0]/width, p[1]/height)
+		}
+		file.lines = append(file.lines, yoloLine(classId, coords...))
+	}
+	d.numAnnotations += len(file.lines)
+	d.files = append(d.files, file)
+	return nil
+}
+
+func (d *YOLODataset) WriteAnnotations(zw *zip.Writer, dir string) error {
+	for _, f := range d.files {
+		w, err := zw.Create(path.Join(dir, "labels", f.name))
+		if err != nil {
+			return err
+		}
+		for _, line := range f.lines {
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *YOLODataset) classId(label string) (int, error) {
+	id, ok := d.classIds[label]
+	if !ok {
+		return 0, fmt.Errorf("unknown label %q: %w", label, e.ErrNotFound)
+	}
+	return id, nil
+}
+
+func yoloLine(classId int, values ...float32) string {
+	fields := []string{strconv.Itoa(classId)}
+	for _, v := range values {
+		fields = append(fields, strconv.FormatFloat(float64(v), 'f', 6, 32))
+	}
+	return strings.Join(fields, " ")
+}
+
+// WriteYOLOConfig writes the data.yaml file describing where images of
+// each subset are located, along with class names.
+func WriteYOLOConfig(zw *zip.Writer, labels []string, subsets []string) error {
+	config := YOLOConfig{Path: ".", Names: map[int]string{}}
+	for i, l := range slices.Sorted(slices.Values(labels)) {
+		config.Names[i] = l
+	}
+	if len(subsets) == 0 {
+		config.Train, config.Val = "images", "images"
+	}
+	for _, s := range subsets {
+		dir := path.Join(s, "images")
+		switch s {
+		case TrainSubset:
+			config.Train = dir
+		case ValSubset:
+			config.Val = dir
+		case TestSubset:
+			config.Test = dir
+		}
+	}
+	w, err := zw.Create("data.yaml")
+	if err != nil {
+		return err
+	}
+	return yaml.NewEncoder(w).Encode(config)
+}
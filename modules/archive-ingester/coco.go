@@ -0,0 +1,122 @@
+package ingester
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type cocoDataset struct {
+	Images []struct {
+		Id       int64  `json:"id"`
+		FileName string `json:"file_name"`
+	} `json:"images"`
+	Categories []struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"categories"`
+	Annotations []struct {
+		ImageId      int64           `json:"image_id"`
+		CategoryId   int64           `json:"category_id"`
+		BBox         []float32       `json:"bbox"`
+		Segmentation json.RawMessage `json:"segmentation"`
+	} `json:"annotations"`
+}
+
+func parseCOCO(zr *zip.Reader, idx imageIndex) (samples, []SkippedFile, error) {
+	result := samples{}
+	skipped := []SkippedFile{}
+	for _, f := range zr.File {
+		if ext(f.Name) != ".json" || !isCOCOFile(f) {
+			continue
+		}
+		var d cocoDataset
+		if err := decodeJSON(f, &d); err != nil {
+			return nil, nil, fmt.Errorf("decoding COCO file %v: %w: %w", f.Name, err, e.ErrValidation)
+		}
+
+		categories := map[int64]string{}
+		for _, c := range d.Categories {
+			categories[c.Id] = c.Name
+		}
+		images := map[int64]*zip.File{}
+		for _, img := range d.Images {
+			file, ok := idx.find(img.FileName)
+			if !ok {
+				skipped = append(skipped, SkippedFile{
+					Name:   img.FileName,
+					Reason: fmt.Sprintf("referenced in %v but missing from archive", f.Name),
+				})
+				continue
+			}
+			images[img.Id] = file
+			result.get(file)
+		}
+
+		for _, a := range d.Annotations {
+			file, ok := images[a.ImageId]
+			if !ok {
+				continue
+			}
+			label, ok := categories[a.CategoryId]
+			if !ok {
+				skipped = append(skipped, SkippedFile{
+					Name:   file.Name,
+					Reason: fmt.Sprintf("annotation with unknown category id %v", a.CategoryId),
+				})
+				continue
+			}
+			sample := result.get(file)
+			if polygons := cocoPolygons(a.Segmentation, label); len(polygons) > 0 {
+				sample.Polygons = append(sample.Polygons, polygons...)
+				continue
+			}
+			if len(a.BBox) != 4 {
+				skipped = append(skipped, SkippedFile{
+					Name:   file.Name,
+					Reason: fmt.Sprintf("annotation with invalid bbox %v", a.BBox),
+				})
+				continue
+			}
+			sample.BoundingBoxes = append(sample.BoundingBoxes, an.BoundingBoxRequest{
+				Label:  label,
+				Xc:     a.BBox[0] + a.BBox[2]/2,
+				Yc:     a.BBox[1] + a.BBox[3]/2,
+				Width:  a.BBox[2],
+				Height: a.BBox[3],
+			})
+		}
+	}
+	return result, skipped, nil
+}
+
+func cocoPolygons(raw json.RawMessage, label string) []an.PolygonRequest {
+	var rings [][]float32
+	if len(raw) == 0 || json.Unmarshal(raw, &rings) != nil {
+		return nil
+	}
+	polygons := []an.PolygonRequest{}
+	for _, ring := range rings {
+		if len(ring) < 6 || len(ring)%2 != 0 {
+			continue
+		}
+		points := an.Points{}
+		for i := 0; i < len(ring); i += 2 {
+			points.Coordinates = append(points.Coordinates, [2]float32{ring[i], ring[i+1]})
+		}
+		polygons = append(polygons, an.PolygonRequest{Label: label, Points: points})
+	}
+	return polygons
+}
+
+func decodeJSON(f *zip.File, v any) error {
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return json.NewDecoder(r).Decode(v)
+}
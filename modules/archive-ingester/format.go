@@ -0,0 +1,87 @@
+package ingester
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"path"
+	"strings"
+)
+
+type Format string
+
+const (
+	FlatFormat Format = "flat"
+	COCOFormat Format = "coco"
+	YOLOFormat Format = "yolo"
+	VOCFormat  Format = "voc"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+var yoloClassFiles = []string{"classes.txt", "obj.names", "data.yaml", "dataset.yaml"}
+
+func DetectFormat(zr *zip.Reader) Format {
+	hasXML, hasClasses := false, false
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		switch ext(f.Name) {
+		case ".json":
+			if isCOCOFile(f) {
+				return COCOFormat
+			}
+		case ".xml":
+			hasXML = true
+		}
+		for _, name := range yoloClassFiles {
+			if path.Base(f.Name) == name {
+				hasClasses = true
+			}
+		}
+	}
+	switch {
+	case hasXML:
+		return VOCFormat
+	case hasClasses:
+		return YOLOFormat
+	default:
+		return FlatFormat
+	}
+}
+
+func isCOCOFile(f *zip.File) bool {
+	r, err := f.Open()
+	if err != nil {
+		return false
+	}
+	defer r.Close()
+	var probe struct {
+		Images      *json.RawMessage `json:"images"`
+		Annotations *json.RawMessage `json:"annotations"`
+	}
+	if err := json.NewDecoder(r).Decode(&probe); err != nil {
+		return false
+	}
+	return probe.Images != nil && probe.Annotations != nil
+}
+
+func ext(name string) string {
+	return strings.ToLower(path.Ext(name))
+}
+
+func stem(name string) string {
+	base := path.Base(name)
+	return strings.TrimSuffix(base, path.Ext(base))
+}
+
+func isAnnotationFile(name string) bool {
+	switch ext(name) {
+	case ".json", ".xml", ".txt", ".yaml", ".yml", ".names":
+		return true
+	default:
+		return false
+	}
+}
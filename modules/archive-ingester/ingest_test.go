@@ -3,8 +3,10 @@ package ingester
 import (
 	"archive/zip"
 	"bytes"
+	"image"
 	"testing"
 
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	st "github.com/lejeunel/go-image-annotator/shared/testing"
@@ -35,7 +37,7 @@ func MakeZipArchive(files map[string][]byte) (*bytes.Reader, int64) {
 }
 
 func Setup() (ArchiveIngester, *bytes.Reader, int64) {
-	ing := New(&fk.ImageStore{}, &FakeImageIngester{})
+	ing := New(&fk.ImageStore{}, &FakeImageIngester{}, &fk.LabelRepo{})
 	archive, size := MakeZipArchive(
 		map[string][]byte{
 			"image1.jpg": st.TestJPGImage,
@@ -63,3 +65,162 @@ func TestDeleteAllOnFailure(t *testing.T) {
 	assert.Error(t, err)
 	assert.True(t, s.DeletedBatch)
 }
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    map[string][]byte
+		expected Format
+	}{
+		{"flat", map[string][]byte{"a.png": st.TestPNGImage}, FlatFormat},
+		{"coco", map[string][]byte{
+			"images/a.png":               st.TestPNGImage,
+			"annotations/instances.json": []byte(`{"images":[],"annotations":[]}`),
+		}, COCOFormat},
+		{"voc", map[string][]byte{
+			"JPEGImages/a.png":  st.TestPNGImage,
+			"Annotations/a.xml": []byte(`<annotation></annotation>`),
+		}, VOCFormat},
+		{"yolo", map[string][]byte{
+			"images/a.png": st.TestPNGImage,
+			"labels/a.txt": []byte("0 0.5 0.5 0.1 0.1"),
+			"classes.txt":  []byte("car"),
+		}, YOLOFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader, size := MakeZipArchive(tt.files)
+			zr, err := zip.NewReader(reader, size)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, DetectFormat(zr))
+		})
+	}
+}
+
+func ingestAnnotated(t *testing.T, files map[string][]byte, labels []string,
+	r Request,
+) (Response, *FakeImageIngester, *fk.LabelRepo) {
+	imageIngester := &FakeImageIngester{}
+	labelRepo := &fk.LabelRepo{ExistingNames: labels}
+	ing := New(&fk.ImageStore{}, imageIngester, labelRepo)
+	r.ReaderAt, r.Size = MakeZipArchive(files)
+	resp, err := ing.IngestArchive(r)
+	assert.NoError(t, err)
+	return resp, imageIngester, labelRepo
+}
+
+func TestIngestCOCO(t *testing.T) {
+	resp, ingester, _ := ingestAnnotated(t, map[string][]byte{
+		"images/a.png": st.TestPNGImage,
+		"annotations/instances.json": []byte(`{
+			"images": [{"id": 1, "file_name": "a.png"}, {"id": 2, "file_name": "b.png"}],
+			"categories": [{"id": 1, "name": "car"}, {"id": 2, "name": "bus"}],
+			"annotations": [
+				{"image_id": 1, "category_id": 1, "bbox": [10, 20, 4, 6]},
+				{"image_id": 1, "category_id": 1, "bbox": [0, 0, 4, 2],
+				 "segmentation": [[0, 0, 4, 0, 4, 2]]},
+				{"image_id": 1, "category_id": 2, "bbox": [0, 0, 1, 1]}
+			]}`),
+	}, []string{"car"}, Request{})
+
+	assert.Equal(t, COCOFormat, resp.Format)
+	assert.Equal(t, 1, len(resp.ImageIds))
+	assert.Equal(t, 2, len(resp.Skipped))
+	got := ingester.Got[0]
+	assert.Equal(t, []an.BoundingBoxRequest{
+		{Label: "car", Xc: 12, Yc: 23, Width: 4, Height: 6},
+	}, got.BoundingBoxes)
+	assert.Equal(t, 1, len(got.Polygons))
+	assert.Equal(t, [][2]float32{{0, 0}, {4, 0}, {4, 2}}, got.Polygons[0].Points.Coordinates)
+}
+
+func TestIngestVOCWithLabelMap(t *testing.T) {
+	_, ingester, _ := ingestAnnotated(t, map[string][]byte{
+		"JPEGImages/a.png": st.TestPNGImage,
+		"Annotations/a.xml": []byte(`<annotation><filename>a.png</filename>
+			<object><name>automobile</name>
+			<bndbox><xmin>10</xmin><ymin>20</ymin><xmax>14</xmax><ymax>26</ymax></bndbox>
+			</object></annotation>`),
+	}, []string{"car"}, Request{LabelMap: map[string]string{"automobile": "car"}})
+
+	assert.Equal(t, []an.BoundingBoxRequest{
+		{Label: "car", Xc: 12, Yc: 23, Width: 4, Height: 6},
+	}, ingester.Got[0].BoundingBoxes)
+}
+
+func TestIngestVOCResolvesLabelAliases(t *testing.T) {
+	imageIngester := &FakeImageIngester{}
+	labelRepo := &fk.LabelRepo{ExistingNames: []string{"car"}, Aliases: map[string]string{"Car": "car"}}
+	ing := New(&fk.ImageStore{}, imageIngester, labelRepo)
+	r := Request{}
+	r.ReaderAt, r.Size = MakeZipArchive(map[string][]byte{
+		"JPEGImages/a.png": st.TestPNGImage,
+		"Annotations/a.xml": []byte(`<annotation><filename>a.png</filename>
+			<object><name>Car</name>
+			<bndbox><xmin>10</xmin><ymin>20</ymin><xmax>14</xmax><ymax>26</ymax></bndbox>
+			</object></annotation>`),
+	})
+	_, err := ing.IngestArchive(r)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []an.BoundingBoxRequest{
+		{Label: "car", Xc: 12, Yc: 23, Width: 4, Height: 6},
+	}, imageIngester.Got[0].BoundingBoxes)
+}
+
+func TestIngestYOLOCreatesMissingLabels(t *testing.T) {
+	img, _, err := image.DecodeConfig(bytes.NewReader(st.TestPNGImage))
+	assert.NoError(t, err)
+	resp, ingester, labelRepo := ingestAnnotated(t, map[string][]byte{
+		"images/a.png": st.TestPNGImage,
+		"labels/a.txt": []byte("0 0.5 0.5 0.5 0.5\n1 0 0 1 0 1 1\n"),
+		"labels/b.txt": []byte("0 0.5 0.5 0.5 0.5\n"),
+		"data.yaml":    []byte("names: [car, truck]\n"),
+	}, []string{"car"}, Request{CreateMissingLabels: true})
+
+	w, h := float32(img.Width), float32(img.Height)
+	assert.Equal(t, YOLOFormat, resp.Format)
+	assert.Equal(t, "truck", labelRepo.Created.Name)
+	assert.Equal(t, []an.BoundingBoxRequest{
+		{Label: "car", Xc: w / 2, Yc: h / 2, Width: w / 2, Height: h / 2},
+	}, ingester.Got[0].BoundingBoxes)
+	assert.Equal(t, "truck", ingester.Got[0].Polygons[0].Label)
+	assert.Equal(t, []SkippedFile{{Name: "labels/b.txt", Reason: "no matching image in archive"}},
+		resp.Skipped)
+}
+
+func TestIngestYOLOWithoutClassesShouldFail(t *testing.T) {
+	ing := New(&fk.ImageStore{}, &FakeImageIngester{}, &fk.LabelRepo{})
+	reader, size := MakeZipArchive(map[string][]byte{
+		"images/a.png": st.TestPNGImage,
+		"labels/a.txt": []byte("0 0.5 0.5 0.5 0.5\n"),
+		"data.yaml":    []byte("names: 3\n"),
+	})
+	_, err := ing.IngestArchive(Request{ReaderAt: reader, Size: size})
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestFailedImagesAreSkippedInAnnotatedArchives(t *testing.T) {
+	ing := New(&fk.ImageStore{}, &FakeImageIngester{Err: e.ErrDuplicate}, &fk.LabelRepo{})
+	reader, size := MakeZipArchive(map[string][]byte{
+		"a.png":          st.TestPNGImage,
+		"instances.json": []byte(`{"images":[],"annotations":[]}`),
+	})
+	resp, err := ing.IngestArchive(Request{ReaderAt: reader, Size: size})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(resp.ImageIds))
+	assert.Equal(t, "a.png", resp.Skipped[0].Name)
+}
+
+func TestStoreErrorsRollBackAnnotatedArchives(t *testing.T) {
+	s := fk.ImageStore{}
+	ing := New(&s, &FakeImageIngester{Err: e.ErrInternal}, &fk.LabelRepo{})
+	reader, size := MakeZipArchive(map[string][]byte{
+		"a.png":          st.TestPNGImage,
+		"instances.json": []byte(`{"images":[],"annotations":[]}`),
+	})
+	resp, err := ing.IngestArchive(Request{ReaderAt: reader, Size: size})
+	assert.ErrorIs(t, err, e.ErrInternal)
+	assert.Empty(t, resp.Skipped)
+	assert.True(t, s.DeletedBatch)
+}
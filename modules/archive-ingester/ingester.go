@@ -2,11 +2,21 @@ package ingester
 
 import (
 	"archive/zip"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/jonboulle/clockwork"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 	ii "github.com/lejeunel/go-image-annotator/modules/image-ingester"
+	v "github.com/lejeunel/go-image-annotator/modules/string-validator"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -15,28 +25,46 @@ type ImageIngester interface {
 }
 
 type ImageStore interface {
-	DeleteBatch([]im.ImageId, clc.CollectionName) error
+	DeleteBatch([]im.ImageId, clc.CollectionName, *u.UserId, *time.Time) error
+}
+
+type LabelRepo interface {
+	Canonical(string) (*string, error)
+	Create(lbl.Label) error
 }
 
 type ArchiveIngester struct {
 	ImageIngester
 	ImageStore
+	LabelRepo
+	v.Validator
+	clockwork.Clock
 }
 
-func New(is ImageStore, ii ImageIngester) ArchiveIngester {
-	return ArchiveIngester{ii, is}
+func New(is ImageStore, ii ImageIngester, lr LabelRepo) ArchiveIngester {
+	return ArchiveIngester{ii, is, lr, v.NewNameValidator(), clockwork.NewRealClock()}
 }
 
 func (i ArchiveIngester) IngestArchive(r Request) (Response, error) {
 	errCtx := fmt.Errorf("ingesting zip archive")
 
-	resp := Response{Collection: r.Collection}
+	resp := Response{Collection: r.Collection, Format: FlatFormat}
 
 	zr, err := zip.NewReader(r.ReaderAt, r.Size)
 	if err != nil {
 		return resp, fmt.Errorf("%w: %w", errCtx, err)
 	}
 
+	resp.Format = DetectFormat(zr)
+	if resp.Format == FlatFormat {
+		return i.ingestFlat(r, zr, resp)
+	}
+	return i.ingestAnnotated(r, zr, resp)
+}
+
+func (i ArchiveIngester) ingestFlat(r Request, zr *zip.Reader, resp Response) (Response, error) {
+	errCtx := fmt.Errorf("ingesting zip archive")
+
 	var lastErr error
 	for _, file := range zr.File {
 		if file.FileInfo().IsDir() {
@@ -67,11 +95,171 @@ func (i ArchiveIngester) IngestArchive(r Request) (Response, error) {
 	}
 
 	if lastErr != nil {
-		if err := i.ImageStore.DeleteBatch(resp.ImageIds, resp.Collection); err != nil {
-			return resp, fmt.Errorf("%w: %w: %w", errCtx, lastErr, err)
-		}
-		return resp, lastErr
+		return resp, i.rollback(r, resp, lastErr)
 	}
 
 	return resp, nil
 }
+
+// rollback deletes the images ingested so far and returns the error that
+// interrupted the ingestion.
+func (i ArchiveIngester) rollback(r Request, resp Response, cause error) error {
+	now := i.Clock.Now()
+	if err := i.ImageStore.DeleteBatch(resp.ImageIds, resp.Collection, &r.UserId, &now); err != nil {
+		return fmt.Errorf("%w: rolling back: %w", cause, err)
+	}
+	return cause
+}
+
+func (i ArchiveIngester) ingestAnnotated(r Request, zr *zip.Reader, resp Response) (Response, error) {
+	errCtx := fmt.Errorf("ingesting %v archive", resp.Format)
+
+	idx := newImageIndex(zr)
+	var parsed samples
+	var err error
+	switch resp.Format {
+	case COCOFormat:
+		parsed, resp.Skipped, err = parseCOCO(zr, idx)
+	case VOCFormat:
+		parsed, resp.Skipped, err = parseVOC(zr, idx)
+	case YOLOFormat:
+		parsed, resp.Skipped, err = parseYOLO(zr, idx)
+	}
+	if err != nil {
+		return resp, fmt.Errorf("%w: %w", errCtx, err)
+	}
+
+	labels := newLabelResolver(i, r)
+	for _, file := range idx.files {
+		sample, ok := parsed[file]
+		if !ok {
+			sample = &Sample{File: file}
+		}
+
+		boxes, polygons, unknown, err := labels.resolve(*sample)
+		if err != nil {
+			return resp, i.rollback(r, resp,
+				fmt.Errorf("%w: resolving labels of %v: %w", errCtx, file.Name, err))
+		}
+		if len(unknown) > 0 {
+			resp.Skipped = append(resp.Skipped, SkippedFile{
+				Name: file.Name,
+				Reason: fmt.Sprintf("dropped annotations with unknown labels %v",
+					strings.Join(unknown, ", ")),
+			})
+		}
+
+		imageId, err := i.ingestFile(r, file, boxes, polygons)
+		if errors.Is(err, e.ErrValidation) || errors.Is(err, e.ErrDuplicate) {
+			resp.Skipped = append(resp.Skipped, SkippedFile{Name: file.Name, Reason: err.Error()})
+			continue
+		}
+		if err != nil {
+			return resp, i.rollback(r, resp,
+				fmt.Errorf("%w: ingesting file %v: %w", errCtx, file.Name, err))
+		}
+		resp.ImageIds = append(resp.ImageIds, *imageId)
+	}
+	return resp, nil
+}
+
+func (i ArchiveIngester) ingestFile(
+	r Request,
+	file *zip.File,
+	boxes []an.BoundingBoxRequest,
+	polygons []an.PolygonRequest,
+) (*im.ImageId, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	resp, err := i.ImageIngester.Ingest(ii.Request{
+		UserId:        r.UserId,
+		Collection:    r.Collection,
+		BoundingBoxes: boxes,
+		Polygons:      polygons,
+		Reader:        reader,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &resp.ImageId, nil
+}
+
+type labelResolver struct {
+	ArchiveIngester
+	Request
+	resolved map[string]*string
+}
+
+func newLabelResolver(i ArchiveIngester, r Request) labelResolver {
+	return labelResolver{i, r, map[string]*string{}}
+}
+
+func (l labelResolver) resolve(s Sample) (
+	[]an.BoundingBoxRequest, []an.PolygonRequest, []string, error,
+) {
+	unknown := []string{}
+	boxes := []an.BoundingBoxRequest{}
+	for _, b := range s.BoundingBoxes {
+		name, err := l.label(b.Label)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if name == nil {
+			unknown = appendUnique(unknown, b.Label)
+			continue
+		}
+		b.Label = *name
+		boxes = append(boxes, b)
+	}
+	polygons := []an.PolygonRequest{}
+	for _, p := range s.Polygons {
+		name, err := l.label(p.Label)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if name == nil {
+			unknown = appendUnique(unknown, p.Label)
+			continue
+		}
+		p.Label = *name
+		polygons = append(polygons, p)
+	}
+	return boxes, polygons, unknown, nil
+}
+
+func (l labelResolver) label(source string) (*string, error) {
+	if name, ok := l.resolved[source]; ok {
+		return name, nil
+	}
+
+	target := source
+	if mapped, ok := l.LabelMap[source]; ok {
+		target = mapped
+	}
+	name, err := l.LabelRepo.Canonical(target)
+	if err != nil {
+		return nil, err
+	}
+	if name == nil && l.CreateMissingLabels {
+		if err := l.Validator.Validate(target); err == nil {
+			if err := l.LabelRepo.Create(lbl.NewLabel(lbl.NewLabelId(), target)); err != nil {
+				return nil, fmt.Errorf("creating label %v: %w", target, err)
+			}
+			name = &target
+		}
+	}
+
+	l.resolved[source] = name
+	return name, nil
+}
+
+func appendUnique(s []string, v string) []string {
+	if slices.Contains(s, v) {
+		return s
+	}
+	return append(s, v)
+}
@@ -10,11 +10,20 @@ import (
 type Response struct {
 	ImageIds   []im.ImageId
 	Collection string
+	Format     Format
+	Skipped    []SkippedFile
 }
 
 type Request struct {
-	UserId     u.UserId
-	Collection string
-	ReaderAt   io.ReaderAt
-	Size       int64
+	UserId              u.UserId
+	Collection          string
+	ReaderAt            io.ReaderAt
+	Size                int64
+	CreateMissingLabels bool
+	LabelMap            map[string]string
+}
+
+type SkippedFile struct {
+	Name   string
+	Reason string
 }
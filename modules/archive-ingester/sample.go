@@ -0,0 +1,60 @@
+package ingester
+
+import (
+	"archive/zip"
+	"path"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Sample struct {
+	File          *zip.File
+	BoundingBoxes []an.BoundingBoxRequest
+	Polygons      []an.PolygonRequest
+}
+
+type imageIndex struct {
+	files  []*zip.File
+	byPath map[string]*zip.File
+	byBase map[string]*zip.File
+	byStem map[string]*zip.File
+}
+
+func newImageIndex(zr *zip.Reader) imageIndex {
+	idx := imageIndex{
+		byPath: map[string]*zip.File{},
+		byBase: map[string]*zip.File{},
+		byStem: map[string]*zip.File{},
+	}
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() || isAnnotationFile(f.Name) {
+			continue
+		}
+		idx.files = append(idx.files, f)
+		idx.byPath[f.Name] = f
+		if _, ok := idx.byBase[path.Base(f.Name)]; !ok {
+			idx.byBase[path.Base(f.Name)] = f
+		}
+		if _, ok := idx.byStem[stem(f.Name)]; !ok {
+			idx.byStem[stem(f.Name)] = f
+		}
+	}
+	return idx
+}
+
+func (idx imageIndex) find(name string) (*zip.File, bool) {
+	if f, ok := idx.byPath[name]; ok {
+		return f, true
+	}
+	f, ok := idx.byBase[path.Base(name)]
+	return f, ok
+}
+
+type samples map[*zip.File]*Sample
+
+func (s samples) get(f *zip.File) *Sample {
+	if _, ok := s[f]; !ok {
+		s[f] = &Sample{File: f}
+	}
+	return s[f]
+}
@@ -7,11 +7,13 @@ import (
 type FakeImageIngester struct {
 	Err    error
 	Return ii.Response
+	Got    []ii.Request
 }
 
 func (i *FakeImageIngester) Ingest(r ii.Request) (*ii.Response, error) {
 	if i.Err != nil {
 		return nil, i.Err
 	}
+	i.Got = append(i.Got, r)
 	return &i.Return, nil
 }
@@ -0,0 +1,72 @@
+package ingester
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type vocAnnotation struct {
+	Filename string `xml:"filename"`
+	Objects  []struct {
+		Name   string `xml:"name"`
+		BndBox struct {
+			XMin float32 `xml:"xmin"`
+			YMin float32 `xml:"ymin"`
+			XMax float32 `xml:"xmax"`
+			YMax float32 `xml:"ymax"`
+		} `xml:"bndbox"`
+	} `xml:"object"`
+}
+
+func parseVOC(zr *zip.Reader, idx imageIndex) (samples, []SkippedFile, error) {
+	result := samples{}
+	skipped := []SkippedFile{}
+	for _, f := range zr.File {
+		if ext(f.Name) != ".xml" {
+			continue
+		}
+		var a vocAnnotation
+		if err := decodeXML(f, &a); err != nil {
+			skipped = append(skipped, SkippedFile{
+				Name: f.Name, Reason: fmt.Sprintf("decoding VOC annotation: %v", err),
+			})
+			continue
+		}
+
+		file, ok := idx.find(a.Filename)
+		if !ok {
+			file, ok = idx.byStem[stem(f.Name)]
+		}
+		if !ok {
+			skipped = append(skipped, SkippedFile{
+				Name: f.Name, Reason: "no matching image in archive",
+			})
+			continue
+		}
+
+		sample := result.get(file)
+		for _, o := range a.Objects {
+			b := o.BndBox
+			sample.BoundingBoxes = append(sample.BoundingBoxes, an.BoundingBoxRequest{
+				Label:  o.Name,
+				Xc:     (b.XMin + b.XMax) / 2,
+				Yc:     (b.YMin + b.YMax) / 2,
+				Width:  b.XMax - b.XMin,
+				Height: b.YMax - b.YMin,
+			})
+		}
+	}
+	return result, skipped, nil
+}
+
+func decodeXML(f *zip.File, v any) error {
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return xml.NewDecoder(r).Decode(v)
+}
@@ -0,0 +1,171 @@
+package ingester
+
+import (
+	"archive/zip"
+	"bufio"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"gopkg.in/yaml.v3"
+)
+
+func parseYOLO(zr *zip.Reader, idx imageIndex) (samples, []SkippedFile, error) {
+	classes, err := yoloClasses(zr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := samples{}
+	skipped := []SkippedFile{}
+	for _, f := range zr.File {
+		if ext(f.Name) != ".txt" || slices.Contains(yoloClassFiles, path.Base(f.Name)) {
+			continue
+		}
+		file, ok := idx.byStem[stem(f.Name)]
+		if !ok {
+			skipped = append(skipped, SkippedFile{
+				Name: f.Name, Reason: "no matching image in archive",
+			})
+			continue
+		}
+		width, height, err := imageSize(file)
+		if err != nil {
+			skipped = append(skipped, SkippedFile{
+				Name: file.Name, Reason: fmt.Sprintf("reading image size: %v", err),
+			})
+			continue
+		}
+
+		sample := result.get(file)
+		err = readLines(f, func(n int, fields []string) {
+			reason := addYOLOAnnotation(sample, fields, classes, float32(width), float32(height))
+			if reason != "" {
+				skipped = append(skipped, SkippedFile{
+					Name: f.Name, Reason: fmt.Sprintf("line %v: %v", n, reason),
+				})
+			}
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading YOLO file %v: %w", f.Name, err)
+		}
+	}
+	return result, skipped, nil
+}
+
+func addYOLOAnnotation(s *Sample, fields []string, classes []string, w, h float32) string {
+	if len(fields) < 5 || len(fields)%2 == 0 {
+		return fmt.Sprintf("expected a class followed by a box or polygon, got %v values", len(fields))
+	}
+	class, err := strconv.Atoi(fields[0])
+	if err != nil || class < 0 || class >= len(classes) {
+		return fmt.Sprintf("unknown class %q", fields[0])
+	}
+	values := make([]float32, 0, len(fields)-1)
+	for _, f := range fields[1:] {
+		v, err := strconv.ParseFloat(f, 32)
+		if err != nil {
+			return fmt.Sprintf("invalid coordinate %q", f)
+		}
+		values = append(values, float32(v))
+	}
+
+	label := classes[class]
+	if len(values) == 4 {
+		s.BoundingBoxes = append(s.BoundingBoxes, an.BoundingBoxRequest{
+			Label: label, Xc: values[0] * w, Yc: values[1] * h,
+			Width: values[2] * w, Height: values[3] * h,
+		})
+		return ""
+	}
+	points := an.Points{}
+	for i := 0; i < len(values); i += 2 {
+		points.Coordinates = append(points.Coordinates, [2]float32{values[i] * w, values[i+1] * h})
+	}
+	s.Polygons = append(s.Polygons, an.PolygonRequest{Label: label, Points: points})
+	return ""
+}
+
+func yoloClasses(zr *zip.Reader) ([]string, error) {
+	for _, f := range zr.File {
+		switch path.Base(f.Name) {
+		case "classes.txt", "obj.names":
+			classes := []string{}
+			err := readLines(f, func(_ int, fields []string) {
+				classes = append(classes, strings.Join(fields, " "))
+			})
+			return classes, err
+		case "data.yaml", "dataset.yaml":
+			return yoloYAMLClasses(f)
+		}
+	}
+	return nil, fmt.Errorf("missing YOLO class names file: %w", e.ErrValidation)
+}
+
+func yoloYAMLClasses(f *zip.File) ([]string, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var data struct {
+		Names yaml.Node `yaml:"names"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("decoding %v: %w: %w", f.Name, err, e.ErrValidation)
+	}
+	var names []string
+	if err := data.Names.Decode(&names); err == nil {
+		return names, nil
+	}
+	var indexed map[int]string
+	if err := data.Names.Decode(&indexed); err != nil {
+		return nil, fmt.Errorf("decoding class names of %v: %w: %w", f.Name, err, e.ErrValidation)
+	}
+	names = make([]string, len(indexed))
+	for i, name := range indexed {
+		if i < 0 || i >= len(names) {
+			return nil, fmt.Errorf("class index %v out of range in %v: %w", i, f.Name, e.ErrValidation)
+		}
+		names[i] = name
+	}
+	return names, nil
+}
+
+func readLines(f *zip.File, fn func(int, []string)) error {
+	r, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			fn(n, fields)
+		}
+	}
+	return scanner.Err()
+}
+
+func imageSize(f *zip.File) (int, int, error) {
+	r, err := f.Open()
+	if err != nil {
+		return 0, 0, err
+	}
+	defer r.Close()
+	cfg, _, err := image.DecodeConfig(r)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cfg.Width, cfg.Height, nil
+}
@@ -0,0 +1,190 @@
+package attributes
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const (
+	MaxTextLength = 1000
+	MaxOptions    = 100
+)
+
+// Names are restricted so that attributes can be referred to in queries,
+// such as attr.occluded:true.
+var validName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,29}$`)
+
+// Definitions checks the attribute definitions of a label, trimming names
+// and options, and converting defaults to the type of their attribute.
+func Definitions(defs []lbl.Attribute) ([]lbl.Attribute, error) {
+	result := []lbl.Attribute{}
+	for _, def := range defs {
+		def.Name = strings.TrimSpace(def.Name)
+		errCtx := fmt.Errorf("checking definition of attribute %q", def.Name)
+		if !validName.MatchString(def.Name) {
+			return nil, fmt.Errorf("%w: expected a name of letters, digits and underscores: %w",
+				errCtx, e.ErrValidation)
+		}
+		if slices.ContainsFunc(result, func(d lbl.Attribute) bool { return d.Name == def.Name }) {
+			return nil, fmt.Errorf("%w: %w", errCtx, e.ErrDuplicate)
+		}
+		if !def.Type.IsValid() {
+			return nil, fmt.Errorf("%w: unknown type %q: %w", errCtx, def.Type, e.ErrValidation)
+		}
+		options, err := checkOptions(def)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		def.Options = options
+		if def.Default != nil {
+			value, err := conform(def, def.Default)
+			if err != nil {
+				return nil, fmt.Errorf("%w: default: %w", errCtx, err)
+			}
+			def.Default = value
+		}
+		result = append(result, def)
+	}
+	return result, nil
+}
+
+func checkOptions(def lbl.Attribute) ([]string, error) {
+	if def.Type != lbl.EnumAttribute {
+		if len(def.Options) > 0 {
+			return nil, fmt.Errorf("options are reserved to enum attributes: %w", e.ErrValidation)
+		}
+		return nil, nil
+	}
+	options := []string{}
+	for _, o := range def.Options {
+		o = strings.TrimSpace(o)
+		if o != "" && !slices.Contains(options, o) {
+			options = append(options, o)
+		}
+	}
+	if len(options) == 0 || len(options) > MaxOptions {
+		return nil, fmt.Errorf("expected between 1 and %v options: %w", MaxOptions, e.ErrValidation)
+	}
+	return options, nil
+}
+
+// Validate checks values against the attributes of a label. Missing values
+// take the default of their attribute, if any. Values of attributes the
+// label does not define are rejected. Required attributes may still lack a
+// value, as regions are drawn before their attributes are filled in, which
+// Complete checks.
+func Validate(defs []lbl.Attribute, values a.Attributes) (a.Attributes, error) {
+	for name := range values {
+		if !slices.ContainsFunc(defs, func(d lbl.Attribute) bool { return d.Name == name }) {
+			return nil, fmt.Errorf("checking attribute %q: not defined by label: %w", name, e.ErrValidation)
+		}
+	}
+	result := a.Attributes{}
+	for _, def := range defs {
+		errCtx := fmt.Errorf("checking attribute %q", def.Name)
+		value, ok := values[def.Name]
+		if !ok || value == nil || value == "" {
+			value = def.Default
+		}
+		if value == nil {
+			continue
+		}
+		value, err := conform(def, value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		result[def.Name] = value
+	}
+	return result, nil
+}
+
+// Complete checks that values hold a value for every required attribute.
+func Complete(defs []lbl.Attribute, values a.Attributes) error {
+	for _, def := range defs {
+		if value, ok := values[def.Name]; def.Required && (!ok || value == nil || value == "") {
+			return fmt.Errorf("checking attribute %q: missing required value: %w", def.Name, e.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// Retain drops the values of attributes that defs do not define, as when a
+// region changes label.
+func Retain(defs []lbl.Attribute, values a.Attributes) a.Attributes {
+	result := a.Attributes{}
+	for _, def := range defs {
+		if value, ok := values[def.Name]; ok {
+			result[def.Name] = value
+		}
+	}
+	return result
+}
+
+// Parse reads the value of an attribute from text, as submitted by forms.
+// Empty text is no value.
+func Parse(def lbl.Attribute, s string) (any, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	switch def.Type {
+	case lbl.BoolAttribute:
+		if s == "on" {
+			return true, nil
+		}
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as bool: %w", s, e.ErrValidation)
+		}
+		return v, nil
+	case lbl.NumberAttribute:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as number: %w", s, e.ErrValidation)
+		}
+		return v, nil
+	}
+	return s, nil
+}
+
+func conform(def lbl.Attribute, value any) (any, error) {
+	switch def.Type {
+	case lbl.BoolAttribute:
+		if v, ok := value.(bool); ok {
+			return v, nil
+		}
+	case lbl.NumberAttribute:
+		switch v := value.(type) {
+		case float64:
+			return v, nil
+		case float32:
+			return float64(v), nil
+		case int:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		}
+	case lbl.TextAttribute:
+		if v, ok := value.(string); ok {
+			if len(v) > MaxTextLength {
+				return nil, fmt.Errorf("longer than %v characters: %w", MaxTextLength, e.ErrValidation)
+			}
+			return v, nil
+		}
+	case lbl.EnumAttribute:
+		if v, ok := value.(string); ok {
+			if !slices.Contains(def.Options, v) {
+				return nil, fmt.Errorf("%q is not one of %v: %w", v, def.Options, e.ErrValidation)
+			}
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("expected a value of type %v, got %v: %w", def.Type, value, e.ErrValidation)
+}
@@ -0,0 +1,105 @@
+package attributes
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var vehicleAttributes = []lbl.Attribute{
+	{Name: "occluded", Type: lbl.BoolAttribute, Default: false},
+	{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+	{Name: "speed", Type: lbl.NumberAttribute},
+	{Name: "plate", Type: lbl.TextAttribute, Required: true},
+}
+
+func TestDefinitionsAreNormalized(t *testing.T) {
+	defs, err := Definitions([]lbl.Attribute{
+		{Name: " color ", Type: lbl.EnumAttribute, Options: []string{" red", "blue", "red", ""}},
+		{Name: "speed", Type: lbl.NumberAttribute, Default: 3},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "color", defs[0].Name)
+	assert.Equal(t, []string{"red", "blue"}, defs[0].Options)
+	assert.Equal(t, float64(3), defs[1].Default)
+}
+
+func TestInvalidDefinitions(t *testing.T) {
+	tests := []struct {
+		name string
+		defs []lbl.Attribute
+		err  error
+	}{
+		{"invalid name", []lbl.Attribute{{Name: "is occluded", Type: lbl.BoolAttribute}}, e.ErrValidation},
+		{"unknown type", []lbl.Attribute{{Name: "occluded", Type: "date"}}, e.ErrValidation},
+		{"duplicate", []lbl.Attribute{{Name: "occluded", Type: lbl.BoolAttribute},
+			{Name: "occluded", Type: lbl.TextAttribute}}, e.ErrDuplicate},
+		{"enum without options", []lbl.Attribute{{Name: "color", Type: lbl.EnumAttribute}}, e.ErrValidation},
+		{"options of text", []lbl.Attribute{{Name: "plate", Type: lbl.TextAttribute,
+			Options: []string{"a"}}}, e.ErrValidation},
+		{"default of wrong type", []lbl.Attribute{{Name: "occluded", Type: lbl.BoolAttribute,
+			Default: "yes"}}, e.ErrValidation},
+		{"default out of options", []lbl.Attribute{{Name: "color", Type: lbl.EnumAttribute,
+			Options: []string{"red"}, Default: "green"}}, e.ErrValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Definitions(tt.defs)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestValidateAppliesDefaults(t *testing.T) {
+	values, err := Validate(vehicleAttributes, a.Attributes{"plate": "GE 1234", "speed": 30})
+	assert.NoError(t, err)
+	assert.Equal(t, a.Attributes{"occluded": false, "plate": "GE 1234", "speed": float64(30)}, values)
+}
+
+func TestInvalidValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		values a.Attributes
+	}{
+		{"undefined", a.Attributes{"plate": "GE 1234", "truncated": true}},
+		{"wrong type", a.Attributes{"plate": "GE 1234", "occluded": "yes"}},
+		{"out of options", a.Attributes{"plate": "GE 1234", "color": "green"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Validate(vehicleAttributes, tt.values)
+			assert.ErrorIs(t, err, e.ErrValidation)
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	assert.NoError(t, Complete(vehicleAttributes, a.Attributes{"plate": "GE 1234"}))
+	assert.ErrorIs(t, Complete(vehicleAttributes, a.Attributes{"occluded": true}), e.ErrValidation)
+	assert.ErrorIs(t, Complete(vehicleAttributes, a.Attributes{"plate": ""}), e.ErrValidation)
+}
+
+func TestRetainDropsUndefinedValues(t *testing.T) {
+	values := Retain(vehicleAttributes, a.Attributes{"plate": "GE 1234", "truncated": true})
+	assert.Equal(t, a.Attributes{"plate": "GE 1234"}, values)
+}
+
+func TestParse(t *testing.T) {
+	v, err := Parse(vehicleAttributes[0], "on")
+	assert.NoError(t, err)
+	assert.Equal(t, true, v)
+
+	v, err = Parse(vehicleAttributes[2], "12.5")
+	assert.NoError(t, err)
+	assert.Equal(t, 12.5, v)
+
+	v, err = Parse(vehicleAttributes[3], " ")
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	_, err = Parse(vehicleAttributes[2], "fast")
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
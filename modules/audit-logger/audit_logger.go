@@ -0,0 +1,87 @@
+package audit_logger
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	AddEntry(au.Entry) error
+}
+
+// AuditLogger writes entries of the audit log. Its zero value discards
+// them.
+type AuditLogger struct {
+	Repo
+	clockwork.Clock
+	Logger slog.Logger
+}
+
+// Record is an operation being carried out, which is written to the audit
+// log once it ends.
+type Record struct {
+	logger AuditLogger
+	Entry  au.Entry
+	err    error
+}
+
+// Begin starts recording action on target on behalf of the user of ctx.
+func (l AuditLogger) Begin(ctx context.Context, action string, target au.Target) *Record {
+	entry := au.Entry{
+		Action:    action,
+		Target:    target,
+		RequestId: au.RequestIdFromContext(ctx),
+	}
+	if user := u.IdentityFromContext(ctx); user != nil {
+		entry.Actor = user.Id
+	}
+	return &Record{logger: l, Entry: entry}
+}
+
+// Fail records that the operation failed with err, which it returns so that
+// use-cases can pass it on to their output: out.Error(record.Fail(err)).
+func (r *Record) Fail(err error) error {
+	r.err = err
+	return err
+}
+
+// End writes the entry along with the outcome of the operation. Failing to
+// do so is logged, but does not affect the operation.
+func (r *Record) End() {
+	if r.logger.Repo == nil {
+		return
+	}
+	r.Entry.Time = r.logger.Clock.Now()
+	r.Entry.Outcome = au.OutcomeOf(r.err)
+	if r.err != nil {
+		r.Entry.Error = r.err.Error()
+	}
+	if err := r.logger.Repo.AddEntry(r.Entry); err != nil {
+		r.logger.Logger.Error("writing audit log entry",
+			"action", r.Entry.Action, "actor", r.Entry.Actor, "error", err.Error())
+	}
+}
+
+type Option func(*AuditLogger)
+
+func WithClock(c clockwork.Clock) Option {
+	return func(l *AuditLogger) {
+		l.Clock = c
+	}
+}
+
+func New(r Repo, logger slog.Logger, opts ...Option) AuditLogger {
+	l := &AuditLogger{
+		Repo:   r,
+		Clock:  clockwork.NewRealClock(),
+		Logger: logger,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return *l
+}
@@ -0,0 +1,67 @@
+package audit_logger
+
+import (
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordSuccess(t *testing.T) {
+	repo := &fk.AuditRepo{}
+	now := time.Now()
+	logger := New(repo, *slog.Default(), WithClock(clockwork.NewFakeClockAt(now)))
+	ctx := au.AppendRequestIdToContext(st.CreateCtxWithUserId(t.Context(), "user@mail.com"), "a-request")
+
+	record := logger.Begin(ctx, "DeleteLabel", au.Target{Type: "label", Id: "cat"})
+	record.End()
+
+	assert.Equal(t, []au.Entry{{
+		Actor:     "user@mail.com",
+		Action:    "DeleteLabel",
+		Target:    au.Target{Type: "label", Id: "cat"},
+		Time:      now,
+		RequestId: "a-request",
+		Outcome:   au.Success,
+	}}, repo.Entries)
+}
+
+func TestRecordOutcomeOfFailure(t *testing.T) {
+	repo := &fk.AuditRepo{}
+	logger := New(repo, *slog.Default())
+
+	record := logger.Begin(t.Context(), "SetPolicies", au.Target{})
+	record.Fail(fmt.Errorf("setting policies: %w", e.ErrAuthorization))
+	record.End()
+	record = logger.Begin(t.Context(), "DeleteImage", au.Target{})
+	record.Fail(e.ErrNotFound)
+	record.End()
+
+	assert.Equal(t, au.Denied, repo.Entries[0].Outcome)
+	assert.Equal(t, "setting policies: authorization error", repo.Entries[0].Error)
+	assert.Equal(t, "", repo.Entries[0].Actor)
+	assert.Equal(t, au.Failure, repo.Entries[1].Outcome)
+}
+
+func TestFailReturnsError(t *testing.T) {
+	record := AuditLogger{}.Begin(t.Context(), "DeleteLabel", au.Target{})
+	err := fmt.Errorf("deleting label: %w", e.ErrNotFound)
+	assert.Equal(t, err, record.Fail(err))
+}
+
+func TestZeroValueDiscardsEntries(t *testing.T) {
+	record := AuditLogger{}.Begin(t.Context(), "DeleteLabel", au.Target{})
+	record.End()
+}
+
+func TestFailingToWriteDoesNotPanic(t *testing.T) {
+	logger := New(&fk.AuditRepo{ErrOnAdd: e.ErrInternal}, *slog.Default())
+	logger.Begin(t.Context(), "DeleteLabel", au.Target{}).End()
+}
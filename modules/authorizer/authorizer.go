@@ -106,10 +106,18 @@ func (a Authorizer) Annotate(ctx context.Context, group string) error {
 	return a.check(ctx, "Annotate", &group)
 }
 
+func (a Authorizer) Review(ctx context.Context, group string) error {
+	return a.check(ctx, "Review", &group)
+}
+
 func (a Authorizer) DeleteImage(ctx context.Context, group string) error {
 	return a.check(ctx, "DeleteImage", &group)
 }
 
+func (a Authorizer) ReadImage(ctx context.Context, group string) error {
+	return a.check(ctx, "ReadImage", &group)
+}
+
 func (a Authorizer) ImportImage(ctx context.Context, group string) error {
 	return a.check(ctx, "ImportImage", &group)
 }
@@ -118,6 +126,10 @@ func (a Authorizer) IngestImage(ctx context.Context, group string) error {
 	return a.check(ctx, "IngestImage", &group)
 }
 
+func (a Authorizer) ImportPredictions(ctx context.Context, group string) error {
+	return a.check(ctx, "ImportPredictions", &group)
+}
+
 func (a Authorizer) CreateUser(ctx context.Context) error {
 	return a.check(ctx, "CreateUser", nil)
 }
@@ -162,6 +174,10 @@ func (a Authorizer) CloneCollection(ctx context.Context, group string) error {
 	return a.check(ctx, "CloneCollection", nil)
 }
 
+func (a Authorizer) ExportCollection(ctx context.Context, group string) error {
+	return a.check(ctx, "ExportCollection", &group)
+}
+
 func (a Authorizer) UpdateUserPrivileges(ctx context.Context) error {
 	return a.check(ctx, "UpdateUserPrivileges", nil)
 }
@@ -185,3 +201,11 @@ func (a Authorizer) DeleteMetadata(ctx context.Context, group string) error {
 func (a Authorizer) UpdateMetadata(ctx context.Context, group string) error {
 	return a.check(ctx, "UpdateMetadata", nil)
 }
+
+func (a Authorizer) AssignImages(ctx context.Context) error {
+	return a.check(ctx, "AssignImages", nil)
+}
+
+func (a Authorizer) ReadAuditLog(ctx context.Context) error {
+	return a.check(ctx, "ReadAuditLog", nil)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -106,3 +107,12 @@ func TestAdminDoesNotNeedRoleNorGroup(t *testing.T) {
 	err := auth.Annotate(ctx, "a-group-i-am-not-member-of")
 	assert.NoError(t, err)
 }
+
+func TestAnnotatorReadsImagesOfItsGroupsOnly(t *testing.T) {
+	auth := NewDefault()
+	ctx := u.AppendUserToContext(t.Context(),
+		u.NewUser("annotator@example.com", u.WithRoles([]string{"annotator"}),
+			u.WithGroups([]string{"my-group"})))
+	assert.NoError(t, auth.ReadImage(ctx, "my-group"))
+	assert.ErrorIs(t, auth.ReadImage(ctx, "another-group"), e.ErrAuthorization)
+}
@@ -15,9 +15,12 @@ type Interface interface {
 	DeleteLabel(ctx context.Context) error
 	UpdateLabel(ctx context.Context) error
 	Annotate(ctx context.Context, group string) error
+	Review(ctx context.Context, group string) error
 	DeleteImage(ctx context.Context, group string) error
+	ReadImage(ctx context.Context, group string) error
 	ImportImage(ctx context.Context, group string) error
 	IngestImage(ctx context.Context, group string) error
+	ImportPredictions(ctx context.Context, group string) error
 	CreateUser(ctx context.Context) error
 	DeleteUser(ctx context.Context) error
 	ListUsers(ctx context.Context) error
@@ -29,10 +32,13 @@ type Interface interface {
 	DeleteRole(ctx context.Context) error
 	UpdateRole(ctx context.Context) error
 	CloneCollection(ctx context.Context, group string) error
+	ExportCollection(ctx context.Context, group string) error
 	UpdateUserPrivileges(ctx context.Context) error
 	AddMetadata(ctx context.Context, group string) error
 	UpdateMetadata(ctx context.Context, group string) error
 	DeleteMetadata(ctx context.Context, group string) error
 	ReadPolicies(ctx context.Context) error
 	SetPolicies(ctx context.Context) error
+	AssignImages(ctx context.Context) error
+	ReadAuditLog(ctx context.Context) error
 }
@@ -31,12 +31,16 @@ func (p Policies) Validate() error {
 var DefaultPolicyFileName = "policies.yaml"
 
 var DefaultPolicies = Policies{
-	"annotator": {"Annotate"},
+	"annotator": {"Annotate", "ReadImage"},
+	"reviewer":  {"Review", "ReadImage"},
 	"image-contributor": {
+		"ReadImage",
 		"IngestImage",
 		"ImportImage",
+		"ImportPredictions",
 		"CreateCollection",
 		"CloneCollection",
+		"ExportCollection",
 		"DeleteCollection",
 	},
 	"admin": {"*"},
@@ -5,6 +5,7 @@ package authorizer
 var ValidMethods = []string{
 	"AddMetadata",
 	"Annotate",
+	"AssignImages",
 	"CloneCollection",
 	"CreateCollection",
 	"CreateGroup",
@@ -18,11 +19,16 @@ var ValidMethods = []string{
 	"DeleteMetadata",
 	"DeleteRole",
 	"DeleteUser",
+	"ExportCollection",
 	"FindUser",
 	"ImportImage",
+	"ImportPredictions",
 	"IngestImage",
 	"ListUsers",
+	"ReadAuditLog",
+	"ReadImage",
 	"ReadPolicies",
+	"Review",
 	"SetPolicies",
 	"UpdateCollection",
 	"UpdateGroup",
@@ -24,6 +24,10 @@ func (a VoidAuthorizer) UpdateCollection(ctx context.Context, group string) erro
 	return nil
 }
 
+func (a VoidAuthorizer) ReadImage(ctx context.Context, group string) error {
+	return nil
+}
+
 func (a VoidAuthorizer) CreateLabel(ctx context.Context) error {
 	return nil
 }
@@ -40,6 +44,10 @@ func (a VoidAuthorizer) Annotate(ctx context.Context, group string) error {
 	return nil
 }
 
+func (a VoidAuthorizer) Review(ctx context.Context, group string) error {
+	return nil
+}
+
 func (a VoidAuthorizer) DeleteImage(ctx context.Context, group string) error {
 	return nil
 }
@@ -52,6 +60,10 @@ func (a VoidAuthorizer) IngestImage(ctx context.Context, group string) error {
 	return nil
 }
 
+func (a VoidAuthorizer) ImportPredictions(ctx context.Context, group string) error {
+	return nil
+}
+
 func (a VoidAuthorizer) CreateUser(ctx context.Context) error {
 	return nil
 }
@@ -84,6 +96,14 @@ func (a VoidAuthorizer) FindUser(ctx context.Context) error {
 	return nil
 }
 
+func (a VoidAuthorizer) AssignImages(ctx context.Context) error {
+	return nil
+}
+
+func (a VoidAuthorizer) ReadAuditLog(ctx context.Context) error {
+	return nil
+}
+
 func (a VoidAuthorizer) CreateGroup(ctx context.Context) error {
 	return nil
 }
@@ -112,6 +132,10 @@ func (a VoidAuthorizer) CloneCollection(ctx context.Context, group string) error
 	return nil
 }
 
+func (a VoidAuthorizer) ExportCollection(ctx context.Context, group string) error {
+	return nil
+}
+
 func (a VoidAuthorizer) AddMetadata(ctx context.Context, group string) error {
 	return nil
 }
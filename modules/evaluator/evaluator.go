@@ -0,0 +1,103 @@
+package evaluator
+
+import (
+	"fmt"
+	"iter"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
+)
+
+type ImageRepo interface {
+	Iterate(im.FilterStr, int) iter.Seq2[im.BaseImage, error]
+}
+
+type AnnotationRepo interface {
+	FindBoundingBoxes(im.ImageId, clc.CollectionName) ([]an.BoundingBox, error)
+	FindPolygons(im.ImageId, clc.CollectionName) ([]an.Polygon, error)
+}
+
+type Request struct {
+	GroundTruth   string
+	Predictions   string
+	Filter        im.FilterStr
+	MinConfidence float32
+}
+
+type Evaluator struct {
+	ImageRepo
+	AnnotationRepo
+}
+
+func New(ir ImageRepo, ar AnnotationRepo) Evaluator {
+	return Evaluator{ir, ar}
+}
+
+// Evaluate scores the boxes and polygons of a collection of predictions
+// against those of a collection of ground truth, on the images that match
+// the filter in both collections.
+func (x Evaluator) Evaluate(r Request) (*ev.Report, error) {
+	errCtx := fmt.Errorf("evaluating collection %v against %v", r.Predictions, r.GroundTruth)
+	images, err := x.shared(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+	truths, predictions := []Region{}, []Region{}
+	for _, image := range images {
+		regions, err := x.regions(image, r.GroundTruth)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		truths = append(truths, regions...)
+		if regions, err = x.regions(image, r.Predictions); err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		predictions = append(predictions, regions...)
+	}
+	report := Compute(truths, predictions, r.MinConfidence)
+	report.NumImages = len(images)
+	return &report, nil
+}
+
+// shared fetches the images that match the filter in both collections.
+func (x Evaluator) shared(r Request) ([]im.ImageId, error) {
+	predicted := map[im.ImageId]bool{}
+	for image, err := range x.ImageRepo.Iterate(qu.CollectionFilter(r.Predictions, r.Filter), 100) {
+		if err != nil {
+			return nil, fmt.Errorf("iterating images of collection %v: %w", r.Predictions, err)
+		}
+		predicted[image.ImageId] = true
+	}
+	images := []im.ImageId{}
+	for image, err := range x.ImageRepo.Iterate(qu.CollectionFilter(r.GroundTruth, r.Filter), 100) {
+		if err != nil {
+			return nil, fmt.Errorf("iterating images of collection %v: %w", r.GroundTruth, err)
+		}
+		if predicted[image.ImageId] {
+			images = append(images, image.ImageId)
+		}
+	}
+	return images, nil
+}
+
+func (x Evaluator) regions(image im.ImageId, collection string) ([]Region, error) {
+	boxes, err := x.AnnotationRepo.FindBoundingBoxes(image, collection)
+	if err != nil {
+		return nil, fmt.Errorf("fetching bounding boxes of image %v in collection %v: %w", image, collection, err)
+	}
+	polygons, err := x.AnnotationRepo.FindPolygons(image, collection)
+	if err != nil {
+		return nil, fmt.Errorf("fetching polygons of image %v in collection %v: %w", image, collection, err)
+	}
+	regions := []Region{}
+	for _, b := range boxes {
+		regions = append(regions, BoxRegion(image, b))
+	}
+	for _, p := range polygons {
+		regions = append(regions, PolygonRegion(image, p))
+	}
+	return regions, nil
+}
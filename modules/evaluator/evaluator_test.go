@@ -0,0 +1,219 @@
+package evaluator
+
+import (
+	"math"
+	"testing"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func box(image im.ImageId, label string, xc, yc, w, h float32, confidence float32) Region {
+	b := an.NewBoundingBox(an.NewAnnotationId(), xc, yc, w, h, lbl.Label{Name: label})
+	if confidence > 0 {
+		b.Source = an.Source{Model: "a-model", Confidence: confidence}
+	}
+	return BoxRegion(image, b)
+}
+
+func square(x, y, side float32) an.Points {
+	return an.Points{Coordinates: [][2]float32{{x, y}, {x + side, y}, {x + side, y + side}, {x, y + side}}}
+}
+
+func TestIoUOfBoxes(t *testing.T) {
+	image := im.NewImageId()
+	a := box(image, "a", 1, 1, 2, 2, 0)
+	b := box(image, "a", 2, 1, 2, 2, 0)
+	assert.InDelta(t, 1.0/3, iou(a.shape, b.shape), 1e-9)
+	assert.InDelta(t, 1.0, iou(a.shape, a.shape), 1e-9)
+	assert.Equal(t, 0.0, iou(a.shape, box(image, "a", 10, 10, 2, 2, 0).shape))
+}
+
+func TestIoUOfRotatedBox(t *testing.T) {
+	b := an.NewBoundingBox(an.NewAnnotationId(), 0, 0, 2, 2, lbl.Label{}, an.WithAngle(math.Pi/4))
+	rotated := boxShape(b)
+	square := boxShape(an.NewBoundingBox(an.NewAnnotationId(), 0, 0, 2, 2, lbl.Label{}))
+	// the overlap of a square with itself rotated by 45 degrees is a regular
+	// octagon
+	octagon := 8 * (math.Sqrt2 - 1)
+	assert.InDelta(t, octagon/(8-octagon), iou(rotated, square), 1e-6)
+}
+
+func TestIoUOfBoxAndPolygon(t *testing.T) {
+	image := im.NewImageId()
+	b := box(image, "a", 1, 1, 2, 2, 0)
+	p := PolygonRegion(image, an.NewPolygon(an.NewAnnotationId(), square(0, 0, 2), lbl.Label{}))
+	assert.InDelta(t, 1.0, iou(b.shape, p.shape), 1e-9)
+}
+
+func TestIoUOfConcavePolygons(t *testing.T) {
+	// an L-shaped polygon covering three quarters of a 2x2 square
+	l := polygonShape(an.NewPolygon(an.NewAnnotationId(), an.Points{Coordinates: [][2]float32{
+		{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}}, lbl.Label{}))
+	assert.False(t, l.convex)
+	assert.InDelta(t, 3.0, l.area, 1e-9)
+	assert.InDelta(t, 1.0, iou(l, l), 1e-9)
+	unit := polygonShape(an.NewPolygon(an.NewAnnotationId(), square(0, 0, 2), lbl.Label{}))
+	assert.InDelta(t, 0.75, iou(l, unit), 1e-9)
+}
+
+func TestPerfectPredictions(t *testing.T) {
+	image := im.NewImageId()
+	truths := []Region{box(image, "cat", 5, 5, 4, 4, 0), box(image, "dog", 20, 20, 4, 4, 0)}
+	predictions := []Region{box(image, "cat", 5, 5, 4, 4, 0.9), box(image, "dog", 20, 20, 4, 4, 0.8)}
+
+	report := Compute(truths, predictions, 0)
+	assert.InDelta(t, 1.0, report.MAP, 1e-9)
+	assert.InDelta(t, 1.0, report.MAP50, 1e-9)
+	assert.Equal(t, 2, len(report.Labels))
+	assert.Equal(t, "cat", report.Labels[0].Label)
+	assert.Equal(t, 101, len(report.Labels[0].Curve))
+	assert.Equal(t, [][]int{{1, 0, 0}, {0, 1, 0}, {0, 0, 0}}, report.Confusion.Counts)
+}
+
+func TestAPDependsOnIoUThreshold(t *testing.T) {
+	image := im.NewImageId()
+	truths := []Region{box(image, "cat", 5, 5, 10, 10, 0)}
+	// IoU of 0.6
+	predictions := []Region{box(image, "cat", 5, 5, 6, 10, 0.9)}
+
+	report := Compute(truths, predictions, 0)
+	metrics := report.Labels[0]
+	assert.InDelta(t, 1.0, metrics.AP50, 1e-9)
+	assert.InDelta(t, 0.0, metrics.AP75, 1e-9)
+	// matched at thresholds .5, .55 and .6 only
+	assert.InDelta(t, 0.3, metrics.AP, 1e-9)
+}
+
+func TestFalsePositivesLowerPrecision(t *testing.T) {
+	image := im.NewImageId()
+	truths := []Region{box(image, "cat", 5, 5, 4, 4, 0), box(image, "cat", 20, 5, 4, 4, 0)}
+	predictions := []Region{
+		box(image, "cat", 5, 5, 4, 4, 0.9),
+		box(image, "cat", 40, 40, 4, 4, 0.8),
+		box(image, "cat", 20, 5, 4, 4, 0.7),
+	}
+
+	report := Compute(truths, predictions, 0)
+	curve := report.Labels[0].Curve
+	assert.InDelta(t, 1.0, curve[50].Precision, 1e-9)
+	assert.InDelta(t, 2.0/3, curve[100].Precision, 1e-9)
+	assert.InDelta(t, (51+50*2.0/3)/101, report.Labels[0].AP50, 1e-9)
+}
+
+func TestDuplicatePredictionIsFalsePositive(t *testing.T) {
+	image := im.NewImageId()
+	truths := []Region{box(image, "cat", 5, 5, 4, 4, 0)}
+	predictions := []Region{box(image, "cat", 5, 5, 4, 4, 0.9), box(image, "cat", 5, 5, 4, 4, 0.8)}
+
+	report := Compute(truths, predictions, 0)
+	assert.InDelta(t, 1.0, report.Labels[0].AP50, 1e-9)
+	assert.Equal(t, [][]int{{1, 0}, {1, 0}}, report.Confusion.Counts)
+}
+
+func TestRegionsOfOtherImagesDoNotMatch(t *testing.T) {
+	truths := []Region{box(im.NewImageId(), "cat", 5, 5, 4, 4, 0)}
+	predictions := []Region{box(im.NewImageId(), "cat", 5, 5, 4, 4, 0.9)}
+
+	report := Compute(truths, predictions, 0)
+	assert.Equal(t, 0.0, report.MAP)
+}
+
+func TestConfusionMatrix(t *testing.T) {
+	image := im.NewImageId()
+	truths := []Region{
+		box(image, "cat", 5, 5, 4, 4, 0),
+		box(image, "dog", 20, 5, 4, 4, 0),
+		box(image, "dog", 40, 5, 4, 4, 0),
+	}
+	predictions := []Region{
+		box(image, "dog", 5, 5, 4, 4, 0.9),
+		box(image, "dog", 20, 5, 4, 4, 0.8),
+		box(image, "cat", 60, 60, 4, 4, 0.7),
+		box(image, "dog", 40, 5, 4, 4, 0.1),
+	}
+
+	report := Compute(truths, predictions, 0.5)
+	assert.Equal(t, []string{"cat", "dog"}, report.Confusion.Labels)
+	assert.Equal(t, [][]int{
+		{0, 1, 0},
+		{0, 1, 1},
+		{1, 0, 0},
+	}, report.Confusion.Counts)
+}
+
+func TestLabelWithoutGroundTruthIsLeftOutOfMeans(t *testing.T) {
+	image := im.NewImageId()
+	truths := []Region{box(image, "cat", 5, 5, 4, 4, 0)}
+	predictions := []Region{box(image, "cat", 5, 5, 4, 4, 0.9), box(image, "dog", 20, 20, 4, 4, 0.8)}
+
+	report := Compute(truths, predictions, 0)
+	assert.InDelta(t, 1.0, report.MAP, 1e-9)
+	assert.Equal(t, 0, report.Labels[1].NumGroundTruth)
+	assert.Equal(t, 1, report.Labels[1].NumPredictions)
+	assert.Nil(t, report.Labels[1].Curve)
+}
+
+type annotationsPerCollection map[string][]an.BoundingBox
+
+func (a annotationsPerCollection) FindBoundingBoxes(_ im.ImageId, c clc.CollectionName) ([]an.BoundingBox, error) {
+	return a[c], nil
+}
+
+func (a annotationsPerCollection) FindPolygons(im.ImageId, clc.CollectionName) ([]an.Polygon, error) {
+	return nil, nil
+}
+
+func TestEvaluateSharedImages(t *testing.T) {
+	shared, other := im.NewImageId(), im.NewImageId()
+	images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{
+		{ImageId: shared, Collection: "truth"},
+		{ImageId: shared, Collection: "predictions"},
+		{ImageId: other, Collection: "truth"},
+	}}
+	cat := lbl.Label{Name: "cat"}
+	annotations := annotationsPerCollection{
+		"truth":       {an.NewBoundingBox(an.NewAnnotationId(), 5, 5, 4, 4, cat)},
+		"predictions": {an.NewBoundingBox(an.NewAnnotationId(), 5, 5, 4, 4, cat)},
+	}
+
+	report, err := New(images, annotations).Evaluate(Request{GroundTruth: "truth", Predictions: "predictions"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.NumImages)
+	assert.Equal(t, 1, report.NumGroundTruth)
+	assert.InDelta(t, 1.0, report.MAP, 1e-9)
+}
+
+func TestEvaluateCollectionsOfAnyName(t *testing.T) {
+	cat := lbl.Label{Name: "cat"}
+	for _, predictions := range []string{"model-run-3", "2024"} {
+		for _, filter := range []im.FilterStr{"", "width>10"} {
+			shared := im.NewImageId()
+			images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{
+				{ImageId: shared, Collection: "ground-truth"},
+				{ImageId: shared, Collection: predictions},
+			}}
+			annotations := annotationsPerCollection{
+				"ground-truth": {an.NewBoundingBox(an.NewAnnotationId(), 5, 5, 4, 4, cat)},
+				predictions:    {an.NewBoundingBox(an.NewAnnotationId(), 5, 5, 4, 4, cat)},
+			}
+			report, err := New(images, annotations).Evaluate(
+				Request{GroundTruth: "ground-truth", Predictions: predictions, Filter: filter})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, report.NumImages, predictions)
+			assert.InDelta(t, 1.0, report.MAP, 1e-9)
+		}
+	}
+}
+
+func TestEvaluateWithFailingRepo(t *testing.T) {
+	images := fk.ImageRepo{IterateBaseImages: []im.BaseImage{{ImageId: im.NewImageId()}}}
+	annotations := &fk.AnnotationRepo{ErrOnFindBoundingBoxes: e.ErrInternal}
+	_, err := New(images, annotations).Evaluate(Request{GroundTruth: "truth", Predictions: "predictions"})
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
@@ -0,0 +1,172 @@
+package evaluator
+
+import (
+	"math"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type vec [2]float64
+
+func (a vec) sub(b vec) vec {
+	return vec{a[0] - b[0], a[1] - b[1]}
+}
+
+func cross(a, b vec) float64 {
+	return a[0]*b[1] - a[1]*b[0]
+}
+
+// shape is the outline of a region along with what overlap computations
+// need of it.
+type shape struct {
+	points     []vec
+	area       float64
+	convex     bool
+	minX, minY float64
+	maxX, maxY float64
+}
+
+func newShape(points []vec) shape {
+	s := shape{minX: math.Inf(1), minY: math.Inf(1), maxX: math.Inf(-1), maxY: math.Inf(-1)}
+	if signedArea(points) < 0 {
+		reversed := make([]vec, len(points))
+		for i, p := range points {
+			reversed[len(points)-1-i] = p
+		}
+		points = reversed
+	}
+	s.points = points
+	s.area = signedArea(points)
+	s.convex = isConvex(points)
+	for _, p := range points {
+		s.minX, s.maxX = math.Min(s.minX, p[0]), math.Max(s.maxX, p[0])
+		s.minY, s.maxY = math.Min(s.minY, p[1]), math.Max(s.maxY, p[1])
+	}
+	return s
+}
+
+// boxShape outlines a bounding box, rotated by its angle in radians around
+// its center.
+func boxShape(b an.BoundingBox) shape {
+	sin, cos := math.Sincos(float64(b.Angle))
+	w, h := float64(b.Width)/2, float64(b.Height)/2
+	points := []vec{}
+	for _, c := range []vec{{-w, -h}, {w, -h}, {w, h}, {-w, h}} {
+		points = append(points, vec{
+			float64(b.Xc) + c[0]*cos - c[1]*sin,
+			float64(b.Yc) + c[0]*sin + c[1]*cos,
+		})
+	}
+	return newShape(points)
+}
+
+func polygonShape(p an.Polygon) shape {
+	points := []vec{}
+	for _, c := range p.Points.Coordinates {
+		points = append(points, vec{float64(c[0]), float64(c[1])})
+	}
+	return newShape(points)
+}
+
+func signedArea(points []vec) float64 {
+	area := 0.0
+	for i, p := range points {
+		area += cross(p, points[(i+1)%len(points)])
+	}
+	return area / 2
+}
+
+func isConvex(points []vec) bool {
+	n := len(points)
+	for i := range points {
+		if cross(points[(i+1)%n].sub(points[i]), points[(i+2)%n].sub(points[(i+1)%n])) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// clip intersects subject with a convex, counter-clockwise polygon using the
+// Sutherland-Hodgman algorithm. The area of the result is exact even when
+// subject is concave.
+func clip(subject []vec, convex []vec) []vec {
+	out := subject
+	for i, a := range convex {
+		if len(out) == 0 {
+			break
+		}
+		b := convex[(i+1)%len(convex)]
+		edge := b.sub(a)
+		in := out
+		out = []vec{}
+		for j, p := range in {
+			q := in[(j+1)%len(in)]
+			pIn := cross(edge, p.sub(a)) >= 0
+			qIn := cross(edge, q.sub(a)) >= 0
+			if pIn {
+				out = append(out, p)
+			}
+			if pIn != qIn {
+				d := q.sub(p)
+				t := cross(edge, a.sub(p)) / cross(edge, d)
+				out = append(out, vec{p[0] + t*d[0], p[1] + t*d[1]})
+			}
+		}
+	}
+	return out
+}
+
+func (s shape) contains(p vec) bool {
+	inside := false
+	for i, a := range s.points {
+		b := s.points[(i+1)%len(s.points)]
+		if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < a[0]+(p[1]-a[1])*(b[0]-a[0])/(b[1]-a[1]) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// rasterSize is the number of samples per side used to estimate the overlap
+// of two concave regions.
+const rasterSize = 64
+
+// intersection computes the area shared by two regions. It is exact when
+// either region is convex, and estimated by sampling otherwise.
+func intersection(a, b shape) float64 {
+	minX, maxX := math.Max(a.minX, b.minX), math.Min(a.maxX, b.maxX)
+	minY, maxY := math.Max(a.minY, b.minY), math.Min(a.maxY, b.maxY)
+	if minX >= maxX || minY >= maxY {
+		return 0
+	}
+	switch {
+	case b.convex:
+		return signedArea(clip(a.points, b.points))
+	case a.convex:
+		return signedArea(clip(b.points, a.points))
+	}
+	dx, dy := (maxX-minX)/rasterSize, (maxY-minY)/rasterSize
+	n := 0
+	for i := range rasterSize {
+		for j := range rasterSize {
+			p := vec{minX + (float64(i)+0.5)*dx, minY + (float64(j)+0.5)*dy}
+			if a.contains(p) && b.contains(p) {
+				n++
+			}
+		}
+	}
+	return float64(n) * dx * dy
+}
+
+func iou(a, b shape) float64 {
+	if a.area <= 0 || b.area <= 0 {
+		return 0
+	}
+	inter := intersection(a, b)
+	return inter / (a.area + b.area - inter)
+}
+
+// IoU is the intersection over union of the areas of two regions.
+func IoU(a, b Region) float64 {
+	return iou(a.shape, b.shape)
+}
@@ -0,0 +1,229 @@
+package evaluator
+
+import (
+	"slices"
+	"sort"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+)
+
+// Region is an annotated area of an image. Boxes and polygons are compared
+// by the area they cover, so that boxes predicted by a model may be scored
+// against polygons.
+type Region struct {
+	Image im.ImageId
+	Label string
+	Score float32
+	shape shape
+}
+
+// BoxRegion makes a region of a bounding box. Annotations that are not
+// predictions are certain, and score 1.
+func BoxRegion(image im.ImageId, b an.BoundingBox) Region {
+	return Region{Image: image, Label: b.Label.Name, Score: score(b.Source), shape: boxShape(b)}
+}
+
+func PolygonRegion(image im.ImageId, p an.Polygon) Region {
+	return Region{Image: image, Label: p.Label.Name, Score: score(p.Source), shape: polygonShape(p)}
+}
+
+func score(s an.Source) float32 {
+	if s.IsPrediction() {
+		return s.Confidence
+	}
+	return 1
+}
+
+// overlaps holds the IoU of every prediction with every ground truth region
+// of the same image, indexed by their position in the evaluated slices.
+type overlaps map[[2]int]float64
+
+func computeOverlaps(truths, predictions []Region) (overlaps, map[im.ImageId][]int) {
+	truthsOf := map[im.ImageId][]int{}
+	for g, r := range truths {
+		truthsOf[r.Image] = append(truthsOf[r.Image], g)
+	}
+	o := overlaps{}
+	for p, r := range predictions {
+		for _, g := range truthsOf[r.Image] {
+			if v := iou(r.shape, truths[g].shape); v > 0 {
+				o[[2]int{p, g}] = v
+			}
+		}
+	}
+	return o, truthsOf
+}
+
+// byScore orders predictions by decreasing score, keeping the original order
+// between ties.
+func byScore(predictions []Region, indices []int) []int {
+	sorted := slices.Clone(indices)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return predictions[sorted[i]].Score > predictions[sorted[j]].Score
+	})
+	return sorted
+}
+
+// match pairs each prediction, from the most confident, with the unmatched
+// ground truth region it overlaps most, provided their IoU reaches
+// threshold. It returns whether each prediction was matched, and the
+// ground truth it matched.
+func match(o overlaps, truthsOf map[im.ImageId][]int, truths, predictions []Region,
+	sorted []int, threshold float64, sameLabel bool,
+) ([]bool, map[int]int) {
+	matched := map[int]int{}
+	taken := map[int]bool{}
+	hits := make([]bool, len(sorted))
+	for k, p := range sorted {
+		best, bestIoU := -1, threshold
+		for _, g := range truthsOf[predictions[p].Image] {
+			if taken[g] || (sameLabel && truths[g].Label != predictions[p].Label) {
+				continue
+			}
+			if v := o[[2]int{p, g}]; v >= bestIoU && (best < 0 || v > bestIoU) {
+				best, bestIoU = g, v
+			}
+		}
+		if best >= 0 {
+			taken[best] = true
+			matched[p] = best
+			hits[k] = true
+		}
+	}
+	return hits, matched
+}
+
+const numRecallLevels = 101
+
+// precisionAtRecall interpolates precision at evenly spaced recall levels, as
+// the best precision reached at that recall or beyond.
+func precisionAtRecall(hits []bool, numTruths int) []float64 {
+	precisions := make([]float64, len(hits))
+	recalls := make([]float64, len(hits))
+	tp := 0
+	for k, hit := range hits {
+		if hit {
+			tp++
+		}
+		precisions[k] = float64(tp) / float64(k+1)
+		recalls[k] = float64(tp) / float64(numTruths)
+	}
+	for k := len(precisions) - 2; k >= 0; k-- {
+		precisions[k] = max(precisions[k], precisions[k+1])
+	}
+	levels := make([]float64, numRecallLevels)
+	for l := range levels {
+		k := sort.SearchFloat64s(recalls, float64(l)/(numRecallLevels-1))
+		if k < len(precisions) {
+			levels[l] = precisions[k]
+		}
+	}
+	return levels
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+func labelsOf(truths, predictions []Region) []string {
+	labels := []string{}
+	for _, r := range append(slices.Clone(truths), predictions...) {
+		if !slices.Contains(labels, r.Label) {
+			labels = append(labels, r.Label)
+		}
+	}
+	slices.Sort(labels)
+	return labels
+}
+
+// Compute scores predictions against ground truth. Predictions whose score
+// is below minConfidence are left out of the confusion matrix only.
+func Compute(truths, predictions []Region, minConfidence float32) ev.Report {
+	o, truthsOf := computeOverlaps(truths, predictions)
+	labels := labelsOf(truths, predictions)
+	report := ev.Report{NumGroundTruth: len(truths), NumPredictions: len(predictions), Labels: []ev.LabelMetrics{}}
+
+	var aps, aps50, aps75 []float64
+	for _, label := range labels {
+		metrics := ev.LabelMetrics{Label: label}
+		indices := []int{}
+		for p, r := range predictions {
+			if r.Label == label {
+				indices = append(indices, p)
+			}
+		}
+		for _, r := range truths {
+			if r.Label == label {
+				metrics.NumGroundTruth++
+			}
+		}
+		metrics.NumPredictions = len(indices)
+		if metrics.NumGroundTruth > 0 {
+			sorted := byScore(predictions, indices)
+			perThreshold := []float64{}
+			for _, threshold := range ev.IoUThresholds {
+				hits, _ := match(o, truthsOf, truths, predictions, sorted, threshold, true)
+				levels := precisionAtRecall(hits, metrics.NumGroundTruth)
+				perThreshold = append(perThreshold, mean(levels))
+				if threshold == ev.ConfusionIoU {
+					metrics.Curve = make([]ev.PRPoint, len(levels))
+					for l, precision := range levels {
+						metrics.Curve[l] = ev.PRPoint{Recall: float64(l) / (numRecallLevels - 1), Precision: precision}
+					}
+				}
+			}
+			metrics.AP, metrics.AP50, metrics.AP75 = mean(perThreshold), perThreshold[0], perThreshold[5]
+			aps, aps50, aps75 = append(aps, metrics.AP), append(aps50, metrics.AP50), append(aps75, metrics.AP75)
+		}
+		report.Labels = append(report.Labels, metrics)
+	}
+	report.MAP, report.MAP50, report.MAP75 = mean(aps), mean(aps50), mean(aps75)
+	report.Confusion = confusion(o, truthsOf, truths, predictions, labels, minConfidence)
+	return report
+}
+
+func confusion(o overlaps, truthsOf map[im.ImageId][]int, truths, predictions []Region,
+	labels []string, minConfidence float32,
+) ev.ConfusionMatrix {
+	background := len(labels)
+	counts := make([][]int, len(labels)+1)
+	for i := range counts {
+		counts[i] = make([]int, len(labels)+1)
+	}
+	indexOf := func(label string) int {
+		i, _ := slices.BinarySearch(labels, label)
+		return i
+	}
+
+	indices := []int{}
+	for p, r := range predictions {
+		if r.Score >= minConfidence {
+			indices = append(indices, p)
+		}
+	}
+	_, matched := match(o, truthsOf, truths, predictions, byScore(predictions, indices), ev.ConfusionIoU, false)
+	found := map[int]bool{}
+	for _, p := range indices {
+		row := background
+		if g, ok := matched[p]; ok {
+			row = indexOf(truths[g].Label)
+			found[g] = true
+		}
+		counts[row][indexOf(predictions[p].Label)]++
+	}
+	for g, r := range truths {
+		if !found[g] {
+			counts[indexOf(r.Label)][background]++
+		}
+	}
+	return ev.ConfusionMatrix{Labels: labels, Counts: counts}
+}
@@ -7,5 +7,10 @@ import (
 type FileStore interface {
 	Store(string, io.Reader) error
 	Delete(string) error
-	Get(string) (io.Reader, error)
+	Get(string) (io.ReadCloser, error)
+}
+
+type RandomAccessFileStore interface {
+	FileStore
+	GetReaderAt(string) (io.ReaderAt, int64, error)
 }
@@ -0,0 +1,266 @@
+package file_store
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/jonboulle/clockwork"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyId     string
+	SecretAccessKey string
+	Prefix          string
+	PathStyle       bool
+}
+
+// S3FileStore stores files as objects of an S3-compatible bucket
+// (AWS S3, MinIO, ...). Requests are signed with AWS signature V4.
+type S3FileStore struct {
+	S3Config
+	Client *http.Client
+	clockwork.Clock
+}
+
+type S3Option func(*S3FileStore)
+
+func WithHTTPClient(c *http.Client) S3Option {
+	return func(s *S3FileStore) {
+		s.Client = c
+	}
+}
+
+func WithS3Clock(c clockwork.Clock) S3Option {
+	return func(s *S3FileStore) {
+		s.Clock = c
+	}
+}
+
+func NewS3FileStore(cfg S3Config, opts ...S3Option) S3FileStore {
+	if cfg.Region == "" {
+		cfg.Region = "us-east-1"
+	}
+	s := &S3FileStore{S3Config: cfg, Client: http.DefaultClient, Clock: clockwork.NewRealClock()}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return *s
+}
+
+// WithPrefix returns a store whose objects are nested under prefix, so that
+// several stores can share a bucket.
+func (s S3FileStore) WithPrefix(prefix string) S3FileStore {
+	s.Prefix = path.Join(s.Prefix, prefix)
+	return s
+}
+
+func (s S3FileStore) Store(name string, reader io.Reader) error {
+	body, size, cleanup, err := sized(reader)
+	if err != nil {
+		return fmt.Errorf("buffering object %v: %w", name, err)
+	}
+	defer cleanup()
+
+	req, err := s.newRequest(http.MethodPut, name, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("storing object %v: %w", name, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+func (s S3FileStore) Delete(name string) error {
+	req, err := s.newRequest(http.MethodDelete, name, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("deleting object %v: %w", name, err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get streams the content of an object. The returned reader must be closed.
+func (s S3FileStore) Get(name string) (io.ReadCloser, error) {
+	req, err := s.newRequest(http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching object %v: %w", name, err)
+	}
+	return resp.Body, nil
+}
+
+// GetRange streams length bytes of an object, starting at offset.
+func (s S3FileStore) GetRange(name string, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 || length <= 0 {
+		return nil, fmt.Errorf("invalid range [%v, %v): %w", offset, offset+length, e.ErrValidation)
+	}
+	req, err := s.newRequest(http.MethodGet, name, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching range of object %v: %w", name, err)
+	}
+	if resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, fmt.Errorf("fetching range of object %v: range not honored: %w", name, e.ErrInternal)
+	}
+	return resp.Body, nil
+}
+
+func (s S3FileStore) Size(name string) (int64, error) {
+	req, err := s.newRequest(http.MethodHead, name, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return 0, fmt.Errorf("fetching size of object %v: %w", name, err)
+	}
+	resp.Body.Close()
+	return resp.ContentLength, nil
+}
+
+// GetReaderAt gives random access to an object, each read being a ranged
+// request.
+func (s S3FileStore) GetReaderAt(name string) (io.ReaderAt, int64, error) {
+	size, err := s.Size(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	return s3ReaderAt{s, name, size}, size, nil
+}
+
+type s3ReaderAt struct {
+	store S3FileStore
+	name  string
+	size  int64
+}
+
+func (r s3ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	length := min(int64(len(p)), r.size-off)
+	if length == 0 {
+		return 0, nil
+	}
+	body, err := r.store.GetRange(r.name, off, length)
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+	n, err := io.ReadFull(body, p[:length])
+	if err != nil {
+		return n, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (s S3FileStore) objectURL(name string) (*url.URL, error) {
+	u, err := url.Parse(s.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing S3 endpoint %v: %v: %w", s.Endpoint, err, e.ErrInternal)
+	}
+	key := strings.TrimPrefix(path.Join(s.Prefix, name), "/")
+	if s.PathStyle {
+		u.Path = "/" + s.Bucket + "/" + key
+	} else {
+		u.Host = s.Bucket + "." + u.Host
+		u.Path = "/" + key
+	}
+	return u, nil
+}
+
+func (s S3FileStore) newRequest(method, name string, body io.Reader) (*http.Request, error) {
+	u, err := s.objectURL(name)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(method, u.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("building %v request for object %v: %v: %w", method, name, err, e.ErrInternal)
+	}
+	return req, nil
+}
+
+func (s S3FileStore) do(req *http.Request) (*http.Response, error) {
+	Sign(req, s.AccessKeyId, s.SecretAccessKey, s.Region, s.Clock.Now())
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, e.ErrInternal)
+	}
+	if resp.StatusCode < 300 {
+		return resp, nil
+	}
+
+	defer resp.Body.Close()
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("file not found: %w", e.ErrNotFound)
+	case http.StatusForbidden:
+		return nil, fmt.Errorf("access denied by object storage: %s: %w", msg, e.ErrInternal)
+	default:
+		return nil, fmt.Errorf("object storage replied %v: %s: %w", resp.Status, msg, e.ErrInternal)
+	}
+}
+
+// sized returns a reader of known length, as required by S3 uploads.
+// Readers of unknown length are spooled to a temporary file.
+func sized(r io.Reader) (io.Reader, int64, func(), error) {
+	noop := func() {}
+	switch v := r.(type) {
+	case *bytes.Reader:
+		return v, int64(v.Len()), noop, nil
+	case *bytes.Buffer:
+		return v, int64(v.Len()), noop, nil
+	case *strings.Reader:
+		return v, int64(v.Len()), noop, nil
+	}
+
+	f, err := os.CreateTemp("", "s3-upload-*")
+	if err != nil {
+		return nil, 0, noop, err
+	}
+	cleanup := func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	size, err := io.Copy(f, r)
+	if err != nil {
+		cleanup()
+		return nil, 0, noop, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, 0, noop, err
+	}
+	return io.NopCloser(f), size, cleanup, nil
+}
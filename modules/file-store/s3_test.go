@@ -0,0 +1,103 @@
+package file_store
+
+import (
+	"bytes"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func SetupS3(t *testing.T) (S3FileStore, *FakeS3) {
+	fake := NewFakeS3("bucket", "secret")
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	store := NewS3FileStore(S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "bucket",
+		AccessKeyId:     "key",
+		SecretAccessKey: "secret",
+		PathStyle:       true,
+	}, WithHTTPClient(server.Client()))
+	return store, fake
+}
+
+func TestS3StoreAndGet(t *testing.T) {
+	store, fake := SetupS3(t)
+	store = store.WithPrefix("images")
+	assert.NoError(t, store.Store("a b.png", strings.NewReader("content")))
+	assert.Equal(t, []byte("content"), fake.Objects["images/a b.png"])
+
+	reader, err := store.Get("a b.png")
+	assert.NoError(t, err)
+	data, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "content", string(data))
+	assert.NoError(t, reader.(io.Closer).Close())
+}
+
+func TestS3StoreReaderOfUnknownLength(t *testing.T) {
+	store, fake := SetupS3(t)
+	pr, pw := io.Pipe()
+	go func() {
+		pw.Write([]byte("streamed"))
+		pw.Close()
+	}()
+	assert.NoError(t, store.Store("a", pr))
+	assert.Equal(t, []byte("streamed"), fake.Objects["a"])
+}
+
+func TestS3GetMissingObject(t *testing.T) {
+	store, _ := SetupS3(t)
+	_, err := store.Get("missing")
+	assert.ErrorIs(t, err, e.ErrNotFound)
+	_, _, err = store.GetReaderAt("missing")
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestS3WrongCredentials(t *testing.T) {
+	store, _ := SetupS3(t)
+	store.SecretAccessKey = "wrong"
+	err := store.Store("a", bytes.NewReader([]byte("a")))
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestS3Delete(t *testing.T) {
+	store, fake := SetupS3(t)
+	assert.NoError(t, store.Store("a", bytes.NewReader([]byte("a"))))
+	assert.NoError(t, store.Delete("a"))
+	assert.Empty(t, fake.Objects)
+}
+
+func TestS3GetRange(t *testing.T) {
+	store, _ := SetupS3(t)
+	assert.NoError(t, store.Store("a", strings.NewReader("0123456789")))
+
+	body, err := store.GetRange("a", 2, 3)
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(body)
+	body.Close()
+	assert.Equal(t, "234", string(data))
+
+	readerAt, size, err := store.GetReaderAt("a")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), size)
+	section, err := io.ReadAll(io.NewSectionReader(readerAt, 7, 10))
+	assert.NoError(t, err)
+	assert.Equal(t, "789", string(section))
+
+	buf := make([]byte, 4)
+	n, err := readerAt.ReadAt(buf, 8)
+	assert.Equal(t, 2, n)
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestS3VirtualHostedURL(t *testing.T) {
+	store := NewS3FileStore(S3Config{Endpoint: "https://s3.example.com", Bucket: "b", Prefix: "p"})
+	u, err := store.objectURL("k.png")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://b.s3.example.com/p/k.png", u.String())
+}
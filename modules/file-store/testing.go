@@ -0,0 +1,69 @@
+package file_store
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FakeS3 is an in-memory, path-style S3 server, meant to be served with
+// httptest. It checks request signatures and honors range requests.
+type FakeS3 struct {
+	Bucket          string
+	SecretAccessKey string
+	Region          string
+	Objects         map[string][]byte
+	mu              sync.Mutex
+}
+
+func NewFakeS3(bucket, secretAccessKey string) *FakeS3 {
+	return &FakeS3{
+		Bucket:          bucket,
+		SecretAccessKey: secretAccessKey,
+		Region:          "us-east-1",
+		Objects:         map[string][]byte{},
+	}
+}
+
+func (s *FakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !VerifySignature(r, s.SecretAccessKey, s.Region) {
+		http.Error(w, "SignatureDoesNotMatch", http.StatusForbidden)
+		return
+	}
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+s.Bucket+"/")
+	if !ok {
+		http.Error(w, "NoSuchBucket", http.StatusNotFound)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		if r.ContentLength < 0 {
+			http.Error(w, "MissingContentLength", http.StatusLengthRequired)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.Objects[key] = data
+	case http.MethodGet, http.MethodHead:
+		data, ok := s.Objects[key]
+		if !ok {
+			http.Error(w, "NoSuchKey", http.StatusNotFound)
+			return
+		}
+		http.ServeContent(w, r, key, time.Time{}, bytes.NewReader(data))
+	case http.MethodDelete:
+		delete(s.Objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "MethodNotAllowed", http.StatusMethodNotAllowed)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
@@ -158,7 +159,7 @@ func TestAddPolygon(t *testing.T) {
 		Polygons: []a.PolygonRequest{
 			{
 				Label:  "a-label",
-				Points: a.Points{Coordinates: [][2]float32{{0, 0}, {0, 1}}},
+				Points: a.Points{Coordinates: [][2]float32{{0, 0}, {0, 1}, {1, 1}}},
 			},
 		},
 		Reader: &fk.ImageReader{},
@@ -167,6 +168,37 @@ func TestAddPolygon(t *testing.T) {
 	assert.Equal(t, 1, anRepo.NumPolygonsAdded)
 }
 
+func TestAddPolyline(t *testing.T) {
+	repos := NewTestingRepos()
+	anRepo := &fk.AnnotationRepo{}
+	repos.AnnotationRepo = anRepo
+	ing := NewTestingImageIngester(repos)
+	_, err := ing.Ingest(Request{
+		Polylines: []a.PolylineRequest{
+			{
+				Label:  "a-label",
+				Points: a.Points{Coordinates: [][2]float32{{0, 0}, {0, 1}, {2, 2}}},
+			},
+		},
+		Reader: &fk.ImageReader{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, anRepo.NumPolylinesAdded)
+}
+
+func TestPolylineWithSinglePointShouldFail(t *testing.T) {
+	repos := NewTestingRepos()
+	anRepo := &fk.AnnotationRepo{}
+	repos.AnnotationRepo = anRepo
+	ing := NewTestingImageIngester(repos)
+	_, err := ing.Ingest(Request{
+		Polylines: []a.PolylineRequest{{Label: "a-label", Points: a.Points{Coordinates: [][2]float32{{0, 0}}}}},
+		Reader:    &fk.ImageReader{},
+	})
+	assert.ErrorIs(t, err, e.ErrValidation)
+	assert.Equal(t, 0, anRepo.NumPolylinesAdded)
+}
+
 func TestInternalErrOnAddImageShouldFail(t *testing.T) {
 	repos := NewTestingRepos()
 	repos.ImageRepo = &fk.ImageRepo{ErrOnAddImage: e.ErrInternal}
@@ -248,3 +280,43 @@ func TestHandleInvalidSpecsError(t *testing.T) {
 	_, err := ing.Ingest(Request{})
 	assert.Error(t, err)
 }
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	repos := NewTestingRepos()
+	repos.CollectionRepo = &fk.CollectionRepo{
+		Return: clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithLabels([]string{"car"})),
+	}
+	repos.LabelRepo = &fk.LabelRepo{Labels: []lbl.Label{
+		lbl.NewLabel(lbl.NewLabelId(), "car"),
+		lbl.NewLabel(lbl.NewLabelId(), "person"),
+	}}
+	anRepo := &fk.AnnotationRepo{}
+	repos.AnnotationRepo = anRepo
+	ing := NewTestingImageIngester(repos)
+	_, err := ing.Ingest(Request{
+		BoundingBoxes: []a.BoundingBoxRequest{
+			{Label: "person", Xc: 10, Yc: 10, Width: 2, Height: 4},
+		},
+		Reader: &fk.ImageReader{},
+	})
+	assert.ErrorIs(t, err, e.ErrValidation)
+	assert.Equal(t, 0, anRepo.NumBoundingBoxesAdded)
+}
+
+func TestUnlabeledRegionTakesDefaultLabelOfCollection(t *testing.T) {
+	repos := NewTestingRepos()
+	repos.CollectionRepo = &fk.CollectionRepo{
+		Return: clc.NewCollection(clc.NewCollectionId(), "a-collection",
+			clc.WithLabels([]string{"car"}), clc.WithDefaultLabel("car")),
+	}
+	repos.LabelRepo = &fk.LabelRepo{Labels: []lbl.Label{lbl.NewLabel(lbl.NewLabelId(), "car")}}
+	anRepo := &fk.AnnotationRepo{}
+	repos.AnnotationRepo = anRepo
+	ing := NewTestingImageIngester(repos)
+	_, err := ing.Ingest(Request{
+		BoundingBoxes: []a.BoundingBoxRequest{{Xc: 10, Yc: 10, Width: 2, Height: 4}},
+		Reader:        &fk.ImageReader{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "car", anRepo.GotBox.Label.Name)
+}
@@ -82,7 +82,7 @@ func (i *ImageIngester) Ingest(r Request) (*Response, error) {
 
 	imageId := im.NewImageId()
 	image, err := i.buildImage(imageId, *collection, r.Labels, r.BoundingBoxes,
-		r.Polygons)
+		r.Polygons, r.Polylines)
 	if err != nil {
 		return nil, fmt.Errorf("%v: %w", errCtx, err)
 	}
@@ -188,11 +188,23 @@ func (i *ImageIngester) ingestImage(
 			return fmt.Errorf("adding polygon: %w", err)
 		}
 	}
+
+	for _, line := range image.Polylines {
+		if err := tx.AnnotationRepo.AddPolyline(
+			image.Id,
+			image.Collection.Name,
+			line,
+			&authorId,
+			&now,
+		); err != nil {
+			return fmt.Errorf("adding polyline: %w", err)
+		}
+	}
 	return nil
 }
 
 func (i *ImageIngester) buildImage(id im.ImageId, collection clc.Collection, labelNames []string,
-	bboxes []a.BoundingBoxRequest, polygons []a.PolygonRequest,
+	bboxes []a.BoundingBoxRequest, polygons []a.PolygonRequest, polylines []a.PolylineRequest,
 ) (*im.Image, error) {
 	image := im.NewImage(id, collection)
 
@@ -206,13 +218,16 @@ func (i *ImageIngester) buildImage(id im.ImageId, collection clc.Collection, lab
 	if err := i.appendPolygons(&image, polygons); err != nil {
 		return nil, err
 	}
+	if err := i.appendPolylines(&image, polylines); err != nil {
+		return nil, err
+	}
 
 	return &image, nil
 }
 
 func (i *ImageIngester) appendLabels(image *im.Image, labelNames []string) error {
 	for _, labelName := range labelNames {
-		label, err := i.findLabelByName(labelName)
+		label, err := i.findLabelByName(image.Collection, labelName)
 		if err != nil {
 			return err
 		}
@@ -226,7 +241,7 @@ func (i *ImageIngester) appendLabels(image *im.Image, labelNames []string) error
 func (i *ImageIngester) appendBoundingBoxes(image *im.Image, bboxes []a.BoundingBoxRequest) error {
 	baseErr := fmt.Errorf("appending bounding boxes")
 	for _, bbox := range bboxes {
-		label, err := i.findLabelByName(bbox.Label)
+		label, err := i.findLabelByName(image.Collection, bbox.Label)
 		if err != nil {
 			return fmt.Errorf("%w: %w", baseErr, err)
 		}
@@ -248,7 +263,7 @@ func (i *ImageIngester) appendBoundingBoxes(image *im.Image, bboxes []a.Bounding
 func (i *ImageIngester) appendPolygons(image *im.Image, polygons []a.PolygonRequest) error {
 	baseErr := fmt.Errorf("appending polygons")
 	for _, p := range polygons {
-		label, err := i.findLabelByName(p.Label)
+		label, err := i.findLabelByName(image.Collection, p.Label)
 		if err != nil {
 			return fmt.Errorf("%w: %w", baseErr, err)
 		}
@@ -264,6 +279,21 @@ func (i *ImageIngester) appendPolygons(image *im.Image, polygons []a.PolygonRequ
 	return nil
 }
 
+func (i *ImageIngester) appendPolylines(image *im.Image, polylines []a.PolylineRequest) error {
+	baseErr := fmt.Errorf("appending polylines")
+	for _, p := range polylines {
+		label, err := i.findLabelByName(image.Collection, p.Label)
+		if err != nil {
+			return fmt.Errorf("%w: %w", baseErr, err)
+		}
+		line := a.NewPolyline(a.NewAnnotationId(), p.Points, *label)
+		if err := image.AddPolyline(line); err != nil {
+			return fmt.Errorf("%w: %w", baseErr, err)
+		}
+	}
+	return nil
+}
+
 func (i *ImageIngester) findCollectionByName(name string) (*clc.Collection, error) {
 	collection, err := i.CollectionRepo.Find(name)
 	baseErr := fmt.Errorf("finding collection with name %v", name)
@@ -273,12 +303,18 @@ func (i *ImageIngester) findCollectionByName(name string) (*clc.Collection, erro
 	return collection, nil
 }
 
-func (i *ImageIngester) findLabelByName(name string) (*lbl.Label, error) {
+func (i *ImageIngester) findLabelByName(collection clc.Collection, name string) (*lbl.Label, error) {
+	if name == "" && collection.DefaultLabel != nil {
+		name = *collection.DefaultLabel
+	}
 	baseErr := fmt.Errorf("fetching label by name %v", name)
 	label, err := i.LabelRepo.FindLabel(name)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", baseErr, err)
 	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		return nil, fmt.Errorf("%w: %w", baseErr, err)
+	}
 	return label, nil
 }
 
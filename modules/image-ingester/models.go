@@ -14,6 +14,7 @@ type Request struct {
 	Labels        []string
 	BoundingBoxes []an.BoundingBoxRequest
 	Polygons      []an.PolygonRequest
+	Polylines     []an.PolylineRequest
 	Reader        io.Reader
 }
 
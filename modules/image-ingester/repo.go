@@ -22,6 +22,7 @@ type AnnotationRepo interface {
 	AddImageLabel(im.ImageId, clc.CollectionName, an.ImageLabel, *u.UserId, *time.Time) error
 	AddBoundingBox(im.ImageId, clc.CollectionName, an.BoundingBox, *u.UserId, *time.Time) error
 	AddPolygon(im.ImageId, clc.CollectionName, an.Polygon, *u.UserId, *time.Time) error
+	AddPolyline(im.ImageId, clc.CollectionName, an.Polyline, *u.UserId, *time.Time) error
 }
 
 type ImageRepo interface {
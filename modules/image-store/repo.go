@@ -14,10 +14,16 @@ type AnnotationRepo interface {
 	FindImageLabels(im.ImageId, clc.CollectionName) ([]a.ImageLabel, error)
 	FindBoundingBoxes(im.ImageId, clc.CollectionName) ([]a.BoundingBox, error)
 	FindPolygons(im.ImageId, clc.CollectionName) ([]a.Polygon, error)
-	RemoveAllAnnotations(im.ImageId, clc.CollectionName) error
+	FindPolylines(im.ImageId, clc.CollectionName) ([]a.Polyline, error)
+	FindKeypoints(im.ImageId, clc.CollectionName) ([]a.Keypoints, error)
+	FindMasks(im.ImageId, clc.CollectionName) ([]a.Mask, error)
+	RemoveAllAnnotations(im.ImageId, clc.CollectionName, *u.UserId, *time.Time) error
 	AddImageLabel(im.ImageId, clc.CollectionName, a.ImageLabel, *u.UserId, *time.Time) error
 	AddBoundingBox(im.ImageId, clc.CollectionName, a.BoundingBox, *u.UserId, *time.Time) error
 	AddPolygon(im.ImageId, clc.CollectionName, a.Polygon, *u.UserId, *time.Time) error
+	AddPolyline(im.ImageId, clc.CollectionName, a.Polyline, *u.UserId, *time.Time) error
+	AddKeypoints(im.ImageId, clc.CollectionName, a.Keypoints, *u.UserId, *time.Time) error
+	AddMask(im.ImageId, clc.CollectionName, a.Mask, *u.UserId, *time.Time) error
 }
 
 type CollectionRepo interface {
@@ -26,6 +32,7 @@ type CollectionRepo interface {
 
 type ImageRepo interface {
 	GetSpecs(im.ImageId) (*im.Specs, error)
+	GetHash(im.ImageId) (string, error)
 	ImageExistsInCollection(im.ImageId, clc.CollectionName) (bool, error)
 	RemoveImageFromCollection(im.ImageId, clc.CollectionName) error
 	IsUsed(im.ImageId) (*bool, error)
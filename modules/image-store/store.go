@@ -2,12 +2,16 @@ package image_store
 
 import (
 	"fmt"
+	"io"
 	"strings"
+	"time"
 
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fs "github.com/lejeunel/go-image-annotator/modules/file-store"
+	th "github.com/lejeunel/go-image-annotator/modules/thumbnailer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -59,6 +63,21 @@ func (s ImageStore) Find(base im.BaseImage) (*im.Image, error) {
 		return nil, fmt.Errorf("fetching polygons: %w", err)
 	}
 
+	polylines, err := s.AnnotationRepo.FindPolylines(base.ImageId, collection.Name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching polylines: %w", err)
+	}
+
+	keypoints, err := s.AnnotationRepo.FindKeypoints(base.ImageId, collection.Name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching keypoints: %w", err)
+	}
+
+	masks, err := s.AnnotationRepo.FindMasks(base.ImageId, collection.Name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching masks: %w", err)
+	}
+
 	specs, err := s.ImageRepo.GetSpecs(base.ImageId)
 	if err != nil {
 		return nil, fmt.Errorf("fetching image specs: %w", err)
@@ -69,35 +88,75 @@ func (s ImageStore) Find(base im.BaseImage) (*im.Image, error) {
 		return nil, fmt.Errorf("fetching image meta-data: %w", err)
 	}
 
-	reader, err := s.FileStore.Get(
-		fmt.Sprintf("%v.%v", base.ImageId, strings.Split(specs.MIMEType, "/")[1]),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("fetching raw data: %w", err)
+	reader := &rawReader{
+		FileStore: s.FileStore,
+		name:      fmt.Sprintf("%v.%v", base.ImageId, strings.Split(specs.MIMEType, "/")[1]),
 	}
 	return &im.Image{
 		Id:         base.ImageId,
 		Collection: *collection, Labels: labels,
 		BoundingBoxes: boxes,
 		Polygons:      polygons,
+		Polylines:     polylines,
+		Keypoints:     keypoints,
+		Masks:         masks,
 		Specs:         *specs,
 		Meta:          meta,
 		Reader:        reader,
 	}, nil
 }
 
+// rawReader fetches the raw data of an image on first read, so that finding
+// an image does not download it. It must be closed once read.
+type rawReader struct {
+	fs.FileStore
+	name string
+	body io.ReadCloser
+}
+
+func (r *rawReader) Read(p []byte) (int, error) {
+	if r.body == nil {
+		body, err := r.FileStore.Get(r.name)
+		if err != nil {
+			return 0, fmt.Errorf("fetching raw data: %w", err)
+		}
+		r.body = body
+	}
+	return r.body.Read(p)
+}
+
+func (r *rawReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	return r.body.Close()
+}
+
 func (s ImageStore) DeleteAsset(id im.ImageId) error {
 	specs, err := s.ImageRepo.GetSpecs(id)
 	if err != nil {
 		return fmt.Errorf("fetching image specs")
 	}
-	return s.FileStore.Delete(fmt.Sprintf("%v.%v", id, strings.Split(specs.MIMEType, "/")[1]))
+	hash, err := s.ImageRepo.GetHash(id)
+	if err != nil {
+		return fmt.Errorf("fetching image hash: %w", err)
+	}
+	if err := s.FileStore.Delete(fmt.Sprintf("%v.%v", id, strings.Split(specs.MIMEType, "/")[1])); err != nil {
+		return err
+	}
+	// thumbnails are generated on demand, most sizes are usually missing
+	for _, size := range th.Sizes {
+		s.FileStore.Delete(th.FileName(hash, size))
+	}
+	return nil
 }
 
-func (s ImageStore) Delete(id im.ImageId, collection clc.CollectionName) error {
+// Delete removes an image from a collection, along with its annotations and
+// metadata there. Removed annotations are recorded as deleted by userId at t.
+func (s ImageStore) Delete(id im.ImageId, collection clc.CollectionName, userId *u.UserId, t *time.Time) error {
 	errCtx := fmt.Errorf("deleting image")
 	if err := s.Transactor.RunInTx(func(tx Repos) error {
-		if err := tx.AnnotationRepo.RemoveAllAnnotations(id, collection); err != nil {
+		if err := tx.AnnotationRepo.RemoveAllAnnotations(id, collection, userId, t); err != nil {
 			return fmt.Errorf("%w: %w", errCtx, err)
 		}
 		if err := tx.MetaRepo.DeleteAll(collection, id); err != nil {
@@ -131,9 +190,9 @@ func (s ImageStore) Delete(id im.ImageId, collection clc.CollectionName) error {
 	return nil
 }
 
-func (s ImageStore) DeleteBatch(ids []im.ImageId, collection clc.CollectionName) error {
+func (s ImageStore) DeleteBatch(ids []im.ImageId, collection clc.CollectionName, userId *u.UserId, t *time.Time) error {
 	for _, id := range ids {
-		if err := s.Delete(id, collection); err != nil {
+		if err := s.Delete(id, collection, userId, t); err != nil {
 			return err
 		}
 	}
@@ -194,6 +253,42 @@ func (s ImageStore) Copy(
 					return fmt.Errorf("%w: adding polygons: %w", errCtx, err)
 				}
 			}
+			for _, line := range image.Polylines {
+				line.Id = a.NewAnnotationId()
+				if err := tx.AnnotationRepo.AddPolyline(
+					image.Id,
+					dst,
+					line,
+					line.Author,
+					line.Time,
+				); err != nil {
+					return fmt.Errorf("%w: adding polylines: %w", errCtx, err)
+				}
+			}
+			for _, k := range image.Keypoints {
+				k.Id = a.NewAnnotationId()
+				if err := tx.AnnotationRepo.AddKeypoints(
+					image.Id,
+					dst,
+					k,
+					k.Author,
+					k.Time,
+				); err != nil {
+					return fmt.Errorf("%w: adding keypoints: %w", errCtx, err)
+				}
+			}
+			for _, mask := range image.Masks {
+				mask.Id = a.NewAnnotationId()
+				if err := tx.AnnotationRepo.AddMask(
+					image.Id,
+					dst,
+					mask,
+					mask.Author,
+					mask.Time,
+				); err != nil {
+					return fmt.Errorf("%w: adding masks: %w", errCtx, err)
+				}
+			}
 
 			for _, m := range image.Meta {
 				if err := tx.MetaRepo.Add(dst, image.Id, m.Key, m.Value); err != nil {
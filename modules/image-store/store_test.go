@@ -11,6 +11,7 @@ import (
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	m "github.com/lejeunel/go-image-annotator/entities/meta"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
+	th "github.com/lejeunel/go-image-annotator/modules/thumbnailer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -87,6 +88,26 @@ func TestErrOnFindPolygonsShouldFail(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestErrOnFindPolylinesShouldFail(t *testing.T) {
+	s, _, _, _ := Setup()
+	s.AnnotationRepo = &fk.AnnotationRepo{ErrOnFindPolylines: e.ErrInternal}
+	_, err := s.Find(im.BaseImage{
+		ImageId:    im.NewImageId(),
+		Collection: "a-collection",
+	})
+	assert.NotNil(t, err)
+}
+
+func TestErrOnFindKeypointsShouldFail(t *testing.T) {
+	s, _, _, _ := Setup()
+	s.AnnotationRepo = &fk.AnnotationRepo{ErrOnFindKeypoints: e.ErrInternal}
+	_, err := s.Find(im.BaseImage{
+		ImageId:    im.NewImageId(),
+		Collection: "a-collection",
+	})
+	assert.NotNil(t, err)
+}
+
 func TestErrOnExistsShouldFail(t *testing.T) {
 	s, _, _, _ := Setup()
 	s.ImageRepo = &fk.ImageRepo{ErrOnImageExistsInCollection: e.ErrInternal}
@@ -103,9 +124,11 @@ func TestFindImageGivesCorrectAnnotations(t *testing.T) {
 	labels := []a.ImageLabel{{Id: a.NewAnnotationId(), Label: label}}
 	bboxes := []a.BoundingBox{{Id: a.NewAnnotationId(), Label: label}}
 	polygons := []a.Polygon{{Id: a.NewAnnotationId(), Label: label}}
+	polylines := []a.Polyline{{Id: a.NewAnnotationId(), Label: label}}
+	keypoints := []a.Keypoints{{Id: a.NewAnnotationId(), Label: label}}
 	s.AnnotationRepo = &fk.AnnotationRepo{
 		Labels:        labels,
-		BoundingBoxes: bboxes, Polygons: polygons,
+		BoundingBoxes: bboxes, Polygons: polygons, Polylines: polylines, Keypoints: keypoints,
 	}
 	s.FileStore = &fk.FileStore{Data: []byte("test-data")}
 
@@ -118,6 +141,8 @@ func TestFindImageGivesCorrectAnnotations(t *testing.T) {
 	assert.Equal(t, 1, len(image.Labels))
 	assert.Equal(t, 1, len(image.BoundingBoxes))
 	assert.Equal(t, 1, len(image.Polygons))
+	assert.Equal(t, 1, len(image.Polylines))
+	assert.Equal(t, 1, len(image.Keypoints))
 }
 
 func TestImageReaderGivesCorrectBytes(t *testing.T) {
@@ -183,3 +208,52 @@ func TestDeepCopyOneImage(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, anrepo.AddedAnnotationId)
 }
+
+func TestDeepCopyGivesNewKeypoints(t *testing.T) {
+	store, srcCollection, image, dstCollection, _, anrepo := SetupCopy()
+	original := a.NewKeypoints(a.NewAnnotationId(), []a.Keypoint{{X: 1, Y: 2, Visibility: a.Visible}},
+		lbl.NewLabel(lbl.NewLabelId(), "person"))
+	anrepo.Keypoints = []a.Keypoints{original}
+	err := store.Copy(srcCollection.Name, image.Id, dstCollection.Name, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, anrepo.NumKeypointsAdded)
+	assert.Equal(t, dstCollection.Name, anrepo.GotCollection)
+	assert.Equal(t, original.Points, anrepo.GotKeypoints.Points)
+	assert.NotEqual(t, original.Id, anrepo.GotKeypoints.Id)
+}
+
+func TestDeepCopyGivesNewPolylines(t *testing.T) {
+	store, srcCollection, image, dstCollection, _, anrepo := SetupCopy()
+	original := a.NewPolyline(a.NewAnnotationId(), a.Points{Coordinates: [][2]float32{{0, 0}, {1, 1}}},
+		lbl.NewLabel(lbl.NewLabelId(), "lane"))
+	anrepo.Polylines = []a.Polyline{original}
+	err := store.Copy(srcCollection.Name, image.Id, dstCollection.Name, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, anrepo.NumPolylinesAdded)
+	assert.Equal(t, original.Points, anrepo.GotPolyline.Points)
+	assert.NotEqual(t, original.Id, anrepo.GotPolyline.Id)
+}
+
+func TestDeleteAssetRemovesThumbnails(t *testing.T) {
+	s, _, image, _ := Setup()
+	store := &fk.FileStore{}
+	s.FileStore = store
+	assert.NoError(t, s.DeleteAsset(image.Id))
+	assert.Equal(t, 1+len(th.Sizes), store.NumDeletedItems)
+}
+
+func TestFindFetchesRawDataOnRead(t *testing.T) {
+	s, _, _, data := Setup()
+	f := &fk.FileStore{Data: data}
+	s.FileStore = f
+	image, err := s.Find(im.BaseImage{
+		ImageId:    im.NewImageId(),
+		Collection: "the-collection",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, f.NumGets)
+	gotBytes, _ := io.ReadAll(image.Reader)
+	assert.Equal(t, 1, f.NumGets)
+	assert.Equal(t, data, gotBytes)
+	assert.NoError(t, image.Reader.(io.Closer).Close())
+}
@@ -0,0 +1,285 @@
+package job_queue
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+)
+
+type Repo interface {
+	Create(job Job, runAt time.Time) error
+	// Claim marks the oldest job due at now as running and increments its
+	// number of attempts. It returns nil when no job is due.
+	Claim(now time.Time) (*Job, error)
+	Retry(id t.TaskId, runAt time.Time, lastError string) error
+	Delete(t.TaskId) error
+	ListRunning() ([]Job, error)
+	// ListOrphanedTasks lists the tasks that are pending or started but
+	// have no job to carry them out.
+	ListOrphanedTasks() ([]t.TaskId, error)
+}
+
+type EventLogger interface {
+	AddEvent(t.TaskId, ev.Event) error
+}
+
+// PersistentQueue stores jobs before running them on a bounded pool of
+// workers, so that they survive restarts. Failed jobs are retried with
+// exponential backoff.
+type PersistentQueue struct {
+	Repo
+	Events EventLogger
+	slog.Logger
+	clockwork.Clock
+	Workers      int
+	MaxAttempts  int
+	Backoff      time.Duration
+	MaxBackoff   time.Duration
+	PollInterval time.Duration
+
+	handlers map[t.TaskType]Handler
+	wake     chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+	cancel   context.CancelFunc
+	running  sync.WaitGroup
+}
+
+type Option func(*PersistentQueue)
+
+func WithWorkers(n int) Option {
+	return func(q *PersistentQueue) {
+		q.Workers = n
+	}
+}
+
+func WithMaxAttempts(n int) Option {
+	return func(q *PersistentQueue) {
+		q.MaxAttempts = n
+	}
+}
+
+func WithBackoff(initial, max time.Duration) Option {
+	return func(q *PersistentQueue) {
+		q.Backoff = initial
+		q.MaxBackoff = max
+	}
+}
+
+func WithPollInterval(d time.Duration) Option {
+	return func(q *PersistentQueue) {
+		q.PollInterval = d
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(q *PersistentQueue) {
+		q.Clock = c
+	}
+}
+
+func New(r Repo, l EventLogger, logger slog.Logger, opts ...Option) *PersistentQueue {
+	q := &PersistentQueue{
+		Repo:         r,
+		Events:       l,
+		Logger:       logger,
+		Clock:        clockwork.NewRealClock(),
+		Workers:      2,
+		MaxAttempts:  3,
+		Backoff:      10 * time.Second,
+		MaxBackoff:   10 * time.Minute,
+		PollInterval: time.Second,
+		handlers:     map[t.TaskType]Handler{},
+		wake:         make(chan struct{}, 1),
+		stop:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(q)
+	}
+	q.Workers = max(q.Workers, 1)
+	q.MaxAttempts = max(q.MaxAttempts, 1)
+	return q
+}
+
+func (q *PersistentQueue) Register(type_ t.TaskType, h Handler) {
+	q.handlers[type_] = h
+}
+
+func (q *PersistentQueue) Submit(job Job) error {
+	if err := q.Repo.Create(job, q.Clock.Now()); err != nil {
+		return fmt.Errorf("submitting job %v: %w", job.TaskId, err)
+	}
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Start recovers the jobs interrupted by a previous shutdown, then starts
+// the workers.
+func (q *PersistentQueue) Start() error {
+	if err := q.Recover(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+	for range q.Workers {
+		q.running.Add(1)
+		go q.work(ctx)
+	}
+	return nil
+}
+
+// Shutdown stops claiming jobs and waits for the running ones to finish.
+// Jobs still running when ctx expires are cancelled, and resumed on next
+// start.
+func (q *PersistentQueue) Shutdown(ctx context.Context) error {
+	q.stopOnce.Do(func() { close(q.stop) })
+	drained := make(chan struct{})
+	go func() {
+		q.running.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		if q.cancel != nil {
+			q.cancel()
+		}
+		return fmt.Errorf("draining job queue: %w", ctx.Err())
+	}
+}
+
+// Recover resumes the jobs that were running when the process stopped, and
+// fails the tasks that were left unfinished without a job.
+func (q *PersistentQueue) Recover() error {
+	errCtx := fmt.Errorf("recovering interrupted jobs")
+	now := q.Clock.Now()
+	interrupted, err := q.Repo.ListRunning()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	for _, job := range interrupted {
+		if job.Attempt < q.MaxAttempts {
+			if err := q.Repo.Retry(job.TaskId, now, "interrupted by shutdown"); err != nil {
+				return fmt.Errorf("%w: %w", errCtx, err)
+			}
+			q.addEvent(job.TaskId, ev.Event{Time: now, State: ev.PendingTask,
+				Error: "interrupted by shutdown, resuming"})
+			continue
+		}
+		if err := q.Repo.Delete(job.TaskId); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		q.addEvent(job.TaskId, ev.Event{Time: now, State: ev.FailedTask,
+			Error: fmt.Sprintf("interrupted by shutdown after %v attempts", job.Attempt)})
+	}
+
+	orphans, err := q.Repo.ListOrphanedTasks()
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	for _, id := range orphans {
+		q.addEvent(id, ev.Event{Time: now, State: ev.FailedTask,
+			Error: "interrupted by shutdown, cannot be resumed"})
+	}
+	if n := len(interrupted) + len(orphans); n > 0 {
+		q.Logger.Info(fmt.Sprintf("recovered %v interrupted tasks", n))
+	}
+	return nil
+}
+
+func (q *PersistentQueue) work(ctx context.Context) {
+	defer q.running.Done()
+	for {
+		select {
+		case <-q.stop:
+			return
+		default:
+		}
+
+		job, err := q.Repo.Claim(q.Clock.Now())
+		if err != nil {
+			q.Logger.Error(fmt.Errorf("claiming job: %w", err).Error())
+		}
+		if job != nil {
+			q.run(ctx, *job)
+			continue
+		}
+
+		select {
+		case <-q.stop:
+			return
+		case <-q.wake:
+		case <-q.Clock.After(q.PollInterval):
+		}
+	}
+}
+
+func (q *PersistentQueue) run(ctx context.Context, job Job) {
+	job.MaxAttempts = q.MaxAttempts
+	err := q.call(ctx, job)
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	now := q.Clock.Now()
+	switch {
+	case err == nil:
+		if err := q.Repo.Delete(job.TaskId); err != nil {
+			q.Logger.Error(fmt.Errorf("removing completed job: %w", err).Error())
+		}
+	case job.WillRetry(err):
+		delay := q.backoff(job.Attempt)
+		if err := q.Repo.Retry(job.TaskId, now.Add(delay), err.Error()); err != nil {
+			q.Logger.Error(fmt.Errorf("rescheduling job: %w", err).Error())
+		}
+		q.addEvent(job.TaskId, ev.Event{Time: now, State: ev.PendingTask,
+			Error: fmt.Sprintf("attempt %v of %v failed, retrying in %v: %v",
+				job.Attempt, job.MaxAttempts, delay, err)})
+	default:
+		if err := q.Repo.Delete(job.TaskId); err != nil {
+			q.Logger.Error(fmt.Errorf("removing failed job: %w", err).Error())
+		}
+		q.addEvent(job.TaskId, ev.Event{Time: now, State: ev.FailedTask, Error: err.Error()})
+		q.Logger.Error(err.Error())
+	}
+}
+
+func (q *PersistentQueue) call(ctx context.Context, job Job) (err error) {
+	h, ok := q.handlers[job.Type]
+	if !ok {
+		return fmt.Errorf("no handler for tasks of type %v", job.Type)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("running task %v: %v", job.TaskId, r)
+		}
+	}()
+	return h(ctx, job)
+}
+
+func (q *PersistentQueue) backoff(attempt int) time.Duration {
+	delay := q.Backoff
+	for range attempt - 1 {
+		delay *= 2
+		if delay >= q.MaxBackoff {
+			return q.MaxBackoff
+		}
+	}
+	return delay
+}
+
+func (q *PersistentQueue) addEvent(id t.TaskId, event ev.Event) {
+	if err := q.Events.AddEvent(id, event); err != nil {
+		q.Logger.Error(fmt.Errorf("logging event of task %v: %w", id, err).Error())
+	}
+}
@@ -0,0 +1,196 @@
+package job_queue
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	ta "github.com/lejeunel/go-image-annotator/entities/task"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Setup(opts ...Option) (*PersistentQueue, *FakeRepo, *FakeEventLogger) {
+	repo := &FakeRepo{}
+	events := &FakeEventLogger{}
+	logger := *slog.New(slog.NewTextHandler(io.Discard, nil))
+	return New(repo, events, logger, opts...), repo, events
+}
+
+func submitAndClaim(t *testing.T, q *PersistentQueue, repo *FakeRepo) Job {
+	job, err := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, map[string]string{"a": "b"})
+	assert.NoError(t, err)
+	assert.NoError(t, q.Submit(job))
+	claimed, err := repo.Claim(q.Clock.Now())
+	assert.NoError(t, err)
+	return *claimed
+}
+
+func TestJobPayloadRoundTrip(t *testing.T) {
+	job, err := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, map[string]string{"a": "b"})
+	assert.NoError(t, err)
+	got := map[string]string{}
+	assert.NoError(t, job.Decode(&got))
+	assert.Equal(t, "b", got["a"])
+}
+
+func TestSubmittedJobIsRunByWorker(t *testing.T) {
+	q, repo, _ := Setup(WithPollInterval(10 * time.Millisecond))
+	done := make(chan Job, 1)
+	q.Register(ta.CollectionCloneTask, func(ctx context.Context, j Job) error {
+		done <- j
+		return nil
+	})
+	assert.NoError(t, q.Start())
+	job, _ := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, nil)
+	assert.NoError(t, q.Submit(job))
+
+	select {
+	case got := <-done:
+		assert.Equal(t, job.TaskId, got.TaskId)
+		assert.Equal(t, 1, got.Attempt)
+	case <-time.After(time.Second):
+		t.Fatal("job was not run")
+	}
+	assert.NoError(t, q.Shutdown(t.Context()))
+	assert.Equal(t, 0, repo.Len())
+}
+
+func TestInternalErrorIsRetriedWithBackoff(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	q, repo, events := Setup(WithClock(clock), WithBackoff(time.Second, time.Minute))
+	q.Register(ta.CollectionCloneTask, func(context.Context, Job) error {
+		return e.ErrInternal
+	})
+	job := submitAndClaim(t, q, repo)
+	q.run(t.Context(), job)
+
+	assert.Equal(t, ev.PendingTask, events.Last(job.TaskId).State)
+	assert.Contains(t, events.Last(job.TaskId).Error, "retrying")
+	next, _ := repo.Claim(clock.Now())
+	assert.Nil(t, next)
+	next, _ = repo.Claim(clock.Now().Add(time.Second))
+	assert.Equal(t, 2, next.Attempt)
+}
+
+func TestJobFailsAfterMaxAttempts(t *testing.T) {
+	q, repo, events := Setup(WithMaxAttempts(1))
+	q.Register(ta.CollectionCloneTask, func(context.Context, Job) error {
+		return e.ErrInternal
+	})
+	job := submitAndClaim(t, q, repo)
+	q.run(t.Context(), job)
+
+	assert.Equal(t, ev.FailedTask, events.Last(job.TaskId).State)
+	assert.Equal(t, 0, repo.Len())
+}
+
+func TestNonInternalErrorIsNotRetried(t *testing.T) {
+	q, repo, events := Setup()
+	q.Register(ta.CollectionCloneTask, func(context.Context, Job) error {
+		return e.ErrValidation
+	})
+	job := submitAndClaim(t, q, repo)
+	q.run(t.Context(), job)
+
+	assert.Equal(t, ev.FailedTask, events.Last(job.TaskId).State)
+	assert.Equal(t, 0, repo.Len())
+}
+
+func TestPanickingJobFails(t *testing.T) {
+	q, repo, events := Setup()
+	q.Register(ta.CollectionCloneTask, func(context.Context, Job) error {
+		panic("boom")
+	})
+	job := submitAndClaim(t, q, repo)
+	q.run(t.Context(), job)
+
+	assert.Equal(t, ev.FailedTask, events.Last(job.TaskId).State)
+	assert.Contains(t, events.Last(job.TaskId).Error, "boom")
+}
+
+func TestJobWithoutHandlerFails(t *testing.T) {
+	q, repo, events := Setup()
+	job := submitAndClaim(t, q, repo)
+	q.run(t.Context(), job)
+	assert.Equal(t, ev.FailedTask, events.Last(job.TaskId).State)
+}
+
+func TestBackoffIsExponentialAndCapped(t *testing.T) {
+	q, _, _ := Setup(WithBackoff(time.Second, 5*time.Second))
+	assert.Equal(t, time.Second, q.backoff(1))
+	assert.Equal(t, 2*time.Second, q.backoff(2))
+	assert.Equal(t, 4*time.Second, q.backoff(3))
+	assert.Equal(t, 5*time.Second, q.backoff(4))
+}
+
+func TestRecoverResumesInterruptedJobs(t *testing.T) {
+	q, repo, events := Setup(WithMaxAttempts(2))
+	now := q.Clock.Now()
+	resumed, _ := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, nil)
+	exhausted, _ := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, nil)
+	exhausted.Attempt = 1
+	repo.Create(resumed, now)
+	repo.Create(exhausted, now)
+	repo.Claim(now)
+	repo.Claim(now)
+	orphan := ta.NewTaskId()
+	repo.Orphans = []ta.TaskId{orphan}
+
+	assert.NoError(t, q.Recover())
+
+	assert.Equal(t, ev.PendingTask, events.Last(resumed.TaskId).State)
+	assert.Equal(t, ev.FailedTask, events.Last(exhausted.TaskId).State)
+	assert.Equal(t, ev.FailedTask, events.Last(orphan).State)
+	running, _ := repo.ListRunning()
+	assert.Empty(t, running)
+	assert.Equal(t, 1, repo.Len())
+}
+
+func TestShutdownWaitsForRunningJobs(t *testing.T) {
+	q, repo, _ := Setup(WithWorkers(1), WithPollInterval(10*time.Millisecond))
+	started := make(chan struct{})
+	release := make(chan struct{})
+	q.Register(ta.CollectionCloneTask, func(context.Context, Job) error {
+		close(started)
+		<-release
+		return nil
+	})
+	assert.NoError(t, q.Start())
+	job, _ := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, nil)
+	q.Submit(job)
+	<-started
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+	}()
+	assert.NoError(t, q.Shutdown(t.Context()))
+	assert.Equal(t, 0, repo.Len())
+}
+
+func TestShutdownTimeoutLeavesJobToResume(t *testing.T) {
+	q, repo, _ := Setup(WithWorkers(1), WithPollInterval(10*time.Millisecond))
+	started := make(chan struct{})
+	q.Register(ta.CollectionCloneTask, func(ctx context.Context, _ Job) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	assert.NoError(t, q.Start())
+	job, _ := NewJob(ta.NewTaskId(), ta.CollectionCloneTask, nil)
+	q.Submit(job)
+	<-started
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, q.Shutdown(ctx), context.DeadlineExceeded)
+	assert.Eventually(t, func() bool {
+		running, _ := repo.ListRunning()
+		return len(running) == 1
+	}, time.Second, 10*time.Millisecond)
+}
@@ -1,15 +1,55 @@
 package job_queue
 
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// JobQueue runs tasks in the background. A job carries out a single task,
+// and is dispatched to the handler registered for the type of that task.
 type JobQueue interface {
-	Submit(f func())
+	Submit(Job) error
+}
+
+type Job struct {
+	TaskId      t.TaskId
+	Type        t.TaskType
+	Payload     []byte
+	Attempt     int
+	MaxAttempts int
 }
 
-type AsyncJobQueue struct{}
+type Handler func(context.Context, Job) error
+
+func NewJob(id t.TaskId, type_ t.TaskType, payload any) (Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Job{}, fmt.Errorf("serializing payload of job %v: %v: %w", id, err, e.ErrInternal)
+	}
+	return Job{TaskId: id, Type: type_, Payload: data}, nil
+}
+
+func (j Job) Decode(payload any) error {
+	if err := json.Unmarshal(j.Payload, payload); err != nil {
+		return fmt.Errorf("deserializing payload of job %v: %v: %w", j.TaskId, err, e.ErrInternal)
+	}
+	return nil
+}
 
-func NewAsyncJobQueue() AsyncJobQueue {
-	return AsyncJobQueue{}
+// Retryable tells whether a job that failed with err may succeed when run
+// again. Only internal errors, such as storage or database failures, are
+// retried: other errors would occur again.
+func Retryable(err error) bool {
+	return errors.Is(err, e.ErrInternal)
 }
 
-func (q AsyncJobQueue) Submit(f func()) {
-	go f()
+// WillRetry tells whether the queue runs the job again after it failed
+// with err.
+func (j Job) WillRetry(err error) bool {
+	return Retryable(err) && j.Attempt < j.MaxAttempts
 }
@@ -0,0 +1,115 @@
+package job_queue
+
+import (
+	"sync"
+	"time"
+
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+)
+
+type fakeRecord struct {
+	Job
+	running   bool
+	runAt     time.Time
+	lastError string
+}
+
+type FakeRepo struct {
+	mu      sync.Mutex
+	records []*fakeRecord
+	Orphans []t.TaskId
+}
+
+func (r *FakeRepo) Create(job Job, runAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.records = append(r.records, &fakeRecord{Job: job, runAt: runAt})
+	return nil
+}
+
+func (r *FakeRepo) Claim(now time.Time) (*Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rec := range r.records {
+		if !rec.running && !rec.runAt.After(now) {
+			rec.running = true
+			rec.Attempt++
+			job := rec.Job
+			return &job, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *FakeRepo) Retry(id t.TaskId, runAt time.Time, lastError string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, rec := range r.records {
+		if rec.TaskId == id {
+			rec.running = false
+			rec.runAt = runAt
+			rec.lastError = lastError
+		}
+	}
+	return nil
+}
+
+func (r *FakeRepo) Delete(id t.TaskId) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, rec := range r.records {
+		if rec.TaskId == id {
+			r.records = append(r.records[:i], r.records[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *FakeRepo) ListRunning() ([]Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	jobs := []Job{}
+	for _, rec := range r.records {
+		if rec.running {
+			jobs = append(jobs, rec.Job)
+		}
+	}
+	return jobs, nil
+}
+
+func (r *FakeRepo) ListOrphanedTasks() ([]t.TaskId, error) {
+	return r.Orphans, nil
+}
+
+func (r *FakeRepo) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.records)
+}
+
+type FakeEventLogger struct {
+	mu     sync.Mutex
+	Events map[t.TaskId][]ev.Event
+}
+
+func (l *FakeEventLogger) AddEvent(id t.TaskId, event ev.Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.Events == nil {
+		l.Events = map[t.TaskId][]ev.Event{}
+	}
+	l.Events[id] = append(l.Events[id], event)
+	return nil
+}
+
+func (l *FakeEventLogger) Last(id t.TaskId) ev.Event {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	events := l.Events[id]
+	if len(events) == 0 {
+		return ev.Event{}
+	}
+	return events[len(events)-1]
+}
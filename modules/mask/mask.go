@@ -0,0 +1,240 @@
+package mask
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const MIMEType = "image/png"
+
+// Bitmap is the decoded form of a mask, with pixels stored row by row.
+type Bitmap struct {
+	Width  int
+	Height int
+	Pix    []bool
+}
+
+func New(width, height int) Bitmap {
+	return Bitmap{Width: width, Height: height, Pix: make([]bool, width*height)}
+}
+
+func (b Bitmap) At(x, y int) bool {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return false
+	}
+	return b.Pix[y*b.Width+x]
+}
+
+func (b Bitmap) Set(x, y int, v bool) {
+	if x < 0 || y < 0 || x >= b.Width || y >= b.Height {
+		return
+	}
+	b.Pix[y*b.Width+x] = v
+}
+
+func Decode(r an.RLE) (Bitmap, error) {
+	if err := an.ValidateMask(r, r.Width, r.Height); err != nil {
+		return Bitmap{}, fmt.Errorf("decoding mask: %w", err)
+	}
+	b := New(r.Width, r.Height)
+	n, value := 0, false
+	for _, count := range r.Counts {
+		for range count {
+			b.Pix[(n%r.Height)*r.Width+n/r.Height] = value
+			n++
+		}
+		value = !value
+	}
+	return b, nil
+}
+
+func (b Bitmap) Encode() an.RLE {
+	counts := []int{}
+	run, value := 0, false
+	for x := range b.Width {
+		for y := range b.Height {
+			if b.Pix[y*b.Width+x] != value {
+				counts = append(counts, run)
+				run, value = 0, !value
+			}
+			run++
+		}
+	}
+	counts = append(counts, run)
+	return an.RLE{Width: b.Width, Height: b.Height, Counts: counts}
+}
+
+// Stroke sets to value every pixel whose centre lies within radius of the
+// path through points, as a brush (or an eraser) dragged along it would.
+func (b Bitmap) Stroke(points [][2]float32, radius float32, value bool) {
+	if len(points) == 0 {
+		return
+	}
+	r := float64(radius)
+	for n := range points {
+		p, q := points[n], points[n]
+		if n > 0 {
+			p = points[n-1]
+		}
+		x0 := math.Min(float64(p[0]), float64(q[0])) - r
+		x1 := math.Max(float64(p[0]), float64(q[0])) + r
+		y0 := math.Min(float64(p[1]), float64(q[1])) - r
+		y1 := math.Max(float64(p[1]), float64(q[1])) + r
+		for y := max(0, int(math.Floor(y0))); y <= min(b.Height-1, int(math.Ceil(y1))); y++ {
+			for x := max(0, int(math.Floor(x0))); x <= min(b.Width-1, int(math.Ceil(x1))); x++ {
+				if distance(float64(x)+0.5, float64(y)+0.5, p, q) <= r {
+					b.Pix[y*b.Width+x] = value
+				}
+			}
+		}
+	}
+}
+
+func distance(x, y float64, p, q [2]float32) float64 {
+	px, py := float64(p[0]), float64(p[1])
+	dx, dy := float64(q[0])-px, float64(q[1])-py
+	t := 0.0
+	if l := dx*dx + dy*dy; l > 0 {
+		t = math.Max(0, math.Min(1, ((x-px)*dx+(y-py)*dy)/l))
+	}
+	return math.Hypot(x-px-t*dx, y-py-t*dy)
+}
+
+// FromPolygon rasterizes a polygon with the even-odd rule: a pixel belongs
+// to the mask when its centre is inside the polygon.
+func FromPolygon(points [][2]float32, width, height int) Bitmap {
+	b := New(width, height)
+	for y := range height {
+		yc := float64(y) + 0.5
+		crossings := []float64{}
+		for n := range points {
+			p, q := points[n], points[(n+1)%len(points)]
+			py, qy := float64(p[1]), float64(q[1])
+			if (py <= yc) == (qy <= yc) {
+				continue
+			}
+			crossings = append(crossings, float64(p[0])+(yc-py)*float64(q[0]-p[0])/(qy-py))
+		}
+		sort.Float64s(crossings)
+		for n := 0; n+1 < len(crossings); n += 2 {
+			start := max(0, int(math.Ceil(crossings[n]-0.5)))
+			end := min(width-1, int(math.Ceil(crossings[n+1]-0.5))-1)
+			for x := start; x <= end; x++ {
+				b.Pix[y*width+x] = true
+			}
+		}
+	}
+	return b
+}
+
+// unit moves along the edges of pixels, towards the right, down, left and
+// up. Turning right is going to the next one.
+var unit = [4][2]int{{1, 0}, {0, 1}, {-1, 0}, {0, -1}}
+
+// ahead gives, for each direction, the offsets from a corner of the pixels
+// on the left and on the right of the edge that continues straight.
+var ahead = [4][2][2]int{
+	{{0, -1}, {0, 0}},
+	{{0, 0}, {-1, 0}},
+	{{-1, 0}, {-1, -1}},
+	{{-1, -1}, {0, -1}},
+}
+
+// Polygons follows the outer boundary of each 4-connected region of the mask
+// along the edges of its pixels. Holes have no polygon counterpart and are
+// dropped.
+func (b Bitmap) Polygons() [][][2]float32 {
+	result := [][][2]float32{}
+	seen := make([]bool, len(b.Pix))
+	for start := range b.Pix {
+		if !b.Pix[start] || seen[start] {
+			continue
+		}
+		b.fill(start, seen)
+		result = append(result, b.trace(start%b.Width, start/b.Width))
+	}
+	return result
+}
+
+func (b Bitmap) fill(start int, seen []bool) {
+	stack := []int{start}
+	seen[start] = true
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		x, y := n%b.Width, n/b.Width
+		for _, d := range unit {
+			if b.At(x+d[0], y+d[1]) && !seen[(y+d[1])*b.Width+x+d[0]] {
+				seen[(y+d[1])*b.Width+x+d[0]] = true
+				stack = append(stack, (y+d[1])*b.Width+x+d[0])
+			}
+		}
+	}
+}
+
+// trace starts at the top-left corner of the first pixel of a region, which
+// only that pixel touches, and walks with the region on its right.
+func (b Bitmap) trace(x0, y0 int) [][2]float32 {
+	vertices := [][2]float32{{float32(x0), float32(y0)}}
+	x, y, dir := x0, y0, 0
+	for {
+		left, right := ahead[dir][0], ahead[dir][1]
+		next := dir
+		if !b.At(x+right[0], y+right[1]) {
+			next = (dir + 1) % 4
+		} else if b.At(x+left[0], y+left[1]) {
+			next = (dir + 3) % 4
+		}
+		if next != dir && (x != x0 || y != y0) {
+			vertices = append(vertices, [2]float32{float32(x), float32(y)})
+		}
+		dir = next
+		x, y = x+unit[dir][0], y+unit[dir][1]
+		if x == x0 && y == y0 {
+			return vertices
+		}
+	}
+}
+
+type Layer struct {
+	Bitmap Bitmap
+	Color  color.Color
+}
+
+// Render writes the layers as a paletted PNG where index 0 is the
+// transparent background and index n the n-th layer, so that the file is
+// both viewable and usable as an instance map. Later layers are drawn over
+// earlier ones.
+func Render(w io.Writer, width, height int, layers []Layer) error {
+	errCtx := "rendering masks"
+	if len(layers) > 255 {
+		return fmt.Errorf("%v: cannot fit %v masks in a paletted image: %w",
+			errCtx, len(layers), e.ErrValidation)
+	}
+	palette := color.Palette{color.RGBA{}}
+	for _, l := range layers {
+		palette = append(palette, l.Color)
+	}
+	img := image.NewPaletted(image.Rect(0, 0, width, height), palette)
+	for n, l := range layers {
+		for y := range min(height, l.Bitmap.Height) {
+			for x := range min(width, l.Bitmap.Width) {
+				if l.Bitmap.Pix[y*l.Bitmap.Width+x] {
+					img.SetColorIndex(x, y, uint8(n+1))
+				}
+			}
+		}
+	}
+	if err := png.Encode(w, img); err != nil {
+		return fmt.Errorf("%v: %w: %w", errCtx, e.ErrInternal, err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package mask
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func bitmap(rows ...string) Bitmap {
+	b := New(len(rows[0]), len(rows))
+	for y, row := range rows {
+		for x, c := range row {
+			b.Set(x, y, c == '#')
+		}
+	}
+	return b
+}
+
+func TestEncodeIsColumnMajor(t *testing.T) {
+	b := bitmap(
+		".#",
+		"##",
+		"..",
+	)
+	rle := b.Encode()
+	assert.Equal(t, an.RLE{Width: 2, Height: 3, Counts: []int{1, 1, 1, 2, 1}}, rle)
+	assert.Equal(t, 3, rle.Area())
+}
+
+func TestEncodeStartsWithBackground(t *testing.T) {
+	rle := bitmap("##", "##").Encode()
+	assert.Equal(t, []int{0, 4}, rle.Counts)
+}
+
+func TestDecodeRoundTrip(t *testing.T) {
+	b := bitmap(
+		"#..#",
+		".##.",
+		"#..#",
+	)
+	decoded, err := Decode(b.Encode())
+	assert.NoError(t, err)
+	assert.Equal(t, b, decoded)
+}
+
+func TestDecodeRejectsWrongTotal(t *testing.T) {
+	_, err := Decode(an.RLE{Width: 2, Height: 2, Counts: []int{1, 2}})
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestDecodeRejectsOverflowingCounts(t *testing.T) {
+	_, err := Decode(an.RLE{Width: 2, Height: 2, Counts: []int{math.MaxInt, math.MaxInt, 2 + 4}})
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestBrushAndEraser(t *testing.T) {
+	b := New(5, 5)
+	b.Stroke([][2]float32{{0.5, 2.5}, {4.5, 2.5}}, 0.5, true)
+	assert.Equal(t, bitmap(
+		".....",
+		".....",
+		"#####",
+		".....",
+		".....",
+	), b)
+	b.Stroke([][2]float32{{2.5, 2.5}}, 0.5, false)
+	assert.Equal(t, bitmap(
+		".....",
+		".....",
+		"##.##",
+		".....",
+		".....",
+	), b)
+}
+
+func TestFromPolygon(t *testing.T) {
+	b := FromPolygon([][2]float32{{1, 1}, {4, 1}, {4, 3}, {1, 3}}, 5, 4)
+	assert.Equal(t, bitmap(
+		".....",
+		".###.",
+		".###.",
+		".....",
+	), b)
+}
+
+func TestPolygonsDropHoles(t *testing.T) {
+	b := bitmap(
+		"###..",
+		"#.#..",
+		"###.#",
+	)
+	polygons := b.Polygons()
+	assert.Equal(t, [][][2]float32{
+		{{0, 0}, {3, 0}, {3, 3}, {0, 3}},
+		{{4, 2}, {5, 2}, {5, 3}, {4, 3}},
+	}, polygons)
+}
+
+func TestPolygonsOfConcaveRegionRoundTrip(t *testing.T) {
+	b := bitmap(
+		"##...",
+		"##...",
+		"#####",
+		"..#..",
+	)
+	polygons := b.Polygons()
+	assert.Len(t, polygons, 1)
+	assert.Equal(t, b, FromPolygon(polygons[0], 5, 4))
+}
+
+func TestPolygonsSplitDiagonalNeighbours(t *testing.T) {
+	b := bitmap(
+		"#.",
+		".#",
+	)
+	assert.Len(t, b.Polygons(), 2)
+}
+
+func TestRender(t *testing.T) {
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	var buf bytes.Buffer
+	err := Render(&buf, 2, 1, []Layer{
+		{Bitmap: bitmap("##"), Color: red},
+		{Bitmap: bitmap(".#"), Color: blue},
+	})
+	assert.NoError(t, err)
+	img, err := png.Decode(&buf)
+	assert.NoError(t, err)
+	paletted := img.(*image.Paletted)
+	assert.Equal(t, uint8(1), paletted.ColorIndexAt(0, 0))
+	assert.Equal(t, uint8(2), paletted.ColorIndexAt(1, 0))
+}
+
+func TestRenderRejectsTooManyMasks(t *testing.T) {
+	err := Render(&bytes.Buffer{}, 1, 1, make([]Layer, 256))
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
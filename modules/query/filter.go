@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"regexp"
 
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"go.tomakado.io/dumbql"
@@ -22,6 +23,19 @@ type FilterSQLizer interface {
 type FilterParser struct {
 	Schema       schema.Schema
 	FieldRenamer *query.FieldRenamer
+	SetFields    map[string]SetField
+}
+
+// SetField is a field that takes several values per row, such as the labels
+// of the annotations of an image. A condition on such a field holds when it
+// holds for any of its values, and is rendered as an EXISTS subquery that
+// selects Column from From, restricted by Where. Nullable fields, whose
+// Column may be NULL, only exist where Column is not NULL.
+type SetField struct {
+	From     string
+	Where    string
+	Column   string
+	Nullable bool
 }
 
 type FilterParserOption func(*FilterParser)
@@ -32,6 +46,30 @@ func WithRenamer(renamer query.FieldRenamer) FilterParserOption {
 	}
 }
 
+func WithSetField(name string, f SetField) FilterParserOption {
+	return WithSetFieldPattern(regexp.QuoteMeta(name), f)
+}
+
+// WithSetFieldPattern declares set fields whose names match pattern, such
+// as attr\.(\w+). Column may refer to the groups of pattern as $1, $2...
+func WithSetFieldPattern(pattern string, f SetField) FilterParserOption {
+	return func(p *FilterParser) {
+		if p.SetFields == nil {
+			p.SetFields = map[string]SetField{}
+		}
+		p.SetFields[pattern] = f
+	}
+}
+
+// CollectionFilter restricts a filter to the images of a collection. The
+// name is quoted, as names that look like numbers would not parse as strings.
+func CollectionFilter(collection string, filter string) string {
+	if filter == "" {
+		return fmt.Sprintf("collection=%q", collection)
+	}
+	return fmt.Sprintf("collection=%q and (%v)", collection, filter)
+}
+
 func NewFilterParser(schm schema.Schema, opts ...FilterParserOption) FilterParser {
 	p := &FilterParser{Schema: schm}
 	for _, opt := range opts {
@@ -71,6 +109,46 @@ func (v FilterParser) ParseToSql(q string) (*SQLizer, error) {
 	if err != nil {
 		return nil, err
 	}
+	for pattern, f := range v.SetFields {
+		sql = f.expand(pattern, sql)
+	}
 	sqlizer := NewSQLizer(sql, args)
 	return &sqlizer, nil
 }
+
+var negatedOperators = map[string]string{
+	"<>":          "=",
+	"NOT LIKE":    "LIKE",
+	"NOT IN":      "IN",
+	"IS NULL":     "IS NOT NULL",
+	"IS NOT NULL": "IS NOT NULL",
+}
+
+// expand rewrites the conditions on fields matching pattern found in sql
+// into EXISTS subqueries. Negated conditions are rewritten as NOT EXISTS, so
+// that label!="car" selects rows without any "car" label.
+func (f SetField) expand(pattern, sql string) string {
+	re := regexp.MustCompile(`(?P<prefix>^|[\s(])(?P<field>` + pattern + `)` +
+		` (?P<op>IS NOT NULL|IS NULL|NOT LIKE|LIKE|NOT IN|IN|<>|>=|<=|=|>|<)(?P<operand> \([?,\s]*\)| \?)?`)
+	name := regexp.MustCompile(`^(?:` + pattern + `)$`)
+	return re.ReplaceAllStringFunc(sql, func(m string) string {
+		sub := re.FindStringSubmatch(m)
+		prefix, op, operand := sub[re.SubexpIndex("prefix")], sub[re.SubexpIndex("op")],
+			sub[re.SubexpIndex("operand")]
+		column := name.ReplaceAllString(sub[re.SubexpIndex("field")], f.Column)
+		exists := "EXISTS"
+		if positive, ok := negatedOperators[op]; ok {
+			if op != "IS NOT NULL" {
+				exists = "NOT EXISTS"
+			}
+			op = positive
+		}
+		cond := f.Where
+		if op != "IS NOT NULL" {
+			cond += " AND " + column + " " + op + operand
+		} else if f.Nullable {
+			cond += " AND " + column + " IS NOT NULL"
+		}
+		return fmt.Sprintf("%v%v (SELECT 1 FROM %v WHERE %v)", prefix, exists, f.From, cond)
+	})
+}
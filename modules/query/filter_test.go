@@ -52,3 +52,78 @@ func TestParseWithJSONExtractMapping(t *testing.T) {
 	assert.Equal(t, `json_extract(metadata.meta, 'name') = ?`, sql)
 	assert.Equal(t, "a-name", args[0])
 }
+
+func TestParseSetFieldToExists(t *testing.T) {
+	sb := schema.NewSchemaBuilder()
+	sb.AddField("label", schema.Is[string]())
+	sb.AddField("collection", schema.Is[string]())
+	p := NewFilterParser(sb.Build(), WithSetField("label", SetField{
+		From: "labels AS l", Where: "l.image_id=i.id", Column: "l.name"}))
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`label:"car"`, "EXISTS (SELECT 1 FROM labels AS l WHERE l.image_id=i.id AND l.name = ?)"},
+		{`label!="car"`, "NOT EXISTS (SELECT 1 FROM labels AS l WHERE l.image_id=i.id AND l.name = ?)"},
+		{`label:["a","b"]`, "EXISTS (SELECT 1 FROM labels AS l WHERE l.image_id=i.id AND l.name IN (?,?))"},
+		{`label?`, "EXISTS (SELECT 1 FROM labels AS l WHERE l.image_id=i.id)"},
+		{`collection:"label" and label~"ca"`,
+			"(collection = ? AND EXISTS (SELECT 1 FROM labels AS l WHERE l.image_id=i.id AND l.name LIKE ?))"},
+	}
+	for _, tt := range tests {
+		sqlizer, err := p.ParseToSql(tt.query)
+		assert.NoError(t, err)
+		sql, _, err := sqlizer.ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, sql, tt.query)
+	}
+}
+
+func TestParseSetFieldPattern(t *testing.T) {
+	sb := schema.NewSchemaBuilder()
+	sb.AddRegExpField(`^attr\..*$`, schema.Any(schema.Is[bool](), schema.Is[string]()))
+	p := NewFilterParser(sb.Build(), WithSetFieldPattern(`attr\.(\w+)`, SetField{
+		From: "annotations AS a", Where: "a.image_id=i.id",
+		Column: "json_extract(a.attributes, '$$.${1}')", Nullable: true}))
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`attr.occluded:true`,
+			"EXISTS (SELECT 1 FROM annotations AS a WHERE a.image_id=i.id AND json_extract(a.attributes, '$.occluded') = ?)"},
+		{`attr.color!="red"`,
+			"NOT EXISTS (SELECT 1 FROM annotations AS a WHERE a.image_id=i.id AND json_extract(a.attributes, '$.color') = ?)"},
+		{`attr.plate?`,
+			"EXISTS (SELECT 1 FROM annotations AS a WHERE a.image_id=i.id AND json_extract(a.attributes, '$.plate') IS NOT NULL)"},
+	}
+	for _, tt := range tests {
+		sqlizer, err := p.ParseToSql(tt.query)
+		assert.NoError(t, err)
+		sql, _, err := sqlizer.ToSql()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, sql, tt.query)
+	}
+}
+
+func TestCollectionFilter(t *testing.T) {
+	assert.Equal(t, `collection="a-collection"`, CollectionFilter("a-collection", ""))
+	assert.Equal(t, `collection="2024-set" and (width>10)`, CollectionFilter("2024-set", "width>10"))
+}
+
+func TestCollectionFilterParses(t *testing.T) {
+	sb := schema.NewSchemaBuilder()
+	sb.AddField("collection", schema.Is[string]())
+	sb.AddField("width", schema.Is[int64]())
+	parser := NewFilterParser(sb.Build())
+	for _, name := range []string{"a-collection", "2024-set", "123"} {
+		for _, filter := range []string{"", "width>10"} {
+			sqlizer, err := parser.ParseToSql(CollectionFilter(name, filter))
+			assert.NoError(t, err, name)
+			_, args, err := sqlizer.ToSql()
+			assert.NoError(t, err, name)
+			assert.Equal(t, name, args[0], name)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package taxonomy
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const MaxAliasLength = 100
+
+type Repo interface {
+	// Canonical gives the name of the label that name or alias refers to,
+	// or nil when there is none.
+	Canonical(string) (*string, error)
+	// Lineage gives the names of a label and of its ancestors, starting
+	// with the label itself.
+	Lineage(string) ([]string, error)
+}
+
+// Parent resolves the parent given to label name, which may be designated
+// by one of its aliases, and checks that it would not make the label an
+// ancestor of itself.
+func Parent(r Repo, name string, parent *string) (*string, error) {
+	if parent == nil || *parent == "" {
+		return nil, nil
+	}
+	errCtx := fmt.Errorf("checking parent %v of label %v", *parent, name)
+	canonical, err := r.Canonical(*parent)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+	if canonical == nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, e.ErrNotFound)
+	}
+	lineage, err := r.Lineage(*canonical)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errCtx, err)
+	}
+	if slices.Contains(lineage, name) {
+		return nil, fmt.Errorf("%w: label would be its own ancestor: %w", errCtx, e.ErrValidation)
+	}
+	return canonical, nil
+}
+
+// Aliases trims and deduplicates the aliases of label name, and checks that
+// none of them already designates another label.
+func Aliases(r Repo, name string, aliases []string) ([]string, error) {
+	result := []string{}
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" || slices.Contains(result, alias) {
+			continue
+		}
+		errCtx := fmt.Errorf("checking alias %q of label %v", alias, name)
+		if alias == name {
+			return nil, fmt.Errorf("%w: alias is the name of the label: %w", errCtx, e.ErrValidation)
+		}
+		if len(alias) > MaxAliasLength {
+			return nil, fmt.Errorf("%w: longer than %v characters: %w", errCtx, MaxAliasLength, e.ErrValidation)
+		}
+		canonical, err := r.Canonical(alias)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		if canonical != nil && *canonical != name {
+			return nil, fmt.Errorf("%w: already designates label %v: %w", errCtx, *canonical, e.ErrDuplicate)
+		}
+		result = append(result, alias)
+	}
+	return result, nil
+}
+
+// Color checks that c is empty or of the form #rrggbb.
+func Color(c string) error {
+	if !lbl.IsValidColor(c) {
+		return fmt.Errorf("checking color %q: expected #rrggbb: %w", c, e.ErrValidation)
+	}
+	return nil
+}
+
+// Tree arranges labels by parent. Labels whose parent is not among labels
+// are roots. Siblings are sorted by name.
+func Tree(labels []lbl.Label) []lbl.Node {
+	names := map[string]bool{}
+	children := map[string][]lbl.Label{}
+	for _, l := range labels {
+		names[l.Name] = true
+	}
+	roots := []lbl.Label{}
+	for _, l := range labels {
+		if l.Parent != nil && names[*l.Parent] && *l.Parent != l.Name {
+			children[*l.Parent] = append(children[*l.Parent], l)
+			continue
+		}
+		roots = append(roots, l)
+	}
+	return nodes(roots, children, map[string]bool{})
+}
+
+func nodes(labels []lbl.Label, children map[string][]lbl.Label, seen map[string]bool) []lbl.Node {
+	slices.SortFunc(labels, func(a, b lbl.Label) int { return strings.Compare(a.Name, b.Name) })
+	result := []lbl.Node{}
+	for _, l := range labels {
+		if seen[l.Name] {
+			continue
+		}
+		seen[l.Name] = true
+		result = append(result, lbl.Node{Label: l, Children: nodes(children[l.Name], children, seen)})
+	}
+	return result
+}
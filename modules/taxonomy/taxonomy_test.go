@@ -0,0 +1,100 @@
+package taxonomy
+
+import (
+	"testing"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func vehicles() *fk.LabelRepo {
+	return &fk.LabelRepo{
+		ExistingNames: []string{"vehicle", "car", "taxi"},
+		Parents:       map[string]string{"car": "vehicle", "taxi": "car"},
+		Aliases:       map[string]string{"automobile": "car"},
+	}
+}
+
+func TestParentResolvesAlias(t *testing.T) {
+	parent := "automobile"
+	got, err := Parent(vehicles(), "sedan", &parent)
+	assert.NoError(t, err)
+	assert.Equal(t, "car", *got)
+}
+
+func TestEmptyParentIsNoParent(t *testing.T) {
+	empty := ""
+	got, err := Parent(vehicles(), "car", &empty)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestUnknownParentShouldFail(t *testing.T) {
+	parent := "plane"
+	_, err := Parent(vehicles(), "sedan", &parent)
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
+
+func TestParentCycleShouldFail(t *testing.T) {
+	parent := "taxi"
+	_, err := Parent(vehicles(), "vehicle", &parent)
+	assert.ErrorIs(t, err, e.ErrValidation)
+
+	self := "car"
+	_, err = Parent(vehicles(), "car", &self)
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestAliasesAreTrimmedAndDeduplicated(t *testing.T) {
+	got, err := Aliases(vehicles(), "car", []string{" automobile", "Car ", "automobile", ""})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"automobile", "Car"}, got)
+}
+
+func TestAliasOfAnotherLabelShouldFail(t *testing.T) {
+	_, err := Aliases(vehicles(), "taxi", []string{"automobile"})
+	assert.ErrorIs(t, err, e.ErrDuplicate)
+
+	_, err = Aliases(vehicles(), "taxi", []string{"vehicle"})
+	assert.ErrorIs(t, err, e.ErrDuplicate)
+}
+
+func TestAliasEqualToNameShouldFail(t *testing.T) {
+	_, err := Aliases(vehicles(), "taxi", []string{"taxi"})
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestColor(t *testing.T) {
+	assert.NoError(t, Color(""))
+	assert.NoError(t, Color("#FF71ce"))
+	assert.ErrorIs(t, Color("red"), e.ErrValidation)
+	assert.ErrorIs(t, Color("#ff71c"), e.ErrValidation)
+}
+
+func TestTree(t *testing.T) {
+	vehicle, car := "vehicle", "car"
+	labels := []lbl.Label{
+		lbl.NewLabel(lbl.NewLabelId(), "taxi", lbl.WithParent(&car)),
+		lbl.NewLabel(lbl.NewLabelId(), "person"),
+		lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithParent(&vehicle)),
+		lbl.NewLabel(lbl.NewLabelId(), "bus", lbl.WithParent(&vehicle)),
+		lbl.NewLabel(lbl.NewLabelId(), "vehicle"),
+	}
+	roots := Tree(labels)
+	assert.Len(t, roots, 2)
+	assert.Equal(t, "person", roots[0].Name)
+	assert.Equal(t, "vehicle", roots[1].Name)
+	assert.Len(t, roots[1].Children, 2)
+	assert.Equal(t, "bus", roots[1].Children[0].Name)
+	assert.Equal(t, "car", roots[1].Children[1].Name)
+	assert.Equal(t, "taxi", roots[1].Children[1].Children[0].Name)
+}
+
+func TestTreeWithMissingParentMakesRoot(t *testing.T) {
+	missing := "missing"
+	roots := Tree([]lbl.Label{lbl.NewLabel(lbl.NewLabelId(), "orphan", lbl.WithParent(&missing))})
+	assert.Len(t, roots, 1)
+	assert.Equal(t, "orphan", roots[0].Name)
+}
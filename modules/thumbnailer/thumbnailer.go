@@ -0,0 +1,143 @@
+package thumbnailer
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"io"
+	"slices"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+// Sizes are the allowed lengths, in pixels, of the longest side of
+// thumbnails.
+var Sizes = []int{128, 256, 512, 1024}
+
+const MIMEType = "image/jpeg"
+
+type Store interface {
+	Store(string, io.Reader) error
+	GetReaderAt(string) (io.ReaderAt, int64, error)
+}
+
+// Thumbnailer generates downscaled JPEG derivatives of images and stores
+// them next to the originals, keyed by the hash of the original and size.
+type Thumbnailer struct {
+	Files   Store
+	Quality int
+}
+
+type Option func(*Thumbnailer)
+
+func WithQuality(q int) Option {
+	return func(t *Thumbnailer) {
+		t.Quality = q
+	}
+}
+
+func New(s Store, opts ...Option) Thumbnailer {
+	t := &Thumbnailer{Files: s, Quality: jpeg.DefaultQuality}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return *t
+}
+
+func FileName(hash string, size int) string {
+	return fmt.Sprintf("%v_%v.jpg", hash, size)
+}
+
+func ValidateSize(size int) error {
+	if !slices.Contains(Sizes, size) {
+		return fmt.Errorf("thumbnail size must be one of %v, got %v: %w", Sizes, size, e.ErrValidation)
+	}
+	return nil
+}
+
+func (t Thumbnailer) GetReaderAt(hash string, size int) (io.ReaderAt, int64, error) {
+	return t.Files.GetReaderAt(FileName(hash, size))
+}
+
+func (t Thumbnailer) Generate(hash string, src io.Reader, size int) error {
+	errCtx := fmt.Errorf("generating thumbnail of size %v", size)
+	if err := ValidateSize(size); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	img, _, err := image.Decode(src)
+	if err != nil {
+		return fmt.Errorf("%w: decoding image: %v: %w", errCtx, err, e.ErrValidation)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := jpeg.Encode(buf, Resize(img, size), &jpeg.Options{Quality: t.Quality}); err != nil {
+		return fmt.Errorf("%w: encoding image: %v: %w", errCtx, err, e.ErrInternal)
+	}
+	if err := t.Files.Store(FileName(hash, size), buf); err != nil {
+		return fmt.Errorf("%w: storing image: %w", errCtx, err)
+	}
+	return nil
+}
+
+// Resize downscales src so that its longest side fits in size, preserving
+// its aspect ratio. Each destination pixel is the average of the source
+// pixels it covers. Images are never upscaled, and transparent areas are
+// flattened onto white.
+func Resize(src image.Image, size int) *image.RGBA {
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if max(w, h) > size {
+		if w >= h {
+			dw, dh = size, max(1, h*size/w)
+		} else {
+			dw, dh = max(1, w*size/h), size
+		}
+	}
+
+	averaged := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	sums := make([]uint64, dw*4)
+	counts := make([]uint64, dw)
+	flush := func(dy int) {
+		for dx := range dw {
+			n := counts[dx]
+			if n == 0 {
+				continue
+			}
+			i := averaged.PixOffset(dx, dy)
+			for c := range 4 {
+				averaged.Pix[i+c] = uint8((sums[dx*4+c] / n) >> 8)
+				sums[dx*4+c] = 0
+			}
+			counts[dx] = 0
+		}
+	}
+
+	row := 0
+	for y := range h {
+		dy := y * dh / h
+		if dy != row {
+			flush(row)
+			row = dy
+		}
+		for x := range w {
+			dx := x * dw / w
+			r, g, bl, a := src.At(b.Min.X+x, b.Min.Y+y).RGBA()
+			sums[dx*4] += uint64(r)
+			sums[dx*4+1] += uint64(g)
+			sums[dx*4+2] += uint64(bl)
+			sums[dx*4+3] += uint64(a)
+			counts[dx]++
+		}
+	}
+	flush(row)
+
+	dst := image.NewRGBA(averaged.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), averaged, image.Point{}, draw.Over)
+	return dst
+}
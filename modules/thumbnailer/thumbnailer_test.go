@@ -0,0 +1,77 @@
+package thumbnailer
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func filled(w, h int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestResizeKeepsAspectRatio(t *testing.T) {
+	assert.Equal(t, image.Rect(0, 0, 128, 42), Resize(filled(300, 100, color.Black), 128).Bounds())
+	assert.Equal(t, image.Rect(0, 0, 42, 128), Resize(filled(100, 300, color.Black), 128).Bounds())
+}
+
+func TestResizeNeverUpscales(t *testing.T) {
+	assert.Equal(t, image.Rect(0, 0, 20, 10), Resize(filled(20, 10, color.Black), 128).Bounds())
+}
+
+func TestResizeAveragesPixels(t *testing.T) {
+	src := filled(4, 2, color.Black)
+	src.Set(1, 0, color.White)
+	src.Set(0, 1, color.White)
+	got := Resize(src, 2)
+	assert.Equal(t, color.RGBA{127, 127, 127, 255}, got.RGBAAt(0, 0))
+	assert.Equal(t, color.RGBA{0, 0, 0, 255}, got.RGBAAt(1, 0))
+}
+
+func TestResizeFlattensTransparencyOntoWhite(t *testing.T) {
+	got := Resize(filled(2, 2, color.Transparent), 2)
+	assert.Equal(t, color.RGBA{255, 255, 255, 255}, got.RGBAAt(0, 0))
+}
+
+func TestGenerate(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, filled(600, 300, color.White)))
+	store := &fk.FileStore{}
+
+	assert.NoError(t, New(store).Generate("cafe", buf, 256))
+
+	got, err := jpeg.DecodeConfig(bytes.NewReader(store.GotData))
+	assert.NoError(t, err)
+	assert.Equal(t, 256, got.Width)
+	assert.Equal(t, 128, got.Height)
+}
+
+func TestGenerateInvalidSize(t *testing.T) {
+	err := New(&fk.FileStore{}).Generate("cafe", &bytes.Buffer{}, 100)
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestGenerateFromInvalidImage(t *testing.T) {
+	err := New(&fk.FileStore{}).Generate("cafe", bytes.NewBufferString("not-an-image"), 128)
+	assert.ErrorIs(t, err, e.ErrValidation)
+}
+
+func TestGenerateHandlesStoreError(t *testing.T) {
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, filled(10, 10, color.White)))
+	err := New(&fk.FileStore{ErrOnStore: e.ErrInternal}).Generate("cafe", buf, 128)
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
@@ -0,0 +1,137 @@
+package visibility
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Auth interface {
+	ReadImage(ctx context.Context, group string) error
+}
+
+type ReviewAuth interface {
+	Review(ctx context.Context, group string) error
+}
+
+type CollectionLister interface {
+	List(pa.PaginationParams) ([]*clc.Collection, error)
+}
+
+type ViewFinder interface {
+	FindView(vw.ViewId) (*vw.View, error)
+}
+
+const pageSize = 100
+
+// CanRead checks that images of a collection of group may be read. Images of
+// collections that belong to no group are readable by everyone.
+func CanRead(ctx context.Context, a Auth, group *string) error {
+	if group == nil {
+		return nil
+	}
+	return a.ReadImage(ctx, *group)
+}
+
+// CanReadAny checks that at least one of the collections, given by their
+// groups, that hold an image may be read.
+func CanReadAny(ctx context.Context, a Auth, groups []*string) error {
+	err := fmt.Errorf("image belongs to no collection: %w", e.ErrAuthorization)
+	for _, group := range groups {
+		if err = CanRead(ctx, a, group); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// Collections lists the names of collections whose images may be read. all
+// is set when every collection is readable, in which case no restriction
+// needs to be applied.
+func Collections(ctx context.Context, a Auth, l CollectionLister) (names []string, all bool, err error) {
+	return collectionsWhere(l, func(group *string) error { return CanRead(ctx, a, group) })
+}
+
+// Reviewable lists the names of collections whose annotations may be
+// reviewed, as Collections does for reading. Annotations of collections
+// that belong to no group are reviewable by everyone.
+func Reviewable(ctx context.Context, a ReviewAuth, l CollectionLister) (names []string, all bool, err error) {
+	return collectionsWhere(l, func(group *string) error {
+		if group == nil {
+			return nil
+		}
+		return a.Review(ctx, *group)
+	})
+}
+
+func collectionsWhere(l CollectionLister, allowed func(group *string) error) (names []string, all bool, err error) {
+	all = true
+	for page := int64(1); ; page++ {
+		collections, err := l.List(pa.PaginationParams{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, false, fmt.Errorf("listing collections: %w", err)
+		}
+		for _, c := range collections {
+			if allowed(c.Group) == nil {
+				names = append(names, c.Name)
+			} else {
+				all = false
+			}
+		}
+		if len(collections) < pageSize {
+			return names, all, nil
+		}
+	}
+}
+
+// Restrict narrows filter to images of the readable collections. ok is
+// false when no collection is readable, as no image can match.
+func Restrict(ctx context.Context, a Auth, l CollectionLister, filter im.FilterStr) (im.FilterStr, bool, error) {
+	names, all, err := Collections(ctx, a, l)
+	if err != nil {
+		return "", false, err
+	}
+	if all {
+		return filter, true, nil
+	}
+	if len(names) == 0 {
+		return "", false, nil
+	}
+
+	clauses := []string{}
+	for _, name := range names {
+		clauses = append(clauses, fmt.Sprintf("collection=%q", name))
+	}
+	restriction := strings.Join(clauses, " or ")
+	if filter == "" {
+		return im.FilterStr(restriction), true, nil
+	}
+	return im.FilterStr(fmt.Sprintf("(%v) and (%v)", filter, restriction)), true, nil
+}
+
+// View fetches the saved view of a given id, provided the caller may open it.
+func View(ctx context.Context, f ViewFinder, id string) (*vw.View, error) {
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		return nil, fmt.Errorf("fetching user identity: %w", e.ErrAuthentication)
+	}
+	viewId, err := vw.NewViewIdFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	view, err := f.FindView(*viewId)
+	if err != nil {
+		return nil, err
+	}
+	if !view.IsVisibleTo(*user) {
+		return nil, fmt.Errorf("view %v is not shared with user %v: %w", id, user.Id, e.ErrAuthorization)
+	}
+	return view, nil
+}
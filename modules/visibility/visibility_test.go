@@ -0,0 +1,136 @@
+package visibility
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type groupAuth struct {
+	groups []string
+}
+
+func (a groupAuth) ReadImage(ctx context.Context, group string) error {
+	for _, g := range a.groups {
+		if g == group {
+			return nil
+		}
+	}
+	return e.ErrAuthorization
+}
+
+func (a groupAuth) Review(ctx context.Context, group string) error {
+	return a.ReadImage(ctx, group)
+}
+
+func collections() *fk.CollectionRepo {
+	return &fk.CollectionRepo{ReturnList: []clc.Collection{
+		clc.NewCollection(clc.NewCollectionId(), "public"),
+		clc.NewCollection(clc.NewCollectionId(), "mine", clc.WithGroup("my-group")),
+		clc.NewCollection(clc.NewCollectionId(), "theirs", clc.WithGroup("their-group")),
+	}}
+}
+
+func TestCanReadCollectionWithoutGroup(t *testing.T) {
+	assert.NoError(t, CanRead(t.Context(), fk.Auth{Err: e.ErrAuthorization}, nil))
+}
+
+func TestCanReadAny(t *testing.T) {
+	mine, theirs := "my-group", "their-group"
+	a := groupAuth{[]string{mine}}
+	assert.NoError(t, CanReadAny(t.Context(), a, []*string{&theirs, &mine}))
+	assert.ErrorIs(t, CanReadAny(t.Context(), a, []*string{&theirs}), e.ErrAuthorization)
+	assert.ErrorIs(t, CanReadAny(t.Context(), a, nil), e.ErrAuthorization)
+}
+
+func TestCollections(t *testing.T) {
+	names, all, err := Collections(t.Context(), groupAuth{[]string{"my-group"}}, collections())
+	assert.NoError(t, err)
+	assert.False(t, all)
+	assert.Equal(t, []string{"public", "mine"}, names)
+}
+
+func TestReviewable(t *testing.T) {
+	names, all, err := Reviewable(t.Context(), groupAuth{[]string{"their-group"}}, collections())
+	assert.NoError(t, err)
+	assert.False(t, all)
+	assert.Equal(t, []string{"public", "theirs"}, names)
+}
+
+func TestCollectionsSpanningSeveralPages(t *testing.T) {
+	repo := &fk.CollectionRepo{}
+	for i := range 2*pageSize + 1 {
+		repo.ReturnList = append(repo.ReturnList,
+			clc.NewCollection(clc.NewCollectionId(), fmt.Sprintf("c-%v", i)))
+	}
+	names, all, err := Collections(t.Context(), fk.Auth{}, repo)
+	assert.NoError(t, err)
+	assert.True(t, all)
+	assert.Len(t, names, 2*pageSize+1)
+}
+
+func TestRestrictLeavesFilterWhenAllCollectionsAreReadable(t *testing.T) {
+	f, ok, err := Restrict(t.Context(), fk.Auth{}, collections(), "meta.site:lab")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "meta.site:lab", string(f))
+}
+
+func TestRestrictToReadableCollections(t *testing.T) {
+	f, ok, err := Restrict(t.Context(), groupAuth{[]string{"my-group"}}, collections(), "meta.site:lab")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, `(meta.site:lab) and (collection="public" or collection="mine")`, string(f))
+
+	f, _, _ = Restrict(t.Context(), groupAuth{[]string{"my-group"}}, collections(), "")
+	assert.Equal(t, `collection="public" or collection="mine"`, string(f))
+}
+
+func TestRestrictWithoutReadableCollections(t *testing.T) {
+	repo := &fk.CollectionRepo{ReturnList: collections().ReturnList[2:]}
+	_, ok, err := Restrict(t.Context(), groupAuth{}, repo, "")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRestrictHandlesListError(t *testing.T) {
+	_, _, err := Restrict(t.Context(), fk.Auth{}, &fk.CollectionRepo{ErrOnList: e.ErrInternal}, "")
+	assert.ErrorIs(t, err, e.ErrInternal)
+}
+
+func TestViewIsOpenedByOwnerAndSharedGroups(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "owner@mail.com", vw.WithGroups([]string{"my-group"}))
+	repo := &fk.ViewRepo{Views: []vw.View{view}}
+
+	for _, user := range []u.User{
+		u.NewUser("owner@mail.com"),
+		u.NewUser("member@mail.com", u.WithGroups([]string{"my-group"})),
+		u.NewUser("admin@mail.com", u.WithRoles([]string{"admin"})),
+	} {
+		got, err := View(u.AppendUserToContext(t.Context(), user), repo, view.Id.String())
+		assert.NoError(t, err, user.Id)
+		assert.Equal(t, view.Id, got.Id)
+	}
+
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("other@mail.com", u.WithGroups([]string{"their-group"})))
+	_, err := View(ctx, repo, view.Id.String())
+	assert.ErrorIs(t, err, e.ErrAuthorization)
+}
+
+func TestViewRequiresIdentityAndValidId(t *testing.T) {
+	_, err := View(t.Context(), &fk.ViewRepo{}, vw.NewViewId().String())
+	assert.ErrorIs(t, err, e.ErrAuthentication)
+
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("owner@mail.com"))
+	_, err = View(ctx, &fk.ViewRepo{}, "not-an-id")
+	assert.ErrorIs(t, err, e.ErrValidation)
+	_, err = View(ctx, &fk.ViewRepo{}, vw.NewViewId().String())
+	assert.ErrorIs(t, err, e.ErrNotFound)
+}
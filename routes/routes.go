@@ -26,15 +26,21 @@ const (
 	CollectionsUrl = "/collections"
 	ImagesUrl      = "/images"
 	LabelsUrl      = "/labels"
+	ViewsUrl       = "/views"
+	ReviewsUrl     = "/reviews"
 	DashboardUrl   = "/dashboard"
 
-	AdminUrl         = "/admin"
-	AdminUsersUrl    = "/admin/users"
-	AdminGroupsUrl   = "/admin/groups"
-	AdminRolesUrl    = "/admin/roles"
-	AdminPoliciesUrl = "/admin/policies"
+	AdminUrl            = "/admin"
+	AdminUsersUrl       = "/admin/users"
+	AdminGroupsUrl      = "/admin/groups"
+	AdminRolesUrl       = "/admin/roles"
+	AdminPoliciesUrl    = "/admin/policies"
+	AdminAssignmentsUrl = "/admin/assignments"
+	AdminAuditUrl       = "/admin/audit"
 
-	ListTasksUrl = "/dashboard/logs"
+	ListTasksUrl      = "/dashboard/logs"
+	MyAssignmentsUrl  = "/dashboard/assignments"
+	NextAssignmentUrl = "/annotate/next"
 
 	SliceUrl = "/slice"
 
@@ -42,6 +48,8 @@ const (
 	OrderingQueryArgName = "ordering"
 	CollectionArgName    = "collection"
 	ImageIdArgName       = "id"
+	ViewArgName          = "view"
+	AssignedArgName      = "assigned"
 )
 
 func MakeOAuthCallbackURL(baseURL string, provider string) string {
@@ -1,6 +1,9 @@
 package server
 
 import (
+	"time"
+
+	"github.com/lejeunel/go-image-annotator/config"
 	"github.com/spf13/cobra"
 )
 
@@ -10,8 +13,9 @@ var (
 		Use:   "serve",
 		Short: "Run server",
 		Run: func(cmd *cobra.Command, args []string) {
-			handler := Make(port)
-			Serve(handler, port)
+			handler, app := Make(port)
+			drainTimeout := time.Duration(config.Parse().JobDrainTimeoutSeconds) * time.Second
+			Serve(handler, port, app.Jobs, drainTimeout)
 		},
 	}
 )
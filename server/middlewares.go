@@ -3,6 +3,8 @@ package server
 import (
 	"net/http"
 
+	"github.com/google/uuid"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	rt "github.com/lejeunel/go-image-annotator/routes"
 )
@@ -44,3 +46,19 @@ func WebRequireLogin(next http.Handler) http.Handler {
 		next.ServeHTTP(w, r)
 	})
 }
+
+// RequestId tags each request with an identifier, taken from the
+// X-Request-Id header when given, so that entries of the audit log can be
+// traced back to requests. The identifier is echoed in the response.
+func RequestId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIdHeader)
+		if id == "" || len(id) > 60 {
+			id = uuid.NewString()
+		}
+		w.Header().Set(RequestIdHeader, id)
+		next.ServeHTTP(w, r.WithContext(au.AppendRequestIdToContext(r.Context(), id)))
+	})
+}
+
+const RequestIdHeader = "X-Request-Id"
@@ -1,10 +1,15 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	api "github.com/lejeunel/go-image-annotator/adapters/api/server"
 	userDashboard "github.com/lejeunel/go-image-annotator/adapters/web/dashboard"
@@ -12,6 +17,8 @@ import (
 	rt "github.com/lejeunel/go-image-annotator/routes"
 
 	adm "github.com/lejeunel/go-image-annotator/adapters/web/admin"
+	admas "github.com/lejeunel/go-image-annotator/adapters/web/admin/assignment"
+	admau "github.com/lejeunel/go-image-annotator/adapters/web/admin/audit"
 	admgrp "github.com/lejeunel/go-image-annotator/adapters/web/admin/group"
 	admpl "github.com/lejeunel/go-image-annotator/adapters/web/admin/policy"
 	admrl "github.com/lejeunel/go-image-annotator/adapters/web/admin/role"
@@ -22,6 +29,8 @@ import (
 	clc "github.com/lejeunel/go-image-annotator/adapters/web/collection"
 	im "github.com/lejeunel/go-image-annotator/adapters/web/image"
 	lbl "github.com/lejeunel/go-image-annotator/adapters/web/label"
+	rv "github.com/lejeunel/go-image-annotator/adapters/web/review"
+	vw "github.com/lejeunel/go-image-annotator/adapters/web/view"
 	a "github.com/lejeunel/go-image-annotator/app"
 	"github.com/lejeunel/go-image-annotator/app/sqlite"
 	"github.com/lejeunel/go-image-annotator/config"
@@ -30,7 +39,7 @@ import (
 	"github.com/go-chi/chi/v5"
 )
 
-func Make(port int) http.Handler {
+func Make(port int) (http.Handler, a.App) {
 	cfg := config.Parse()
 	defaultAuth := auth.NewDefault()
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
@@ -49,6 +58,7 @@ func Make(port int) http.Handler {
 	)
 
 	router := chi.NewRouter()
+	router.Use(RequestId)
 	webAuth := Chain(
 		app.SessionManager.LoadAndSave,
 		app.SessionManager.AuthCookiesMiddleWare,
@@ -64,7 +74,8 @@ func Make(port int) http.Handler {
 	RouteWebPages(router, HomePageHandlerFunc(pageBuilder), webAuth)
 
 	udb := userDashboard.New(pageBuilder, cfg.DefaultPageSize, app.Itrs.User.RenewToken,
-		app.Itrs.User.ChangePassword, app.Itrs.Log.ListTasks, app.Itrs.Log.FindTask)
+		app.Itrs.User.ChangePassword, app.Itrs.Log.ListTasks, app.Itrs.Log.FindTask, app.Itrs.Assignment.Mine,
+		app.Itrs.Evaluation.List, app.Itrs.Evaluation.Find)
 	udb.Route(router, webAuth)
 
 	RouteAPI(router, *api.NewServer(&app.Itrs, *logger), apiAuth)
@@ -72,7 +83,11 @@ func Make(port int) http.Handler {
 	RouteAPISpecs(router)
 	RouteStaticFiles(router)
 
-	annotatorServer := an.NewServer(app.Annotator, pageBuilder, app.SessionManager)
+	annotatorServer := an.NewServer(app.Annotator, pageBuilder, app.SessionManager, app.Itrs.Review.Submit,
+		app.Itrs.Assignment.Next, app.Itrs.Assignment.Finish,
+		app.Itrs.Annotation.History, app.Itrs.Annotation.Revert, app.Itrs.Annotation.AcceptPrediction,
+		app.Itrs.Annotation.SetAttributes, app.Itrs.Annotation.AddKeypoints, app.Itrs.Annotation.UpdateKeypoints,
+		app.Itrs.Label.Find, app.Itrs.Annotation.AddPolyline, app.Itrs.Annotation.UpdatePolyline)
 	annotatorServer.Route(router, webAuth)
 
 	collectionServer := clc.New(pageBuilder, cfg.DefaultPageSize,
@@ -106,12 +121,22 @@ func Make(port int) http.Handler {
 	adminRoleServer.Route(router, webAuth)
 	adminPolicyServer := admpl.New(adminPageBuilder, app.Itrs.Policy)
 	adminPolicyServer.Route(router, webAuth)
+	adminAssignmentServer := admas.New(adminPageBuilder, app.Itrs.Assignment, cfg.DefaultPageSize)
+	adminAssignmentServer.Route(router, webAuth)
+	adminAuditServer := admau.New(adminPageBuilder, app.Itrs.Audit, cfg.DefaultPageSize)
+	adminAuditServer.Route(router, webAuth)
 
 	labelServer := lbl.New(pageBuilder, cfg.DefaultPageSize,
 		app.Itrs.Label.Create, app.Itrs.Label.List, app.Itrs.Label.Update,
-		app.Itrs.Label.Delete, app.Itrs.Label.Find)
+		app.Itrs.Label.Delete, app.Itrs.Label.Find, app.Itrs.Label.Tree)
 	labelServer.Route(router, webAuth)
 
+	viewServer := vw.New(pageBuilder, cfg.DefaultPageSize, app.Itrs.View)
+	viewServer.Route(router, webAuth)
+
+	reviewServer := rv.New(pageBuilder, cfg.DefaultPageSize, app.Itrs.Review)
+	reviewServer.Route(router, webAuth)
+
 	notifier := wauth.MakeNotifierFromEnv(*logger)
 	authServer := wauth.New(
 		fmt.Sprintf("%v:%v", cfg.URL, port),
@@ -124,10 +149,37 @@ func Make(port int) http.Handler {
 	authServer.Route(router,
 		app.SessionManager.LoadAndSave)
 
-	return router
+	return router, app
 }
 
-func Serve(handler http.Handler, port int) {
-	fmt.Println("serving on port:", port)
-	http.ListenAndServe(fmt.Sprintf(":%v", port), handler)
+// Serve runs the background jobs and serves handler until interrupted.
+// Running jobs are then given drainTimeout to complete, and are otherwise
+// resumed on next start.
+func Serve(handler http.Handler, port int, jobs a.JobRunner, drainTimeout time.Duration) {
+	if err := jobs.Start(); err != nil {
+		fmt.Println("starting job queue:", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	srv := &http.Server{Addr: fmt.Sprintf(":%v", port), Handler: handler}
+	go func() {
+		fmt.Println("serving on port:", port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Println(err)
+			stop()
+		}
+	}()
+	<-ctx.Done()
+
+	fmt.Println("shutting down")
+	drainCtx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	if err := srv.Shutdown(drainCtx); err != nil {
+		fmt.Println("shutting down server:", err)
+	}
+	if err := jobs.Shutdown(drainCtx); err != nil {
+		fmt.Println("shutting down job queue:", err)
+	}
 }
@@ -0,0 +1,152 @@
+package analyze
+
+import (
+	"testing"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	evt "github.com/lejeunel/go-image-annotator/entities/event"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	"github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	agr "github.com/lejeunel/go-image-annotator/modules/agreement"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func cat() an.BoundingBox {
+	return an.NewBoundingBox(an.NewAnnotationId(), 5, 5, 4, 4, lbl.Label{Name: "cat"})
+}
+
+func TestSubmitTaskWithoutIdentity(t *testing.T) {
+	itr := NewTestingAnalyzer()
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collections: []string{"a", "b"}}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestInvalidRequests(t *testing.T) {
+	for name, r := range map[string]Request{
+		"single collection":          {Collections: []string{"a"}},
+		"same collections":           {Collections: []string{"a", "a"}},
+		"single author":              {Collections: []string{"a"}, Authors: []string{"alice"}},
+		"authors without collection": {Authors: []string{"alice", "bob"}},
+		"iou threshold":              {Collections: []string{"a", "b"}, IoUThreshold: 1.5},
+		"method":                     {Collections: []string{"a", "b"}, Consensus: &ConsensusRequest{Destination: "c", Method: "mean"}},
+		"min votes":                  {Collections: []string{"a", "b"}, Consensus: &ConsensusRequest{Destination: "c", MinVotes: 3}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			itr := NewTestingAnalyzer()
+			q := &fk.JobQueue{}
+			itr.JobQueue = q
+			p := &FakePresenter{}
+			itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"), r, p)
+			assert.True(t, p.GotValidationErr)
+			assert.Empty(t, q.Jobs)
+		})
+	}
+}
+
+func TestExistingConsensusCollection(t *testing.T) {
+	itr := NewTestingAnalyzer()
+	itr.CollectionRepo = &fk.CollectionRepo{ExistingNames: []string{"a", "b", "c"}}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collections: []string{"a", "b"}, Consensus: &ConsensusRequest{Destination: "c"}}, p)
+	assert.True(t, p.GotDuplicationErr)
+}
+
+func TestHandleAuthErr(t *testing.T) {
+	group := "my-group"
+	itr := NewTestingAnalyzer()
+	itr.Auth = fk.Auth{Err: e.ErrAuthorization}
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a", Group: &group}}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collections: []string{"a", "b"}}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestAnalyze(t *testing.T) {
+	itr := NewTestingAnalyzer()
+	image := agr.Image{Id: im.NewImageId(), Ratings: []agr.Rating{
+		{Boxes: []an.BoundingBox{cat()}}, {Boxes: []an.BoundingBox{cat()}},
+	}}
+	analyzer := &FakeAnalyzer{Images: []agr.Image{image}}
+	itr.Analyzer = analyzer
+	repo := &fk.AgreementRepo{}
+	itr.Repo = repo
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collections: []string{"a", "b"}, Filter: "meta.site:lab"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, task.AgreementTask, p.Got.Type)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.Equal(t, []string{"a", "b"}, analyzer.Got.Collections)
+	assert.Equal(t, "meta.site:lab", analyzer.Got.Filter)
+
+	assert.Equal(t, p.Got.Id, repo.Created.Id)
+	assert.Equal(t, ag.DefaultIoUThreshold, repo.Created.IoUThreshold)
+	assert.Equal(t, 1.0, repo.Created.Report.RegionF1)
+	assert.Nil(t, repo.Created.Report.Consensus)
+	last := logger.Events[len(logger.Events)-1]
+	assert.Equal(t, evt.DoneTask, last.State)
+	assert.Equal(t, "1.000", last.Extra["region-f1"])
+}
+
+func TestAnalyzeWithConsensus(t *testing.T) {
+	itr := NewTestingAnalyzer()
+	image := agr.Image{Id: im.NewImageId(), Ratings: []agr.Rating{
+		{Boxes: []an.BoundingBox{cat()}, Labels: []lbl.Label{{Name: "indoor"}}},
+		{Boxes: []an.BoundingBox{cat()}},
+		{Boxes: []an.BoundingBox{cat()}, Labels: []lbl.Label{{Name: "indoor"}}},
+	}}
+	itr.Analyzer = &FakeAnalyzer{Images: []agr.Image{image}}
+	collections := &fk.CollectionRepo{}
+	itr.CollectionRepo = collections
+	images := &fk.ImageRepo{}
+	itr.ImageRepo = images
+	annotations := &fk.AnnotationRepo{}
+	itr.AnnotationRepo = annotations
+	repo := &fk.AgreementRepo{}
+	itr.Repo = repo
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collections: []string{"a", "b", "c"}, Consensus: &ConsensusRequest{Destination: "merged"}}, p)
+	assert.True(t, p.GotSuccess)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+
+	assert.Equal(t, "merged", collections.Got.Name)
+	assert.Equal(t, image.Id, images.AddedImageId)
+	assert.Equal(t, 1, annotations.NumBoundingBoxesAdded)
+	assert.Equal(t, 1, annotations.NumImageLabelsAdded)
+	assert.Equal(t, "user@mail.com", *annotations.GotUserId)
+	assert.Equal(t, &ag.ConsensusSummary{Collection: "merged", Method: ag.MajorityVote, MinVotes: 2,
+		NumRegions: 1, NumImageLabels: 1}, repo.Created.Report.Consensus)
+}
+
+func TestAnalysisFailure(t *testing.T) {
+	itr := NewTestingAnalyzer()
+	itr.Analyzer = &FakeAnalyzer{Err: e.ErrInternal}
+	repo := &fk.AgreementRepo{}
+	itr.Repo = repo
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collections: []string{"a", "b"}}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.ErrorIs(t, q.Run(t.Context(), itr.Run), e.ErrInternal)
+	assert.Nil(t, repo.Created)
+}
@@ -0,0 +1,10 @@
+package analyze
+
+import (
+	"context"
+)
+
+type Auth interface {
+	ReadImage(ctx context.Context, group string) error
+	CloneCollection(ctx context.Context, group string) error
+}
@@ -0,0 +1,337 @@
+package analyze
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+
+	"github.com/jonboulle/clockwork"
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	evt "github.com/lejeunel/go-image-annotator/entities/event"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	agr "github.com/lejeunel/go-image-annotator/modules/agreement"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Analyzer interface {
+	Collect(agr.Request) ([]agr.Image, error)
+}
+
+type FilterValidator interface {
+	Validate(im.FilterStr) error
+}
+
+type Interactor struct {
+	CollectionRepo
+	GroupRepo
+	FilterValidator
+	ImageRepo
+	AnnotationRepo
+	Repo
+	Analyzer
+	el.IEventLogger
+	Auth
+	clockwork.Clock
+	slog.Logger
+	jq.JobQueue
+	Audit al.AuditLogger
+}
+
+func New(c CollectionRepo, g GroupRepo, fv FilterValidator, ir ImageRepo, ar AnnotationRepo,
+	r Repo, x Analyzer, l el.IEventLogger, logger slog.Logger, j jq.JobQueue,
+	opts ...Option,
+) Interactor {
+	itr := &Interactor{c, g, fv, ir, ar, r, x, l, auth.NewVoidAuth(), clockwork.NewRealClock(),
+		logger, j, al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(itr)
+	}
+	return *itr
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func hasDuplicates(values []string) bool {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return len(slices.Compact(sorted)) != len(values)
+}
+
+func (i *Interactor) validate(ctx context.Context, r *Request) error {
+	raters := agr.Request{Collections: r.Collections, Authors: r.Authors}.Raters()
+	if len(raters) < 2 {
+		return fmt.Errorf("at least two collections or authors are needed, got %v: %w", raters, e.ErrValidation)
+	}
+	if len(r.Collections) == 0 {
+		return fmt.Errorf("no collection to compare authors in: %w", e.ErrValidation)
+	}
+	if hasDuplicates(r.Collections) || hasDuplicates(r.Authors) {
+		return fmt.Errorf("raters %v are not unique: %w", raters, e.ErrValidation)
+	}
+	if r.IoUThreshold == 0 {
+		r.IoUThreshold = ag.DefaultIoUThreshold
+	}
+	if r.IoUThreshold < 0 || r.IoUThreshold > 1 {
+		return fmt.Errorf("IoU threshold %v is not between 0 and 1: %w", r.IoUThreshold, e.ErrValidation)
+	}
+	if r.Filter != "" {
+		if err := i.FilterValidator.Validate(r.Filter); err != nil {
+			return err
+		}
+	}
+	for _, name := range r.Collections {
+		collection, err := i.CollectionRepo.Find(name)
+		if err != nil {
+			return fmt.Errorf("fetching collection %v: %w", name, err)
+		}
+		if collection.Group != nil {
+			if err := i.Auth.ReadImage(ctx, *collection.Group); err != nil {
+				return err
+			}
+		}
+	}
+	if r.Consensus != nil {
+		return i.validateConsensus(ctx, r.Consensus, len(raters))
+	}
+	return nil
+}
+
+func (i *Interactor) validateConsensus(ctx context.Context, c *ConsensusRequest, raters int) error {
+	if c.Method == "" {
+		c.Method = ag.MajorityVote
+	}
+	if !c.Method.IsValid() {
+		return fmt.Errorf("invalid consensus method %q, expected %v or %v: %w",
+			c.Method, ag.MajorityVote, ag.WeightedBoxFusion, e.ErrValidation)
+	}
+	if c.MinVotes == 0 {
+		c.MinVotes = agr.MajorityOf(raters)
+	}
+	if c.MinVotes < 1 || c.MinVotes > raters {
+		return fmt.Errorf("minimum votes %v is not between 1 and %v: %w", c.MinVotes, raters, e.ErrValidation)
+	}
+	if c.Group != nil {
+		if err := i.Auth.CloneCollection(ctx, *c.Group); err != nil {
+			return err
+		}
+		if _, err := i.GroupRepo.Find(*c.Group); err != nil {
+			return err
+		}
+	}
+	exists, err := i.CollectionRepo.Exists(c.Destination)
+	if err != nil {
+		return fmt.Errorf("checking existence of destination collection: %w", err)
+	}
+	if exists {
+		return fmt.Errorf("destination collection %q already exists: %w", c.Destination, e.ErrDuplicate)
+	}
+	return nil
+}
+
+// Execute submits a background task that measures how much raters agree on
+// the images that their collections share, and optionally merges what they
+// agree on into a new collection.
+func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	target := au.Target{Type: "collection"}
+	if len(r.Collections) > 0 {
+		target.Id = r.Collections[0]
+	}
+	record := i.Audit.Begin(ctx, "AnalyzeAgreement", target)
+	defer record.End()
+
+	errCtx := fmt.Errorf("initiating agreement task")
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%w: failed fetching user id from context: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+	if err := i.validate(ctx, &r); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	task := t.NewTask(t.NewTaskId(), user.Id, t.AgreementTask)
+	payload := Payload{
+		Collections: r.Collections, Authors: r.Authors, Filter: r.Filter,
+		IoUThreshold: r.IoUThreshold, Issuer: user.Id,
+	}
+	if c := r.Consensus; c != nil {
+		payload.Consensus = &ConsensusPayload{
+			Destination: c.Destination, Group: c.Group, Method: c.Method, MinVotes: c.MinVotes,
+		}
+	}
+	job, err := jq.NewJob(task.Id, task.Type, payload)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if err := i.IEventLogger.InitTask(task.Id, task.Type, task.Issuer); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: pushing init task to logger: %w", errCtx, err)))
+		return
+	}
+	if err := i.IEventLogger.AddEvent(
+		task.Id,
+		evt.Event{Time: i.Clock.Now(), State: evt.PendingTask},
+	); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: adding pending status: %w", errCtx, err)))
+		return
+	}
+
+	if err := i.JobQueue.Submit(job); err != nil {
+		i.IEventLogger.AddEvent(task.Id, evt.Event{Time: i.Clock.Now(), State: evt.FailedTask, Error: err.Error()})
+		i.Logger.Error(err.Error())
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessSubmitAgreementTask(Response{Id: task.Id, Issuer: task.Issuer, Type: task.Type})
+}
+
+// Run carries out an agreement job, and stores its report under the id of
+// the task. When resuming a job that failed, images already merged into the
+// consensus collection are skipped.
+func (i *Interactor) Run(ctx context.Context, job jq.Job) error {
+	errCtx := fmt.Errorf("running agreement task")
+	p := Payload{}
+	if err := job.Decode(&p); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	i.Logger.Info(fmt.Sprintf("started agreement task %v", job.TaskId))
+
+	request := agr.Request{Collections: p.Collections, Authors: p.Authors, Filter: p.Filter}
+	extra := map[string]string{"raters": fmt.Sprint(request.Raters())}
+	if p.Consensus != nil {
+		extra["consensus-collection"] = p.Consensus.Destination
+	}
+	if err := i.IEventLogger.AddEvent(
+		job.TaskId,
+		evt.Event{Time: i.Clock.Now(), State: evt.StartedTask, Extra: extra},
+	); err != nil {
+		return fmt.Errorf("%w: logging event upon agreement task startup: %w", errCtx, err)
+	}
+
+	images, err := i.Analyzer.Collect(request)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	report := agr.Compute(images, request.Raters(), p.IoUThreshold)
+	if p.Consensus != nil {
+		summary, err := i.merge(images, *p.Consensus, p.IoUThreshold, p.Issuer, job.Attempt > 1)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		report.Consensus = summary
+	}
+
+	if err := i.Repo.Create(ag.Agreement{
+		Id:           job.TaskId,
+		Collections:  p.Collections,
+		Authors:      p.Authors,
+		Filter:       p.Filter,
+		IoUThreshold: p.IoUThreshold,
+		Issuer:       p.Issuer,
+		CreatedAt:    i.Clock.Now(),
+		Report:       report,
+	}); err != nil {
+		return fmt.Errorf("%w: storing report: %w", errCtx, err)
+	}
+
+	extra["num-images"] = strconv.Itoa(report.NumImages)
+	extra["region-f1"] = strconv.FormatFloat(report.RegionF1, 'f', 3, 64)
+	extra["kappa"] = strconv.FormatFloat(report.Kappa, 'f', 3, 64)
+	i.IEventLogger.AddEvent(
+		job.TaskId,
+		evt.Event{Time: i.Clock.Now(), State: evt.DoneTask, Extra: extra},
+	)
+	return nil
+}
+
+// merge writes the consensus of every image into the destination
+// collection, authored by the issuer of the task.
+func (i *Interactor) merge(images []agr.Image, c ConsensusPayload, threshold float64,
+	issuer u.UserId, resuming bool,
+) (*ag.ConsensusSummary, error) {
+	errCtx := fmt.Errorf("merging consensus into collection %v", c.Destination)
+	if resuming {
+		exists, err := i.CollectionRepo.Exists(c.Destination)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		resuming = exists
+	}
+	merged := map[im.ImageId]bool{}
+	if resuming {
+		for baseImage, err := range i.ImageRepo.Iterate(qu.CollectionFilter(c.Destination, ""), 1) {
+			if err != nil {
+				return nil, fmt.Errorf("%w: listing images already merged: %w", errCtx, err)
+			}
+			merged[baseImage.ImageId] = true
+		}
+	} else {
+		dst := clc.NewCollection(clc.NewCollectionId(), c.Destination, clc.WithCreatedAt(i.Clock.Now()))
+		dst.Group = c.Group
+		if err := i.CollectionRepo.Create(dst); err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+	}
+
+	summary := ag.ConsensusSummary{Collection: c.Destination, Method: c.Method, MinVotes: c.MinVotes}
+	now := i.Clock.Now()
+	for _, image := range images {
+		consensus := agr.Merge(image, c.Method, c.MinVotes, threshold)
+		summary.NumRegions += len(consensus.Boxes) + len(consensus.Polygons)
+		summary.NumImageLabels += len(consensus.Labels)
+		if merged[image.Id] {
+			continue
+		}
+		if err := i.ImageRepo.AddToCollection(image.Id, c.Destination); err != nil {
+			return nil, fmt.Errorf("%w: adding image %v: %w", errCtx, image.Id, err)
+		}
+		for _, b := range consensus.Boxes {
+			if err := i.AnnotationRepo.AddBoundingBox(image.Id, c.Destination, b, &issuer, &now); err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+		}
+		for _, p := range consensus.Polygons {
+			if err := i.AnnotationRepo.AddPolygon(image.Id, c.Destination, p, &issuer, &now); err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+		}
+		for _, l := range consensus.Labels {
+			label := an.ImageLabel{Id: an.NewAnnotationId(), Label: l}
+			if err := i.AnnotationRepo.AddImageLabel(image.Id, c.Destination, label, &issuer, &now); err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+		}
+	}
+	return &summary, nil
+}
@@ -0,0 +1,49 @@
+package analyze
+
+import (
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Request struct {
+	Collections []string
+	// Authors, when given, are the raters instead of the collections.
+	Authors      []u.UserId
+	Filter       string
+	IoUThreshold float64
+	Consensus    *ConsensusRequest
+}
+
+// ConsensusRequest asks for the annotations that enough raters agree on to
+// be written into a new collection. MinVotes defaults to a majority of
+// raters.
+type ConsensusRequest struct {
+	Destination string
+	Group       *string
+	Method      ag.Method
+	MinVotes    int
+}
+
+// Payload is what an agreement job needs to run, once persisted.
+type Payload struct {
+	Collections  []string          `json:"collections"`
+	Authors      []u.UserId        `json:"authors,omitempty"`
+	Filter       string            `json:"filter,omitempty"`
+	IoUThreshold float64           `json:"iou_threshold"`
+	Consensus    *ConsensusPayload `json:"consensus,omitempty"`
+	Issuer       u.UserId          `json:"issuer"`
+}
+
+type ConsensusPayload struct {
+	Destination string    `json:"destination"`
+	Group       *string   `json:"group,omitempty"`
+	Method      ag.Method `json:"method"`
+	MinVotes    int       `json:"min_votes"`
+}
+
+type Response struct {
+	Id     t.TaskId
+	Issuer u.UserId
+	Type   t.TaskType
+}
@@ -0,0 +1,6 @@
+package analyze
+
+type OutputPort interface {
+	SuccessSubmitAgreementTask(Response)
+	Error(error)
+}
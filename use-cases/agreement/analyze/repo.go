@@ -0,0 +1,38 @@
+package analyze
+
+import (
+	"iter"
+	"time"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	grp "github.com/lejeunel/go-image-annotator/entities/group"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type CollectionRepo interface {
+	Find(string) (*clc.Collection, error)
+	Exists(string) (bool, error)
+	Create(clc.Collection) error
+}
+
+type GroupRepo interface {
+	Find(string) (*grp.Group, error)
+}
+
+type ImageRepo interface {
+	Iterate(im.FilterStr, int) iter.Seq2[im.BaseImage, error]
+	AddToCollection(im.ImageId, clc.CollectionName) error
+}
+
+type AnnotationRepo interface {
+	AddBoundingBox(im.ImageId, clc.CollectionName, an.BoundingBox, *u.UserId, *time.Time) error
+	AddPolygon(im.ImageId, clc.CollectionName, an.Polygon, *u.UserId, *time.Time) error
+	AddImageLabel(im.ImageId, clc.CollectionName, an.ImageLabel, *u.UserId, *time.Time) error
+}
+
+type Repo interface {
+	Create(ag.Agreement) error
+}
@@ -0,0 +1,44 @@
+package analyze
+
+import (
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	agr "github.com/lejeunel/go-image-annotator/modules/agreement"
+	testing "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakeAnalyzer struct {
+	Got    agr.Request
+	Images []agr.Image
+	Err    error
+}
+
+func (x *FakeAnalyzer) Collect(r agr.Request) ([]agr.Image, error) {
+	if x.Err != nil {
+		return nil, x.Err
+	}
+	x.Got = r
+	return x.Images, nil
+}
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	testing.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessSubmitAgreementTask(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
+
+func NewTestingAnalyzer() Interactor {
+	return New(
+		&fk.CollectionRepo{},
+		&fk.GroupRepo{},
+		&fk.FilterValidator{},
+		&fk.ImageRepo{},
+		&fk.AnnotationRepo{},
+		&fk.AgreementRepo{},
+		&FakeAnalyzer{},
+		&fk.EventLogger{}, fk.NewLogger(), &fk.JobQueue{})
+}
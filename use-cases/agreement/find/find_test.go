@@ -0,0 +1,54 @@
+package find
+
+import (
+	"testing"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	tk "github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func repoWith(issuer string) (*fk.AgreementRepo, tk.TaskId) {
+	id := tk.NewTaskId()
+	return &fk.AgreementRepo{Agreements: []ag.Agreement{{Id: id, Issuer: issuer}}}, id
+}
+
+func TestFindAgreement(t *testing.T) {
+	repo, id := repoWith("user@mail.com")
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"), Request{Id: id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, id, p.Got.Agreement.Id)
+}
+
+func TestFindAgreementWithoutIdentity(t *testing.T) {
+	repo, id := repoWith("user@mail.com")
+	p := &FakePresenter{}
+	New(repo).Execute(t.Context(), Request{Id: id.String()}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestFindAgreementWithInvalidId(t *testing.T) {
+	repo, _ := repoWith("user@mail.com")
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"), Request{Id: "not-an-id"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestFindUnfinishedAgreement(t *testing.T) {
+	repo, _ := repoWith("user@mail.com")
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Id: tk.NewTaskId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestFindAgreementOfOtherUser(t *testing.T) {
+	repo, id := repoWith("other@mail.com")
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"), Request{Id: id.String()}, p)
+	assert.True(t, p.GotAuthErr)
+}
@@ -0,0 +1,45 @@
+package find
+
+import (
+	"context"
+	"fmt"
+
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	Repo
+}
+
+func New(r Repo) Interactor {
+	return Interactor{r}
+}
+
+// Execute fetches the report of an agreement analysis issued by the caller.
+// The report exists once the agreement task is done.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := fmt.Errorf("fetching agreement")
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(fmt.Errorf("%w: failed fetching user id from context: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+	id, err := t.NewTaskIdFromString(r.Id)
+	if err != nil {
+		out.Error(fmt.Errorf("%w: %w", errCtx, err))
+		return
+	}
+	agreement, err := i.Repo.Find(*id)
+	if err != nil {
+		out.Error(fmt.Errorf("%w: %w", errCtx, err))
+		return
+	}
+	if agreement.Issuer != user.Id {
+		out.Error(fmt.Errorf("%w: agreement %v was issued by another user: %w",
+			errCtx, agreement.Id, e.ErrAuthorization))
+		return
+	}
+	out.SuccessFindAgreement(Response{Agreement: *agreement})
+}
@@ -0,0 +1,13 @@
+package find
+
+import (
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+)
+
+type Request struct {
+	Id string
+}
+
+type Response struct {
+	Agreement ag.Agreement
+}
@@ -0,0 +1,6 @@
+package find
+
+type OutputPort interface {
+	SuccessFindAgreement(Response)
+	Error(error)
+}
@@ -0,0 +1,10 @@
+package find
+
+import (
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+)
+
+type Repo interface {
+	Find(t.TaskId) (*ag.Agreement, error)
+}
@@ -0,0 +1,13 @@
+package agreement
+
+import (
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/analyze"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/agreement/list"
+)
+
+type Interactors struct {
+	Analyze analyze.Interactor
+	Find    find.Interactor
+	List    list.Interactor
+}
@@ -0,0 +1,36 @@
+package list
+
+import (
+	"testing"
+
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	tk "github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMyAgreements(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AgreementRepo{Agreements: []ag.Agreement{{Id: tk.NewTaskId()}}}
+	New(repo, 10, 100).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), pag.PaginationParams{}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "me@mail.com", repo.GotUser)
+	assert.Equal(t, repo.Agreements, p.Got.Agreements)
+	assert.Equal(t, int64(1), p.Got.Pagination.TotalRecords)
+}
+
+func TestListAgreementsRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AgreementRepo{}, 10, 100).Execute(t.Context(), pag.PaginationParams{}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestListAgreementsHandlesInternalError(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AgreementRepo{ErrOnCount: e.ErrInternal}, 10, 100).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), pag.PaginationParams{}, p)
+	assert.True(t, p.GotInternalErr)
+}
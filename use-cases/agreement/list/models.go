@@ -0,0 +1,11 @@
+package list
+
+import (
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Response struct {
+	Agreements []ag.Agreement
+	Pagination pag.Pagination
+}
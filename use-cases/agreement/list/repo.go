@@ -0,0 +1,12 @@
+package list
+
+import (
+	ag "github.com/lejeunel/go-image-annotator/entities/agreement"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Repo interface {
+	ListOf(u.UserId, pag.PaginationParams) ([]ag.Agreement, error)
+	CountOf(u.UserId) (*int64, error)
+}
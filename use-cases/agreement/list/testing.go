@@ -0,0 +1,16 @@
+package list
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessListAgreements(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
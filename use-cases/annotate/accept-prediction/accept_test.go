@@ -0,0 +1,52 @@
+package accept_prediction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleAuthError(t *testing.T) {
+	repo := &fk.AnnotationRepo{Source: &a.Source{Model: "a-model"}}
+	itr := New(repo, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Id: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.Nil(t, repo.AcceptedPrediction)
+}
+
+func TestMissingAnnotationShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.AnnotationRepo{ErrOnFindSource: e.ErrNotFound})
+	itr.Execute(t.Context(), Request{Id: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestAcceptingHumanAnnotationShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Id: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Nil(t, repo.AcceptedPrediction)
+}
+
+func TestAcceptPrediction(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{Source: &a.Source{Model: "a-model", Confidence: 0.7}}
+	now := time.Now()
+	itr := New(repo, WithClock(clockwork.NewFakeClockAt(now)))
+	user := u.NewUser("user@example.com")
+	id := a.NewAnnotationId()
+	itr.Execute(u.AppendUserToContext(t.Context(), user), Request{Id: id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, id, *repo.AcceptedPrediction)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+}
@@ -0,0 +1,103 @@
+package accept_prediction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
+)
+
+type Interactor struct {
+	Repo
+	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a auth.Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(repo Repo, opts ...Option) Interactor {
+	i := &Interactor{
+		Repo:  repo,
+		Auth:  sauth.NewVoidAuth(),
+		Clock: clockwork.NewRealClock(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute makes a prediction an annotation of the current user, who then
+// submits it for review like any other annotation.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.Id})
+	defer record.End()
+
+	errCtx := "accepting prediction"
+	id, err := a.NewAnnotationIdFromString(r.Id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	group, err := i.Repo.GroupOfAnnotation(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if group != nil {
+		if err := i.Auth.Annotate(ctx, *group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	source, err := i.Repo.FindSource(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if !source.IsPrediction() {
+		out.Error(record.Fail(fmt.Errorf("%v: annotation %v is not a prediction: %w", errCtx, *id, e.ErrValidation)))
+		return
+	}
+
+	var userId *u.UserId
+	if user := u.IdentityFromContext(ctx); user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	if err := i.Repo.AcceptPrediction(*id, userId, &now); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	out.SuccessAcceptPrediction(Response{Id: *id})
+}
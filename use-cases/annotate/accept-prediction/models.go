@@ -0,0 +1,13 @@
+package accept_prediction
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Request struct {
+	Id string
+}
+
+type Response struct {
+	Id a.AnnotationId
+}
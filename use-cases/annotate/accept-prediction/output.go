@@ -0,0 +1,6 @@
+package accept_prediction
+
+type OutputPort interface {
+	Error(error)
+	SuccessAcceptPrediction(Response)
+}
@@ -0,0 +1,14 @@
+package accept_prediction
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	FindSource(a.AnnotationId) (*a.Source, error)
+	AcceptPrediction(a.AnnotationId, *u.UserId, *time.Time) error
+}
@@ -0,0 +1,14 @@
+package accept_prediction
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessAcceptPrediction(Response) {
+	p.GotSuccess = true
+}
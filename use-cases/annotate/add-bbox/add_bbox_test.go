@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	g "github.com/lejeunel/go-image-annotator/entities/group"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
@@ -142,3 +143,41 @@ func TestAddBoundingBox(t *testing.T) {
 	assert.Equal(t, req.Height, repo.GotBox.Height)
 	assert.Equal(t, req.Angle, repo.GotBox.Angle)
 }
+
+func TestAddBoundingBoxWithAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.AnnotationRepo{}
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute, Default: false},
+		{Name: "plate", Type: lbl.TextAttribute},
+	}))
+	req := CreateTestAddBoxRequest()
+	req.Attributes = a.Attributes{"plate": "GE 1234"}
+	itr := New(&fk.ImageStore{}, &repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Attributes{"occluded": false, "plate": "GE 1234"}, repo.GotBox.Attributes)
+}
+
+func TestUndefinedAttributeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.AnnotationRepo{}
+	req := CreateTestAddBoxRequest()
+	req.Attributes = a.Attributes{"occluded": true}
+	itr := New(&fk.ImageStore{}, &repo, &fk.LabelRepo{})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumBoundingBoxesAdded)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	image := CreateImage()
+	image.Collection.Labels = []string{"car"}
+	repo := &fk.AnnotationRepo{}
+	itr := New(&fk.ImageStore{Return: &image}, repo,
+		&fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "a-label")})
+	itr.Execute(t.Context(), CreateTestAddBoxRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumBoundingBoxesAdded)
+}
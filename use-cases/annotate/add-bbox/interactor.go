@@ -6,9 +6,13 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
 )
@@ -26,6 +30,7 @@ type Interactor struct {
 	LabelRepo
 	auth.Auth
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 func New(imageStore ImageStore, repo Repo, labelRepo LabelRepo, opts ...Option) Interactor {
@@ -50,6 +55,12 @@ func WithAuth(a auth.Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithClock(c clockwork.Clock) Option {
 	return func(i *Interactor) {
 		i.Clock = c
@@ -57,40 +68,49 @@ func WithClock(c clockwork.Clock) Option {
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
 	errCtx := "adding bounding box"
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	image, err := i.findImage(imageId, r.Collection)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if image.Collection.Group != nil {
 		if err := i.Auth.Annotate(ctx, *image.Collection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
-	label, err := i.findLabel(r.Label)
+	label, err := i.findLabel(image.Collection, r.Label)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	attributes, err := at.Validate(label.Attributes, r.Attributes)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	box := a.NewBoundingBox(a.NewAnnotationId(), r.Xc, r.Yc, r.Width, r.Height, *label,
-		a.WithAngle(r.Angle))
+		a.WithAngle(r.Angle), a.WithAttributes(attributes))
 	if err := i.validateBox(image, box); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.addBox(ctx, image, box); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -123,11 +143,14 @@ func (i Interactor) validateBox(image *im.Image, box a.BoundingBox) error {
 	return nil
 }
 
-func (i Interactor) findLabel(name string) (*lbl.Label, error) {
+func (i Interactor) findLabel(collection clc.Collection, name string) (*lbl.Label, error) {
 	label, err := i.LabelRepo.FindLabel(name)
 	if err != nil {
 		return nil, err
 	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		return nil, err
+	}
 	return label, nil
 }
 
@@ -17,4 +17,5 @@ type Request struct {
 	Width      float32
 	Height     float32
 	Angle      float32
+	Attributes an.Attributes
 }
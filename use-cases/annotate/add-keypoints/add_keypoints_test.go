@@ -0,0 +1,123 @@
+package add_keypoints
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	g "github.com/lejeunel/go-image-annotator/entities/group"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateLabel() lbl.Label {
+	return lbl.NewLabel(lbl.NewLabelId(), "person", lbl.WithSkeleton(&lbl.Skeleton{
+		Points: []string{"head", "neck"}, Edges: [][2]string{{"head", "neck"}},
+	}))
+}
+
+func CreateRequest() Request {
+	return Request{
+		ImageId: im.NewImageId().String(), Collection: "a-collection", Label: "person",
+		Points: []a.Keypoint{{X: 1, Y: 2, Visibility: a.Visible}, {X: 3, Y: 4, Visibility: a.Occluded}},
+	}
+}
+
+func TestHandleAuthError(t *testing.T) {
+	image := im.NewImage(im.NewImageId(), clc.NewCollection(clc.NewCollectionId(), "a-collection"))
+	group := g.NewGroup(g.NewGroupId(), "my-group")
+	image.Collection.Group = &group.Name
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{}, &fk.LabelRepo{Return: CreateLabel()},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), CreateRequest(), p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnImageRetrievalShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{ErrOnFind: e.ErrInternal}, &fk.AnnotationRepo{}, &fk.LabelRepo{})
+	itr.Execute(t.Context(), CreateRequest(), p)
+	assert.True(t, p.GotInternalErr)
+}
+
+func TestErrOnFindLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{}, &fk.LabelRepo{ErrOnFind: e.ErrInternal})
+	itr.Execute(t.Context(), CreateRequest(), p)
+	assert.True(t, p.GotInternalErr)
+}
+
+func TestErrOnAddKeypointsShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{ErrOnAddKeypoints: e.ErrInternal},
+		&fk.LabelRepo{Return: CreateLabel()})
+	itr.Execute(t.Context(), CreateRequest(), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelWithoutSkeletonShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	itr := New(&fk.ImageStore{}, repo, &fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "person")})
+	itr.Execute(t.Context(), CreateRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumKeypointsAdded)
+}
+
+func TestWrongNumberOfPointsShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req := CreateRequest()
+	req.Points = req.Points[:1]
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{}, &fk.LabelRepo{Return: CreateLabel()})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestInvalidVisibilityShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req := CreateRequest()
+	req.Points[1].Visibility = 3
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{}, &fk.LabelRepo{Return: CreateLabel()})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestAddKeypoints(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.AnnotationRepo{}
+	image := im.NewImage(im.NewImageId(), clc.NewCollection(clc.NewCollectionId(), "a-collection"))
+	req := CreateRequest()
+	req.ImageId = image.Id.String()
+	now := time.Now()
+	user := u.NewUser("user@example.com")
+	itr := New(&fk.ImageStore{Return: &image}, &repo, &fk.LabelRepo{Return: CreateLabel()},
+		WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(u.AppendUserToContext(t.Context(), user), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, image.Id, repo.GotImageId)
+	assert.Equal(t, image.Collection.Name, repo.GotCollection)
+	assert.Equal(t, "person", repo.GotKeypoints.Label.Name)
+	assert.Equal(t, req.Points, repo.GotKeypoints.Points)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithLabels([]string{"car"}))
+	image := im.NewImage(im.NewImageId(), collection)
+	itr := New(&fk.ImageStore{Return: &image}, repo, &fk.LabelRepo{Return: CreateLabel()})
+	itr.Execute(t.Context(), CreateRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumKeypointsAdded)
+}
@@ -0,0 +1,6 @@
+package add_keypoints
+
+type OutputPort interface {
+	Error(error)
+	SuccessAddKeypoints(Response)
+}
@@ -0,0 +1,14 @@
+package add_keypoints
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessAddKeypoints(Response) {
+	p.GotSuccess = true
+}
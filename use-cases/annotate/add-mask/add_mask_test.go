@@ -0,0 +1,201 @@
+package add_mask
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	g "github.com/lejeunel/go-image-annotator/entities/group"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateImage() im.Image {
+	collection := clc.NewCollection(clc.NewCollectionId(), "my-collection")
+	return im.NewImage(im.NewImageId(), collection)
+}
+
+func TestHandleAuthError(t *testing.T) {
+	image := CreateImage()
+	group := g.NewGroup(g.NewGroupId(), "my-group")
+	image.Collection.Group = &group.Name
+	itr := New(&fk.ImageStore{Return: &image},
+		&fk.AnnotationRepo{},
+		&fk.LabelRepo{},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{ImageId: im.NewImageId().String()},
+		p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnImageRetrievalShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{ErrOnFind: e.ErrInternal},
+		&fk.AnnotationRepo{},
+		&fk.LabelRepo{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnFindLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{},
+		&fk.AnnotationRepo{},
+		&fk.LabelRepo{ErrOnFind: e.ErrInternal},
+	)
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func CreateTestAddMaskRequest() Request {
+	return Request{
+		ImageId: im.NewImageId().String(), Collection: "a-collection",
+
+		Label: "a-label",
+	}
+}
+
+func TestErrOnAddMaskShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{},
+		&fk.AnnotationRepo{ErrOnAddMask: e.ErrInternal},
+		&fk.LabelRepo{})
+	itr.Execute(t.Context(), CreateTestAddMaskRequest(), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestAddUserIdFromContext(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	itr := New(&fk.ImageStore{}, repo,
+		&fk.LabelRepo{})
+	user := u.NewUser("user@example.com")
+	ctx := u.AppendUserToContext(t.Context(), user)
+	itr.Execute(ctx, CreateTestAddMaskRequest(), p)
+	assert.NotNil(t, repo.GotUserId)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+}
+
+func TestTime(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	now := time.Now()
+	itr := New(&fk.ImageStore{},
+		repo,
+		&fk.LabelRepo{},
+		WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(t.Context(), CreateTestAddMaskRequest(), p)
+	assert.NotNil(t, repo.GotTime)
+	assert.Equal(t, now, *repo.GotTime)
+}
+
+func TestAddMask(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection")
+	image := CreateImageOfSize(collection, 2, 3)
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label")
+	req := CreateTestAddMaskRequest()
+	req.ImageId = image.Id.String()
+	req.RLE = &a.RLE{Width: 2, Height: 3, Counts: []int{1, 4, 1}}
+	itr := New(&fk.ImageStore{Return: &image},
+		&repo,
+		&fk.LabelRepo{Return: label},
+	)
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, req.ImageId, repo.GotImageId.String())
+	assert.Equal(t, collection.Name, repo.GotCollection)
+	assert.Equal(t, req.Label, repo.GotMask.Label.Name)
+	assert.Equal(t, *req.RLE, repo.GotMask.RLE)
+}
+
+func CreateImageOfSize(collection clc.Collection, width, height int) im.Image {
+	image := im.NewImage(im.NewImageId(), collection)
+	image.Specs = im.Specs{Width: width, Height: height}
+	return image
+}
+
+func TestAddEmptyMaskOfImageSize(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.AnnotationRepo{}
+	image := CreateImageOfSize(clc.NewCollection(clc.NewCollectionId(), "a-collection"), 4, 5)
+	itr := New(&fk.ImageStore{Return: &image}, &repo, &fk.LabelRepo{})
+	itr.Execute(t.Context(), CreateTestAddMaskRequest(), p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.EmptyRLE(4, 5), repo.GotMask.RLE)
+}
+
+func TestMaskOfOtherSizeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	image := CreateImageOfSize(clc.NewCollection(clc.NewCollectionId(), "a-collection"), 4, 5)
+	req := CreateTestAddMaskRequest()
+	req.RLE = &a.RLE{Width: 5, Height: 4, Counts: []int{20}}
+	itr := New(&fk.ImageStore{Return: &image}, repo, &fk.LabelRepo{})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumMasksAdded)
+}
+
+func TestCountsNotCoveringImageShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	image := CreateImageOfSize(clc.NewCollection(clc.NewCollectionId(), "a-collection"), 2, 2)
+	req := CreateTestAddMaskRequest()
+	req.RLE = &a.RLE{Width: 2, Height: 2, Counts: []int{1, 2}}
+	itr := New(&fk.ImageStore{Return: &image}, repo, &fk.LabelRepo{})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumMasksAdded)
+}
+
+func TestOverflowingCountsShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	image := CreateImageOfSize(clc.NewCollection(clc.NewCollectionId(), "a-collection"), 2, 2)
+	req := CreateTestAddMaskRequest()
+	req.RLE = &a.RLE{Width: 2, Height: 2, Counts: []int{math.MaxInt, math.MaxInt, 2 + 4}}
+	itr := New(&fk.ImageStore{Return: &image}, repo, &fk.LabelRepo{})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumMasksAdded)
+}
+
+func TestInvalidAttributeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+	}))
+	req := CreateTestAddMaskRequest()
+	req.Attributes = a.Attributes{"color": "green"}
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{}, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithLabels([]string{"car"}))
+	image := im.NewImage(im.NewImageId(), collection)
+	itr := New(&fk.ImageStore{Return: &image}, repo,
+		&fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "a-label")})
+	itr.Execute(t.Context(), CreateTestAddMaskRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumMasksAdded)
+}
@@ -0,0 +1,162 @@
+package add_mask
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
+)
+
+type Interface interface {
+	Execute(context.Context, Request, OutputPort)
+}
+type ImageStore interface {
+	Find(base im.BaseImage) (*im.Image, error)
+}
+
+type Interactor struct {
+	ImageStore
+	Repo
+	LabelRepo
+	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+func New(imageStore ImageStore, repo Repo, labelRepo LabelRepo, opts ...Option) Interactor {
+	i := &Interactor{
+		Repo:       repo,
+		LabelRepo:  labelRepo,
+		ImageStore: imageStore,
+		Clock:      clockwork.NewRealClock(),
+		Auth:       sauth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a auth.Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
+	errCtx := "adding mask"
+
+	imageId, err := im.NewImageIdFromString(r.ImageId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	image, err := i.findImage(imageId, r.Collection)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	if image.Collection.Group != nil {
+		if err := i.Auth.Annotate(ctx, *image.Collection.Group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	label, err := i.findLabel(image.Collection, r.Label)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	rle := a.EmptyRLE(image.Specs.Width, image.Specs.Height)
+	if r.RLE != nil {
+		rle = *r.RLE
+	}
+
+	attributes, err := at.Validate(label.Attributes, r.Attributes)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	mask := a.NewMask(a.NewAnnotationId(), rle, *label)
+	mask.Attributes = attributes
+	if err := image.AddMask(mask); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if err := i.addMask(ctx, image, mask); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	out.SuccessAddMask(Response{mask.Id})
+}
+
+func (i Interactor) addMask(ctx context.Context, image *im.Image, mask a.Mask) error {
+	var userId *u.UserId
+	user := u.IdentityFromContext(ctx)
+	if user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	if err := i.Repo.AddMask(
+		image.Id,
+		image.Collection.Name,
+		mask,
+		userId,
+		&now,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (i Interactor) findLabel(collection clc.Collection, name string) (*lbl.Label, error) {
+	label, err := i.LabelRepo.FindLabel(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		return nil, err
+	}
+	return label, nil
+}
+
+func (i Interactor) findImage(imageId im.ImageId, collectionName string) (*im.Image, error) {
+	image, err := i.ImageStore.Find(im.BaseImage{ImageId: imageId, Collection: collectionName})
+	if err != nil {
+		return nil, err
+	}
+	return image, nil
+}
@@ -0,0 +1,19 @@
+package add_mask
+
+import (
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Response struct {
+	Id an.AnnotationId
+}
+
+type Request struct {
+	ImageId    string
+	Collection string
+	Label      string
+	// RLE covers the whole image. Without it, the mask starts out empty, to
+	// be painted with the brush.
+	RLE        *an.RLE
+	Attributes an.Attributes
+}
@@ -0,0 +1,6 @@
+package add_mask
+
+type OutputPort interface {
+	Error(error)
+	SuccessAddMask(Response)
+}
@@ -120,3 +120,28 @@ func TestAddPolygon(t *testing.T) {
 	assert.Equal(t, req.Label, repo.GotPolygon.Label.Name)
 	assert.Equal(t, req.Points, repo.GotPolygon.Points)
 }
+
+func TestInvalidAttributeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+	}))
+	req := CreateTestAddPolygonRequest()
+	req.Attributes = a.Attributes{"color": "green"}
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{}, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithLabels([]string{"car"}))
+	image := im.NewImage(im.NewImageId(), collection)
+	itr := New(&fk.ImageStore{Return: &image}, repo,
+		&fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "a-label")})
+	itr.Execute(t.Context(), CreateTestAddPolygonRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumPolygonsAdded)
+}
@@ -13,4 +13,5 @@ type Request struct {
 	Collection string
 	Label      string
 	Points     an.Points
+	Attributes an.Attributes
 }
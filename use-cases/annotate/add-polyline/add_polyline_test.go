@@ -0,0 +1,158 @@
+package add_polyline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	g "github.com/lejeunel/go-image-annotator/entities/group"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateImage() im.Image {
+	collection := clc.NewCollection(clc.NewCollectionId(), "my-collection")
+	return im.NewImage(im.NewImageId(), collection)
+}
+
+func TestHandleAuthError(t *testing.T) {
+	image := CreateImage()
+	group := g.NewGroup(g.NewGroupId(), "my-group")
+	image.Collection.Group = &group.Name
+	itr := New(&fk.ImageStore{Return: &image},
+		&fk.AnnotationRepo{},
+		&fk.LabelRepo{},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{ImageId: im.NewImageId().String()},
+		p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnImageRetrievalShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{ErrOnFind: e.ErrInternal},
+		&fk.AnnotationRepo{},
+		&fk.LabelRepo{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnFindLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{},
+		&fk.AnnotationRepo{},
+		&fk.LabelRepo{ErrOnFind: e.ErrInternal},
+	)
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func CreateTestAddPolylineRequest() Request {
+	return Request{
+		ImageId: im.NewImageId().String(), Collection: "a-collection",
+
+		Label: "a-label", Points: a.Points{Coordinates: [][2]float32{{0, 0}, {1, 1}}},
+	}
+}
+
+func TestErrOnAddPolylineShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{},
+		&fk.AnnotationRepo{ErrOnAddPolyline: e.ErrInternal},
+		&fk.LabelRepo{})
+	itr.Execute(t.Context(), CreateTestAddPolylineRequest(), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestAddUserIdFromContext(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	itr := New(&fk.ImageStore{}, repo,
+		&fk.LabelRepo{})
+	user := u.NewUser("user@example.com")
+	ctx := u.AppendUserToContext(t.Context(), user)
+	itr.Execute(ctx, CreateTestAddPolylineRequest(), p)
+	assert.NotNil(t, repo.GotUserId)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+}
+
+func TestTime(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	now := time.Now()
+	itr := New(&fk.ImageStore{},
+		repo,
+		&fk.LabelRepo{},
+		WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(t.Context(), CreateTestAddPolylineRequest(), p)
+	assert.NotNil(t, repo.GotTime)
+	assert.Equal(t, now, *repo.GotTime)
+}
+
+func TestAddPolyline(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection")
+	image := im.NewImage(im.NewImageId(), collection)
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label")
+	req := CreateTestAddPolylineRequest()
+	req.ImageId = image.Id.String()
+	itr := New(&fk.ImageStore{Return: &image},
+		&repo,
+		&fk.LabelRepo{Return: label},
+	)
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, req.ImageId, repo.GotImageId.String())
+	assert.Equal(t, collection.Name, repo.GotCollection)
+	assert.Equal(t, req.Label, repo.GotPolyline.Label.Name)
+	assert.Equal(t, req.Points, repo.GotPolyline.Points)
+}
+
+func TestSinglePointShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	req := CreateTestAddPolylineRequest()
+	req.Points = a.Points{Coordinates: [][2]float32{{0, 0}}}
+	itr := New(&fk.ImageStore{}, repo, &fk.LabelRepo{})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumPolylinesAdded)
+}
+
+func TestInvalidAttributeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+	}))
+	req := CreateTestAddPolylineRequest()
+	req.Attributes = a.Attributes{"color": "green"}
+	itr := New(&fk.ImageStore{}, &fk.AnnotationRepo{}, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithLabels([]string{"car"}))
+	image := im.NewImage(im.NewImageId(), collection)
+	itr := New(&fk.ImageStore{Return: &image}, repo,
+		&fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "a-label")})
+	itr.Execute(t.Context(), CreateTestAddPolylineRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumPolylinesAdded)
+}
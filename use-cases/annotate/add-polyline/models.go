@@ -0,0 +1,17 @@
+package add_polyline
+
+import (
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Response struct {
+	Id an.AnnotationId
+}
+
+type Request struct {
+	ImageId    string
+	Collection string
+	Label      string
+	Points     an.Points
+	Attributes an.Attributes
+}
@@ -0,0 +1,6 @@
+package add_polyline
+
+type OutputPort interface {
+	Error(error)
+	SuccessAddPolyline(Response)
+}
@@ -0,0 +1,19 @@
+package add_polyline
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	AddPolyline(im.ImageId, clc.CollectionName, a.Polyline, *u.UserId, *time.Time) error
+}
+
+type LabelRepo interface {
+	FindLabel(string) (*lbl.Label, error)
+}
@@ -0,0 +1,14 @@
+package add_polyline
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessAddPolyline(Response) {
+	p.GotSuccess = true
+}
@@ -111,3 +111,16 @@ func TestAssignLabelToImage(t *testing.T) {
 	assert.Equal(t, repo.AddedOnImageId, image.Id)
 	assert.Equal(t, repo.AddedOnCollection, image.Collection.Name)
 }
+
+func TestAssignLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	image := CreateImage()
+	image.Collection.Labels = []string{"car"}
+	repo := &fk.AnnotationRepo{}
+	itr := New(repo,
+		&fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "a-label")},
+		&fk.ImageStore{Return: &image})
+	itr.Execute(t.Context(), Request{ImageId: image.Id.String(), Label: "a-label"}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumImageLabelsAdded)
+}
@@ -7,10 +7,12 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
 )
@@ -28,39 +30,43 @@ type Interactor struct {
 	ImageStore
 	auth.Auth
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
 	errCtx := "assigning label to image"
 
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	image, err := i.findImage(imageId, r.Collection)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if image.Collection.Group != nil {
 		if err := i.Auth.Annotate(ctx, *image.Collection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
-	label, err := i.findLabel(r.Label)
+	label, err := i.findLabel(image.Collection, r.Label)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	imageLabel, err := i.addLabel(ctx, image.Id, image.Collection.Name, *label)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -72,11 +78,14 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	})
 }
 
-func (i Interactor) findLabel(name string) (*lbl.Label, error) {
+func (i Interactor) findLabel(collection clc.Collection, name string) (*lbl.Label, error) {
 	label, err := i.LabelRepo.FindLabel(name)
 	if err != nil {
 		return nil, err
 	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		return nil, err
+	}
 	return label, nil
 }
 
@@ -122,6 +131,12 @@ func WithAuth(a auth.Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithClock(c clockwork.Clock) Option {
 	return func(i *Interactor) {
 		i.Clock = c
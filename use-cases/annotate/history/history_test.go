@@ -0,0 +1,48 @@
+package history
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRevisions(t *testing.T) {
+	id := a.NewAnnotationId()
+	repo := &fk.AnnotationRepo{Revisions: []a.Revision{
+		{Id: a.NewRevisionId(), AnnotationId: id, Action: a.Created},
+		{Id: a.NewRevisionId(), AnnotationId: a.NewAnnotationId(), Action: a.Created},
+		{Id: a.NewRevisionId(), AnnotationId: id, Action: a.Deleted},
+	}}
+	p := &FakePresenter{}
+	New(repo).Execute(t.Context(), Request{AnnotationId: id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, id, p.Got.AnnotationId)
+	assert.Equal(t, 2, len(p.Got.Revisions))
+	assert.Equal(t, a.Deleted, p.Got.Revisions[1].Action)
+}
+
+func TestInvalidIdShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}).Execute(t.Context(), Request{AnnotationId: "not-an-id"}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestHandleAuthError(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}, WithAuth(fk.Auth{Err: e.ErrAuthorization})).Execute(
+		t.Context(), Request{AnnotationId: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestInternalErrShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{ErrOnListRevisions: e.ErrInternal}).Execute(
+		t.Context(), Request{AnnotationId: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
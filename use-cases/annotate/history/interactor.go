@@ -0,0 +1,59 @@
+package history
+
+import (
+	"context"
+	"fmt"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	AnnotationRepo
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(repo AnnotationRepo, opts ...Option) Interactor {
+	i := &Interactor{repo, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute lists the changes made to an annotation, including those made
+// before it was deleted.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := "listing revisions of annotation"
+	id, err := a.NewAnnotationIdFromString(r.AnnotationId)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %v: %w", errCtx, err, e.ErrValidation))
+		return
+	}
+	group, err := i.AnnotationRepo.GroupOfAnnotation(*id)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err))
+		return
+	}
+	if group != nil {
+		if err := i.Auth.ReadImage(ctx, *group); err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+	}
+	revisions, err := i.AnnotationRepo.ListRevisions(*id)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	out.SuccessListRevisions(Response{AnnotationId: *id, Revisions: revisions})
+}
@@ -0,0 +1,15 @@
+package history
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Request struct {
+	AnnotationId string
+}
+
+type Response struct {
+	AnnotationId a.AnnotationId
+	// Revisions are ordered from oldest to newest.
+	Revisions []a.Revision
+}
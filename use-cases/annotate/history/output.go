@@ -0,0 +1,6 @@
+package history
+
+type OutputPort interface {
+	Error(error)
+	SuccessListRevisions(Response)
+}
@@ -0,0 +1,10 @@
+package history
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type AnnotationRepo interface {
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	ListRevisions(a.AnnotationId) ([]a.Revision, error)
+}
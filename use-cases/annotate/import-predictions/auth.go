@@ -0,0 +1,9 @@
+package import_predictions
+
+import (
+	"context"
+)
+
+type Auth interface {
+	ImportPredictions(ctx context.Context, group string) error
+}
@@ -0,0 +1,124 @@
+package import_predictions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateTestRequest() Request {
+	imageId := im.NewImageId().String()
+	return Request{
+		Collection: "a-collection",
+		Model:      "a-model",
+		Predictions: []Prediction{
+			{ImageId: imageId, Label: "a-label", Confidence: 0.9,
+				Box: &a.BoxCoordinates{Xc: 1, Yc: 1, Width: 2, Height: 2}},
+			{ImageId: imageId, Label: "a-label", Confidence: 0.4,
+				Points: &a.Points{Coordinates: [][2]float32{{0, 0}, {1, 1}, {0, 1}}}},
+		},
+	}
+}
+
+func TestHandleAuthError(t *testing.T) {
+	group := "a-group"
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithGroup(group))
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	itr := New(&TestingTransactor{repo}, &fk.ImageStore{}, &fk.LabelRepo{}, &fk.CollectionRepo{Return: collection},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotAuthErr)
+	assert.Equal(t, 0, repo.NumBoundingBoxesAdded)
+}
+
+func TestMissingCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&TestingTransactor{&fk.AnnotationRepo{}}, &fk.ImageStore{}, &fk.LabelRepo{},
+		&fk.CollectionRepo{ErrOnFind: e.ErrNotFound})
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestImportPredictions(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label")
+	user := u.NewUser("user@example.com")
+	now := time.Now()
+	itr := New(&TestingTransactor{repo}, &fk.ImageStore{}, &fk.LabelRepo{Return: label}, &fk.CollectionRepo{},
+		WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(u.AppendUserToContext(t.Context(), user), CreateTestRequest(), p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 2, p.Got.NumImported)
+	assert.Equal(t, 1, repo.NumBoundingBoxesAdded)
+	assert.Equal(t, 1, repo.NumPolygonsAdded)
+	assert.Equal(t, a.Source{Model: "a-model", Confidence: 0.9}, repo.GotBox.Source)
+	assert.Equal(t, a.Source{Model: "a-model", Confidence: 0.4}, repo.GotPolygon.Source)
+	assert.Equal(t, label.Name, repo.GotPolygon.Label.Name)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+}
+
+func TestInvalidPredictionShouldImportNothing(t *testing.T) {
+	for name, edit := range map[string]func(*Request){
+		"missing model":      func(r *Request) { r.Model = " " },
+		"no predictions":     func(r *Request) { r.Predictions = nil },
+		"confidence above 1": func(r *Request) { r.Predictions[1].Confidence = 1.5 },
+		"no shape":           func(r *Request) { r.Predictions[1].Points = nil },
+		"two shapes":         func(r *Request) { r.Predictions[0].Points = r.Predictions[1].Points },
+		"invalid box":        func(r *Request) { r.Predictions[0].Box.Width = -1 },
+		"degenerate polygon": func(r *Request) { r.Predictions[1].Points = &a.Points{} },
+		"malformed image id": func(r *Request) { r.Predictions[1].ImageId = "not-an-id" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			p := &FakePresenter{}
+			repo := &fk.AnnotationRepo{}
+			itr := New(&TestingTransactor{repo}, &fk.ImageStore{}, &fk.LabelRepo{}, &fk.CollectionRepo{})
+			req := CreateTestRequest()
+			edit(&req)
+			itr.Execute(t.Context(), req, p)
+			assert.True(t, p.GotValidationErr)
+			assert.Equal(t, 0, repo.NumBoundingBoxesAdded+repo.NumPolygonsAdded)
+		})
+	}
+}
+
+func TestMissingLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	itr := New(&TestingTransactor{repo}, &fk.ImageStore{}, &fk.LabelRepo{ErrOnFind: e.ErrNotFound}, &fk.CollectionRepo{})
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.Equal(t, 0, repo.NumBoundingBoxesAdded)
+}
+
+func TestInternalErrOnAddShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&TestingTransactor{&fk.AnnotationRepo{ErrOnAddPoly: e.ErrInternal}}, &fk.ImageStore{}, &fk.LabelRepo{},
+		&fk.CollectionRepo{})
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelNotAllowedInCollectionShouldImportNothing(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithLabels([]string{"car"}))
+	itr := New(&TestingTransactor{repo}, &fk.ImageStore{}, &fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "a-label")},
+		&fk.CollectionRepo{Return: collection})
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumBoundingBoxesAdded)
+	assert.Equal(t, 0, repo.NumPolygonsAdded)
+}
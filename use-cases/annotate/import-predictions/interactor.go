@@ -0,0 +1,185 @@
+package import_predictions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	Transactor
+	ImageStore
+	LabelRepo
+	CollectionRepo
+	Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(tra Transactor, imageStore ImageStore, labelRepo LabelRepo, collectionRepo CollectionRepo,
+	opts ...Option) Interactor {
+	i := &Interactor{
+		Transactor:     tra,
+		ImageStore:     imageStore,
+		LabelRepo:      labelRepo,
+		CollectionRepo: collectionRepo,
+		Clock:          clockwork.NewRealClock(),
+		Auth:           auth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// shape is a validated prediction, ready to be stored.
+type shape struct {
+	imageId im.ImageId
+	box     *a.BoundingBox
+	polygon *a.Polygon
+}
+
+// Execute validates all predictions before storing any of them, and stores
+// them in a single transaction, so that a faulty prediction does not leave
+// the collection half imported.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "ImportPredictions", au.Target{Type: "collection", Id: r.Collection})
+	defer record.End()
+
+	errCtx := fmt.Errorf("importing predictions of model %v", r.Model)
+	collection, err := i.CollectionRepo.Find(r.Collection)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: finding collection %v: %w", errCtx, r.Collection, err)))
+		return
+	}
+	if collection.Group != nil {
+		if err := i.Auth.ImportPredictions(ctx, *collection.Group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	shapes, err := i.validate(*collection, r)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	var userId *u.UserId
+	if user := u.IdentityFromContext(ctx); user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	if err := i.Transactor.RunInTx(func(tx Repo) error {
+		for _, s := range shapes {
+			var err error
+			if s.box != nil {
+				err = tx.AddBoundingBox(s.imageId, collection.Name, *s.box, userId, &now)
+			} else {
+				err = tx.AddPolygon(s.imageId, collection.Name, *s.polygon, userId, &now)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	out.SuccessImportPredictions(Response{NumImported: len(shapes)})
+}
+
+func (i Interactor) validate(collection clc.Collection, r Request) ([]shape, error) {
+	if len(r.Predictions) == 0 {
+		return nil, fmt.Errorf("no predictions given: %w", e.ErrValidation)
+	}
+	labels := map[string]*lbl.Label{}
+	images := map[string]*im.Image{}
+	shapes := []shape{}
+	for n, p := range r.Predictions {
+		errCtx := fmt.Errorf("validating prediction %v", n)
+		source, err := a.NewPrediction(r.Model, p.Confidence)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCtx, err)
+		}
+		if (p.Box == nil) == (p.Points == nil) {
+			return nil, fmt.Errorf("%w: expected either a bounding box or a polygon: %w", errCtx, e.ErrValidation)
+		}
+
+		image, ok := images[p.ImageId]
+		if !ok {
+			imageId, err := im.NewImageIdFromString(p.ImageId)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			image, err = i.ImageStore.Find(im.BaseImage{ImageId: imageId, Collection: collection.Name})
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			images[p.ImageId] = image
+		}
+		label, ok := labels[p.Label]
+		if !ok {
+			label, err = i.LabelRepo.FindLabel(p.Label)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			if err := collection.CheckLabel(label.Name); err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			labels[p.Label] = label
+		}
+
+		s := shape{imageId: image.Id}
+		if p.Box != nil {
+			box := a.NewBoundingBox(a.NewAnnotationId(), p.Box.Xc, p.Box.Yc, p.Box.Width, p.Box.Height,
+				*label, a.WithAngle(p.Box.Angle))
+			box.Source = source
+			if err := image.AddBoundingBox(box); err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			s.box = &box
+		} else {
+			polygon := a.NewPolygon(a.NewAnnotationId(), *p.Points, *label)
+			polygon.Source = source
+			if err := image.AddPolygon(polygon); err != nil {
+				return nil, fmt.Errorf("%w: %w", errCtx, err)
+			}
+			s.polygon = &polygon
+		}
+		shapes = append(shapes, s)
+	}
+	return shapes, nil
+}
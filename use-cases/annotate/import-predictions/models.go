@@ -0,0 +1,25 @@
+package import_predictions
+
+import (
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+// Prediction is a single shape produced by a model, which is either a
+// bounding box or a polygon.
+type Prediction struct {
+	ImageId    string
+	Label      string
+	Confidence float32
+	Box        *an.BoxCoordinates
+	Points     *an.Points
+}
+
+type Request struct {
+	Collection  string
+	Model       string
+	Predictions []Prediction
+}
+
+type Response struct {
+	NumImported int
+}
@@ -0,0 +1,6 @@
+package import_predictions
+
+type OutputPort interface {
+	Error(error)
+	SuccessImportPredictions(Response)
+}
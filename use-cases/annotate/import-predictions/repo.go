@@ -0,0 +1,34 @@
+package import_predictions
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	AddBoundingBox(im.ImageId, clc.CollectionName, a.BoundingBox, *u.UserId, *time.Time) error
+	AddPolygon(im.ImageId, clc.CollectionName, a.Polygon, *u.UserId, *time.Time) error
+}
+
+// Transactor runs fn with a repository whose writes are committed together,
+// or not at all.
+type Transactor interface {
+	RunInTx(fn func(Repo) error) error
+}
+
+type ImageStore interface {
+	Find(base im.BaseImage) (*im.Image, error)
+}
+
+type LabelRepo interface {
+	FindLabel(string) (*lbl.Label, error)
+}
+
+type CollectionRepo interface {
+	Find(string) (*clc.Collection, error)
+}
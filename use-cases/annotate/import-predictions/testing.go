@@ -0,0 +1,24 @@
+package import_predictions
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type TestingTransactor struct {
+	Repo
+}
+
+func (t *TestingTransactor) RunInTx(fn func(Repo) error) error {
+	return fn(t.Repo)
+}
+
+type FakePresenter struct {
+	GotSuccess bool
+	Got        Response
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessImportPredictions(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
@@ -2,22 +2,50 @@ package annotate
 
 import (
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	accpred "github.com/lejeunel/go-image-annotator/use-cases/annotate/accept-prediction"
 	addbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-bbox"
+	addkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-keypoints"
+	addmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-mask"
 	addpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polygon"
+	addline "github.com/lejeunel/go-image-annotator/use-cases/annotate/add-polyline"
 	addlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/assign-label"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/history"
+	imppred "github.com/lejeunel/go-image-annotator/use-cases/annotate/import-predictions"
+	mask2poly "github.com/lejeunel/go-image-annotator/use-cases/annotate/mask-to-polygons"
 	updbox "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-bbox"
+	updkp "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-keypoints"
+	updmask "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-mask"
 	updpoly "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polygon"
+	updline "github.com/lejeunel/go-image-annotator/use-cases/annotate/modify-polyline"
+	paint "github.com/lejeunel/go-image-annotator/use-cases/annotate/paint-mask"
+	poly2mask "github.com/lejeunel/go-image-annotator/use-cases/annotate/polygon-to-mask"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/remove"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/revert"
+	setattr "github.com/lejeunel/go-image-annotator/use-cases/annotate/set-attributes"
 	updlbl "github.com/lejeunel/go-image-annotator/use-cases/annotate/update-label"
 )
 
 type Interactors struct {
-	AddBox        addbox.Interactor
-	UpdateBox     updbox.Interactor
-	AddPolygon    addpoly.Interactor
-	UpdatePolygon updpoly.Interactor
-	Delete        remove.Interactor
-	UpdateLabel   updlbl.Interactor
-	AddImageLabel addlbl.Interactor
-	Authorizer    auth.Authorizer
+	AddBox            addbox.Interactor
+	UpdateBox         updbox.Interactor
+	AddPolygon        addpoly.Interactor
+	UpdatePolygon     updpoly.Interactor
+	AddPolyline       addline.Interactor
+	UpdatePolyline    updline.Interactor
+	AddKeypoints      addkp.Interactor
+	UpdateKeypoints   updkp.Interactor
+	AddMask           addmask.Interactor
+	UpdateMask        updmask.Interactor
+	PaintMask         paint.Interactor
+	PolygonToMask     poly2mask.Interactor
+	MaskToPolygons    mask2poly.Interactor
+	Delete            remove.Interactor
+	UpdateLabel       updlbl.Interactor
+	SetAttributes     setattr.Interactor
+	AddImageLabel     addlbl.Interactor
+	History           history.Interactor
+	Revert            revert.Interactor
+	ImportPredictions imppred.Interactor
+	AcceptPrediction  accpred.Interactor
+	Authorizer        auth.Authorizer
 }
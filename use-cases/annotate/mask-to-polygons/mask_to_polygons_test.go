@@ -0,0 +1,86 @@
+package mask_to_polygons
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateImage() im.Image {
+	collection := clc.NewCollection(clc.NewCollectionId(), "my-collection")
+	image := im.NewImage(im.NewImageId(), collection)
+	image.Specs = im.Specs{Width: 3, Height: 2}
+	return image
+}
+
+// CreateMask covers the first and last columns of a 3x2 image, which makes
+// two separate regions.
+func CreateMask() *a.Mask {
+	mask := a.NewMask(a.NewAnnotationId(), a.RLE{Width: 3, Height: 2, Counts: []int{0, 2, 2, 2}},
+		lbl.NewLabel(lbl.NewLabelId(), "a-label"))
+	mask.Attributes = a.Attributes{"occluded": true}
+	return &mask
+}
+
+func TestHandleAuthError(t *testing.T) {
+	image := CreateImage()
+	group := "my-group"
+	image.Collection.Group = &group
+	mask := CreateMask()
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{Mask: mask},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{AnnotationId: mask.Id.String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestConvert(t *testing.T) {
+	image := CreateImage()
+	mask := CreateMask()
+	repo := &fk.AnnotationRepo{Mask: mask}
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, repo).Execute(t.Context(), Request{AnnotationId: mask.Id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 2, repo.NumPolygonsAdded)
+	assert.Len(t, p.Ids, 2)
+	assert.Equal(t, [][2]float32{{2, 0}, {3, 0}, {3, 2}, {2, 2}}, repo.GotPolygon.Points.Coordinates)
+	assert.Equal(t, mask.Label, repo.GotPolygon.Label)
+	assert.Equal(t, mask.Attributes, repo.GotPolygon.Attributes)
+}
+
+func TestEmptyMaskShouldFail(t *testing.T) {
+	image := CreateImage()
+	mask := CreateMask()
+	mask.RLE = a.EmptyRLE(3, 2)
+	repo := &fk.AnnotationRepo{Mask: mask}
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, repo).Execute(t.Context(), Request{AnnotationId: mask.Id.String()}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumPolygonsAdded)
+}
+
+func TestMissingMaskShouldFail(t *testing.T) {
+	image := CreateImage()
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{}).Execute(t.Context(),
+		Request{AnnotationId: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnAddPolygonShouldFail(t *testing.T) {
+	image := CreateImage()
+	mask := CreateMask()
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{Mask: mask, ErrOnAddPoly: e.ErrInternal}).
+		Execute(t.Context(), Request{AnnotationId: mask.Id.String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
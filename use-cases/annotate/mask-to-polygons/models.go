@@ -0,0 +1,15 @@
+package mask_to_polygons
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Request struct {
+	AnnotationId string
+}
+
+// Response lists the polygons created, one per connected region of the
+// mask.
+type Response struct {
+	Ids []a.AnnotationId
+}
@@ -0,0 +1,6 @@
+package mask_to_polygons
+
+type OutputPort interface {
+	Error(error)
+	SuccessConvertMaskToPolygons(Response)
+}
@@ -0,0 +1,16 @@
+package mask_to_polygons
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type AnnotationRepo interface {
+	ImageOfAnnotation(a.AnnotationId) (*im.BaseImage, error)
+	FindMask(a.AnnotationId) (*a.Mask, error)
+	AddPolygon(im.ImageId, clc.CollectionName, a.Polygon, *u.UserId, *time.Time) error
+}
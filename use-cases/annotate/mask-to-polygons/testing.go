@@ -0,0 +1,17 @@
+package mask_to_polygons
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	Ids        []a.AnnotationId
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessConvertMaskToPolygons(r Response) {
+	p.GotSuccess = true
+	p.Ids = r.Ids
+}
@@ -7,8 +7,11 @@ import (
 	"github.com/jonboulle/clockwork"
 
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
 )
@@ -22,6 +25,7 @@ type Interactor struct {
 	LabelRepo
 	auth.Auth
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 type Option func(*Interactor)
@@ -32,6 +36,12 @@ func WithAuth(a auth.Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithClock(c clockwork.Clock) Option {
 	return func(i *Interactor) {
 		i.Clock = c
@@ -52,37 +62,45 @@ func New(repo AnnotationRepo, labelRepo LabelRepo, opts ...Option) Interactor {
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.AnnotationId})
+	defer record.End()
+
 	errCtx := "updating bounding box properties"
 	annotationId, err := a.NewAnnotationIdFromString(r.AnnotationId)
 	if err != nil {
-		out.Error(err)
+		out.Error(record.Fail(err))
 		return
 	}
 	group, err := i.AnnotationRepo.GroupOfAnnotation(*annotationId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err)))
 		return
 	}
 
 	if group != nil {
 		if err := i.Auth.Annotate(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: authenticating: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: authenticating: %w", errCtx, err)))
 			return
 		}
 	}
-	label, err := i.findLabel(r.Label)
+	label, err := i.findLabel(*annotationId, r.Label)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: fetching label %v: %w", errCtx, r.Label, err))
+		out.Error(record.Fail(fmt.Errorf("%v: fetching label %v: %w", errCtx, r.Label, err)))
 		return
 	}
 	u, err := i.validate(r.Xc, r.Yc, r.Width, r.Height, *label, r.Angle)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: validating coordinates: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: validating coordinates: %w", errCtx, err)))
+		return
+	}
+	u.Attributes, err = i.attributes(*annotationId, *label, r.Attributes)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: validating attributes: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.update(ctx, *annotationId, *u); err != nil {
-		out.Error(fmt.Errorf("%v: updating: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: updating: %w", errCtx, err)))
 		return
 	}
 	out.SuccessUpdateBox(Response{})
@@ -118,10 +136,30 @@ func (i Interactor) validate(xc float32, yc float32, width float32,
 	}, nil
 }
 
-func (i Interactor) findLabel(name string) (*lbl.Label, error) {
+// attributes validates the attributes requested for the box. Without any,
+// the box keeps the values it has for attributes of its new label.
+func (i Interactor) attributes(id a.AnnotationId, label lbl.Label, requested a.Attributes) (a.Attributes, error) {
+	if requested == nil {
+		current, err := i.AnnotationRepo.FindAttributes(id)
+		if err != nil {
+			return nil, err
+		}
+		requested = at.Retain(label.Attributes, current)
+	}
+	return at.Validate(label.Attributes, requested)
+}
+
+func (i Interactor) findLabel(id a.AnnotationId, name string) (*lbl.Label, error) {
 	label, err := i.LabelRepo.FindLabel(name)
 	if err != nil {
 		return nil, err
 	}
+	collection, err := i.AnnotationRepo.CollectionOfAnnotation(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		return nil, err
+	}
 	return label, nil
 }
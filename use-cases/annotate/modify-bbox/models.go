@@ -16,4 +16,6 @@ type Request struct {
 	Width        float32
 	Height       float32
 	Angle        float32
+	// Attributes replace those of the box, unless nil.
+	Attributes a.Attributes
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
@@ -137,3 +138,37 @@ func TestUpdate(t *testing.T) {
 	assert.True(t, p.GotSuccess)
 	AssertUpdated(t, upd, repo.GotUpdatableBox)
 }
+
+func TestUpdateKeepsAttributesOfLabel(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, _ := CreateRequestAndUpdatable()
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute},
+	}))
+	repo := &fk.AnnotationRepo{Attributes: a.Attributes{"occluded": true, "plate": "GE 1234"}}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Attributes{"occluded": true}, repo.GotUpdatableBox.Attributes)
+}
+
+func TestUpdateWithInvalidAttributesShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	req.Attributes = a.Attributes{"occluded": true}
+	itr := New(&fk.AnnotationRepo{}, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{Collection: clc.NewCollection(clc.NewCollectionId(), "a-collection",
+		clc.WithLabels([]string{"car"}))}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 )
@@ -11,6 +12,8 @@ import (
 type AnnotationRepo interface {
 	UpdateBoundingBox(a.AnnotationId, a.BoundingBoxUpdatables, *u.UserId, *time.Time) error
 	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	CollectionOfAnnotation(a.AnnotationId) (*clc.Collection, error)
+	FindAttributes(a.AnnotationId) (a.Attributes, error)
 }
 
 type LabelRepo interface {
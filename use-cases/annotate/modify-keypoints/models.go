@@ -0,0 +1,17 @@
+package modify_keypoints
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Response struct {
+	Id a.AnnotationId
+}
+
+type Request struct {
+	AnnotationId string
+	Label        string
+	Points       []a.Keypoint
+	// Attributes replace those of the pose, unless nil.
+	Attributes a.Attributes
+}
@@ -0,0 +1,103 @@
+package modify_keypoints
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateRequestAndLabel() (Request, lbl.Label) {
+	label := lbl.NewLabel(lbl.NewLabelId(), "person", lbl.WithSkeleton(&lbl.Skeleton{
+		Points: []string{"head", "neck"}, Edges: [][2]string{{"head", "neck"}},
+	}))
+	req := Request{
+		AnnotationId: a.NewAnnotationId().String(),
+		Label:        label.Name,
+		Points:       []a.Keypoint{{X: 1, Y: 2, Visibility: a.Visible}, {Visibility: a.NotLabeled}},
+	}
+	return req, label
+}
+
+func TestHandleAuthError(t *testing.T) {
+	p := &FakePresenter{}
+	req, label := CreateRequestAndLabel()
+	itr := New(&fk.AnnotationRepo{}, &fk.LabelRepo{Return: label},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnFindLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _ := CreateRequestAndLabel()
+	itr := New(&fk.AnnotationRepo{}, &fk.LabelRepo{ErrOnFind: e.ErrInternal})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnUpdateShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, label := CreateRequestAndLabel()
+	itr := New(&fk.AnnotationRepo{ErrOnUpdate: e.ErrInternal}, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestPointsNotFittingSkeletonShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, label := CreateRequestAndLabel()
+	req.Points = append(req.Points, a.Keypoint{X: 5, Y: 5, Visibility: a.Visible})
+	repo := &fk.AnnotationRepo{}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Nil(t, repo.GotUpdatableKeypoints.Points)
+}
+
+func TestUpdate(t *testing.T) {
+	p := &FakePresenter{}
+	req, label := CreateRequestAndLabel()
+	repo := &fk.AnnotationRepo{}
+	now := time.Now()
+	user := u.NewUser("user@example.com")
+	itr := New(repo, &fk.LabelRepo{Return: label}, WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(u.AppendUserToContext(t.Context(), user), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, label.Id, repo.GotUpdatableKeypoints.LabelId)
+	assert.Equal(t, req.Points, repo.GotUpdatableKeypoints.Points)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+}
+
+func TestUpdateRetainsAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	req, label := CreateRequestAndLabel()
+	label.Attributes = []lbl.Attribute{{Name: "crowd", Type: lbl.BoolAttribute}}
+	repo := &fk.AnnotationRepo{Attributes: a.Attributes{"crowd": true, "stale": 1.0}}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Attributes{"crowd": true}, repo.GotUpdatableKeypoints.Attributes)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, label := CreateRequestAndLabel()
+	repo := &fk.AnnotationRepo{Collection: clc.NewCollection(clc.NewCollectionId(), "a-collection",
+		clc.WithLabels([]string{"car"}))}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
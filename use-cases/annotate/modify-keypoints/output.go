@@ -0,0 +1,6 @@
+package modify_keypoints
+
+type OutputPort interface {
+	Error(error)
+	SuccessUpdateKeypoints(Response)
+}
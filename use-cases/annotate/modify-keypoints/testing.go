@@ -0,0 +1,14 @@
+package modify_keypoints
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessUpdateKeypoints(Response) {
+	p.GotSuccess = true
+}
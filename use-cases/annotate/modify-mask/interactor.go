@@ -0,0 +1,156 @@
+package modify_mask
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
+)
+
+type Interface interface {
+	Execute(context.Context, Request, OutputPort)
+}
+
+type Interactor struct {
+	AnnotationRepo
+	LabelRepo
+	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a auth.Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(repo AnnotationRepo, labelRepo LabelRepo, opts ...Option) Interactor {
+	i := &Interactor{
+		AnnotationRepo: repo,
+		LabelRepo:      labelRepo,
+		Clock:          clockwork.NewRealClock(),
+		Auth:           sauth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.AnnotationId})
+	defer record.End()
+
+	errCtx := "updating mask"
+	annotationId, err := a.NewAnnotationIdFromString(r.AnnotationId)
+	if err != nil {
+		out.Error(record.Fail(err))
+		return
+	}
+	group, err := i.AnnotationRepo.GroupOfAnnotation(*annotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err)))
+		return
+	}
+
+	if group != nil {
+		if err := i.Auth.Annotate(ctx, *group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: authenticating: %w", errCtx, err)))
+			return
+		}
+	}
+	label, err := i.findLabel(*annotationId, r.Label)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching label %v: %w", errCtx, r.Label, err)))
+		return
+	}
+	current, err := i.AnnotationRepo.FindMask(*annotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching mask: %w", errCtx, err)))
+		return
+	}
+	if err := a.ValidateMask(r.RLE, current.RLE.Width, current.RLE.Height); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	attributes, err := i.attributes(*annotationId, *label, r.Attributes)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: validating attributes: %w", errCtx, err)))
+		return
+	}
+	if err := i.update(
+		ctx,
+		*annotationId,
+		a.MaskUpdatables{LabelId: label.Id, RLE: r.RLE, Attributes: attributes},
+	); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: updating: %w", errCtx, err)))
+		return
+	}
+	out.SuccessUpdateMask(Response{})
+}
+
+func (i Interactor) update(ctx context.Context, id a.AnnotationId, upd a.MaskUpdatables) error {
+	var userId *u.UserId
+	user := u.IdentityFromContext(ctx)
+	if user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+
+	if err := i.AnnotationRepo.UpdateMask(id, upd, userId, &now); err != nil {
+		return err
+	}
+	return nil
+}
+
+// attributes validates the attributes requested for the mask. Without
+// any, the mask keeps the values it has for attributes of its new label.
+func (i Interactor) attributes(id a.AnnotationId, label lbl.Label, requested a.Attributes) (a.Attributes, error) {
+	if requested == nil {
+		current, err := i.AnnotationRepo.FindAttributes(id)
+		if err != nil {
+			return nil, err
+		}
+		requested = at.Retain(label.Attributes, current)
+	}
+	return at.Validate(label.Attributes, requested)
+}
+
+func (i Interactor) findLabel(id a.AnnotationId, name string) (*lbl.Label, error) {
+	label, err := i.LabelRepo.FindLabel(name)
+	if err != nil {
+		return nil, err
+	}
+	collection, err := i.AnnotationRepo.CollectionOfAnnotation(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		return nil, err
+	}
+	return label, nil
+}
@@ -0,0 +1,17 @@
+package modify_mask
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Response struct {
+	Id a.AnnotationId
+}
+
+type Request struct {
+	AnnotationId string
+	Label        string
+	RLE          a.RLE
+	// Attributes replace those of the mask, unless nil.
+	Attributes a.Attributes
+}
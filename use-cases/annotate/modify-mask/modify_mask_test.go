@@ -0,0 +1,170 @@
+package modify_mask
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateRequestAndUpdatable() (Request, a.MaskUpdatables, lbl.Label) {
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label")
+
+	req := Request{
+		AnnotationId: a.NewAnnotationId().String(),
+		RLE:          a.RLE{Width: 2, Height: 2, Counts: []int{1, 3}},
+		Label:        label.Name,
+	}
+	upd := a.MaskUpdatables{LabelId: label.Id, RLE: req.RLE}
+	return req, upd, label
+}
+
+func AssertUpdated(t *testing.T, expected, got a.MaskUpdatables) {
+	assert.Equal(t, expected.RLE, got.RLE)
+	assert.Equal(t, expected.LabelId, got.LabelId)
+}
+
+// CurrentMask is the mask being modified, of the same size as requested ones.
+func CurrentMask() *a.Mask {
+	mask := a.NewMask(a.NewAnnotationId(), a.EmptyRLE(2, 2), lbl.Label{})
+	return &mask
+}
+
+func TestHandleAuthError(t *testing.T) {
+	itr := New(&fk.AnnotationRepo{},
+		&fk.LabelRepo{},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{AnnotationId: a.NewAnnotationId().String()},
+		p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnFindLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.AnnotationRepo{}, &fk.LabelRepo{ErrOnFind: e.ErrInternal})
+	itr.Execute(t.Context(), Request{}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnUpdateShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.AnnotationRepo{ErrOnUpdate: e.ErrInternal, Mask: CurrentMask()},
+		&fk.LabelRepo{})
+	req, _, _ := CreateRequestAndUpdatable()
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestUpdateWithDefaultGroup(t *testing.T) {
+	p := &FakePresenter{}
+	req, upd, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{NoGroup: true, Mask: CurrentMask()}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	AssertUpdated(t, upd, repo.GotUpdatableMask)
+}
+
+func TestUpdateWithUserIdFromContext(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{NoGroup: true, Mask: CurrentMask()}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	user := u.NewUser("user@example.com")
+	ctx := u.AppendUserToContext(t.Context(), user)
+	itr.Execute(ctx, req, p)
+	assert.NotNil(t, repo.GotUserId)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+}
+
+func TestTime(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{NoGroup: true, Mask: CurrentMask()}
+	now := time.Now()
+	itr := New(repo, &fk.LabelRepo{Return: label},
+		WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(t.Context(), req, p)
+	assert.NotNil(t, repo.GotTime)
+	assert.Equal(t, now, *repo.GotTime)
+}
+
+func TestUpdate(t *testing.T) {
+	p := &FakePresenter{}
+	req, upd, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{Mask: CurrentMask()}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	AssertUpdated(t, upd, repo.GotUpdatableMask)
+}
+
+func TestUpdateReplacesAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, _ := CreateRequestAndUpdatable()
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute},
+		{Name: "truncated", Type: lbl.BoolAttribute},
+	}))
+	req.Attributes = a.Attributes{"truncated": true}
+	repo := &fk.AnnotationRepo{Attributes: a.Attributes{"occluded": true}, Mask: CurrentMask()}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Attributes{"truncated": true}, repo.GotUpdatableMask.Attributes)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{Collection: clc.NewCollection(clc.NewCollectionId(), "a-collection",
+		clc.WithLabels([]string{"car"}))}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestMaskOfOtherSizeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	req.RLE = a.RLE{Width: 1, Height: 4, Counts: []int{4}}
+	repo := &fk.AnnotationRepo{Mask: CurrentMask()}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestOverflowingCountsShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	req.RLE = a.RLE{Width: 2, Height: 2, Counts: []int{math.MaxInt, math.MaxInt, 2 + 4}}
+	repo := &fk.AnnotationRepo{Mask: CurrentMask()}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestMissingMaskShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	itr := New(&fk.AnnotationRepo{}, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.False(t, p.GotSuccess)
+}
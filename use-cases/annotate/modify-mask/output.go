@@ -0,0 +1,6 @@
+package modify_mask
+
+type OutputPort interface {
+	Error(error)
+	SuccessUpdateMask(Response)
+}
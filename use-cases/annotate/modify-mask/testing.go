@@ -0,0 +1,14 @@
+package modify_mask
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessUpdateMask(Response) {
+	p.GotSuccess = true
+}
@@ -12,4 +12,6 @@ type Request struct {
 	AnnotationId string
 	Label        string
 	Points       a.Points
+	// Attributes replace those of the polygon, unless nil.
+	Attributes a.Attributes
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
@@ -105,3 +106,29 @@ func TestUpdate(t *testing.T) {
 	assert.True(t, p.GotSuccess)
 	AssertUpdated(t, upd, repo.GotUpdatablePoly)
 }
+
+func TestUpdateReplacesAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, _ := CreateRequestAndUpdatable()
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute},
+		{Name: "truncated", Type: lbl.BoolAttribute},
+	}))
+	req.Attributes = a.Attributes{"truncated": true}
+	repo := &fk.AnnotationRepo{Attributes: a.Attributes{"occluded": true}}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Attributes{"truncated": true}, repo.GotUpdatablePoly.Attributes)
+}
+
+func TestLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	req, _, label := CreateRequestAndUpdatable()
+	repo := &fk.AnnotationRepo{Collection: clc.NewCollection(clc.NewCollectionId(), "a-collection",
+		clc.WithLabels([]string{"car"}))}
+	itr := New(repo, &fk.LabelRepo{Return: label})
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
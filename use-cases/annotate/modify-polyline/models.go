@@ -0,0 +1,17 @@
+package modify_polyline
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Response struct {
+	Id a.AnnotationId
+}
+
+type Request struct {
+	AnnotationId string
+	Label        string
+	Points       a.Points
+	// Attributes replace those of the polyline, unless nil.
+	Attributes a.Attributes
+}
@@ -0,0 +1,6 @@
+package modify_polyline
+
+type OutputPort interface {
+	Error(error)
+	SuccessUpdatePolyline(Response)
+}
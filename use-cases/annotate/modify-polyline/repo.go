@@ -0,0 +1,21 @@
+package modify_polyline
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type AnnotationRepo interface {
+	UpdatePolyline(a.AnnotationId, a.PolylineUpdatables, *u.UserId, *time.Time) error
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	CollectionOfAnnotation(a.AnnotationId) (*clc.Collection, error)
+	FindAttributes(a.AnnotationId) (a.Attributes, error)
+}
+
+type LabelRepo interface {
+	FindLabel(string) (*lbl.Label, error)
+}
@@ -0,0 +1,14 @@
+package modify_polyline
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessUpdatePolyline(Response) {
+	p.GotSuccess = true
+}
@@ -0,0 +1,120 @@
+package paint_mask
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	mk "github.com/lejeunel/go-image-annotator/modules/mask"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
+)
+
+type Interface interface {
+	Execute(context.Context, Request, OutputPort)
+}
+
+type Interactor struct {
+	AnnotationRepo
+	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a auth.Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(repo AnnotationRepo, opts ...Option) Interactor {
+	i := &Interactor{
+		AnnotationRepo: repo,
+		Clock:          clockwork.NewRealClock(),
+		Auth:           sauth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.AnnotationId})
+	defer record.End()
+
+	errCtx := "painting mask"
+	if r.Erase {
+		errCtx = "erasing mask"
+	}
+	annotationId, err := a.NewAnnotationIdFromString(r.AnnotationId)
+	if err != nil {
+		out.Error(record.Fail(err))
+		return
+	}
+	group, err := i.AnnotationRepo.GroupOfAnnotation(*annotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err)))
+		return
+	}
+	if group != nil {
+		if err := i.Auth.Annotate(ctx, *group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: authenticating: %w", errCtx, err)))
+			return
+		}
+	}
+	if len(r.Points.Coordinates) == 0 || r.Radius <= 0 {
+		out.Error(record.Fail(fmt.Errorf("%v: a stroke needs at least one point and a positive radius: %w",
+			errCtx, e.ErrValidation)))
+		return
+	}
+	mask, err := i.AnnotationRepo.FindMask(*annotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching mask: %w", errCtx, err)))
+		return
+	}
+	bitmap, err := mk.Decode(mask.RLE)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	bitmap.Stroke(r.Points.Coordinates, r.Radius, !r.Erase)
+	rle := bitmap.Encode()
+
+	if err := i.update(ctx, *annotationId,
+		a.MaskUpdatables{LabelId: mask.Label.Id, RLE: rle, Attributes: mask.Attributes}); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: updating: %w", errCtx, err)))
+		return
+	}
+	out.SuccessPaintMask(Response{Id: *annotationId, RLE: rle})
+}
+
+func (i Interactor) update(ctx context.Context, id a.AnnotationId, upd a.MaskUpdatables) error {
+	var userId *u.UserId
+	user := u.IdentityFromContext(ctx)
+	if user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	return i.AnnotationRepo.UpdateMask(id, upd, userId, &now)
+}
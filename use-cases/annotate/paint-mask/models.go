@@ -0,0 +1,19 @@
+package paint_mask
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+// Request drags a round brush of the given radius, in pixels, along the
+// points. With Erase, the brush clears pixels instead of setting them.
+type Request struct {
+	AnnotationId string
+	Points       a.Points
+	Radius       float32
+	Erase        bool
+}
+
+type Response struct {
+	Id  a.AnnotationId
+	RLE a.RLE
+}
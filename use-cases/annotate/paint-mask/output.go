@@ -0,0 +1,6 @@
+package paint_mask
+
+type OutputPort interface {
+	Error(error)
+	SuccessPaintMask(Response)
+}
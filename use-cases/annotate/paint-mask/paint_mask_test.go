@@ -0,0 +1,108 @@
+package paint_mask
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateMask(rle a.RLE) *a.Mask {
+	mask := a.NewMask(a.NewAnnotationId(), rle, lbl.NewLabel(lbl.NewLabelId(), "a-label"))
+	mask.Attributes = a.Attributes{"occluded": true}
+	return &mask
+}
+
+// CreateRequest strokes the middle row of a 3x3 mask.
+func CreateRequest(mask *a.Mask) Request {
+	return Request{
+		AnnotationId: mask.Id.String(),
+		Points:       a.Points{Coordinates: [][2]float32{{0.5, 1.5}, {2.5, 1.5}}},
+		Radius:       0.5,
+	}
+}
+
+func TestHandleAuthError(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	p := &FakePresenter{}
+	itr := New(&fk.AnnotationRepo{Mask: mask}, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), CreateRequest(mask), p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestBrush(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	repo := &fk.AnnotationRepo{Mask: mask}
+	p := &FakePresenter{}
+	New(repo).Execute(t.Context(), CreateRequest(mask), p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []int{1, 1, 2, 1, 2, 1, 1}, repo.GotUpdatableMask.RLE.Counts)
+	assert.Equal(t, mask.Label.Id, repo.GotUpdatableMask.LabelId)
+	assert.Equal(t, mask.Attributes, repo.GotUpdatableMask.Attributes)
+}
+
+func TestEraser(t *testing.T) {
+	mask := CreateMask(a.RLE{Width: 3, Height: 3, Counts: []int{0, 9}})
+	repo := &fk.AnnotationRepo{Mask: mask}
+	p := &FakePresenter{}
+	req := CreateRequest(mask)
+	req.Erase = true
+	New(repo).Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []int{0, 1, 1, 2, 1, 2, 1, 1}, repo.GotUpdatableMask.RLE.Counts)
+	assert.Equal(t, 6, repo.GotUpdatableMask.RLE.Area())
+}
+
+func TestEmptyStrokeShouldFail(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	p := &FakePresenter{}
+	req := CreateRequest(mask)
+	req.Points = a.Points{}
+	New(&fk.AnnotationRepo{Mask: mask}).Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestNonPositiveRadiusShouldFail(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	p := &FakePresenter{}
+	req := CreateRequest(mask)
+	req.Radius = 0
+	New(&fk.AnnotationRepo{Mask: mask}).Execute(t.Context(), req, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestMissingMaskShouldFail(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}).Execute(t.Context(), CreateRequest(mask), p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnUpdateShouldFail(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{Mask: mask, ErrOnUpdate: e.ErrInternal}).Execute(t.Context(), CreateRequest(mask), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestUserIdAndTime(t *testing.T) {
+	mask := CreateMask(a.EmptyRLE(3, 3))
+	repo := &fk.AnnotationRepo{Mask: mask}
+	now := time.Now()
+	user := u.NewUser("user@example.com")
+	ctx := u.AppendUserToContext(t.Context(), user)
+	New(repo, WithClock(clockwork.NewFakeClockAt(now))).Execute(ctx, CreateRequest(mask), &FakePresenter{})
+	assert.Equal(t, user.Id, *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+}
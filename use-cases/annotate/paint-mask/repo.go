@@ -0,0 +1,14 @@
+package paint_mask
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type AnnotationRepo interface {
+	FindMask(a.AnnotationId) (*a.Mask, error)
+	UpdateMask(a.AnnotationId, a.MaskUpdatables, *u.UserId, *time.Time) error
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+}
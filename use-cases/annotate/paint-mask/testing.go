@@ -0,0 +1,14 @@
+package paint_mask
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessPaintMask(Response) {
+	p.GotSuccess = true
+}
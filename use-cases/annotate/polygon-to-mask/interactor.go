@@ -0,0 +1,126 @@
+package polygon_to_mask
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	mk "github.com/lejeunel/go-image-annotator/modules/mask"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
+)
+
+type Interface interface {
+	Execute(context.Context, Request, OutputPort)
+}
+
+type ImageStore interface {
+	Find(base im.BaseImage) (*im.Image, error)
+}
+
+type Interactor struct {
+	ImageStore
+	AnnotationRepo
+	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a auth.Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(imageStore ImageStore, repo AnnotationRepo, opts ...Option) Interactor {
+	i := &Interactor{
+		ImageStore:     imageStore,
+		AnnotationRepo: repo,
+		Clock:          clockwork.NewRealClock(),
+		Auth:           sauth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute rasterizes a polygon into a new mask on the same image, with the
+// same label and attributes. The polygon itself is left untouched.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.AnnotationId})
+	defer record.End()
+
+	errCtx := "converting polygon to mask"
+	annotationId, err := a.NewAnnotationIdFromString(r.AnnotationId)
+	if err != nil {
+		out.Error(record.Fail(err))
+		return
+	}
+	base, err := i.AnnotationRepo.ImageOfAnnotation(*annotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	image, err := i.ImageStore.Find(*base)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if image.Collection.Group != nil {
+		if err := i.Auth.Annotate(ctx, *image.Collection.Group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+	polygon, err := i.AnnotationRepo.FindPolygon(*annotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	bitmap := mk.FromPolygon(polygon.Points.Coordinates, image.Specs.Width, image.Specs.Height)
+	mask := a.NewMask(a.NewAnnotationId(), bitmap.Encode(), polygon.Label)
+	mask.Attributes = polygon.Attributes
+	mask.Source = polygon.Source
+	if mask.RLE.Area() == 0 {
+		out.Error(record.Fail(fmt.Errorf("%v: polygon covers no pixel of the image: %w", errCtx, e.ErrValidation)))
+		return
+	}
+	if err := image.AddMask(mask); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	var userId *u.UserId
+	if user := u.IdentityFromContext(ctx); user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	if err := i.AnnotationRepo.AddMask(image.Id, image.Collection.Name, mask, userId, &now); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	out.SuccessConvertPolygonToMask(Response{Id: mask.Id})
+}
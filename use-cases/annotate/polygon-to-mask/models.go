@@ -0,0 +1,13 @@
+package polygon_to_mask
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Request struct {
+	AnnotationId string
+}
+
+type Response struct {
+	Id a.AnnotationId
+}
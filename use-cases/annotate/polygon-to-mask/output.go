@@ -0,0 +1,6 @@
+package polygon_to_mask
+
+type OutputPort interface {
+	Error(error)
+	SuccessConvertPolygonToMask(Response)
+}
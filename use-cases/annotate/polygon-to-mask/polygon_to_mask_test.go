@@ -0,0 +1,83 @@
+package polygon_to_mask
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateImage() im.Image {
+	collection := clc.NewCollection(clc.NewCollectionId(), "my-collection")
+	image := im.NewImage(im.NewImageId(), collection)
+	image.Specs = im.Specs{Width: 4, Height: 3}
+	return image
+}
+
+func CreatePolygon(coordinates ...[2]float32) *a.Polygon {
+	polygon := a.NewPolygon(a.NewAnnotationId(), a.Points{Coordinates: coordinates},
+		lbl.NewLabel(lbl.NewLabelId(), "a-label"))
+	polygon.Attributes = a.Attributes{"occluded": true}
+	return &polygon
+}
+
+func TestHandleAuthError(t *testing.T) {
+	image := CreateImage()
+	group := "my-group"
+	image.Collection.Group = &group
+	polygon := CreatePolygon([2]float32{0, 0}, [2]float32{2, 0}, [2]float32{2, 2})
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{Polygon: polygon},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{AnnotationId: polygon.Id.String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestConvert(t *testing.T) {
+	image := CreateImage()
+	polygon := CreatePolygon([2]float32{1, 0}, [2]float32{3, 0}, [2]float32{3, 2}, [2]float32{1, 2})
+	repo := &fk.AnnotationRepo{Polygon: polygon}
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, repo).Execute(t.Context(), Request{AnnotationId: polygon.Id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.RLE{Width: 4, Height: 3, Counts: []int{3, 2, 1, 2, 4}}, repo.GotMask.RLE)
+	assert.Equal(t, polygon.Label, repo.GotMask.Label)
+	assert.Equal(t, polygon.Attributes, repo.GotMask.Attributes)
+	assert.Equal(t, image.Id, repo.GotImageId)
+	assert.NotEqual(t, polygon.Id, repo.GotMask.Id)
+}
+
+func TestPolygonOutsideImageShouldFail(t *testing.T) {
+	image := CreateImage()
+	polygon := CreatePolygon([2]float32{10, 10}, [2]float32{12, 10}, [2]float32{12, 12})
+	repo := &fk.AnnotationRepo{Polygon: polygon}
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, repo).Execute(t.Context(), Request{AnnotationId: polygon.Id.String()}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Equal(t, 0, repo.NumMasksAdded)
+}
+
+func TestMissingPolygonShouldFail(t *testing.T) {
+	image := CreateImage()
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{}).Execute(t.Context(),
+		Request{AnnotationId: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrOnAddMaskShouldFail(t *testing.T) {
+	image := CreateImage()
+	polygon := CreatePolygon([2]float32{0, 0}, [2]float32{2, 0}, [2]float32{2, 2})
+	p := &FakePresenter{}
+	New(&fk.ImageStore{Return: &image}, &fk.AnnotationRepo{Polygon: polygon, ErrOnAddMask: e.ErrInternal}).
+		Execute(t.Context(), Request{AnnotationId: polygon.Id.String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
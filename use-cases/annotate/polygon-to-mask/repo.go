@@ -0,0 +1,16 @@
+package polygon_to_mask
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type AnnotationRepo interface {
+	ImageOfAnnotation(a.AnnotationId) (*im.BaseImage, error)
+	FindPolygon(a.AnnotationId) (*a.Polygon, error)
+	AddMask(im.ImageId, clc.CollectionName, a.Mask, *u.UserId, *time.Time) error
+}
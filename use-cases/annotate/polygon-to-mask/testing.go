@@ -0,0 +1,14 @@
+package polygon_to_mask
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessConvertPolygonToMask(Response) {
+	p.GotSuccess = true
+}
@@ -4,7 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jonboulle/clockwork"
+
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
 )
@@ -16,6 +21,8 @@ type Interface interface {
 type Interactor struct {
 	Repo
 	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 type Option func(*Interactor)
@@ -26,10 +33,23 @@ func WithAuth(a auth.Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
 func New(repo Repo, opts ...Option) Interactor {
 	i := &Interactor{
-		Repo: repo,
-		Auth: sauth.NewVoidAuth(),
+		Repo:  repo,
+		Auth:  sauth.NewVoidAuth(),
+		Clock: clockwork.NewRealClock(),
 	}
 	for _, opt := range opts {
 		opt(i)
@@ -38,28 +58,36 @@ func New(repo Repo, opts ...Option) Interactor {
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.Id})
+	defer record.End()
+
 	errCtx := "removing annotation"
 	id, err := a.NewAnnotationIdFromString(r.Id)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	group, err := i.Repo.GroupOfAnnotation(*id)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if group != nil {
 		if err := i.Auth.Annotate(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
-	if err := i.Repo.RemoveAnnotation(*id); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+	var userId *u.UserId
+	if user := u.IdentityFromContext(ctx); user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	if err := i.Repo.RemoveAnnotation(*id, userId, &now); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
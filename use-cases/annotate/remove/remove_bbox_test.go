@@ -2,10 +2,13 @@ package remove
 
 import (
 	"testing"
+	"time"
 
+	"github.com/jonboulle/clockwork"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,3 +58,15 @@ func TestRemoveBox(t *testing.T) {
 	assert.True(t, p.GotSuccess)
 	assert.Equal(t, annotationId, repo.GotRemovedAnnotation)
 }
+
+func TestRemoveRecordsAuthorAndTime(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{}
+	now := time.Now()
+	itr := New(repo, WithClock(clockwork.NewFakeClockAt(now)))
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Id: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "user@mail.com", *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+}
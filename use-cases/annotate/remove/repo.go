@@ -1,10 +1,13 @@
 package remove
 
 import (
+	"time"
+
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 )
 
 type Repo interface {
-	RemoveAnnotation(a.AnnotationId) error
+	RemoveAnnotation(a.AnnotationId, *u.UserId, *time.Time) error
 	GroupOfAnnotation(a.AnnotationId) (*string, error)
 }
@@ -0,0 +1,96 @@
+package revert
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
+)
+
+type Interactor struct {
+	AnnotationRepo
+	auth.Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a auth.Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(repo AnnotationRepo, opts ...Option) Interactor {
+	i := &Interactor{repo, sauth.NewVoidAuth(), clockwork.NewRealClock(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute brings an annotation back to its state right after the given
+// revision. Deleted annotations are recreated.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "revision", Id: r.RevisionId})
+	defer record.End()
+
+	errCtx := "reverting annotation"
+	id, err := a.NewRevisionIdFromString(r.RevisionId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	revision, err := i.AnnotationRepo.FindRevision(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	group, err := i.AnnotationRepo.GroupOfAnnotation(revision.AnnotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err)))
+		return
+	}
+	if group != nil {
+		if err := i.Auth.Annotate(ctx, *group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+	if !revision.IsRestorable() {
+		out.Error(record.Fail(fmt.Errorf("%v: revision %v deleted the annotation: %w", errCtx, r.RevisionId, e.ErrValidation)))
+		return
+	}
+
+	var userId *u.UserId
+	if user := u.IdentityFromContext(ctx); user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	reverted, err := i.AnnotationRepo.RestoreRevision(*id, userId, &now)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	out.SuccessRevert(Response{Revision: *reverted})
+}
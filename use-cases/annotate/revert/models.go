@@ -0,0 +1,15 @@
+package revert
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Request struct {
+	// RevisionId is the revision to bring the annotation back to.
+	RevisionId string
+}
+
+type Response struct {
+	// Revision records the revert itself.
+	Revision a.Revision
+}
@@ -0,0 +1,6 @@
+package revert
+
+type OutputPort interface {
+	Error(error)
+	SuccessRevert(Response)
+}
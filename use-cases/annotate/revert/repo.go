@@ -0,0 +1,14 @@
+package revert
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type AnnotationRepo interface {
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	FindRevision(a.RevisionId) (*a.Revision, error)
+	RestoreRevision(a.RevisionId, *u.UserId, *time.Time) (*a.Revision, error)
+}
@@ -0,0 +1,72 @@
+package revert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func repoWithRevision(after *a.Shape) (*fk.AnnotationRepo, a.Revision) {
+	revision := a.Revision{Id: a.NewRevisionId(), AnnotationId: a.NewAnnotationId(), Action: a.Modified,
+		After: after}
+	return &fk.AnnotationRepo{Revisions: []a.Revision{revision}}, revision
+}
+
+func TestRevert(t *testing.T) {
+	repo, revision := repoWithRevision(&a.Shape{Type: "image"})
+	now := time.Now()
+	p := &FakePresenter{}
+	New(repo, WithClock(clockwork.NewFakeClockAt(now))).Execute(
+		st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{RevisionId: revision.Id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, revision.Id, *repo.Restored)
+	assert.Equal(t, "user@mail.com", *repo.GotUserId)
+	assert.Equal(t, now, *repo.GotTime)
+	assert.Equal(t, a.Reverted, p.Got.Revision.Action)
+	assert.Equal(t, revision.AnnotationId, p.Got.Revision.AnnotationId)
+}
+
+func TestRevertToDeletionShouldFail(t *testing.T) {
+	repo, revision := repoWithRevision(nil)
+	p := &FakePresenter{}
+	New(repo).Execute(t.Context(), Request{RevisionId: revision.Id.String()}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Nil(t, repo.Restored)
+}
+
+func TestRevertMissingRevisionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}).Execute(t.Context(), Request{RevisionId: a.NewRevisionId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestInvalidIdShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}).Execute(t.Context(), Request{RevisionId: "not-an-id"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestHandleAuthError(t *testing.T) {
+	repo, revision := repoWithRevision(&a.Shape{Type: "image"})
+	p := &FakePresenter{}
+	New(repo, WithAuth(fk.Auth{Err: e.ErrAuthorization})).Execute(
+		t.Context(), Request{RevisionId: revision.Id.String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.Nil(t, repo.Restored)
+}
+
+func TestInternalErrShouldFail(t *testing.T) {
+	repo, revision := repoWithRevision(&a.Shape{Type: "image"})
+	repo.ErrOnRestoreRevision = e.ErrInternal
+	p := &FakePresenter{}
+	New(repo).Execute(t.Context(), Request{RevisionId: revision.Id.String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
@@ -0,0 +1,16 @@
+package revert
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessRevert(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
@@ -0,0 +1,15 @@
+package set_attributes
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Response struct {
+	AnnotationId a.AnnotationId
+	Attributes   a.Attributes
+}
+
+type Request struct {
+	AnnotationId string
+	Attributes   a.Attributes
+}
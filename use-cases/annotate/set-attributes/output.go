@@ -0,0 +1,6 @@
+package set_attributes
+
+type OutputPort interface {
+	Error(error)
+	SuccessSetAttributes(Response)
+}
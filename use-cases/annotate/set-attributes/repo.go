@@ -0,0 +1,15 @@
+package set_attributes
+
+import (
+	"time"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type AnnotationRepo interface {
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	LabelOfAnnotation(a.AnnotationId) (*lbl.Label, error)
+	UpdateAttributes(a.AnnotationId, a.Attributes, *u.UserId, *time.Time) error
+}
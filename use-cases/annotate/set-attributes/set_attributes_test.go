@@ -0,0 +1,49 @@
+package set_attributes
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var car = lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithAttributes([]lbl.Attribute{
+	{Name: "occluded", Type: lbl.BoolAttribute, Default: false},
+	{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+}))
+
+func TestHandleAuthError(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.AnnotationRepo{AnnotationLabel: car}, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{AnnotationId: a.NewAnnotationId().String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestInvalidValueShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{AnnotationLabel: car}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{AnnotationId: a.NewAnnotationId().String(),
+		Attributes: a.Attributes{"color": "green"}}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Nil(t, repo.GotAttributes)
+}
+
+func TestSetAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{AnnotationLabel: car}
+	itr := New(repo)
+	user := u.NewUser("user@example.com")
+	ctx := u.AppendUserToContext(t.Context(), user)
+	req := Request{AnnotationId: a.NewAnnotationId().String(), Attributes: a.Attributes{"color": "red"}}
+	itr.Execute(ctx, req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, req.AnnotationId, repo.UpdatedAnnotationId.String())
+	assert.Equal(t, a.Attributes{"color": "red", "occluded": false}, repo.GotAttributes)
+	assert.Equal(t, user.Id, *repo.GotUserId)
+}
@@ -0,0 +1,16 @@
+package set_attributes
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	Got        Response
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessSetAttributes(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
@@ -6,7 +6,10 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	"github.com/lejeunel/go-image-annotator/use-cases/annotate/auth"
 )
@@ -20,6 +23,7 @@ type Interactor struct {
 	LabelRepo
 	auth.Auth
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 type Option func(*Interactor)
@@ -30,6 +34,12 @@ func WithAuth(a auth.Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithClock(c clockwork.Clock) Option {
 	return func(i *Interactor) {
 		i.Clock = c
@@ -50,32 +60,56 @@ func New(repo AnnotationRepo, labelRepo LabelRepo, opts ...Option) Interactor {
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.Target{Type: "annotation", Id: r.AnnotationId})
+	defer record.End()
+
 	errCtx := "updating bounding box properties"
 	label, err := i.LabelRepo.FindLabel(r.Label)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	id, err := a.NewAnnotationIdFromString(r.AnnotationId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	group, err := i.AnnotationRepo.GroupOfAnnotation(*id)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if group != nil {
 		if err := i.Auth.Annotate(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
+	collection, err := i.AnnotationRepo.CollectionOfAnnotation(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if err := collection.CheckLabel(label.Name); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	current, err := i.AnnotationRepo.FindAttributes(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	attributes, err := at.Validate(label.Attributes, at.Retain(label.Attributes, current))
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
 	var userId *u.UserId
 	user := u.IdentityFromContext(ctx)
 	if user != nil {
@@ -83,9 +117,9 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	}
 	now := i.Clock.Now()
 
-	err = i.AnnotationRepo.UpdateLabelOfAnnotation(*id, label.Id, userId, &now)
+	err = i.AnnotationRepo.UpdateLabelOfAnnotation(*id, label.Id, attributes, userId, &now)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -4,13 +4,16 @@ import (
 	"time"
 
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 )
 
 type AnnotationRepo interface {
-	UpdateLabelOfAnnotation(a.AnnotationId, lbl.LabelId, *u.UserId, *time.Time) error
+	UpdateLabelOfAnnotation(a.AnnotationId, lbl.LabelId, a.Attributes, *u.UserId, *time.Time) error
 	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	CollectionOfAnnotation(a.AnnotationId) (*clc.Collection, error)
+	FindAttributes(a.AnnotationId) (a.Attributes, error)
 }
 
 type LabelRepo interface {
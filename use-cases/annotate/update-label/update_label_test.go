@@ -6,6 +6,7 @@ import (
 
 	"github.com/jonboulle/clockwork"
 	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
@@ -91,3 +92,26 @@ func TestUpdateLabel(t *testing.T) {
 	assert.Equal(t, req.AnnotationId, repo.UpdatedAnnotationId.String())
 	assert.Equal(t, repo.UpdatedLabelId, newLabel.Id)
 }
+
+func TestUpdateLabelKeepsAttributesOfNewLabel(t *testing.T) {
+	p := &FakePresenter{}
+	newLabel := lbl.NewLabel(lbl.NewLabelId(), "another-label", lbl.WithAttributes([]lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute},
+		{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red"}, Default: "red"},
+	}))
+	repo := &fk.AnnotationRepo{Attributes: a.Attributes{"occluded": true, "plate": "GE 1234"}}
+	itr := New(repo, &fk.LabelRepo{Return: newLabel})
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Attributes{"occluded": true, "color": "red"}, repo.GotAttributes)
+}
+
+func TestUpdateToLabelNotAllowedInCollectionShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AnnotationRepo{Collection: clc.NewCollection(clc.NewCollectionId(), "a-collection",
+		clc.WithLabels([]string{"car"}))}
+	itr := New(repo, &fk.LabelRepo{Return: lbl.NewLabel(lbl.NewLabelId(), "another-label")})
+	itr.Execute(t.Context(), CreateTestRequest(), p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
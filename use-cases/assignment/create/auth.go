@@ -0,0 +1,7 @@
+package create
+
+import "context"
+
+type Auth interface {
+	AssignImages(ctx context.Context) error
+}
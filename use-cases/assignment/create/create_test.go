@@ -0,0 +1,143 @@
+package create
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+type FailingAuth struct{}
+
+func (FailingAuth) AssignImages(ctx context.Context) error {
+	return e.ErrAuthorization
+}
+
+var images = []im.BaseImage{
+	{ImageId: im.NewImageId(), Collection: "a-collection"},
+	{ImageId: im.NewImageId(), Collection: "a-collection"},
+	{ImageId: im.NewImageId(), Collection: "a-collection"},
+}
+
+func Setup(repo *fk.AssignmentRepo, fv *fk.FilterValidator, opts ...Option) Interactor {
+	users := &fk.UserRepo{ExistingIds: []string{"alice@mail.com", "bob@mail.com"}}
+	groups := &fk.GroupRepo{ExistingNames: []string{"annotators"},
+		GroupMembers: map[string][]string{"annotators": {"bob@mail.com", "carol@mail.com"}}}
+	return New(repo, fk.ImageRepo{IterateBaseImages: images}, users, groups, fv, opts...)
+}
+
+func ctx(t *testing.T) context.Context {
+	return st.CreateCtxWithUserId(t.Context(), "admin@mail.com")
+}
+
+func TestCreateBatch(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AssignmentRepo{}
+	fv := &fk.FilterValidator{}
+	clock := clockwork.NewFakeClockAt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	req := Request{Name: "a-batch", Filter: `label="car"`,
+		Users: []string{"alice@mail.com"}, Groups: []string{"annotators"}}
+	Setup(repo, fv, WithClock(clock)).Execute(ctx(t), req, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, req.Filter, fv.Got)
+	assert.Equal(t, "admin@mail.com", repo.Created.Creator)
+	assert.Equal(t, clock.Now(), repo.Created.CreatedAt)
+	assert.Equal(t, []a.Assignment{
+		{Batch: repo.Created.Id, Image: images[0], Assignee: "alice@mail.com"},
+		{Batch: repo.Created.Id, Image: images[1], Assignee: "bob@mail.com"},
+		{Batch: repo.Created.Id, Image: images[2], Assignee: "carol@mail.com"},
+	}, repo.GotAssignments)
+	assert.Equal(t, a.Progress{Total: 3}, p.Got.Batch.Progress())
+	assert.Len(t, p.Got.Batch.Assignees, 3)
+}
+
+func TestCreateBatchSkipsAssignedImages(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AssignmentRepo{Assigned: images[:2]}
+	Setup(repo, &fk.FilterValidator{}).Execute(ctx(t),
+		Request{Name: "a-batch", Users: []string{"alice@mail.com", "bob@mail.com"}}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []a.Assignment{{Batch: repo.Created.Id, Image: images[2], Assignee: "alice@mail.com"}},
+		repo.GotAssignments)
+	assert.Equal(t, []a.AssigneeProgress{{Assignee: "alice@mail.com", Progress: a.Progress{Total: 1}}},
+		p.Got.Batch.Assignees)
+}
+
+func TestCreateBatchWithoutUnassignedImageShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AssignmentRepo{Assigned: images}
+	Setup(repo, &fk.FilterValidator{}).Execute(ctx(t),
+		Request{Name: "a-batch", Users: []string{"alice@mail.com"}}, p)
+
+	assert.True(t, p.GotValidationErr)
+	assert.Nil(t, repo.Created)
+}
+
+func TestCreateBatchRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	Setup(&fk.AssignmentRepo{}, &fk.FilterValidator{}, WithAuth(FailingAuth{})).Execute(ctx(t),
+		Request{Name: "a-batch", Users: []string{"alice@mail.com"}}, p)
+	assert.True(t, p.GotAuthErr)
+
+	p = &FakePresenter{}
+	Setup(&fk.AssignmentRepo{}, &fk.FilterValidator{}).Execute(t.Context(),
+		Request{Name: "a-batch", Users: []string{"alice@mail.com"}}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestCreateBatchKeepsAuthorizerError(t *testing.T) {
+	p := &FakePresenter{}
+	Setup(&fk.AssignmentRepo{}, &fk.FilterValidator{}, WithAuth(fk.Auth{Err: e.ErrInternal})).Execute(ctx(t),
+		Request{Name: "a-batch", Users: []string{"alice@mail.com"}}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotAuthErr)
+}
+
+func TestCreateInvalidBatchShouldFail(t *testing.T) {
+	for _, req := range []Request{
+		{Name: "", Users: []string{"alice@mail.com"}},
+		{Name: string(make([]byte, MaxNameLength+1)), Users: []string{"alice@mail.com"}},
+		{Name: "a-batch"},
+	} {
+		p := &FakePresenter{}
+		Setup(&fk.AssignmentRepo{}, &fk.FilterValidator{}).Execute(ctx(t), req, p)
+		assert.True(t, p.GotValidationErr)
+	}
+
+	p := &FakePresenter{}
+	Setup(&fk.AssignmentRepo{}, &fk.FilterValidator{Err: e.ErrValidation}).Execute(ctx(t),
+		Request{Name: "a-batch", Filter: "foo:1", Users: []string{"alice@mail.com"}}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestCreateBatchWithMissingAssigneeShouldFail(t *testing.T) {
+	for _, req := range []Request{
+		{Name: "a-batch", Users: []string{"missing@mail.com"}},
+		{Name: "a-batch", Groups: []string{"missing"}},
+	} {
+		p := &FakePresenter{}
+		Setup(&fk.AssignmentRepo{}, &fk.FilterValidator{}).Execute(ctx(t), req, p)
+		assert.True(t, p.GotNotFoundErr)
+	}
+}
+
+func TestCreateBatchHandlesInternalError(t *testing.T) {
+	for _, repo := range []*fk.AssignmentRepo{
+		{ErrOnCreate: e.ErrInternal},
+		{ErrOnIsAssigned: e.ErrInternal},
+	} {
+		p := &FakePresenter{}
+		Setup(repo, &fk.FilterValidator{}).Execute(ctx(t),
+			Request{Name: "a-batch", Users: []string{"alice@mail.com"}}, p)
+		assert.True(t, p.GotInternalErr)
+	}
+}
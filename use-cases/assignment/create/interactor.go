@@ -0,0 +1,189 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const (
+	MaxNameLength = 60
+	pageSize      = 100
+)
+
+type Interactor struct {
+	Repo
+	Images ImageRepo
+	Users  UserRepo
+	Groups GroupRepo
+	FilterValidator
+	Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(r Repo, ir ImageRepo, ur UserRepo, gr GroupRepo, fv FilterValidator, opts ...Option) Interactor {
+	i := &Interactor{r, ir, ur, gr, fv, auth.NewVoidAuth(), clockwork.NewRealClock(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute distributes the images matching a filter that are not assigned
+// yet across the requested users and the members of the requested groups.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "AssignImages", au.Target{Type: "assignment_batch", Id: r.Name})
+	defer record.End()
+
+	errCtx := "creating assignment batch"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+	if err := i.Auth.AssignImages(ctx); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if err := i.validate(r); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	assignees, err := i.assignees(r)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	images, err := i.unassignedImages(r.Filter)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if len(images) == 0 {
+		out.Error(record.Fail(fmt.Errorf("%v: no unassigned image matches %q: %w", errCtx, r.Filter, e.ErrValidation)))
+		return
+	}
+
+	batch := a.Batch{
+		Id:        a.NewBatchId(),
+		Name:      r.Name,
+		Filter:    r.Filter,
+		Creator:   user.Id,
+		CreatedAt: i.Clock.Now(),
+		Assignees: []a.AssigneeProgress{},
+	}
+	assignments := a.Distribute(batch.Id, images, assignees)
+	if err := i.Repo.CreateBatch(batch, assignments); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	for n, assignee := range assignees[:min(len(assignees), len(images))] {
+		total := len(images) / len(assignees)
+		if n < len(images)%len(assignees) {
+			total++
+		}
+		batch.Assignees = append(batch.Assignees,
+			a.AssigneeProgress{Assignee: assignee, Progress: a.Progress{Total: int64(total)}})
+	}
+	out.SuccessCreateBatch(Response{Batch: batch})
+}
+
+func (i Interactor) validate(r Request) error {
+	if r.Name == "" || len(r.Name) > MaxNameLength {
+		return fmt.Errorf("checking that name %q has between 1 and %v characters: %w",
+			r.Name, MaxNameLength, e.ErrValidation)
+	}
+	if r.Filter != "" {
+		if err := i.FilterValidator.Validate(r.Filter); err != nil {
+			return fmt.Errorf("validating query %v: %v: %w", r.Filter, err, e.ErrValidation)
+		}
+	}
+	return nil
+}
+
+// assignees lists the requested users followed by the members of the
+// requested groups, without duplicates.
+func (i Interactor) assignees(r Request) ([]u.UserId, error) {
+	assignees := []u.UserId{}
+	for _, user := range r.Users {
+		exists, err := i.Users.Exists(user)
+		if err != nil {
+			return nil, fmt.Errorf("checking that user %v exists: %w", user, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("checking that user %v exists: %w", user, e.ErrNotFound)
+		}
+		if !slices.Contains(assignees, user) {
+			assignees = append(assignees, user)
+		}
+	}
+	for _, group := range r.Groups {
+		exists, err := i.Groups.Exists(group)
+		if err != nil {
+			return nil, fmt.Errorf("checking that group %v exists: %w", group, err)
+		}
+		if !*exists {
+			return nil, fmt.Errorf("checking that group %v exists: %w", group, e.ErrNotFound)
+		}
+		members, err := i.Groups.Members(group)
+		if err != nil {
+			return nil, fmt.Errorf("listing members of group %v: %w", group, err)
+		}
+		for _, member := range members {
+			if !slices.Contains(assignees, member) {
+				assignees = append(assignees, member)
+			}
+		}
+	}
+	if len(assignees) == 0 {
+		return nil, fmt.Errorf("checking that at least one user is assigned: %w", e.ErrValidation)
+	}
+	return assignees, nil
+}
+
+func (i Interactor) unassignedImages(filter im.FilterStr) ([]im.BaseImage, error) {
+	images := []im.BaseImage{}
+	for image, err := range i.Images.Iterate(filter, pageSize) {
+		if err != nil {
+			return nil, fmt.Errorf("fetching images matching %q: %w", filter, err)
+		}
+		assigned, err := i.Repo.IsAssigned(image)
+		if err != nil {
+			return nil, err
+		}
+		if !assigned {
+			images = append(images, image)
+		}
+	}
+	return images, nil
+}
@@ -0,0 +1,17 @@
+package create
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+)
+
+type Request struct {
+	Name   string
+	Filter im.FilterStr
+	Users  []string
+	Groups []string
+}
+
+type Response struct {
+	Batch a.Batch
+}
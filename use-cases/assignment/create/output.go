@@ -0,0 +1,6 @@
+package create
+
+type OutputPort interface {
+	SuccessCreateBatch(Response)
+	Error(error)
+}
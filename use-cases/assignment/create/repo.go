@@ -0,0 +1,30 @@
+package create
+
+import (
+	"iter"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+)
+
+type Repo interface {
+	CreateBatch(a.Batch, []a.Assignment) error
+	IsAssigned(im.BaseImage) (bool, error)
+}
+
+type ImageRepo interface {
+	Iterate(im.FilterStr, int) iter.Seq2[im.BaseImage, error]
+}
+
+type UserRepo interface {
+	Exists(string) (bool, error)
+}
+
+type GroupRepo interface {
+	Exists(string) (*bool, error)
+	Members(string) ([]string, error)
+}
+
+type FilterValidator interface {
+	Validate(im.FilterStr) error
+}
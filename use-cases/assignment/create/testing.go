@@ -0,0 +1,16 @@
+package create
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessCreateBatch(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
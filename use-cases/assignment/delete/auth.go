@@ -0,0 +1,7 @@
+package delete
+
+import "context"
+
+type Auth interface {
+	AssignImages(ctx context.Context) error
+}
@@ -0,0 +1,58 @@
+package delete
+
+import (
+	"context"
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type FailingAuth struct{}
+
+func (FailingAuth) AssignImages(ctx context.Context) error {
+	return e.ErrAuthorization
+}
+
+func TestDeleteBatch(t *testing.T) {
+	p := &FakePresenter{}
+	batch := a.Batch{Id: a.NewBatchId()}
+	repo := &fk.AssignmentRepo{Batches: []a.Batch{batch}}
+	New(repo).Execute(t.Context(), batch.Id.String(), p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, batch.Id, *repo.Deleted)
+}
+
+func TestDeleteBatchRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}, WithAuth(FailingAuth{})).Execute(t.Context(), a.NewBatchId().String(), p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestDeleteBatchKeepsAuthorizerError(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}, WithAuth(fk.Auth{Err: e.ErrInternal})).Execute(t.Context(), a.NewBatchId().String(), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotAuthErr)
+}
+
+func TestDeleteMissingBatchShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(t.Context(), a.NewBatchId().String(), p)
+	assert.True(t, p.GotNotFoundErr)
+
+	p = &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(t.Context(), "not-an-id", p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestDeleteBatchHandlesInternalError(t *testing.T) {
+	batch := a.Batch{Id: a.NewBatchId()}
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{Batches: []a.Batch{batch}, ErrOnDelete: e.ErrInternal}).Execute(
+		t.Context(), batch.Id.String(), p)
+	assert.True(t, p.GotInternalErr)
+}
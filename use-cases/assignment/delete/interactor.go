@@ -0,0 +1,66 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+)
+
+type Interactor struct {
+	Repo
+	Auth
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(r Repo, opts ...Option) Interactor {
+	i := &Interactor{r, auth.NewVoidAuth(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute deletes an assignment batch, which releases its images so that
+// they can be assigned again. Annotations are left untouched.
+func (i Interactor) Execute(ctx context.Context, id string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "AssignImages", au.Target{Type: "assignment_batch", Id: id})
+	defer record.End()
+
+	errCtx := fmt.Errorf("deleting assignment batch %v", id)
+	if err := i.Auth.AssignImages(ctx); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	batchId, err := a.NewBatchIdFromString(id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if _, err := i.Repo.FindBatch(*batchId); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if err := i.Repo.DeleteBatch(*batchId); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessDeleteBatch(id)
+}
@@ -0,0 +1,6 @@
+package delete
+
+type OutputPort interface {
+	SuccessDeleteBatch(string)
+	Error(error)
+}
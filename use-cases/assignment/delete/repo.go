@@ -0,0 +1,10 @@
+package delete
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+)
+
+type Repo interface {
+	FindBatch(a.BatchId) (*a.Batch, error)
+	DeleteBatch(a.BatchId) error
+}
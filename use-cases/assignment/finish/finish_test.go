@@ -0,0 +1,47 @@
+package finish
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinishAssignment(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AssignmentRepo{}
+	clock := clockwork.NewFakeClockAt(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	image := im.BaseImage{ImageId: im.NewImageId(), Collection: "a-collection"}
+	New(repo, WithClock(clock)).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{ImageId: image.ImageId.String(), Collection: image.Collection}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "me@mail.com", repo.GotUser)
+	assert.Equal(t, image, *repo.Finished)
+	assert.Equal(t, clock.Now(), repo.FinishedAt)
+}
+
+func TestFinishAssignmentWithInvalidImageIdShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{ImageId: "not-an-id"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestFinishAssignmentRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestFinishAssignmentHandlesRepoErrors(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{ErrOnFinish: e.ErrNotFound}).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
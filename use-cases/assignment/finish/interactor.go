@@ -0,0 +1,65 @@
+package finish
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	Repo
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(r Repo, opts ...Option) Interactor {
+	i := &Interactor{r, clockwork.NewRealClock(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute marks an image assigned to the caller as finished.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "FinishAssignment", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
+	errCtx := fmt.Errorf("finishing image %v of collection %v", r.ImageId, r.Collection)
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%w: fetching user identity: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+	id, err := im.NewImageIdFromString(r.ImageId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	image := im.BaseImage{ImageId: id, Collection: r.Collection}
+	if err := i.Repo.FinishAssignment(user.Id, image, i.Clock.Now()); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessFinishAssignment(r)
+}
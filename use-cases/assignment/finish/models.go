@@ -0,0 +1,6 @@
+package finish
+
+type Request struct {
+	ImageId    string
+	Collection string
+}
@@ -0,0 +1,6 @@
+package finish
+
+type OutputPort interface {
+	SuccessFinishAssignment(Request)
+	Error(error)
+}
@@ -0,0 +1,12 @@
+package finish
+
+import (
+	"time"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	FinishAssignment(u.UserId, im.BaseImage, time.Time) error
+}
@@ -0,0 +1,16 @@
+package finish
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Request
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessFinishAssignment(r Request) {
+	p.Got = r
+	p.GotSuccess = true
+}
@@ -0,0 +1,19 @@
+package assignment
+
+import (
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/delete"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/finish"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/mine"
+	"github.com/lejeunel/go-image-annotator/use-cases/assignment/next"
+)
+
+type Interactors struct {
+	Create create.Interactor
+	List   list.Interactor
+	Delete delete.Interactor
+	Mine   mine.Interactor
+	Next   next.Interactor
+	Finish finish.Interactor
+}
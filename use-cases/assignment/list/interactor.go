@@ -0,0 +1,57 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Interactor struct {
+	Repo
+	DefaultPageSize int
+	MaxPageSize     int
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(r Repo, dps int, mps int, opts ...Option) Interactor {
+	i := &Interactor{r, dps, mps, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute lists assignment batches, most recent first, along with the
+// progress of each assignee.
+func (i Interactor) Execute(ctx context.Context, r pag.PaginationParams, out OutputPort) {
+	errCtx := "listing assignment batches"
+	if err := i.Auth.AssignImages(ctx); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	r.Sanitize(i.DefaultPageSize, i.MaxPageSize)
+
+	batches, err := i.Repo.ListBatches(r)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	count, err := i.Repo.CountBatches()
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	out.SuccessListBatches(Response{Batches: batches, Pagination: pag.New(r.Page, r.PageSize, *count)})
+}
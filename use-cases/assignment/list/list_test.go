@@ -0,0 +1,50 @@
+package list
+
+import (
+	"context"
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+type FailingAuth struct{}
+
+func (FailingAuth) AssignImages(ctx context.Context) error {
+	return e.ErrAuthorization
+}
+
+func TestListBatches(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AssignmentRepo{Batches: []a.Batch{{Id: a.NewBatchId()}, {Id: a.NewBatchId()}}}
+	New(repo, 10, 100).Execute(t.Context(), pag.PaginationParams{Page: 1}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Len(t, p.Got.Batches, 2)
+	assert.Equal(t, int64(2), p.Got.Pagination.TotalRecords)
+	assert.Equal(t, 10, p.Got.Pagination.PageSize)
+}
+
+func TestListBatchesRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}, 10, 100, WithAuth(FailingAuth{})).Execute(t.Context(), pag.PaginationParams{}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestListBatchesKeepsAuthorizerError(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}, 10, 100, WithAuth(fk.Auth{Err: e.ErrInternal})).Execute(t.Context(), pag.PaginationParams{}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotAuthErr)
+}
+
+func TestListBatchesHandlesInternalErrors(t *testing.T) {
+	for _, repo := range []*fk.AssignmentRepo{{ErrOnList: e.ErrInternal}, {ErrOnCount: e.ErrInternal}} {
+		p := &FakePresenter{}
+		New(repo, 10, 100).Execute(t.Context(), pag.PaginationParams{}, p)
+		assert.True(t, p.GotInternalErr)
+	}
+}
@@ -0,0 +1,11 @@
+package list
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Repo interface {
+	ListBatches(pag.PaginationParams) ([]a.Batch, error)
+	CountBatches() (*int64, error)
+}
@@ -0,0 +1,34 @@
+package mine
+
+import (
+	"context"
+	"fmt"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	Repo
+}
+
+func New(r Repo) Interactor {
+	return Interactor{r}
+}
+
+// Execute lists the batches that assign images to the caller, along with
+// their own progress.
+func (i Interactor) Execute(ctx context.Context, out OutputPort) {
+	errCtx := "listing my assignment batches"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+	batches, err := i.Repo.ListBatchesOf(user.Id)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	out.SuccessListMyBatches(Response{Batches: batches})
+}
@@ -0,0 +1,34 @@
+package mine
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMyBatches(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AssignmentRepo{Batches: []a.Batch{{Id: a.NewBatchId()}}}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "me@mail.com", repo.GotUser)
+	assert.Equal(t, repo.Batches, p.Got.Batches)
+}
+
+func TestListMyBatchesRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(t.Context(), p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestListMyBatchesHandlesInternalError(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{ErrOnList: e.ErrInternal}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), p)
+	assert.True(t, p.GotInternalErr)
+}
@@ -0,0 +1,9 @@
+package mine
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+)
+
+type Response struct {
+	Batches []a.Batch
+}
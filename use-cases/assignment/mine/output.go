@@ -0,0 +1,6 @@
+package mine
+
+type OutputPort interface {
+	SuccessListMyBatches(Response)
+	Error(error)
+}
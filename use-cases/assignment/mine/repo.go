@@ -0,0 +1,10 @@
+package mine
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	ListBatchesOf(u.UserId) ([]a.Batch, error)
+}
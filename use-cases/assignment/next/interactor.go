@@ -0,0 +1,44 @@
+package next
+
+import (
+	"context"
+	"fmt"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	Repo
+}
+
+func New(r Repo) Interactor {
+	return Interactor{r}
+}
+
+// Execute fetches the oldest image assigned to the caller that is not
+// finished yet.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := "fetching next assigned image"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+	var skip *im.BaseImage
+	if r.ImageId != "" {
+		id, err := im.NewImageIdFromString(r.ImageId)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+		skip = &im.BaseImage{ImageId: id, Collection: r.Collection}
+	}
+	assignment, err := i.Repo.NextAssignment(user.Id, skip)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	out.SuccessNextAssignment(Response{Assignment: *assignment})
+}
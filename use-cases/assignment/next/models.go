@@ -0,0 +1,16 @@
+package next
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+)
+
+// Request optionally holds the image the caller is currently working on,
+// which is skipped.
+type Request struct {
+	ImageId    string
+	Collection string
+}
+
+type Response struct {
+	Assignment a.Assignment
+}
@@ -0,0 +1,54 @@
+package next
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextAssignment(t *testing.T) {
+	p := &FakePresenter{}
+	next := a.Assignment{Batch: a.NewBatchId(), Image: im.BaseImage{ImageId: im.NewImageId(), Collection: "a-collection"}}
+	repo := &fk.AssignmentRepo{Next: &next}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "me@mail.com", repo.GotUser)
+	assert.Nil(t, repo.GotSkip)
+	assert.Equal(t, next, p.Got.Assignment)
+}
+
+func TestNextAssignmentSkipsCurrentImage(t *testing.T) {
+	p := &FakePresenter{}
+	current := im.BaseImage{ImageId: im.NewImageId(), Collection: "a-collection"}
+	repo := &fk.AssignmentRepo{Next: &a.Assignment{}}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{ImageId: current.ImageId.String(), Collection: current.Collection}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, current, *repo.GotSkip)
+}
+
+func TestNextAssignmentWithInvalidImageIdShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{ImageId: "not-an-id"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestNextAssignmentWithNothingLeft(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestNextAssignmentRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AssignmentRepo{}).Execute(t.Context(), Request{}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
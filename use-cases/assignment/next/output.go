@@ -0,0 +1,6 @@
+package next
+
+type OutputPort interface {
+	SuccessNextAssignment(Response)
+	Error(error)
+}
@@ -0,0 +1,11 @@
+package next
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/assignment"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Repo interface {
+	NextAssignment(u.UserId, *im.BaseImage) (*a.Assignment, error)
+}
@@ -0,0 +1,7 @@
+package export
+
+import "context"
+
+type Auth interface {
+	ReadAuditLog(ctx context.Context) error
+}
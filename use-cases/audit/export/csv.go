@@ -0,0 +1,39 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+var Header = []string{"time", "actor", "action", "target_type", "target_id", "request_id", "outcome", "error"}
+
+// WriteCSV writes the entries of a response as CSV, header first.
+func (r Response) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(Header); err != nil {
+		return fmt.Errorf("writing audit log as csv: %v: %w", err, e.ErrInternal)
+	}
+	for _, entry := range r.Entries {
+		if err := cw.Write([]string{
+			entry.Time.UTC().Format(time.RFC3339),
+			entry.Actor,
+			entry.Action,
+			entry.Target.Type,
+			entry.Target.Id,
+			entry.RequestId,
+			entry.Outcome.String(),
+			entry.Error,
+		}); err != nil {
+			return fmt.Errorf("writing audit log as csv: %v: %w", err, e.ErrInternal)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing audit log as csv: %v: %w", err, e.ErrInternal)
+	}
+	return nil
+}
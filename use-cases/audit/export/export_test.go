@@ -0,0 +1,48 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportAuditLog(t *testing.T) {
+	p := &FakePresenter{}
+	entries := []au.Entry{{Actor: "alice@mail.com", Action: "DeleteLabel",
+		Target: au.Target{Type: "label", Id: "cat"}, Time: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+		RequestId: "a-request", Outcome: au.Failure, Error: "label is used, by images"}}
+	repo := &fk.AuditRepo{Entries: entries}
+	New(repo).Execute(t.Context(), list.Search{Actor: "alice@mail.com"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "alice@mail.com", *repo.GotFilter.Actor)
+
+	var buf bytes.Buffer
+	assert.NoError(t, p.Got.WriteCSV(&buf))
+	assert.Equal(t, "time,actor,action,target_type,target_id,request_id,outcome,error\n"+
+		`2026-01-01T12:00:00Z,alice@mail.com,DeleteLabel,label,cat,a-request,failure,"label is used, by images"`+"\n",
+		buf.String())
+}
+
+func TestExportAuditLogRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AuditRepo{}, WithAuth(&fk.Auth{Err: e.ErrAuthorization})).Execute(t.Context(), list.Search{}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestExportAuditLogWithInvalidSearchShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AuditRepo{}).Execute(t.Context(), list.Search{To: "tomorrow"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestExportAuditLogHandlesInternalErrors(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AuditRepo{ErrOnList: e.ErrInternal}).Execute(t.Context(), list.Search{}, p)
+	assert.True(t, p.GotInternalErr)
+}
@@ -0,0 +1,51 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+)
+
+type Interactor struct {
+	Repo
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(r Repo, opts ...Option) Interactor {
+	i := &Interactor{r, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute fetches every entry of the audit log matching a search, oldest
+// first.
+func (i Interactor) Execute(ctx context.Context, s list.Search, out OutputPort) {
+	errCtx := "exporting audit log"
+	if err := i.Auth.ReadAuditLog(ctx); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	filter, err := s.Filter()
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	entries, err := i.Repo.AllEntries(*filter)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	out.SuccessExportAuditLog(Response{Entries: entries})
+}
@@ -0,0 +1,9 @@
+package export
+
+import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+)
+
+type Response struct {
+	Entries []au.Entry
+}
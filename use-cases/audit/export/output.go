@@ -0,0 +1,6 @@
+package export
+
+type OutputPort interface {
+	SuccessExportAuditLog(Response)
+	Error(error)
+}
@@ -0,0 +1,9 @@
+package export
+
+import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+)
+
+type Repo interface {
+	AllEntries(au.Filter) ([]au.Entry, error)
+}
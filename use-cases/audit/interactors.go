@@ -0,0 +1,11 @@
+package audit
+
+import (
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/export"
+	"github.com/lejeunel/go-image-annotator/use-cases/audit/list"
+)
+
+type Interactors struct {
+	List   list.Interactor
+	Export export.Interactor
+}
@@ -0,0 +1,7 @@
+package list
+
+import "context"
+
+type Auth interface {
+	ReadAuditLog(ctx context.Context) error
+}
@@ -0,0 +1,50 @@
+package list
+
+import (
+	"fmt"
+	"time"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const dayLayout = "2006-01-02"
+
+func parseDate(s string, endOfDay bool) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return &t, nil
+	}
+	t, err := time.Parse(dayLayout, s)
+	if err != nil {
+		return nil, fmt.Errorf("parsing date %q: expected a day or an RFC 3339 timestamp: %w", s, e.ErrValidation)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// Filter validates a search and turns it into a filter on entries.
+func (s Search) Filter() (*au.Filter, error) {
+	f := au.Filter{}
+	if s.Actor != "" {
+		f.Actor = &s.Actor
+	}
+	if s.Action != "" {
+		f.Action = &s.Action
+	}
+	var err error
+	if f.From, err = parseDate(s.From, false); err != nil {
+		return nil, err
+	}
+	if f.To, err = parseDate(s.To, true); err != nil {
+		return nil, err
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return nil, fmt.Errorf("date range from %v to %v is empty: %w", s.From, s.To, e.ErrValidation)
+	}
+	return &f, nil
+}
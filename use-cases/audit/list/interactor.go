@@ -0,0 +1,62 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Interactor struct {
+	Repo
+	DefaultPageSize int
+	MaxPageSize     int
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(r Repo, dps int, mps int, opts ...Option) Interactor {
+	i := &Interactor{r, dps, mps, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute searches the audit log, most recent entries first.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := "searching audit log"
+	if err := i.Auth.ReadAuditLog(ctx); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	filter, err := r.Search.Filter()
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	p := r.PaginationParams
+	p.Sanitize(i.DefaultPageSize, i.MaxPageSize)
+
+	entries, err := i.Repo.ListEntries(*filter, p)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	count, err := i.Repo.CountEntries(*filter)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	out.SuccessListAuditLog(Response{Entries: entries, Pagination: pag.New(p.Page, p.PageSize, *count)})
+}
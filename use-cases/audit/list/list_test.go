@@ -0,0 +1,67 @@
+package list
+
+import (
+	"testing"
+	"time"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAuditLog(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AuditRepo{Entries: []au.Entry{{Action: "DeleteLabel"}, {Action: "SetPolicies"}}}
+	req := Request{Search: Search{Actor: "alice@mail.com", Action: "DeleteLabel"}}
+	New(repo, 10, 100).Execute(t.Context(), req, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Len(t, p.Got.Entries, 2)
+	assert.Equal(t, int64(2), p.Got.Pagination.TotalRecords)
+	assert.Equal(t, 10, p.Got.Pagination.PageSize)
+	assert.Equal(t, "alice@mail.com", *repo.GotFilter.Actor)
+	assert.Equal(t, "DeleteLabel", *repo.GotFilter.Action)
+	assert.Nil(t, repo.GotFilter.From)
+}
+
+func TestDaysSpanWholeDays(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.AuditRepo{}
+	req := Request{Search: Search{From: "2026-01-01", To: "2026-01-31"}}
+	New(repo, 10, 100).Execute(t.Context(), req, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), *repo.GotFilter.From)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), *repo.GotFilter.To)
+}
+
+func TestTimestampsAreKeptAsIs(t *testing.T) {
+	filter, err := Search{From: "2026-01-01T10:00:00+02:00"}.Filter()
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC).Equal(*filter.From))
+}
+
+func TestInvalidDatesShouldFail(t *testing.T) {
+	for _, s := range []Search{{From: "yesterday"}, {To: "31/01/2026"}, {From: "2026-02-01", To: "2026-01-01"}} {
+		p := &FakePresenter{}
+		New(&fk.AuditRepo{}, 10, 100).Execute(t.Context(), Request{Search: s}, p)
+		assert.True(t, p.GotValidationErr, s)
+	}
+}
+
+func TestListAuditLogRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AuditRepo{}, 10, 100, WithAuth(&fk.Auth{Err: e.ErrAuthorization})).
+		Execute(t.Context(), Request{PaginationParams: pag.PaginationParams{Page: 1}}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestListAuditLogHandlesInternalErrors(t *testing.T) {
+	for _, repo := range []*fk.AuditRepo{{ErrOnList: e.ErrInternal}, {ErrOnCount: e.ErrInternal}} {
+		p := &FakePresenter{}
+		New(repo, 10, 100).Execute(t.Context(), Request{}, p)
+		assert.True(t, p.GotInternalErr)
+	}
+}
@@ -0,0 +1,26 @@
+package list
+
+import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+// Search selects entries of the audit log. Empty fields match any entry.
+// Dates are either RFC 3339 timestamps or days, e.g. 2026-01-31, in which
+// case To includes the whole day.
+type Search struct {
+	Actor  string
+	Action string
+	From   string
+	To     string
+}
+
+type Request struct {
+	Search
+	pag.PaginationParams
+}
+
+type Response struct {
+	Entries    []au.Entry
+	Pagination pag.Pagination
+}
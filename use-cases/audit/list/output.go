@@ -0,0 +1,6 @@
+package list
+
+type OutputPort interface {
+	SuccessListAuditLog(Response)
+	Error(error)
+}
@@ -0,0 +1,11 @@
+package list
+
+import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Repo interface {
+	ListEntries(au.Filter, pag.PaginationParams) ([]au.Entry, error)
+	CountEntries(au.Filter) (*int64, error)
+}
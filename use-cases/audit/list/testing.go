@@ -0,0 +1,16 @@
+package list
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessListAuditLog(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
@@ -3,9 +3,11 @@ package clone
 import (
 	"testing"
 
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	"github.com/lejeunel/go-image-annotator/entities/task"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	st "github.com/lejeunel/go-image-annotator/shared/testing"
 	"github.com/stretchr/testify/assert"
@@ -78,8 +80,60 @@ func TestClone(t *testing.T) {
 			{ImageId: im.NewImageId(), Collection: src},
 		},
 	}
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
 	p := &FakePresenter{}
 	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
 		Request{Source: src, Destination: dst}, p)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
 	assert.Equal(t, dst, s.CopiedToCollection)
+	assert.Equal(t, 2, s.NumCopied)
+}
+
+func TestResumedCloneCopiesRemainingImages(t *testing.T) {
+	itr := NewTestingCloner()
+	dst := "destination-collection"
+	src := "source-collection"
+	s := fk.ImageStore{}
+	itr.ImageStore = &s
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	itr.CollectionRepo = &fk.CollectionRepo{ExistingNames: []string{src, dst}}
+	copied := im.NewImageId()
+	itr.ImageRepo = &fk.ImageRepo{
+		IterateBaseImages: []im.BaseImage{
+			{ImageId: copied, Collection: src},
+			{ImageId: im.NewImageId(), Collection: src},
+			{ImageId: copied, Collection: dst},
+		},
+	}
+	job, _ := jq.NewJob(task.NewTaskId(), task.CollectionCloneTask,
+		Payload{Source: src, Destination: dst})
+	job.Attempt = 2
+
+	assert.NoError(t, itr.Run(t.Context(), job))
+	assert.Equal(t, 1, s.NumCopied)
+	assert.Equal(t, ev.DoneTask, logger.Events[len(logger.Events)-1].State)
+}
+
+func TestFirstAttemptFailsOnExistingDestination(t *testing.T) {
+	itr := NewTestingCloner()
+	itr.CollectionRepo = &fk.CollectionRepo{ExistingNames: []string{"src", "dst"}}
+	job, _ := jq.NewJob(task.NewTaskId(), task.CollectionCloneTask,
+		Payload{Source: "src", Destination: "dst"})
+	job.Attempt = 1
+	assert.Error(t, itr.Run(t.Context(), job))
+}
+
+func TestSubmitFailureFailsTask(t *testing.T) {
+	itr := NewTestingCloner()
+	itr.CollectionRepo = &fk.CollectionRepo{ExistingNames: []string{"src"}}
+	itr.JobQueue = &fk.JobQueue{ErrOnSubmit: e.ErrInternal}
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Source: "src", Destination: "dst"}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.Equal(t, ev.FailedTask, logger.Events[len(logger.Events)-1].State)
 }
@@ -8,12 +8,13 @@ import (
 	"strconv"
 
 	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	e "github.com/lejeunel/go-image-annotator/entities/event"
-	grp "github.com/lejeunel/go-image-annotator/entities/group"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	t "github.com/lejeunel/go-image-annotator/entities/task"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
 	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
@@ -34,13 +35,14 @@ type Interactor struct {
 	clockwork.Clock
 	slog.Logger
 	jq.JobQueue
+	Audit al.AuditLogger
 }
 
 func New(ims ImageStore, ir ImageRepo, c CollectionRepo, g GroupRepo,
 	l el.IEventLogger, logger slog.Logger, j jq.JobQueue,
 	opts ...Option,
 ) Interactor {
-	itr := &Interactor{ims, ir, c, g, l, auth.NewVoidAuth(), clockwork.NewRealClock(), logger, j}
+	itr := &Interactor{ims, ir, c, g, l, auth.NewVoidAuth(), clockwork.NewRealClock(), logger, j, al.AuditLogger{}}
 	for _, opt := range opts {
 		opt(itr)
 	}
@@ -55,51 +57,67 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "CloneCollection", au.Target{Type: "collection", Id: r.Source})
+	defer record.End()
+
 	errCtx := fmt.Errorf("initiating cloning collection task")
 	user := u.IdentityFromContext(ctx)
 	if user == nil {
-		out.Error(fmt.Errorf("%w: failed fetching user id from context", errCtx))
+		out.Error(record.Fail(fmt.Errorf("%w: failed fetching user id from context", errCtx)))
 		return
 	}
 
 	task := t.NewTask(t.NewTaskId(), user.Id, t.CollectionCloneTask)
 
-	var group *grp.Group
 	if r.DestinationGroup != nil {
 		if err := i.Auth.CloneCollection(ctx, *r.DestinationGroup); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
-		var err error
-		group, err = i.GroupRepo.Find(*r.DestinationGroup)
-		if err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		if _, err := i.GroupRepo.Find(*r.DestinationGroup); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
 	if err := i.checkCollections(r.Source, r.Destination); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
+	job, err := jq.NewJob(task.Id, task.Type, Payload{
+		Source: r.Source, Destination: r.Destination,
+		Group: r.DestinationGroup, Deep: r.Deep,
+	})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
 	if err := i.IEventLogger.InitTask(
 		task.Id, task.Type, task.Issuer); err != nil {
-		out.Error(fmt.Errorf("%v: pushing init task to logger: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: pushing init task to logger: %w", errCtx, err)))
 		return
 	}
 	if err := i.IEventLogger.AddEvent(
 		task.Id,
 		e.Event{Time: i.Clock.Now(), State: e.PendingTask},
 	); err != nil {
-		out.Error(fmt.Errorf("%v: adding pending status: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: adding pending status: %w", errCtx, err)))
+		return
+	}
+	if err := i.JobQueue.Submit(job); err != nil {
+		i.LogError(task.Id, err)
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
-	i.JobQueue.Submit(func() {
-		i.runTask(task, r.Source, r.Destination, group, r.Deep)
-	})
 	out.SuccessSubmitCloneTask(Response{Id: task.Id, Issuer: task.Issuer, Type: task.Type})
 }
 
@@ -132,59 +150,69 @@ func (i *Interactor) LogError(id t.TaskId, err error) {
 	i.Logger.Error(err.Error())
 }
 
-func (i *Interactor) runTask(
-	task t.Task,
-	source string,
-	destination string,
-	group *grp.Group,
-	deep bool,
-) {
+// Run carries out a cloning job. When resuming a job that failed, the
+// destination collection it created is completed rather than rejected.
+func (i *Interactor) Run(ctx context.Context, job jq.Job) error {
 	errCtx := fmt.Errorf("running collection cloning task")
-	i.Logger.Info(fmt.Sprintf("started clone task %v", task.Id))
+	p := Payload{}
+	if err := job.Decode(&p); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	i.Logger.Info(fmt.Sprintf("started clone task %v", job.TaskId))
 
-	if err := i.checkCollections(source, destination); err != nil {
-		i.LogError(task.Id, fmt.Errorf("%w: %w", errCtx, err))
-		return
+	resuming := false
+	if job.Attempt > 1 {
+		exists, err := i.CollectionRepo.Exists(p.Destination)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
+		resuming = exists
+	}
+	if !resuming {
+		if err := i.checkCollections(p.Source, p.Destination); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
 	}
 	extra := map[string]string{
-		"source-collection":      source,
-		"destination-collection": destination,
-		"deep-copy":              strconv.FormatBool(deep),
+		"source-collection":      p.Source,
+		"destination-collection": p.Destination,
+		"deep-copy":              strconv.FormatBool(p.Deep),
 	}
 	if err := i.IEventLogger.AddEvent(
-		task.Id,
+		job.TaskId,
 		e.Event{Time: i.Clock.Now(), State: e.StartedTask, Extra: extra},
 	); err != nil {
-		i.Logger.Error(
-			fmt.Errorf("%w: logging event upon cloning task startup: %w", errCtx, err).Error(),
-		)
-		return
-	}
-
-	dst := clc.NewCollection(clc.NewCollectionId(), destination, clc.WithCreatedAt(i.Clock.Now()))
-	if group != nil {
-		dst.Group = &group.Name
+		return fmt.Errorf("%w: logging event upon cloning task startup: %w", errCtx, err)
 	}
 
-	if err := i.CollectionRepo.Create(dst); err != nil {
-		i.IEventLogger.AddEvent(
-			task.Id,
-			e.Event{Time: i.Clock.Now(), State: e.FailedTask, Error: err.Error()},
-		)
-		i.Logger.Error(err.Error())
-		return
+	copied := map[im.ImageId]bool{}
+	if resuming {
+		for baseImage, err := range i.ImageRepo.Iterate(fmt.Sprintf("collection=%q", p.Destination), 1) {
+			if err != nil {
+				return fmt.Errorf("%w: listing images already cloned: %w", errCtx, err)
+			}
+			copied[baseImage.ImageId] = true
+		}
+	} else {
+		dst := clc.NewCollection(clc.NewCollectionId(), p.Destination,
+			clc.WithCreatedAt(i.Clock.Now()))
+		dst.Group = p.Group
+		if err := i.CollectionRepo.Create(dst); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
+		}
 	}
 
-	for baseImage, err := range i.ImageRepo.Iterate("collection="+source, 1) {
+	for baseImage, err := range i.ImageRepo.Iterate(fmt.Sprintf("collection=%q", p.Source), 1) {
 		if err != nil {
-			i.LogError(task.Id, err)
-			return
+			return fmt.Errorf("%w: %w", errCtx, err)
 		}
-		if err := i.ImageStore.Copy(source, baseImage.ImageId, dst.Name, deep); err != nil {
-			i.LogError(task.Id, err)
-			return
-
+		if copied[baseImage.ImageId] {
+			continue
+		}
+		if err := i.ImageStore.Copy(p.Source, baseImage.ImageId, p.Destination, p.Deep); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
 		}
 	}
-	i.IEventLogger.AddEvent(task.Id, e.Event{Time: i.Clock.Now(), State: e.DoneTask})
+	i.IEventLogger.AddEvent(job.TaskId, e.Event{Time: i.Clock.Now(), State: e.DoneTask})
+	return nil
 }
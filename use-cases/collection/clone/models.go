@@ -12,6 +12,14 @@ type Request struct {
 	Deep             bool
 }
 
+// Payload is what a cloning job needs to run, once persisted.
+type Payload struct {
+	Source      string  `json:"source"`
+	Destination string  `json:"destination"`
+	Group       *string `json:"group,omitempty"`
+	Deep        bool    `json:"deep"`
+}
+
 type Response struct {
 	Id     t.TaskId
 	Issuer u.UserId
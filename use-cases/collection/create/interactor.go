@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	v "github.com/lejeunel/go-image-annotator/modules/string-validator"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
@@ -17,23 +19,27 @@ type Interactor struct {
 	v.Validator
 	clockwork.Clock
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "CreateCollection", au.Target{Type: "collection", Id: r.Name})
+	defer record.End()
+
 	errCtx := "creating collection"
 	if r.Group != nil {
 		if err := i.Auth.CreateCollection(ctx, *r.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 	if err := i.validate(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.create(r); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -99,6 +105,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(rc CollectionRepo, rg GroupRepo, opts ...Option) Interactor {
 	i := &Interactor{
 		CollectionRepo: rc,
@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
 	grp "github.com/lejeunel/go-image-annotator/entities/group"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
@@ -62,3 +64,51 @@ func TestDeleteEmptyCollection(t *testing.T) {
 	itr.Execute(ctx, "my-collection", p)
 	assert.True(t, p.GotSuccess)
 }
+
+func TestDeleteJobDeletesImagesThenCollection(t *testing.T) {
+	itr, collection, _, ctx := Setup(t)
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	s := &fk.ImageStore{}
+	itr.ImageStore = s
+	itr.ImageRepo = &fk.ImageRepo{IterateBaseImages: []im.BaseImage{
+		{ImageId: im.NewImageId(), Collection: collection.Name}}}
+	logger := &fk.EventLogger{}
+	itr.EventLogger = logger
+	p := &FakePresenter{}
+	itr.Execute(ctx, collection.Name, p)
+
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.NotNil(t, s.DeletedId)
+	assert.Equal(t, u.UserId("user@mail.com"), *s.DeletedBy)
+	assert.Equal(t, ev.DoneTask, logger.Events[len(logger.Events)-1].State)
+}
+
+func TestDeleteJobDeletesImagesOfNumericCollection(t *testing.T) {
+	itr, _, _, ctx := Setup(t)
+	collection := clc.NewCollection(clc.NewCollectionId(), "2024")
+	itr.CollectionRepo = &fk.CollectionRepo{Return: collection}
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	s := &fk.ImageStore{}
+	itr.ImageStore = s
+	itr.ImageRepo = &fk.ImageRepo{IterateBaseImages: []im.BaseImage{
+		{ImageId: im.NewImageId(), Collection: collection.Name}}}
+	p := &FakePresenter{}
+	itr.Execute(ctx, collection.Name, p)
+
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.NotNil(t, s.DeletedId)
+}
+
+func TestDeleteJobReturnsErrorOnCollectionDeletion(t *testing.T) {
+	itr, collection, _, ctx := Setup(t)
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	itr.CollectionRepo = &fk.CollectionRepo{Return: collection, ErrOnDelete: e.ErrInternal}
+	p := &FakePresenter{}
+	itr.Execute(ctx, collection.Name, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.ErrorIs(t, q.Run(t.Context(), itr.Run), e.ErrInternal)
+}
@@ -4,20 +4,23 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	ev "github.com/lejeunel/go-image-annotator/entities/event"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	t "github.com/lejeunel/go-image-annotator/entities/task"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	event_logger "github.com/lejeunel/go-image-annotator/modules/event-logger"
 	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
 )
 
 type ImageStore interface {
-	Delete(id im.ImageId, collection clc.CollectionName) error
+	Delete(im.ImageId, clc.CollectionName, *u.UserId, *time.Time) error
 }
 
 type Interactor struct {
@@ -29,6 +32,7 @@ type Interactor struct {
 	EventLogger event_logger.IEventLogger
 	slog.Logger
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 func New(
@@ -57,78 +61,89 @@ func New(
 }
 
 func (i Interactor) Execute(ctx context.Context, name string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteCollection", au.Target{Type: "collection", Id: name})
+	defer record.End()
+
 	errCtx := "deleting collection"
 
 	collection, err := i.CollectionRepo.Find(name)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: fetching collection: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: fetching collection: %w", errCtx, err)))
 		return
 	}
 	if collection.Group != nil {
 		if err := i.Auth.DeleteCollection(ctx, *collection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 	user := u.IdentityFromContext(ctx)
 	if user == nil {
-		out.Error(fmt.Errorf("%v: failed fetching user id from context", errCtx))
+		out.Error(record.Fail(fmt.Errorf("%v: failed fetching user id from context", errCtx)))
 		return
 	}
 
 	task := t.NewTask(t.NewTaskId(), user.Id, t.CollectionDeleteTask)
+	job, err := jq.NewJob(task.Id, task.Type, Payload{Collection: collection.Name, Issuer: user.Id})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
 	if err := i.EventLogger.InitTask(
 		task.Id, task.Type, task.Issuer); err != nil {
-		out.Error(fmt.Errorf("%v: pushing init task to logger: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: pushing init task to logger: %w", errCtx, err)))
 		return
 	}
 	if err := i.EventLogger.AddEvent(
 		task.Id,
 		ev.Event{Time: i.Clock.Now(), State: ev.PendingTask},
 	); err != nil {
-		out.Error(fmt.Errorf("%v: adding pending status: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: adding pending status: %w", errCtx, err)))
 		return
 	}
 
-	i.JobQueue.Submit(func() {
-		i.runTask(task, *collection)
-	})
+	if err := i.JobQueue.Submit(job); err != nil {
+		i.LogError(task.Id, err)
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
 	out.SuccessDeleteCollection(Response{Id: task.Id, Type: task.Type, Issuer: user.Id})
 }
 
-func (i *Interactor) runTask(task t.Task, collection clc.Collection) {
+// Run carries out a deletion job. Images deleted by a failed attempt are
+// not visited again when it is retried.
+func (i *Interactor) Run(ctx context.Context, job jq.Job) error {
 	errCtx := fmt.Errorf("running delete collection task")
-	i.Logger.Info(fmt.Sprintf("started delete task %v", task.Id))
+	p := Payload{}
+	if err := job.Decode(&p); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	i.Logger.Info(fmt.Sprintf("started delete task %v", job.TaskId))
 
-	extra := map[string]string{"collection": collection.Name}
+	extra := map[string]string{"collection": p.Collection}
 	if err := i.EventLogger.AddEvent(
-		task.Id,
+		job.TaskId,
 		ev.Event{Time: i.Clock.Now(), State: ev.StartedTask, Extra: extra},
 	); err != nil {
-		i.Logger.Error(
-			fmt.Errorf("%w: logging event upon delete task startup: %w", errCtx, err).Error(),
-		)
-		return
+		return fmt.Errorf("%w: logging event upon delete task startup: %w", errCtx, err)
 	}
 
-	for baseImage, err := range i.ImageRepo.Iterate("collection="+collection.Name, 1) {
+	for baseImage, err := range i.ImageRepo.Iterate(fmt.Sprintf("collection=%q", p.Collection), 1) {
 		if err != nil {
-			i.LogError(task.Id, err)
-			return
-
+			return fmt.Errorf("%w: %w", errCtx, err)
 		}
-		if err := i.ImageStore.Delete(baseImage.ImageId, baseImage.Collection); err != nil {
-			i.LogError(task.Id, err)
-			return
+		now := i.Clock.Now()
+		if err := i.ImageStore.Delete(baseImage.ImageId, baseImage.Collection, p.issuer(), &now); err != nil {
+			return fmt.Errorf("%w: %w", errCtx, err)
 		}
 	}
 
-	if err := i.CollectionRepo.Delete(collection.Name); err != nil {
-		i.Logger.Error(fmt.Errorf("%w: deleting collection: %w", errCtx, err).Error())
-		return
+	if err := i.CollectionRepo.Delete(p.Collection); err != nil {
+		return fmt.Errorf("%w: deleting collection: %w", errCtx, err)
 	}
 
-	i.EventLogger.AddEvent(task.Id, ev.Event{Time: i.Clock.Now(), State: ev.DoneTask})
+	i.EventLogger.AddEvent(job.TaskId, ev.Event{Time: i.Clock.Now(), State: ev.DoneTask})
+	return nil
 }
 
 func (i *Interactor) LogError(id t.TaskId, err error) {
@@ -141,6 +156,12 @@ func (i *Interactor) LogError(id t.TaskId, err error) {
 
 type Option func(*Interactor)
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithAuth(a Auth) Option {
 	return func(i *Interactor) {
 		i.Auth = a
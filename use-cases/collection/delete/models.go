@@ -5,6 +5,21 @@ import (
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 )
 
+// Payload is what a deletion job needs to run, once persisted.
+type Payload struct {
+	Collection string   `json:"collection"`
+	Issuer     u.UserId `json:"issuer,omitempty"`
+}
+
+// issuer is who annotations removed with the images are deleted by, unknown
+// for jobs queued before it was recorded.
+func (p Payload) issuer() *u.UserId {
+	if p.Issuer == "" {
+		return nil
+	}
+	return &p.Issuer
+}
+
 type Response struct {
 	Id     t.TaskId
 	Issuer u.UserId
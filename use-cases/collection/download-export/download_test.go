@@ -0,0 +1,81 @@
+package download_export
+
+import (
+	"io"
+	"testing"
+
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	tk "github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func doneExportTask(issuer string) tk.Task {
+	task := tk.NewTask(tk.NewTaskId(), issuer, tk.CollectionExportTask)
+	task.Events = []ev.Event{
+		{State: ev.DoneTask, Extra: map[string]string{"collection": "a", "format": "coco"}},
+		{State: ev.StartedTask},
+	}
+	return task
+}
+
+func TestDownloadWithoutIdentity(t *testing.T) {
+	itr := NewTestingDownloader()
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{TaskId: tk.NewTaskId().String()}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestDownloadInvalidTaskId(t *testing.T) {
+	itr := NewTestingDownloader()
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{TaskId: "not-an-id"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestDownloadTaskOfOtherUser(t *testing.T) {
+	itr := NewTestingDownloader()
+	itr.TaskRepo = &fk.EventLogger{ReturnTask: doneExportTask("other@mail.com")}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{TaskId: tk.NewTaskId().String()}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestDownloadUnfinishedTask(t *testing.T) {
+	itr := NewTestingDownloader()
+	task := doneExportTask("user@mail.com")
+	task.Events = task.Events[1:]
+	itr.TaskRepo = &fk.EventLogger{ReturnTask: task}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{TaskId: tk.NewTaskId().String()}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestDownloadOtherTaskType(t *testing.T) {
+	itr := NewTestingDownloader()
+	task := doneExportTask("user@mail.com")
+	task.Type = tk.CollectionCloneTask
+	itr.TaskRepo = &fk.EventLogger{ReturnTask: task}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{TaskId: tk.NewTaskId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestDownload(t *testing.T) {
+	itr := NewTestingDownloader()
+	itr.TaskRepo = &fk.EventLogger{ReturnTask: doneExportTask("user@mail.com")}
+	itr.ArchiveStore = &fk.FileStore{Data: []byte("archive")}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{TaskId: tk.NewTaskId().String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "a-coco.zip", p.Got.FileName)
+	data, _ := io.ReadAll(p.Got.Reader)
+	assert.Equal(t, []byte("archive"), data)
+}
@@ -0,0 +1,70 @@
+package download_export
+
+import (
+	"context"
+	"fmt"
+
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	xt "github.com/lejeunel/go-image-annotator/use-cases/collection/export-task"
+)
+
+type Interactor struct {
+	TaskRepo
+	ArchiveStore
+}
+
+func New(r TaskRepo, s ArchiveStore) Interactor {
+	return Interactor{r, s}
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := fmt.Errorf("downloading exported archive")
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(fmt.Errorf("%w: failed fetching user id from context: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+
+	id, err := t.NewTaskIdFromString(r.TaskId)
+	if err != nil {
+		out.Error(fmt.Errorf("%w: %w", errCtx, err))
+		return
+	}
+
+	task, err := i.TaskRepo.FindTask(*id)
+	if err != nil {
+		out.Error(fmt.Errorf("%w: fetching task: %w", errCtx, err))
+		return
+	}
+	if task.Issuer != user.Id {
+		out.Error(fmt.Errorf("%w: task %v was issued by another user: %w",
+			errCtx, task.Id, e.ErrAuthorization))
+		return
+	}
+	if task.Type != t.CollectionExportTask {
+		out.Error(fmt.Errorf("%w: task %v is not an export task: %w",
+			errCtx, task.Id, e.ErrNotFound))
+		return
+	}
+	if len(task.Events) == 0 || task.Events[0].State != ev.DoneTask {
+		out.Error(fmt.Errorf("%w: export task %v is not done: %w",
+			errCtx, task.Id, e.ErrValidation))
+		return
+	}
+
+	reader, err := i.ArchiveStore.Get(xt.ArchiveName(task.Id))
+	if err != nil {
+		out.Error(fmt.Errorf("%w: fetching archive: %w", errCtx, err))
+		return
+	}
+
+	fileName := xt.ArchiveName(task.Id)
+	extra := task.Events[0].Extra
+	if collection, ok := extra["collection"]; ok {
+		fileName = fmt.Sprintf("%v-%v.zip", collection, extra["format"])
+	}
+	out.SuccessDownloadExport(Response{FileName: fileName, Reader: reader})
+}
@@ -0,0 +1,14 @@
+package download_export
+
+import (
+	"io"
+)
+
+type Request struct {
+	TaskId string
+}
+
+type Response struct {
+	FileName string
+	Reader   io.ReadCloser
+}
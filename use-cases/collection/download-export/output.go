@@ -0,0 +1,6 @@
+package download_export
+
+type OutputPort interface {
+	SuccessDownloadExport(Response)
+	Error(error)
+}
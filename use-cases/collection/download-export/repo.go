@@ -0,0 +1,15 @@
+package download_export
+
+import (
+	"io"
+
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+)
+
+type TaskRepo interface {
+	FindTask(t.TaskId) (*t.Task, error)
+}
+
+type ArchiveStore interface {
+	Get(string) (io.ReadCloser, error)
+}
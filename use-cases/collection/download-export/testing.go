@@ -0,0 +1,21 @@
+package download_export
+
+import (
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	testing "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	testing.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessDownloadExport(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
+
+func NewTestingDownloader() Interactor {
+	return New(&fk.EventLogger{}, &fk.FileStore{})
+}
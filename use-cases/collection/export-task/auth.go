@@ -0,0 +1,9 @@
+package export_task
+
+import (
+	"context"
+)
+
+type Auth interface {
+	ExportCollection(ctx context.Context, group string) error
+}
@@ -0,0 +1,165 @@
+package export_task
+
+import (
+	"testing"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	"github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitTaskWithoutIdentity(t *testing.T) {
+	itr := NewTestingExporter()
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Format: "coco"}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestInvalidFormat(t *testing.T) {
+	itr := NewTestingExporter()
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Format: "unknown"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestHandleAuthErr(t *testing.T) {
+	group := "my-group"
+	itr := NewTestingExporter()
+	itr.Auth = fk.Auth{Err: e.ErrAuthorization}
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a", Group: &group}}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco"}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestCollectionNotFound(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{ErrOnFind: e.ErrNotFound}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco"}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestExport(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a"}}
+	store := &fk.FileStore{}
+	itr.ArchiveStore = store
+	exporter := &FakeExporter{Data: []byte("archive")}
+	itr.Exporter = exporter
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.Equal(t, task.CollectionExportTask, p.Got.Type)
+	assert.Equal(t, ax.COCOFormat, exporter.Got.Format)
+	assert.Equal(t, `collection="a"`, exporter.Got.Filter)
+	assert.Equal(t, []byte("archive"), store.GotData)
+	last := logger.Events[len(logger.Events)-1]
+	assert.Equal(t, ev.DoneTask, last.State)
+	assert.Equal(t, ArchiveName(p.Got.Id), last.Extra["archive"])
+}
+
+func TestExportFailureDeletesArchive(t *testing.T) {
+	itr := NewTestingExporter()
+	store := &fk.FileStore{}
+	itr.ArchiveStore = store
+	itr.Exporter = &FakeExporter{Err: e.ErrInternal}
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.ErrorIs(t, q.Run(t.Context(), itr.Run), e.ErrInternal)
+	assert.Equal(t, 1, store.NumDeletedItems)
+}
+
+func TestInvalidFilter(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.FilterValidator = &fk.FilterValidator{Err: e.ErrValidation}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco", Filter: "meta.site=="}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestInvalidSplit(t *testing.T) {
+	itr := NewTestingExporter()
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco", Split: &ax.Split{}}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestExportWithFilterAndSplit(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a"}}
+	exporter := &FakeExporter{}
+	itr.Exporter = exporter
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	split := &ax.Split{Train: 0.8, Val: 0.2, Seed: 3, Stratify: true}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "yolo", Filter: "meta.site:lab", Split: split}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.Equal(t, `collection="a" and (meta.site:lab)`, exporter.Got.Filter)
+	assert.Equal(t, split, exporter.Got.Split)
+	last := logger.Events[len(logger.Events)-1]
+	assert.Equal(t, ev.DoneTask, last.State)
+	assert.Equal(t, "meta.site:lab", last.Extra["filter"])
+	assert.Equal(t, "0.8/0.2/0", last.Extra["split"])
+	assert.Equal(t, "3", last.Extra["seed"])
+	assert.Equal(t, "true", last.Extra["stratify"])
+}
+
+func TestExportAcceptedAnnotations(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a"}}
+	exporter := &FakeExporter{}
+	itr.Exporter = exporter
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco", ReviewStatus: "accepted"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.Equal(t, an.Accepted, exporter.Got.Status)
+	last := logger.Events[len(logger.Events)-1]
+	assert.Equal(t, "accepted", last.Extra["review-status"])
+}
+
+func TestInvalidReviewStatus(t *testing.T) {
+	itr := NewTestingExporter()
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{Collection: "a", Format: "coco", ReviewStatus: "approved"}, p)
+	assert.True(t, p.GotValidationErr)
+}
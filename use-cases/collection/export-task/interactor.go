@@ -0,0 +1,261 @@
+package export_task
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"github.com/jonboulle/clockwork"
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	ev "github.com/lejeunel/go-image-annotator/entities/event"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Exporter interface {
+	Export(ax.Request) (*ax.Response, error)
+}
+
+type FilterValidator interface {
+	Validate(im.FilterStr) error
+}
+
+type Interactor struct {
+	CollectionRepo
+	FilterValidator
+	ArchiveStore
+	Exporter
+	el.IEventLogger
+	Auth
+	clockwork.Clock
+	slog.Logger
+	jq.JobQueue
+	Audit al.AuditLogger
+}
+
+func New(c CollectionRepo, fv FilterValidator, s ArchiveStore, x Exporter,
+	l el.IEventLogger, logger slog.Logger, j jq.JobQueue,
+	opts ...Option,
+) Interactor {
+	itr := &Interactor{c, fv, s, x, l, auth.NewVoidAuth(), clockwork.NewRealClock(), logger, j, al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(itr)
+	}
+	return *itr
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func parseReviewStatus(s string) (an.ReviewStatus, error) {
+	if s == "" {
+		return "", nil
+	}
+	return an.ParseReviewStatus(s)
+}
+
+func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "ExportCollection", au.Target{Type: "collection", Id: r.Collection})
+	defer record.End()
+
+	errCtx := fmt.Errorf("initiating collection export task")
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%w: failed fetching user id from context: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+
+	format, err := ax.ParseFormat(r.Format)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	if _, err := parseReviewStatus(r.ReviewStatus); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	if r.Filter != "" {
+		if err := i.FilterValidator.Validate(r.Filter); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	if r.Split != nil {
+		if err := r.Split.Validate(); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	collection, err := i.CollectionRepo.Find(r.Collection)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: fetching collection: %w", errCtx, err)))
+		return
+	}
+	if collection.Group != nil {
+		if err := i.Auth.ExportCollection(ctx, *collection.Group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	task := t.NewTask(t.NewTaskId(), user.Id, t.CollectionExportTask)
+	job, err := jq.NewJob(task.Id, task.Type, Payload{
+		Collection: collection.Name, Filter: r.Filter,
+		Format: format.String(), ReviewStatus: r.ReviewStatus, Split: r.Split,
+	})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if err := i.IEventLogger.InitTask(task.Id, task.Type, task.Issuer); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: pushing init task to logger: %w", errCtx, err)))
+		return
+	}
+	if err := i.IEventLogger.AddEvent(
+		task.Id,
+		ev.Event{Time: i.Clock.Now(), State: ev.PendingTask},
+	); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: adding pending status: %w", errCtx, err)))
+		return
+	}
+
+	if err := i.JobQueue.Submit(job); err != nil {
+		i.LogError(task.Id, err)
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessSubmitExportTask(Response{Id: task.Id, Issuer: task.Issuer, Type: task.Type})
+}
+
+func (i *Interactor) LogError(id t.TaskId, err error) {
+	i.IEventLogger.AddEvent(
+		id,
+		ev.Event{Time: i.Clock.Now(), State: ev.FailedTask, Error: err.Error()},
+	)
+	i.Logger.Error(err.Error())
+}
+
+// Run carries out an export job. The archive written by a failed attempt
+// is deleted, and rewritten from scratch when the job is retried.
+func (i *Interactor) Run(ctx context.Context, job jq.Job) error {
+	errCtx := fmt.Errorf("running collection export task")
+	p := Payload{}
+	if err := job.Decode(&p); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	format, err := ax.ParseFormat(p.Format)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	status, err := parseReviewStatus(p.ReviewStatus)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	i.Logger.Info(fmt.Sprintf("started export task %v", job.TaskId))
+
+	extra := map[string]string{
+		"collection": p.Collection,
+		"format":     format.String(),
+	}
+	if p.Filter != "" {
+		extra["filter"] = p.Filter
+	}
+	if p.ReviewStatus != "" {
+		extra["review-status"] = p.ReviewStatus
+	}
+	if p.Split != nil {
+		extra["split"] = p.Split.String()
+		extra["seed"] = strconv.FormatUint(p.Split.Seed, 10)
+		extra["stratify"] = strconv.FormatBool(p.Split.Stratify)
+	}
+	if err := i.IEventLogger.AddEvent(
+		job.TaskId,
+		ev.Event{Time: i.Clock.Now(), State: ev.StartedTask, Extra: extra},
+	); err != nil {
+		return fmt.Errorf("%w: logging event upon export task startup: %w", errCtx, err)
+	}
+
+	archive := ArchiveName(job.TaskId)
+	resp, err := i.export(archive, ax.Request{
+		Filter: qu.CollectionFilter(p.Collection, p.Filter),
+		Format: format,
+		Split:  p.Split,
+		Status: status,
+	})
+	if err != nil {
+		i.ArchiveStore.Delete(archive)
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+
+	extra["archive"] = archive
+	extra["num-exported-images"] = strconv.Itoa(resp.NumImages)
+	extra["num-exported-annotations"] = strconv.Itoa(resp.NumAnnotations)
+	for subset, n := range resp.NumPerSubset {
+		extra["num-exported-images: "+subset] = strconv.Itoa(n)
+	}
+	i.IEventLogger.AddEvent(
+		job.TaskId,
+		ev.Event{Time: i.Clock.Now(), State: ev.DoneTask, Extra: extra},
+	)
+	return nil
+}
+
+func (i *Interactor) export(archive string, r ax.Request) (*ax.Response, error) {
+	pr, pw := io.Pipe()
+	type result struct {
+		resp *ax.Response
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		r.Writer = pw
+		resp, err := i.Exporter.Export(r)
+		pw.CloseWithError(err)
+		done <- result{resp, err}
+	}()
+
+	storeErr := i.ArchiveStore.Store(archive, pr)
+	if storeErr != nil {
+		pr.CloseWithError(storeErr)
+	}
+	res := <-done
+	if res.err != nil {
+		return nil, fmt.Errorf("writing archive: %w", res.err)
+	}
+	if storeErr != nil {
+		return nil, fmt.Errorf("storing archive: %w", storeErr)
+	}
+	return res.resp, nil
+}
@@ -0,0 +1,36 @@
+package export_task
+
+import (
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+)
+
+type Request struct {
+	Collection string
+	Format     string
+	Filter     string
+	// ReviewStatus restricts exported annotations to a review status, e.g.
+	// accepted.
+	ReviewStatus string
+	Split        *ax.Split
+}
+
+// Payload is what an export job needs to run, once persisted.
+type Payload struct {
+	Collection   string    `json:"collection"`
+	Filter       string    `json:"filter,omitempty"`
+	Format       string    `json:"format"`
+	ReviewStatus string    `json:"review_status,omitempty"`
+	Split        *ax.Split `json:"split,omitempty"`
+}
+
+type Response struct {
+	Id     t.TaskId
+	Issuer u.UserId
+	Type   t.TaskType
+}
+
+func ArchiveName(id t.TaskId) string {
+	return id.String() + ".zip"
+}
@@ -0,0 +1,6 @@
+package export_task
+
+type OutputPort interface {
+	SuccessSubmitExportTask(Response)
+	Error(error)
+}
@@ -0,0 +1,16 @@
+package export_task
+
+import (
+	"io"
+
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+)
+
+type CollectionRepo interface {
+	Find(string) (*clc.Collection, error)
+}
+
+type ArchiveStore interface {
+	Store(string, io.Reader) error
+	Delete(string) error
+}
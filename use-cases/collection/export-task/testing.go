@@ -0,0 +1,44 @@
+package export_task
+
+import (
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	testing "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakeExporter struct {
+	Got  ax.Request
+	Err  error
+	Data []byte
+}
+
+func (x *FakeExporter) Export(r ax.Request) (*ax.Response, error) {
+	if x.Err != nil {
+		return nil, x.Err
+	}
+	x.Got = r
+	if _, err := r.Writer.Write(x.Data); err != nil {
+		return nil, err
+	}
+	return &ax.Response{NumImages: 1}, nil
+}
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	testing.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessSubmitExportTask(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
+
+func NewTestingExporter() Interactor {
+	return New(
+		&fk.CollectionRepo{},
+		&fk.FilterValidator{},
+		&fk.FileStore{},
+		&FakeExporter{},
+		&fk.EventLogger{}, fk.NewLogger(), &fk.JobQueue{})
+}
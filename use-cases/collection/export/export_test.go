@@ -0,0 +1,124 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+	"go.tomakado.io/dumbql/schema"
+)
+
+func TestInvalidFormat(t *testing.T) {
+	itr := NewTestingExporter()
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Format: "unknown"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestHandleAuthErr(t *testing.T) {
+	group := "my-group"
+	itr := NewTestingExporter()
+	itr.Auth = fk.Auth{Err: e.ErrAuthorization}
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a", Group: &group}}
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco"}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestCollectionNotFound(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{ErrOnFind: e.ErrNotFound}
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco"}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestExportErr(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.Exporter = &FakeExporter{Err: e.ErrInternal}
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco"}, p)
+	assert.True(t, p.GotInternalErr)
+}
+
+func TestExport(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a"}}
+	x := &FakeExporter{}
+	itr.Exporter = x
+	var buf bytes.Buffer
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco", Writer: &buf}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, ax.COCOFormat, x.Got.Format)
+	assert.Equal(t, `collection="a"`, x.Got.Filter)
+	assert.Equal(t, &buf, x.Got.Writer)
+	assert.Equal(t, 2, p.Got.NumImages)
+}
+
+func TestExportFilterParsesAnyCollectionName(t *testing.T) {
+	sb := schema.NewSchemaBuilder()
+	sb.AddField("collection", schema.Is[string]())
+	sb.AddRegExpField(`^meta\..*$`, schema.Is[string]())
+	parser := qu.NewFilterParser(sb.Build())
+	for _, name := range []string{"a-collection", "2024-set", "1e5"} {
+		itr := NewTestingExporter()
+		itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: name}}
+		x := &FakeExporter{}
+		itr.Exporter = x
+		p := &FakePresenter{}
+		itr.Execute(t.Context(), Request{Collection: name, Format: "coco", Filter: "meta.site:lab"}, p)
+		assert.True(t, p.GotSuccess)
+		sqlizer, err := parser.ParseToSql(x.Got.Filter)
+		assert.NoError(t, err, name)
+		_, args, err := sqlizer.ToSql()
+		assert.NoError(t, err, name)
+		assert.Equal(t, []any{name, "lab"}, args, name)
+	}
+}
+
+func TestInvalidFilter(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.FilterValidator = &fk.FilterValidator{Err: e.ErrValidation}
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco", Filter: "meta.site=="}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestExportWithFilter(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a"}}
+	x := &FakeExporter{}
+	itr.Exporter = x
+	p := &FakePresenter{}
+	split := &ax.Split{Train: 1, Test: 1}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "voc", Filter: "meta.site:lab", Split: split}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, ax.VOCFormat, x.Got.Format)
+	assert.Equal(t, `collection="a" and (meta.site:lab)`, x.Got.Filter)
+	assert.Equal(t, split, x.Got.Split)
+}
+
+func TestExportAcceptedAnnotations(t *testing.T) {
+	itr := NewTestingExporter()
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "a"}}
+	x := &FakeExporter{}
+	itr.Exporter = x
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco", ReviewStatus: "accepted"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, an.Accepted, x.Got.Status)
+}
+
+func TestInvalidReviewStatus(t *testing.T) {
+	itr := NewTestingExporter()
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a", Format: "coco", ReviewStatus: "approved"}, p)
+	assert.True(t, p.GotValidationErr)
+}
@@ -0,0 +1,122 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	an "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	qu "github.com/lejeunel/go-image-annotator/modules/query"
+)
+
+type Exporter interface {
+	Export(ax.Request) (*ax.Response, error)
+}
+
+type FilterValidator interface {
+	Validate(im.FilterStr) error
+}
+
+type Interactor struct {
+	CollectionRepo
+	FilterValidator
+	Exporter
+	Auth
+	Audit al.AuditLogger
+}
+
+func New(c CollectionRepo, fv FilterValidator, x Exporter, opts ...Option) Interactor {
+	itr := &Interactor{c, fv, x, auth.NewVoidAuth(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(itr)
+	}
+	return *itr
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func parseReviewStatus(s string) (an.ReviewStatus, error) {
+	if s == "" {
+		return "", nil
+	}
+	return an.ParseReviewStatus(s)
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "ExportCollection", au.Target{Type: "collection", Id: r.Collection})
+	defer record.End()
+
+	errCtx := fmt.Errorf("exporting collection")
+
+	format, err := ax.ParseFormat(r.Format)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	status, err := parseReviewStatus(r.ReviewStatus)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	if r.Filter != "" {
+		if err := i.FilterValidator.Validate(r.Filter); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	if r.Split != nil {
+		if err := r.Split.Validate(); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	collection, err := i.CollectionRepo.Find(r.Collection)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: fetching collection: %w", errCtx, err)))
+		return
+	}
+	if collection.Group != nil {
+		if err := i.Auth.ExportCollection(ctx, *collection.Group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	resp, err := i.Exporter.Export(ax.Request{
+		Filter: qu.CollectionFilter(collection.Name, r.Filter),
+		Format: format,
+		Split:  r.Split,
+		Status: status,
+		Writer: r.Writer,
+	})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessExport(Response{
+		Collection:     collection.Name,
+		NumImages:      resp.NumImages,
+		NumAnnotations: resp.NumAnnotations,
+		NumPerSubset:   resp.NumPerSubset,
+	})
+}
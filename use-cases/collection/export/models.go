@@ -0,0 +1,25 @@
+package export
+
+import (
+	"io"
+
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+)
+
+type Request struct {
+	Collection string
+	Format     string
+	Filter     string
+	// ReviewStatus restricts exported annotations to a review status, e.g.
+	// accepted.
+	ReviewStatus string
+	Split        *ax.Split
+	Writer       io.Writer
+}
+
+type Response struct {
+	Collection     string
+	NumImages      int
+	NumAnnotations int
+	NumPerSubset   map[string]int
+}
@@ -0,0 +1,9 @@
+package export
+
+import (
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+)
+
+type CollectionRepo interface {
+	Find(string) (*clc.Collection, error)
+}
@@ -0,0 +1,35 @@
+package export
+
+import (
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	ax "github.com/lejeunel/go-image-annotator/modules/archive-exporter"
+	testing "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakeExporter struct {
+	Got ax.Request
+	Err error
+}
+
+func (x *FakeExporter) Export(r ax.Request) (*ax.Response, error) {
+	if x.Err != nil {
+		return nil, x.Err
+	}
+	x.Got = r
+	return &ax.Response{NumImages: 2, NumAnnotations: 3}, nil
+}
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	testing.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessExport(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
+
+func NewTestingExporter() Interactor {
+	return New(&fk.CollectionRepo{}, &fk.FilterValidator{}, &FakeExporter{})
+}
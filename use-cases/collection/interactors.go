@@ -5,8 +5,12 @@ import (
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/clone"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/create"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/delete"
+	download_export "github.com/lejeunel/go-image-annotator/use-cases/collection/download-export"
+	"github.com/lejeunel/go-image-annotator/use-cases/collection/export"
+	export_task "github.com/lejeunel/go-image-annotator/use-cases/collection/export-task"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/list"
+	set_labels "github.com/lejeunel/go-image-annotator/use-cases/collection/set-labels"
 	"github.com/lejeunel/go-image-annotator/use-cases/collection/update"
 )
 
@@ -16,7 +20,11 @@ type Interactors struct {
 	Delete          delete.Interactor
 	List            list.Interactor
 	Update          update.Interactor
+	SetLabels       set_labels.Interactor
 	Clone           clone.Interactor
+	Export          export.Interactor
+	ExportTask      export_task.Interactor
+	DownloadExport  download_export.Interactor
 	DefaultPageSize int
 	Authorizer      auth.Authorizer
 }
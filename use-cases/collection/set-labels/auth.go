@@ -0,0 +1,9 @@
+package set_labels
+
+import (
+	"context"
+)
+
+type Auth interface {
+	UpdateCollection(context.Context, string) error
+}
@@ -0,0 +1,109 @@
+package set_labels
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	CollectionRepo
+	LabelRepo
+	Auth
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(cr CollectionRepo, lr LabelRepo, opts ...Option) Interactor {
+	i := &Interactor{cr, lr, auth.NewVoidAuth(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateCollection", au.Target{Type: "collection", Id: r.Collection})
+	defer record.End()
+
+	errCtx := fmt.Sprintf("setting labels of collection %v", r.Collection)
+	group, err := i.CollectionRepo.GetGroup(r.Collection)
+	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if group != nil {
+		if err := i.Auth.UpdateCollection(ctx, *group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	exists, err := i.CollectionRepo.Exists(r.Collection)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if !exists {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, e.ErrNotFound)))
+		return
+	}
+
+	names := []string{}
+	ids := []lbl.LabelId{}
+	for _, name := range r.Labels {
+		label, err := i.LabelRepo.FindLabel(name)
+		if err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+		if !slices.Contains(names, label.Name) {
+			names = append(names, label.Name)
+			ids = append(ids, label.Id)
+		}
+	}
+
+	var defaultName *string
+	var defaultId *lbl.LabelId
+	if r.Default != nil {
+		label, err := i.LabelRepo.FindLabel(*r.Default)
+		if err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: finding default label: %w", errCtx, err)))
+			return
+		}
+		if !slices.Contains(names, label.Name) {
+			out.Error(record.Fail(fmt.Errorf("%v: default label %v is not part of the allowed labels: %w",
+				errCtx, label.Name, e.ErrValidation)))
+			return
+		}
+		defaultName = &label.Name
+		defaultId = &label.Id
+	}
+
+	if err := i.CollectionRepo.SetLabels(r.Collection, ids, defaultId); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	out.SuccessSetCollectionLabels(Response{Collection: r.Collection, Labels: names, Default: defaultName})
+}
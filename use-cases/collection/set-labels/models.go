@@ -0,0 +1,13 @@
+package set_labels
+
+type Request struct {
+	Collection string
+	Labels     []string
+	Default    *string
+}
+
+type Response struct {
+	Collection string
+	Labels     []string
+	Default    *string
+}
@@ -0,0 +1,6 @@
+package set_labels
+
+type OutputPort interface {
+	SuccessSetCollectionLabels(Response)
+	Error(error)
+}
@@ -0,0 +1,15 @@
+package set_labels
+
+import (
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+)
+
+type CollectionRepo interface {
+	Exists(string) (bool, error)
+	GetGroup(string) (*string, error)
+	SetLabels(string, []lbl.LabelId, *lbl.LabelId) error
+}
+
+type LabelRepo interface {
+	FindLabel(string) (*lbl.Label, error)
+}
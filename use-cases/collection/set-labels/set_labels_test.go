@@ -0,0 +1,85 @@
+package set_labels
+
+import (
+	"testing"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func labelRepo() (*fk.LabelRepo, []lbl.Label) {
+	labels := []lbl.Label{
+		lbl.NewLabel(lbl.NewLabelId(), "car"),
+		lbl.NewLabel(lbl.NewLabelId(), "person"),
+		lbl.NewLabel(lbl.NewLabelId(), "bicycle"),
+	}
+	return &fk.LabelRepo{Labels: labels, Aliases: map[string]string{"automobile": "car"}}, labels
+}
+
+func TestHandleAuthError(t *testing.T) {
+	lr, _ := labelRepo()
+	itr := New(&fk.CollectionRepo{ReturnGroup: "a-group"}, lr,
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a-collection"}, p)
+	assert.False(t, p.GotSuccess)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestSetLabelsOfNonExistingCollectionShouldFail(t *testing.T) {
+	lr, _ := labelRepo()
+	itr := New(&fk.CollectionRepo{}, lr)
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a-collection", Labels: []string{"car"}}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestSetLabelsWithUnknownLabelShouldFail(t *testing.T) {
+	lr, _ := labelRepo()
+	cr := &fk.CollectionRepo{ExistingNames: []string{"a-collection"}}
+	itr := New(cr, lr)
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a-collection", Labels: []string{"car", "truck"}}, p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.Nil(t, cr.GotLabels)
+}
+
+func TestDefaultLabelMustBeAllowed(t *testing.T) {
+	lr, _ := labelRepo()
+	cr := &fk.CollectionRepo{ExistingNames: []string{"a-collection"}}
+	itr := New(cr, lr)
+	p := &FakePresenter{}
+	def := "bicycle"
+	itr.Execute(t.Context(), Request{Collection: "a-collection", Labels: []string{"car"}, Default: &def}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestSetLabelsResolvesAliases(t *testing.T) {
+	lr, labels := labelRepo()
+	cr := &fk.CollectionRepo{ExistingNames: []string{"a-collection"}}
+	itr := New(cr, lr)
+	p := &FakePresenter{}
+	def := "automobile"
+	itr.Execute(t.Context(), Request{
+		Collection: "a-collection",
+		Labels:     []string{"automobile", "person", "car"},
+		Default:    &def}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []string{"car", "person"}, p.Got.Labels)
+	assert.Equal(t, "car", *p.Got.Default)
+	assert.Equal(t, []lbl.LabelId{labels[0].Id, labels[1].Id}, cr.GotLabels)
+	assert.Equal(t, labels[0].Id, *cr.GotDefault)
+}
+
+func TestClearLabels(t *testing.T) {
+	lr, _ := labelRepo()
+	cr := &fk.CollectionRepo{ExistingNames: []string{"a-collection"}}
+	itr := New(cr, lr)
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{Collection: "a-collection"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Empty(t, cr.GotLabels)
+	assert.Nil(t, cr.GotDefault)
+}
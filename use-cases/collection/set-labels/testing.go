@@ -0,0 +1,16 @@
+package set_labels
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessSetCollectionLabels(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
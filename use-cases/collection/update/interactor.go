@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -14,6 +16,7 @@ type Interactor struct {
 	CollectionRepo
 	GroupRepo
 	Auth
+	Audit al.AuditLogger
 }
 
 type Option func(*Interactor)
@@ -24,8 +27,14 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(cr CollectionRepo, gr GroupRepo, opts ...Option) Interactor {
-	i := &Interactor{cr, gr, auth.NewVoidAuth()}
+	i := &Interactor{cr, gr, auth.NewVoidAuth(), al.AuditLogger{}}
 	for _, opt := range opts {
 		opt(i)
 	}
@@ -33,15 +42,18 @@ func New(cr CollectionRepo, gr GroupRepo, opts ...Option) Interactor {
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateCollection", au.Target{Type: "collection", Id: r.Name})
+	defer record.End()
+
 	errCtx := "updating collection"
 	group, err := i.CollectionRepo.GetGroup(r.Name)
 	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	if group != nil {
 		if err := i.Auth.UpdateCollection(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
@@ -49,14 +61,14 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	updateModel := clc.UpdateModel{NewDescription: r.NewDescription}
 
 	if err := i.ensureCollectionNameExists(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	updateModel.Name = r.Name
 
 	if r.NewName != r.Name {
 		if err := i.ensureCollectionNameDoesNotExist(r.NewName); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
@@ -65,36 +77,36 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	if r.NewGroup != nil {
 		groupExists, err := i.GroupRepo.Exists(*r.NewGroup)
 		if err != nil {
-			out.Error(fmt.Errorf("%v: checking existence of group: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: checking existence of group: %w", errCtx, err)))
 			return
 		}
 		if !*groupExists {
-			out.Error(
+			out.Error(record.Fail(
 				fmt.Errorf(
 					"%v: requested assignment to new group %v: %w",
 					errCtx,
 					*r.NewGroup,
 					err,
 				),
-			)
+			))
 			return
 		}
 		if err := i.Auth.UpdateCollection(ctx, *r.NewGroup); err != nil {
-			out.Error(
+			out.Error(record.Fail(
 				fmt.Errorf(
 					"%v: authorizing assignment to new group %v: %w",
 					errCtx,
 					*r.NewGroup,
 					err,
 				),
-			)
+			))
 			return
 		}
 	}
 	updateModel.NewGroup = r.NewGroup
 
 	if err := i.CollectionRepo.Update(updateModel); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
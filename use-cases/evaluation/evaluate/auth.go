@@ -0,0 +1,9 @@
+package evaluate
+
+import (
+	"context"
+)
+
+type Auth interface {
+	ReadImage(ctx context.Context, group string) error
+}
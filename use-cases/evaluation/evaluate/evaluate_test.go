@@ -0,0 +1,116 @@
+package evaluate
+
+import (
+	"testing"
+
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	evt "github.com/lejeunel/go-image-annotator/entities/event"
+	"github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitTaskWithoutIdentity(t *testing.T) {
+	itr := NewTestingEvaluator()
+	p := &FakePresenter{}
+	itr.Execute(t.Context(), Request{GroundTruth: "truth", Predictions: "predictions"}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestEvaluateCollectionAgainstItself(t *testing.T) {
+	itr := NewTestingEvaluator()
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "a", Predictions: "a"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestInvalidMinConfidence(t *testing.T) {
+	itr := NewTestingEvaluator()
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "truth", Predictions: "predictions", MinConfidence: 1.5}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestInvalidFilter(t *testing.T) {
+	itr := NewTestingEvaluator()
+	itr.FilterValidator = &fk.FilterValidator{Err: e.ErrValidation}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "truth", Predictions: "predictions", Filter: "meta.site=="}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestCollectionNotFound(t *testing.T) {
+	itr := NewTestingEvaluator()
+	itr.CollectionRepo = &fk.CollectionRepo{ErrOnFind: e.ErrNotFound}
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "truth", Predictions: "predictions"}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestHandleAuthErr(t *testing.T) {
+	group := "my-group"
+	itr := NewTestingEvaluator()
+	itr.Auth = fk.Auth{Err: e.ErrAuthorization}
+	itr.CollectionRepo = &fk.CollectionRepo{Return: clc.Collection{Name: "truth", Group: &group}}
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "truth", Predictions: "predictions"}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.Empty(t, q.Jobs)
+}
+
+func TestEvaluate(t *testing.T) {
+	itr := NewTestingEvaluator()
+	evaluator := &FakeEvaluator{}
+	itr.Evaluator = evaluator
+	repo := &fk.EvaluationRepo{}
+	itr.Repo = repo
+	logger := &fk.EventLogger{}
+	itr.IEventLogger = logger
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "truth", Predictions: "predictions", Filter: "meta.site:lab", MinConfidence: 0.25}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, task.EvaluationTask, p.Got.Type)
+	assert.NoError(t, q.Run(t.Context(), itr.Run))
+	assert.Equal(t, "truth", evaluator.Got.GroundTruth)
+	assert.Equal(t, "predictions", evaluator.Got.Predictions)
+	assert.Equal(t, "meta.site:lab", evaluator.Got.Filter)
+	assert.Equal(t, float32(0.25), evaluator.Got.MinConfidence)
+
+	assert.Equal(t, p.Got.Id, repo.Created.Id)
+	assert.Equal(t, "user@mail.com", repo.Created.Issuer)
+	assert.Equal(t, 0.5, repo.Created.Report.MAP)
+	last := logger.Events[len(logger.Events)-1]
+	assert.Equal(t, evt.DoneTask, last.State)
+	assert.Equal(t, "0.500", last.Extra["mAP"])
+	assert.Equal(t, "2", last.Extra["num-images"])
+}
+
+func TestEvaluationFailure(t *testing.T) {
+	itr := NewTestingEvaluator()
+	itr.Evaluator = &FakeEvaluator{Err: e.ErrInternal}
+	repo := &fk.EvaluationRepo{}
+	itr.Repo = repo
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "user@mail.com"),
+		Request{GroundTruth: "truth", Predictions: "predictions"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.ErrorIs(t, q.Run(t.Context(), itr.Run), e.ErrInternal)
+	assert.Nil(t, repo.Created)
+}
@@ -0,0 +1,208 @@
+package evaluate
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+
+	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	evt "github.com/lejeunel/go-image-annotator/entities/event"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	evr "github.com/lejeunel/go-image-annotator/modules/evaluator"
+	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Evaluator interface {
+	Evaluate(evr.Request) (*ev.Report, error)
+}
+
+type FilterValidator interface {
+	Validate(im.FilterStr) error
+}
+
+type Interactor struct {
+	CollectionRepo
+	FilterValidator
+	Repo
+	Evaluator
+	el.IEventLogger
+	Auth
+	clockwork.Clock
+	slog.Logger
+	jq.JobQueue
+	Audit al.AuditLogger
+}
+
+func New(c CollectionRepo, fv FilterValidator, r Repo, x Evaluator,
+	l el.IEventLogger, logger slog.Logger, j jq.JobQueue,
+	opts ...Option,
+) Interactor {
+	itr := &Interactor{c, fv, r, x, l, auth.NewVoidAuth(), clockwork.NewRealClock(), logger, j, al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(itr)
+	}
+	return *itr
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func (i *Interactor) checkCollection(ctx context.Context, name string) error {
+	collection, err := i.CollectionRepo.Find(name)
+	if err != nil {
+		return fmt.Errorf("fetching collection %v: %w", name, err)
+	}
+	if collection.Group != nil {
+		if err := i.Auth.ReadImage(ctx, *collection.Group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Execute submits a background task that scores the annotations of a
+// collection of predictions against those of a collection of ground truth.
+func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Evaluate", au.Target{Type: "collection", Id: r.Predictions})
+	defer record.End()
+
+	errCtx := fmt.Errorf("initiating evaluation task")
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%w: failed fetching user id from context: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+
+	if r.GroundTruth == r.Predictions {
+		out.Error(record.Fail(fmt.Errorf("%w: predictions and ground truth are both collection %v: %w",
+			errCtx, r.GroundTruth, e.ErrValidation)))
+		return
+	}
+	if r.MinConfidence < 0 || r.MinConfidence > 1 {
+		out.Error(record.Fail(fmt.Errorf("%w: minimum confidence %v is not between 0 and 1: %w",
+			errCtx, r.MinConfidence, e.ErrValidation)))
+		return
+	}
+	if r.Filter != "" {
+		if err := i.FilterValidator.Validate(r.Filter); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+	for _, name := range []string{r.GroundTruth, r.Predictions} {
+		if err := i.checkCollection(ctx, name); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+			return
+		}
+	}
+
+	task := t.NewTask(t.NewTaskId(), user.Id, t.EvaluationTask)
+	job, err := jq.NewJob(task.Id, task.Type, Payload{
+		GroundTruth: r.GroundTruth, Predictions: r.Predictions,
+		Filter: r.Filter, MinConfidence: r.MinConfidence, Issuer: user.Id,
+	})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if err := i.IEventLogger.InitTask(task.Id, task.Type, task.Issuer); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: pushing init task to logger: %w", errCtx, err)))
+		return
+	}
+	if err := i.IEventLogger.AddEvent(
+		task.Id,
+		evt.Event{Time: i.Clock.Now(), State: evt.PendingTask},
+	); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: adding pending status: %w", errCtx, err)))
+		return
+	}
+
+	if err := i.JobQueue.Submit(job); err != nil {
+		i.IEventLogger.AddEvent(task.Id, evt.Event{Time: i.Clock.Now(), State: evt.FailedTask, Error: err.Error()})
+		i.Logger.Error(err.Error())
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessSubmitEvaluationTask(Response{Id: task.Id, Issuer: task.Issuer, Type: task.Type})
+}
+
+// Run carries out an evaluation job, and stores its report under the id of
+// the task.
+func (i *Interactor) Run(ctx context.Context, job jq.Job) error {
+	errCtx := fmt.Errorf("running evaluation task")
+	p := Payload{}
+	if err := job.Decode(&p); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	i.Logger.Info(fmt.Sprintf("started evaluation task %v", job.TaskId))
+
+	extra := map[string]string{
+		"ground-truth": p.GroundTruth,
+		"predictions":  p.Predictions,
+	}
+	if p.Filter != "" {
+		extra["filter"] = p.Filter
+	}
+	if err := i.IEventLogger.AddEvent(
+		job.TaskId,
+		evt.Event{Time: i.Clock.Now(), State: evt.StartedTask, Extra: extra},
+	); err != nil {
+		return fmt.Errorf("%w: logging event upon evaluation task startup: %w", errCtx, err)
+	}
+
+	report, err := i.Evaluator.Evaluate(evr.Request{
+		GroundTruth:   p.GroundTruth,
+		Predictions:   p.Predictions,
+		Filter:        p.Filter,
+		MinConfidence: p.MinConfidence,
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	if err := i.Repo.Create(ev.Evaluation{
+		Id:            job.TaskId,
+		GroundTruth:   p.GroundTruth,
+		Predictions:   p.Predictions,
+		Filter:        p.Filter,
+		MinConfidence: p.MinConfidence,
+		Issuer:        p.Issuer,
+		CreatedAt:     i.Clock.Now(),
+		Report:        *report,
+	}); err != nil {
+		return fmt.Errorf("%w: storing report: %w", errCtx, err)
+	}
+
+	extra["num-images"] = strconv.Itoa(report.NumImages)
+	extra["mAP"] = strconv.FormatFloat(report.MAP, 'f', 3, 64)
+	i.IEventLogger.AddEvent(
+		job.TaskId,
+		evt.Event{Time: i.Clock.Now(), State: evt.DoneTask, Extra: extra},
+	)
+	return nil
+}
@@ -0,0 +1,30 @@
+package evaluate
+
+import (
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+)
+
+type Request struct {
+	GroundTruth string
+	Predictions string
+	Filter      string
+	// MinConfidence leaves less confident predictions out of the confusion
+	// matrix.
+	MinConfidence float32
+}
+
+// Payload is what an evaluation job needs to run, once persisted.
+type Payload struct {
+	GroundTruth   string   `json:"ground_truth"`
+	Predictions   string   `json:"predictions"`
+	Filter        string   `json:"filter,omitempty"`
+	MinConfidence float32  `json:"min_confidence,omitempty"`
+	Issuer        u.UserId `json:"issuer"`
+}
+
+type Response struct {
+	Id     t.TaskId
+	Issuer u.UserId
+	Type   t.TaskType
+}
@@ -0,0 +1,6 @@
+package evaluate
+
+type OutputPort interface {
+	SuccessSubmitEvaluationTask(Response)
+	Error(error)
+}
@@ -0,0 +1,14 @@
+package evaluate
+
+import (
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+)
+
+type CollectionRepo interface {
+	Find(string) (*clc.Collection, error)
+}
+
+type Repo interface {
+	Create(ev.Evaluation) error
+}
@@ -0,0 +1,41 @@
+package evaluate
+
+import (
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	evr "github.com/lejeunel/go-image-annotator/modules/evaluator"
+	testing "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakeEvaluator struct {
+	Got evr.Request
+	Err error
+}
+
+func (x *FakeEvaluator) Evaluate(r evr.Request) (*ev.Report, error) {
+	if x.Err != nil {
+		return nil, x.Err
+	}
+	x.Got = r
+	return &ev.Report{NumImages: 2, MAP: 0.5}, nil
+}
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	testing.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessSubmitEvaluationTask(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
+
+func NewTestingEvaluator() Interactor {
+	return New(
+		&fk.CollectionRepo{},
+		&fk.FilterValidator{},
+		&fk.EvaluationRepo{},
+		&FakeEvaluator{},
+		&fk.EventLogger{}, fk.NewLogger(), &fk.JobQueue{})
+}
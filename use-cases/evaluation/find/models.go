@@ -0,0 +1,13 @@
+package find
+
+import (
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+)
+
+type Request struct {
+	Id string
+}
+
+type Response struct {
+	Evaluation ev.Evaluation
+}
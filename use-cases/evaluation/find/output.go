@@ -0,0 +1,6 @@
+package find
+
+type OutputPort interface {
+	SuccessFindEvaluation(Response)
+	Error(error)
+}
@@ -0,0 +1,10 @@
+package find
+
+import (
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	t "github.com/lejeunel/go-image-annotator/entities/task"
+)
+
+type Repo interface {
+	Find(t.TaskId) (*ev.Evaluation, error)
+}
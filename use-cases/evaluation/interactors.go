@@ -0,0 +1,13 @@
+package evaluation
+
+import (
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/evaluate"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/evaluation/list"
+)
+
+type Interactors struct {
+	Evaluate evaluate.Interactor
+	Find     find.Interactor
+	List     list.Interactor
+}
@@ -0,0 +1,45 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Interactor struct {
+	Repo
+	DefaultPageSize int
+	MaxPageSize     int
+}
+
+func New(r Repo, dps int, mps int) Interactor {
+	return Interactor{r, dps, mps}
+}
+
+// Execute lists the evaluations issued by the caller, most recent first.
+func (i Interactor) Execute(ctx context.Context, r pag.PaginationParams, out OutputPort) {
+	errCtx := "listing evaluations"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+
+	r.Sanitize(i.DefaultPageSize, i.MaxPageSize)
+
+	evaluations, err := i.Repo.ListOf(user.Id, r)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	count, err := i.Repo.CountOf(user.Id)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	out.SuccessListEvaluations(Response{Evaluations: evaluations, Pagination: pag.New(r.Page, r.PageSize, *count)})
+}
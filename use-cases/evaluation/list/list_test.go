@@ -0,0 +1,36 @@
+package list
+
+import (
+	"testing"
+
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	tk "github.com/lejeunel/go-image-annotator/entities/task"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMyEvaluations(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.EvaluationRepo{Evaluations: []ev.Evaluation{{Id: tk.NewTaskId()}}}
+	New(repo, 10, 100).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), pag.PaginationParams{}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "me@mail.com", repo.GotUser)
+	assert.Equal(t, repo.Evaluations, p.Got.Evaluations)
+	assert.Equal(t, int64(1), p.Got.Pagination.TotalRecords)
+}
+
+func TestListEvaluationsRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.EvaluationRepo{}, 10, 100).Execute(t.Context(), pag.PaginationParams{}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestListEvaluationsHandlesInternalError(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.EvaluationRepo{ErrOnCount: e.ErrInternal}, 10, 100).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), pag.PaginationParams{}, p)
+	assert.True(t, p.GotInternalErr)
+}
@@ -0,0 +1,11 @@
+package list
+
+import (
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Response struct {
+	Evaluations []ev.Evaluation
+	Pagination  pag.Pagination
+}
@@ -0,0 +1,6 @@
+package list
+
+type OutputPort interface {
+	SuccessListEvaluations(Response)
+	Error(error)
+}
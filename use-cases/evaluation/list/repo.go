@@ -0,0 +1,12 @@
+package list
+
+import (
+	ev "github.com/lejeunel/go-image-annotator/entities/evaluation"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Repo interface {
+	ListOf(u.UserId, pag.PaginationParams) ([]ev.Evaluation, error)
+	CountOf(u.UserId) (*int64, error)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	g "github.com/lejeunel/go-image-annotator/entities/group"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	v "github.com/lejeunel/go-image-annotator/modules/string-validator"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
@@ -14,22 +16,26 @@ type Interactor struct {
 	Repo
 	v.Validator
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "CreateGroup", au.Target{Type: "group", Id: r.Name})
+	defer record.End()
+
 	errCtx := "creating group"
 	if err := i.Auth.CreateGroup(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.validate(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.create(r); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -80,6 +86,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) Interactor {
 	i := &Interactor{
 		Repo: r, Validator: v.NewNameValidator(),
@@ -0,0 +1,67 @@
+package backfill_thumbnails
+
+import (
+	"testing"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	th "github.com/lejeunel/go-image-annotator/modules/thumbnailer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func setup(thumbnailer *fk.Thumbnailer) (Interactor, im.ImageId) {
+	id := im.NewImageId()
+	repo := &fk.ImageRepo{
+		IterateBaseImages: []im.BaseImage{
+			{ImageId: id, Collection: "a-collection"},
+			{ImageId: id, Collection: "another-collection"},
+		},
+		ReturnSpecs: &im.Specs{MIMEType: "image/png"},
+		ReturnHash:  "cafe",
+	}
+	return New(repo, &fk.FileStore{Data: []byte("original")}, thumbnailer), id
+}
+
+func TestBackfillAllSizesOfEachImageOnce(t *testing.T) {
+	p := &FakePresenter{}
+	thumbnailer := &fk.Thumbnailer{}
+	itr, _ := setup(thumbnailer)
+	itr.Execute(Request{}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, Response{NumImages: 1, NumGenerated: len(th.Sizes)}, p.Got)
+	assert.Equal(t, "original", string(thumbnailer.GotSource))
+}
+
+func TestBackfillSkipsExistingThumbnails(t *testing.T) {
+	p := &FakePresenter{}
+	itr, _ := setup(&fk.Thumbnailer{Thumbnails: map[string][]byte{"cafe_128": []byte("thumbnail")}})
+	itr.Execute(Request{Sizes: []int{128, 256}}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 1, p.Got.NumGenerated)
+}
+
+func TestForceBackfillRegeneratesThumbnails(t *testing.T) {
+	p := &FakePresenter{}
+	itr, _ := setup(&fk.Thumbnailer{Thumbnails: map[string][]byte{"cafe_128": []byte("thumbnail")}})
+	itr.Execute(Request{Sizes: []int{128, 256}, Force: true}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 2, p.Got.NumGenerated)
+}
+
+func TestBackfillInvalidSize(t *testing.T) {
+	p := &FakePresenter{}
+	itr, _ := setup(&fk.Thumbnailer{})
+	itr.Execute(Request{Sizes: []int{100}}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestBackfillReportsFailedImages(t *testing.T) {
+	p := &FakePresenter{}
+	itr, id := setup(&fk.Thumbnailer{ErrOnGenerate: e.ErrValidation})
+	itr.Execute(Request{}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, Response{NumImages: 1, NumFailed: 1}, p.Got)
+	assert.ErrorIs(t, p.GotImageErrs[id], e.ErrValidation)
+}
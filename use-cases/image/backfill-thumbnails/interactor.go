@@ -0,0 +1,136 @@
+package backfill_thumbnails
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	th "github.com/lejeunel/go-image-annotator/modules/thumbnailer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Repo interface {
+	Iterate(im.FilterStr, int) iter.Seq2[im.BaseImage, error]
+	GetSpecs(im.ImageId) (*im.Specs, error)
+	GetHash(im.ImageId) (string, error)
+}
+
+type FileGetter interface {
+	GetReaderAt(string) (io.ReaderAt, int64, error)
+}
+
+type Thumbnailer interface {
+	GetReaderAt(hash string, size int) (io.ReaderAt, int64, error)
+	Generate(hash string, src io.Reader, size int) error
+}
+
+type Interactor struct {
+	Repo
+	FileGetter
+	Thumbnailer
+}
+
+func New(repo Repo, fileGetter FileGetter, thumbnailer Thumbnailer) Interactor {
+	return Interactor{Repo: repo, FileGetter: fileGetter, Thumbnailer: thumbnailer}
+}
+
+// Execute generates the missing thumbnails of all images. Images that fail
+// are reported and skipped, so that a single corrupted file does not stop
+// the backfill.
+func (i Interactor) Execute(r Request, out OutputPort) {
+	errCtx := "backfilling thumbnails"
+	sizes := r.Sizes
+	if len(sizes) == 0 {
+		sizes = th.Sizes
+	}
+	for _, size := range sizes {
+		if err := th.ValidateSize(size); err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+	}
+	filter := im.FilterStr("")
+	if r.Collection != "" {
+		filter = im.FilterStr(fmt.Sprintf("collection=%q", r.Collection))
+	}
+
+	resp := Response{}
+	seen := map[im.ImageId]bool{}
+	for base, err := range i.Repo.Iterate(filter, 100) {
+		if err != nil {
+			out.Error(fmt.Errorf("%v: iterating images: %w", errCtx, err))
+			return
+		}
+		if seen[base.ImageId] {
+			continue
+		}
+		seen[base.ImageId] = true
+		resp.NumImages++
+
+		n, err := i.backfill(base.ImageId, sizes, r.Force)
+		resp.NumGenerated += n
+		if err != nil {
+			resp.NumFailed++
+			out.ErrorOnImage(base.ImageId, fmt.Errorf("%v: %w", errCtx, err))
+		}
+	}
+	out.SuccessBackfillThumbnails(resp)
+}
+
+func (i Interactor) backfill(id im.ImageId, sizes []int, force bool) (int, error) {
+	specs, err := i.Repo.GetSpecs(id)
+	if err != nil {
+		return 0, fmt.Errorf("fetching image specifications: %w", err)
+	}
+	hash, err := i.Repo.GetHash(id)
+	if err != nil {
+		return 0, fmt.Errorf("fetching image hash: %w", err)
+	}
+
+	numGenerated := 0
+	for _, size := range sizes {
+		if !force {
+			exists, err := i.exists(hash, size)
+			if err != nil {
+				return numGenerated, err
+			}
+			if exists {
+				continue
+			}
+		}
+		if err := i.generate(id, *specs, hash, size); err != nil {
+			return numGenerated, err
+		}
+		numGenerated++
+	}
+	return numGenerated, nil
+}
+
+func (i Interactor) exists(hash string, size int) (bool, error) {
+	reader, _, err := i.Thumbnailer.GetReaderAt(hash, size)
+	if errors.Is(err, e.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("fetching thumbnail of size %v: %w", size, err)
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		closer.Close()
+	}
+	return true, nil
+}
+
+func (i Interactor) generate(id im.ImageId, specs im.Specs, hash string, size int) error {
+	original, n, err := i.FileGetter.GetReaderAt(
+		fmt.Sprintf("%v.%v", id.String(), strings.Split(specs.MIMEType, "/")[1]))
+	if err != nil {
+		return fmt.Errorf("fetching raw-data: %w", err)
+	}
+	if closer, ok := original.(io.Closer); ok {
+		defer closer.Close()
+	}
+	return i.Thumbnailer.Generate(hash, io.NewSectionReader(original, 0, n), size)
+}
@@ -0,0 +1,16 @@
+package backfill_thumbnails
+
+// Request restricts the backfill to a collection and to some sizes, all
+// images and sizes being processed by default. Existing thumbnails are
+// regenerated when Force is set.
+type Request struct {
+	Collection string
+	Sizes      []int
+	Force      bool
+}
+
+type Response struct {
+	NumImages    int
+	NumGenerated int
+	NumFailed    int
+}
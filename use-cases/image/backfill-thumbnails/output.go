@@ -0,0 +1,11 @@
+package backfill_thumbnails
+
+import (
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+)
+
+type OutputPort interface {
+	SuccessBackfillThumbnails(Response)
+	ErrorOnImage(im.ImageId, error)
+	Error(error)
+}
@@ -0,0 +1,25 @@
+package backfill_thumbnails
+
+import (
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got          Response
+	GotSuccess   bool
+	GotImageErrs map[im.ImageId]error
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessBackfillThumbnails(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
+
+func (p *FakePresenter) ErrorOnImage(id im.ImageId, err error) {
+	if p.GotImageErrs == nil {
+		p.GotImageErrs = map[im.ImageId]error{}
+	}
+	p.GotImageErrs[id] = err
+}
@@ -6,6 +6,7 @@ import (
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	g "github.com/lejeunel/go-image-annotator/entities/group"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
@@ -45,7 +46,9 @@ func TestDelete(t *testing.T) {
 	p := &FakePresenter{}
 	s := fk.ImageStore{}
 	itr := New(&s)
-	itr.Execute(t.Context(), Request{}, p)
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("user@mail.com"))
+	itr.Execute(ctx, Request{}, p)
 	assert.True(t, p.GotSuccess)
 	assert.NotNil(t, s.DeletedId)
+	assert.Equal(t, u.UserId("user@mail.com"), *s.DeletedBy)
 }
@@ -3,20 +3,27 @@ package delete
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 )
 
 type ImageStore interface {
 	Find(im.BaseImage) (*im.Image, error)
-	Delete(im.ImageId, clc.CollectionName) error
+	Delete(im.ImageId, clc.CollectionName, *u.UserId, *time.Time) error
 }
 
 type Interactor struct {
 	ImageStore
 	Auth
+	Audit al.AuditLogger
+	clockwork.Clock
 }
 
 type Option func(*Interactor)
@@ -27,10 +34,23 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
 func New(store ImageStore, opts ...Option) Interactor {
 	i := &Interactor{
 		ImageStore: store,
 		Auth:       auth.NewVoidAuth(),
+		Clock:      clockwork.NewRealClock(),
 	}
 	for _, opt := range opts {
 		opt(i)
@@ -39,28 +59,36 @@ func New(store ImageStore, opts ...Option) Interactor {
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteImage", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
 	errCtx := "deleting image"
 
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 	}
 
 	image, err := i.findImage(imageId, r.Collection)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if image.Collection.Group != nil {
 		if err := i.Auth.DeleteImage(ctx, *image.Collection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
-	if err := i.ImageStore.Delete(imageId, r.Collection); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+	var userId *u.UserId
+	if user := u.IdentityFromContext(ctx); user != nil {
+		userId = &user.Id
+	}
+	now := i.Clock.Now()
+	if err := i.ImageStore.Delete(imageId, r.Collection, userId, &now); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
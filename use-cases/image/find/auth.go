@@ -0,0 +1,9 @@
+package find
+
+import (
+	"context"
+)
+
+type Auth interface {
+	ReadImage(ctx context.Context, group string) error
+}
@@ -1,13 +1,16 @@
 package find
 
 import (
+	"context"
 	"fmt"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
 )
 
 type Interface interface {
-	Execute(Request, OutputPort)
+	Execute(context.Context, Request, OutputPort)
 }
 type ImageStore interface {
 	Find(base im.BaseImage) (*im.Image, error)
@@ -15,13 +18,26 @@ type ImageStore interface {
 
 type Interactor struct {
 	ImageStore
+	Auth
 }
 
-func New(store ImageStore) Interactor {
-	return Interactor{ImageStore: store}
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(store ImageStore, opts ...Option) Interactor {
+	i := &Interactor{ImageStore: store, Auth: auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
 }
 
-func (i Interactor) Execute(r Request, out OutputPort) {
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	errCtx := "reading image meta-data"
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
@@ -33,6 +49,10 @@ func (i Interactor) Execute(r Request, out OutputPort) {
 		out.Error(fmt.Errorf("%v: %w", errCtx, err))
 		return
 	}
+	if err := visibility.CanRead(ctx, i.Auth, image.Collection.Group); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
 
 	out.SuccessReadImage(*image)
 }
@@ -13,7 +13,7 @@ import (
 func TestHandleErrorOnFind(t *testing.T) {
 	p := &FakePresenter{}
 	itr := New(&fk.ImageStore{ErrOnFind: e.ErrNotFound})
-	itr.Execute(Request{ImageId: im.NewImageId().String(), Collection: "a-collection"}, p)
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String(), Collection: "a-collection"}, p)
 	assert.ErrorIs(t, p.GotErr, e.ErrNotFound)
 	assert.False(t, p.GotSuccess)
 }
@@ -25,7 +25,7 @@ func TestFindImageGivesCorrectIdAndCollection(t *testing.T) {
 		clc.NewCollection(clc.NewCollectionId(), "a-collection"),
 	)
 	itr := New(&fk.ImageStore{Return: &existingImage})
-	itr.Execute(Request{
+	itr.Execute(t.Context(), Request{
 		ImageId:    existingImage.Id.String(),
 		Collection: existingImage.Collection.Name,
 	}, p)
@@ -33,3 +33,13 @@ func TestFindImageGivesCorrectIdAndCollection(t *testing.T) {
 	assert.Equal(t, p.Got.Id, existingImage.Id, "id")
 	assert.Equal(t, p.Got.Collection.Name, existingImage.Collection.Name, "collection name")
 }
+
+func TestFindImageOfGroupRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	image := im.NewImage(im.NewImageId(),
+		clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithGroup("a-group")))
+	itr := New(&fk.ImageStore{Return: &image}, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{ImageId: image.Id.String(), Collection: "a-collection"}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -14,6 +16,7 @@ type Interactor struct {
 	ImageRepo
 	CollectionRepo
 	Auth
+	Audit al.AuditLogger
 }
 
 type Option func(*Interactor)
@@ -24,6 +27,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(imr ImageRepo, c CollectionRepo, opts ...Option) *Interactor {
 	i := &Interactor{
 		ImageRepo: imr, CollectionRepo: c,
@@ -36,26 +45,29 @@ func New(imr ImageRepo, c CollectionRepo, opts ...Option) *Interactor {
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "ImportImage", au.ImageTarget(r.DestinationCollection, r.ImageId))
+	defer record.End()
+
 	errCtx := "importing image"
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.ensureSourceImageExists(imageId); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	dstCollection, err := i.findCollection(r.DestinationCollection)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if dstCollection.Group != nil {
 		if err := i.Auth.ImportImage(ctx, *dstCollection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
@@ -64,12 +76,12 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		imageId,
 		dstCollection.Name,
 	); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.ImageRepo.AddToCollection(imageId, dstCollection.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
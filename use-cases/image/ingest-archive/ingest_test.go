@@ -8,8 +8,11 @@ import (
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	ev "github.com/lejeunel/go-image-annotator/entities/event"
 	grp "github.com/lejeunel/go-image-annotator/entities/group"
+	"github.com/lejeunel/go-image-annotator/entities/task"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
+	aig "github.com/lejeunel/go-image-annotator/modules/archive-ingester"
+	jq "github.com/lejeunel/go-image-annotator/modules/job-queue"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
 )
@@ -29,6 +32,14 @@ func Setup(t *testing.T) (Interactor, clc.Collection, grp.Group, context.Context
 	return itr, collection, group, ctx, data
 }
 
+// executeAndRun executes the request, then runs the submitted job.
+func executeAndRun(t *testing.T, itr Interactor, ctx context.Context, r Request, p *FakePresenter) error {
+	q := &fk.JobQueue{}
+	itr.JobQueue = q
+	itr.Execute(ctx, r, p)
+	return q.Run(t.Context(), itr.Run)
+}
+
 func TestHandleAuthError(t *testing.T) {
 	itr, collection, _, ctx, _ := Setup(t)
 	itr.CollectionRepo = &fk.CollectionRepo{
@@ -82,7 +93,7 @@ func TestIngestBatch(t *testing.T) {
 	ig := &FakeIngester{}
 	itr.TemporaryFileStore = &fk.FileStore{Data: data}
 	itr.ArchiveIngester = ig
-	itr.Execute(ctx,
+	executeAndRun(t, itr, ctx,
 		Request{
 			Reader:     bytes.NewReader(data),
 			Collection: collection.Name,
@@ -97,7 +108,7 @@ func TestArchiveIsDeleted(t *testing.T) {
 	itr, collection, _, ctx, data := Setup(t)
 	tfs := &fk.FileStore{}
 	itr.TemporaryFileStore = tfs
-	itr.Execute(ctx,
+	executeAndRun(t, itr, ctx,
 		Request{
 			Reader:     bytes.NewReader(data),
 			Collection: collection.Name,
@@ -105,18 +116,30 @@ func TestArchiveIsDeleted(t *testing.T) {
 	assert.Equal(t, 1, tfs.NumDeletedItems)
 }
 
-func TestFailedIngestionIsLogged(t *testing.T) {
+func TestFailedIngestionIsReturnedAndArchiveDeleted(t *testing.T) {
 	p := &FakePresenter{}
 	itr, collection, _, ctx, data := Setup(t)
-	el := fk.EventLogger{}
-	itr.IEventLogger = &el
-	itr.ArchiveIngester = &FakeIngester{Err: e.ErrInternal}
-	itr.Execute(ctx,
+	tfs := &fk.FileStore{}
+	itr.TemporaryFileStore = tfs
+	itr.ArchiveIngester = &FakeIngester{Err: e.ErrValidation}
+	err := executeAndRun(t, itr, ctx,
 		Request{
 			Reader:     bytes.NewReader(data),
 			Collection: collection.Name,
 		}, p)
-	assert.Equal(t, ev.FailedTask, el.Events[len(el.Events)-1].State)
+	assert.ErrorIs(t, err, e.ErrValidation)
+	assert.Equal(t, 1, tfs.NumDeletedItems)
+}
+
+func TestArchiveIsKeptForRetry(t *testing.T) {
+	itr, _, _, _, _ := Setup(t)
+	tfs := &fk.FileStore{}
+	itr.TemporaryFileStore = tfs
+	itr.ArchiveIngester = &FakeIngester{Err: e.ErrInternal}
+	job, _ := jq.NewJob(task.NewTaskId(), task.IngestArchiveTask, Payload{File: "a.zip"})
+	job.Attempt, job.MaxAttempts = 1, 3
+	assert.ErrorIs(t, itr.Run(t.Context(), job), e.ErrInternal)
+	assert.Equal(t, 0, tfs.NumDeletedItems)
 }
 
 func TestTooManyBytesShouldFail(t *testing.T) {
@@ -141,10 +164,48 @@ func TestIngest(t *testing.T) {
 	el := fk.EventLogger{}
 	itr.IEventLogger = &el
 	itr.ArchiveIngester = &FakeIngester{}
-	itr.Execute(ctx,
+	executeAndRun(t, itr, ctx,
 		Request{
 			Reader:     bytes.NewReader(data),
 			Collection: collection.Name,
 		}, p)
 	assert.Equal(t, ev.DoneTask, el.Events[len(el.Events)-1].State)
 }
+
+func TestCreatingMissingLabelsRequiresPermission(t *testing.T) {
+	itr, collection, _, ctx, data := Setup(t)
+	itr.Auth = &fk.Auth{Err: e.ErrAuthorization}
+	p := &FakePresenter{}
+	itr.Execute(ctx, Request{
+		Reader:              bytes.NewReader(data),
+		Collection:          collection.Name,
+		CreateMissingLabels: true,
+	}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestSkippedFilesAreReported(t *testing.T) {
+	p := &FakePresenter{}
+	itr, collection, _, ctx, data := Setup(t)
+	el := fk.EventLogger{}
+	itr.IEventLogger = &el
+	ingester := &FakeIngester{Return: aig.Response{
+		Format: aig.COCOFormat,
+		Skipped: []aig.SkippedFile{
+			{Name: "a.png", Reason: "duplicate"},
+			{Name: "a.png", Reason: "unknown label"},
+		},
+	}}
+	itr.ArchiveIngester = ingester
+	executeAndRun(t, itr, ctx, Request{
+		Reader:     bytes.NewReader(data),
+		Collection: collection.Name,
+		LabelMap:   map[string]string{"automobile": "car"},
+	}, p)
+	assert.Equal(t, "car", ingester.Got.LabelMap["automobile"])
+	extra := el.Events[len(el.Events)-1].Extra
+	assert.Equal(t, "coco", extra["format"])
+	assert.Equal(t, "2", extra["num-skipped"])
+	assert.Equal(t, "duplicate; unknown label", extra["skipped: a.png"])
+}
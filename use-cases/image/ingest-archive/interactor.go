@@ -8,11 +8,12 @@ import (
 
 	"github.com/jonboulle/clockwork"
 
-	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	ev "github.com/lejeunel/go-image-annotator/entities/event"
 	t "github.com/lejeunel/go-image-annotator/entities/task"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
 	aig "github.com/lejeunel/go-image-annotator/modules/archive-ingester"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	el "github.com/lejeunel/go-image-annotator/modules/event-logger"
 	iig "github.com/lejeunel/go-image-annotator/modules/image-ingester"
@@ -22,6 +23,7 @@ import (
 
 type Auth interface {
 	IngestImage(ctx context.Context, group string) error
+	CreateLabel(ctx context.Context) error
 }
 
 type ImageIngester interface {
@@ -48,6 +50,7 @@ type Interactor struct {
 	jq.JobQueue
 	slog.Logger
 	MaxMB int64
+	Audit al.AuditLogger
 }
 type Option func(*Interactor)
 
@@ -57,6 +60,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(aig ArchiveIngester,
 	cr CollectionRepo,
 	tfs TemporaryFileStore,
@@ -84,105 +93,148 @@ func New(aig ArchiveIngester,
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "IngestImage", au.Target{Type: "collection", Id: r.Collection})
+	defer record.End()
+
 	errCtx := fmt.Errorf("ingesting image archive")
 
 	collection, err := i.CollectionRepo.Find(r.Collection)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: finding collection with name %v: %w", errCtx, r.Collection, err))
+		out.Error(record.Fail(fmt.Errorf("%v: finding collection with name %v: %w", errCtx, r.Collection, err)))
 		return
 	}
 
 	if collection.Group != nil {
 		if err := i.Auth.IngestImage(ctx, *collection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	if r.CreateMissingLabels {
+		if err := i.Auth.CreateLabel(ctx); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
 	user := u.IdentityFromContext(ctx)
 	if user == nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%w: extracting user identity failed from context: %w",
 				errCtx,
 				e.ErrAuthentication,
 			),
-		)
+		))
 		return
 	}
 	task := t.NewTask(t.NewTaskId(), user.Id, t.IngestArchiveTask)
 	tmpFileName := fmt.Sprintf("%v.zip", task.Id)
+	job, err := jq.NewJob(task.Id, task.Type, Payload{
+		User: user.Id, Collection: r.Collection,
+		CreateMissingLabels: r.CreateMissingLabels, LabelMap: r.LabelMap,
+		File: tmpFileName,
+	})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
 
 	maxBytes := i.MaxMB * 1024 * 1024
 	maxBytesReader := NewMaxBytesReader(r.Reader, maxBytes,
 		fmt.Errorf("content exceeds maximum allowed size of %d MB: %w", i.MaxMB, e.ErrValidation))
 	if err := i.TemporaryFileStore.Store(tmpFileName, &maxBytesReader); err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf("%w: storing archive in temporary location: %w", errCtx, err),
-		)
+		))
 		return
 	}
 	if err := i.IEventLogger.InitTask(task.Id, task.Type, task.Issuer); err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf("%w: initializing ingestion task: %w", errCtx, err),
-		)
+		))
 		return
 	}
 	if err := i.IEventLogger.AddEvent(
 		task.Id,
 		ev.Event{Time: i.Clock.Now(), State: ev.PendingTask},
 	); err != nil {
-		out.Error(fmt.Errorf("%v: adding pending status: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: adding pending status: %w", errCtx, err)))
 		return
 	}
 
-	i.JobQueue.Submit(func() {
-		i.runTask(task, user.Id, r.Collection, tmpFileName)
-	})
+	if err := i.JobQueue.Submit(job); err != nil {
+		i.LogError(task.Id, err)
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
 	out.SuccessSubmitIngestArchiveTask(Response{Id: task.Id, Issuer: task.Issuer, Type: task.Type})
 }
 
-func (i Interactor) runTask(
-	task t.Task,
-	user u.UserId,
-	collection clc.CollectionName,
-	filename string,
-) {
-	reader, size, err := i.TemporaryFileStore.GetReaderAt(filename)
+// Run carries out an ingestion job. The archive is removed from the
+// temporary store once the job succeeds or will not be retried.
+func (i Interactor) Run(ctx context.Context, job jq.Job) error {
+	errCtx := fmt.Errorf("ingesting archive")
+	p := Payload{}
+	if err := job.Decode(&p); err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+	resp, err := i.ingest(job, p)
+	if err == nil || !job.WillRetry(err) {
+		if err := i.TemporaryFileStore.Delete(p.File); err != nil {
+			i.Logger.Error(
+				fmt.Errorf("deleting file %v from temporary store: %w", p.File, err).Error(),
+			)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCtx, err)
+	}
+
+	extra := map[string]string{
+		"format":              resp.Format.String(),
+		"num-ingested-images": fmt.Sprintf("%v", len(resp.ImageIds)),
+		"num-skipped":         fmt.Sprintf("%v", len(resp.Skipped)),
+	}
+	for _, s := range resp.Skipped {
+		key := "skipped: " + s.Name
+		if previous, ok := extra[key]; ok {
+			extra[key] = previous + "; " + s.Reason
+			continue
+		}
+		extra[key] = s.Reason
+	}
+	i.IEventLogger.AddEvent(
+		job.TaskId,
+		ev.Event{Time: i.Clock.Now(), State: ev.DoneTask, Extra: extra},
+	)
+	return nil
+}
+
+func (i Interactor) ingest(job jq.Job, p Payload) (*aig.Response, error) {
+	reader, size, err := i.TemporaryFileStore.GetReaderAt(p.File)
 	if err != nil {
-		i.LogError(
-			task.Id,
-			fmt.Errorf("ingesting archive: reading archive from temporary store: %w", err),
-		)
-		return
+		return nil, fmt.Errorf("reading archive from temporary store: %w", err)
 	}
-	i.IEventLogger.AddEvent(task.Id,
+	if err := i.IEventLogger.AddEvent(job.TaskId,
 		ev.Event{
 			Time:  i.Clock.Now(),
 			State: ev.StartedTask,
-			Extra: map[string]string{"collection": collection},
-		})
+			Extra: map[string]string{"collection": p.Collection},
+		}); err != nil {
+		return nil, fmt.Errorf("logging event upon ingestion task startup: %w", err)
+	}
 	resp, err := i.ArchiveIngester.IngestArchive(aig.Request{
-		UserId: user, Collection: collection,
+		UserId: p.User, Collection: p.Collection,
 		ReaderAt: reader, Size: size,
+		CreateMissingLabels: p.CreateMissingLabels,
+		LabelMap:            p.LabelMap,
 	})
 	if err != nil {
-		i.LogError(task.Id, err)
-		return
-	}
-	if err := i.TemporaryFileStore.Delete(filename); err != nil {
-		i.Logger.Error(
-			fmt.Errorf("deleting file %v from temporary store: %w", filename, err).Error(),
-		)
+		return nil, err
 	}
-
-	i.IEventLogger.AddEvent(
-		task.Id,
-		ev.Event{
-			Time: i.Clock.Now(), State: ev.DoneTask,
-			Extra: map[string]string{"num-ingested-images": fmt.Sprintf("%v", len(resp.ImageIds))},
-		},
-	)
+	return &resp, nil
 }
 
 func (i *Interactor) LogError(id t.TaskId, err error) {
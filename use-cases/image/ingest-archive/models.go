@@ -8,8 +8,20 @@ import (
 )
 
 type Request struct {
-	Collection string
-	Reader     io.Reader
+	Collection          string
+	Reader              io.Reader
+	CreateMissingLabels bool
+	LabelMap            map[string]string
+}
+
+// Payload is what an ingestion job needs to run, once persisted. The
+// archive itself is kept in the temporary file store.
+type Payload struct {
+	User                u.UserId          `json:"user"`
+	Collection          string            `json:"collection"`
+	CreateMissingLabels bool              `json:"create-missing-labels"`
+	LabelMap            map[string]string `json:"label-map,omitempty"`
+	File                string            `json:"file"`
 }
 
 type Response struct {
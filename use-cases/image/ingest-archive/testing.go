@@ -6,8 +6,9 @@ import (
 )
 
 type FakeIngester struct {
-	Got ing.Request
-	Err error
+	Got    ing.Request
+	Err    error
+	Return ing.Response
 }
 
 func (i *FakeIngester) IngestArchive(r ing.Request) (ing.Response, error) {
@@ -15,7 +16,7 @@ func (i *FakeIngester) IngestArchive(r ing.Request) (ing.Response, error) {
 		return ing.Response{}, i.Err
 	}
 	i.Got = r
-	return ing.Response{}, nil
+	return i.Return, nil
 }
 
 type FakePresenter struct {
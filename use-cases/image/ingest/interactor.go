@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	ing "github.com/lejeunel/go-image-annotator/modules/image-ingester"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
@@ -19,6 +21,7 @@ type Interactor struct {
 	Ingester
 	Auth
 	CollectionRepo
+	Audit al.AuditLogger
 }
 type Option func(*Interactor)
 
@@ -28,6 +31,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(ingester Ingester, repo CollectionRepo, opts ...Option) *Interactor {
 	i := &Interactor{
 		Ingester:       ingester,
@@ -41,29 +50,32 @@ func New(ingester Ingester, repo CollectionRepo, opts ...Option) *Interactor {
 }
 
 func (i Interactor) Execute(ctx context.Context, r ing.Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "IngestImage", au.Target{Type: "collection", Id: r.Collection})
+	defer record.End()
+
 	errCtx := fmt.Errorf("ingesting image")
 	collection, err := i.findCollectionByName(r.Collection)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if collection.Group != nil {
 		if err := i.Auth.IngestImage(ctx, *collection.Group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
 	user := u.IdentityFromContext(ctx)
 	if user == nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%w: extracting user identity failed from context: %w",
 				errCtx,
 				e.ErrAuthentication,
 			),
-		)
+		))
 		return
 	}
 	response, err := i.Ingester.Ingest(ing.Request{
@@ -71,7 +83,7 @@ func (i Interactor) Execute(ctx context.Context, r ing.Request, out OutputPort)
 		BoundingBoxes: r.BoundingBoxes, Reader: r.Reader,
 	})
 	if err != nil {
-		out.Error(fmt.Errorf("%w: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
 		return
 	}
 
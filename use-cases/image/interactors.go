@@ -2,12 +2,14 @@ package image
 
 import (
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	bt "github.com/lejeunel/go-image-annotator/use-cases/image/backfill-thumbnails"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/delete"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/ingest"
 	aig "github.com/lejeunel/go-image-annotator/use-cases/image/ingest-archive"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/list"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/raw"
+	rawmask "github.com/lejeunel/go-image-annotator/use-cases/image/raw-mask"
 	"github.com/lejeunel/go-image-annotator/use-cases/image/scroll"
 )
 
@@ -18,7 +20,9 @@ type Interactors struct {
 	List            list.Interactor
 	Scroll          scroll.Interactor
 	Raw             raw.Interactor
+	RawMask         rawmask.Interactor
 	Delete          delete.Interactor
+	BackfillThumbs  bt.Interactor
 	DefaultPageSize int
 	Authorizer      auth.Authorizer
 }
@@ -1,9 +1,12 @@
 package list
 
 import (
+	"context"
 	"fmt"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
 	"github.com/lejeunel/go-image-annotator/shared/pagination"
 )
 
@@ -24,8 +27,19 @@ type Interactor struct {
 	FilterValidator
 	OrderingValidator
 	ImageStore
+	Collections     visibility.CollectionLister
+	Views           visibility.ViewFinder
 	DefaultPageSize int
 	MaxPageSize     int
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
 }
 
 func New(
@@ -33,17 +47,36 @@ func New(
 	fv FilterValidator,
 	ov OrderingValidator,
 	s ImageStore,
+	cl visibility.CollectionLister,
+	vf visibility.ViewFinder,
 	dps int,
 	mps int,
+	opts ...Option,
 ) Interactor {
-	return Interactor{r, fv, ov, s, dps, mps}
+	i := &Interactor{r, fv, ov, s, cl, vf, dps, mps, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
 }
 
-func (i Interactor) Execute(r Request, out OutputPort) {
+// Execute lists images matching the request, restricted to collections whose
+// images the caller may read. When a saved view is given, its filter and
+// ordering take precedence over those of the request.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	errCtx := "listing images"
 
 	r.PaginationParams.Sanitize(i.DefaultPageSize, i.MaxPageSize)
 
+	if r.View != "" {
+		view, err := visibility.View(ctx, i.Views, r.View)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+		r.FilterStr, r.OrderStr = view.Filter, view.Order
+	}
+
 	if r.FilterStr != "" {
 		if err := i.FilterValidator.Validate(r.FilterStr); err != nil {
 			out.Error(fmt.Errorf("%v: validating query %v: %w", errCtx, r.FilterStr, err))
@@ -58,16 +91,26 @@ func (i Interactor) Execute(r Request, out OutputPort) {
 		}
 	}
 
-	baseImages, err := i.Repo.Slice(r.FilterStr, r.PaginationParams, r.OrderStr)
+	filter, ok, err := visibility.Restrict(ctx, i.Auth, i.Collections, r.FilterStr)
 	if err != nil {
 		out.Error(fmt.Errorf("%v: %w", errCtx, err))
 		return
 	}
 
-	count, err := i.Repo.Count(r.FilterStr)
-	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
-		return
+	baseImages := []im.BaseImage{}
+	count := new(int64)
+	if ok {
+		baseImages, err = i.Repo.Slice(filter, r.PaginationParams, r.OrderStr)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+
+		count, err = i.Repo.Count(filter)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
 	}
 
 	imageResponses, err := i.buildResponse(baseImages)
@@ -86,6 +129,7 @@ func (i Interactor) Execute(r Request, out OutputPort) {
 		},
 		FilterStr: r.FilterStr,
 		OrderStr:  r.OrderStr,
+		View:      r.View,
 	}
 
 	out.SuccessListImages(response)
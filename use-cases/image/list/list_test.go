@@ -3,10 +3,13 @@ package list
 import (
 	"testing"
 
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	q "github.com/lejeunel/go-image-annotator/modules/query"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	pa "github.com/lejeunel/go-image-annotator/shared/pagination"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
 	"github.com/stretchr/testify/assert"
 	"go.tomakado.io/dumbql/schema"
 )
@@ -18,7 +21,7 @@ func SetupList() Interactor {
 	ov := q.NewOrderParserBuilder().AddField("ingested_at").Build()
 	repo := &fk.ImageRepo{}
 	st := &fk.ImageStore{}
-	return New(repo, fv, ov, st, 1, 10)
+	return New(repo, fv, ov, st, &fk.CollectionRepo{ReturnList: []clc.Collection{}}, &fk.ViewRepo{}, 1, 10)
 }
 
 func TestSanitizePaginationParams(t *testing.T) {
@@ -26,7 +29,7 @@ func TestSanitizePaginationParams(t *testing.T) {
 	itr := SetupList()
 	repo := fk.ImageRepo{}
 	itr.Repo = &repo
-	itr.Execute(
+	itr.Execute(t.Context(),
 		Request{
 			PaginationParams: pa.PaginationParams{PageSize: 0, Page: 0},
 		},
@@ -45,7 +48,7 @@ func TestQueryPaginationParams(t *testing.T) {
 	r := Request{
 		PaginationParams: pa.PaginationParams{Page: 1, PageSize: 2},
 	}
-	itr.Execute(r, p)
+	itr.Execute(t.Context(), r, p)
 	pg := repo.GotPagination
 	assert.Equal(t, int(r.Page), int(pg.Page))
 	assert.Equal(t, r.PageSize, pg.PageSize)
@@ -58,7 +61,7 @@ func TestPaginationMetaData(t *testing.T) {
 	r := Request{
 		PaginationParams: pa.PaginationParams{Page: 1, PageSize: 10},
 	}
-	itr.Execute(r, p)
+	itr.Execute(t.Context(), r, p)
 	pg := p.Got.Pagination
 	assert.Equal(t, pg.Page, r.Page)
 	assert.Equal(t, pg.PageSize, r.PageSize)
@@ -73,7 +76,7 @@ func TestInvalidFilterQueryShouldFail(t *testing.T) {
 	itr.FilterValidator = &fv
 	query := "i-dont-know-what-to-type-here"
 	r := Request{FilterStr: query}
-	itr.Execute(r, p)
+	itr.Execute(t.Context(), r, p)
 	assert.Equal(t, query, fv.Got)
 	assert.ErrorIs(t, p.GotErr, e.ErrValidation)
 }
@@ -85,7 +88,7 @@ func TestInvalidOrderingStrShouldFail(t *testing.T) {
 	itr.OrderingValidator = &ov
 	query := "i-dont-know-what-to-type-here"
 	r := Request{OrderStr: query}
-	itr.Execute(r, p)
+	itr.Execute(t.Context(), r, p)
 	assert.Equal(t, query, ov.Got)
 	assert.ErrorIs(t, p.GotErr, e.ErrValidation)
 }
@@ -96,7 +99,7 @@ func TestHandleErrOnSlice(t *testing.T) {
 	repo := fk.ImageRepo{ErrOnSlice: e.ErrInternal}
 	itr.Repo = &repo
 	query := "collection:my-collection"
-	itr.Execute(
+	itr.Execute(t.Context(),
 		Request{FilterStr: query, PaginationParams: pa.PaginationParams{PageSize: 1}},
 		p,
 	)
@@ -109,7 +112,7 @@ func TestHandleErrOnCount(t *testing.T) {
 	p := &FakePresenter{}
 	itr := SetupList()
 	itr.Repo = &fk.ImageRepo{ErrOnCount: e.ErrInternal}
-	itr.Execute(Request{}, p)
+	itr.Execute(t.Context(), Request{}, p)
 	assert.True(t, p.GotInternalErr)
 	assert.False(t, p.GotSuccess)
 }
@@ -118,7 +121,7 @@ func TestHandleErrOnImageStoreRetrieval(t *testing.T) {
 	p := &FakePresenter{}
 	itr := SetupList()
 	itr.ImageStore = &fk.ImageStore{ErrOnFind: e.ErrInternal}
-	itr.Execute(Request{}, p)
+	itr.Execute(t.Context(), Request{}, p)
 	assert.True(t, p.GotInternalErr)
 	assert.False(t, p.GotSuccess)
 }
@@ -127,6 +130,70 @@ func TestListImagesWithoutFilterAndOrder(t *testing.T) {
 	p := &FakePresenter{}
 	itr := SetupList()
 	r := Request{}
-	itr.Execute(r, p)
+	itr.Execute(t.Context(), r, p)
 	assert.True(t, p.GotSuccess)
 }
+
+func restrictedCollections() *fk.CollectionRepo {
+	return &fk.CollectionRepo{ReturnList: []clc.Collection{
+		clc.NewCollection(clc.NewCollectionId(), "public"),
+		clc.NewCollection(clc.NewCollectionId(), "private", clc.WithGroup("a-group")),
+	}}
+}
+
+func TestListRestrictedToReadableCollections(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.ImageRepo{}
+	itr := New(&repo, &fk.FilterValidator{}, &fk.FilterValidator{}, &fk.ImageStore{},
+		restrictedCollections(), &fk.ViewRepo{}, 1, 10, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{FilterStr: "meta.site:lab"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, `(meta.site:lab) and (collection="public")`, string(repo.GotFilters))
+	assert.Equal(t, "meta.site:lab", string(p.Got.FilterStr))
+}
+
+func TestListWithoutReadableCollectionsIsEmpty(t *testing.T) {
+	p := &FakePresenter{}
+	repo := fk.ImageRepo{Count_: 3}
+	collections := &fk.CollectionRepo{ReturnList: restrictedCollections().ReturnList[1:]}
+	itr := New(&repo, &fk.FilterValidator{}, &fk.FilterValidator{}, &fk.ImageStore{},
+		collections, &fk.ViewRepo{}, 1, 10, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Empty(t, p.Got.Images)
+	assert.Equal(t, int64(0), p.Got.Pagination.TotalRecords)
+}
+
+func TestHandleErrOnListCollections(t *testing.T) {
+	p := &FakePresenter{}
+	itr := SetupList()
+	itr.Collections = &fk.CollectionRepo{ErrOnList: e.ErrInternal}
+	itr.Execute(t.Context(), Request{}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestListFromSavedView(t *testing.T) {
+	p := &FakePresenter{}
+	itr := SetupList()
+	repo := fk.ImageRepo{}
+	itr.Repo = &repo
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com",
+		vw.WithFilter(`collection:"a-collection"`), vw.WithOrder("ingested_at:desc"))
+	itr.Views = &fk.ViewRepo{Views: []vw.View{view}}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{FilterStr: `collection:"other"`, View: view.Id.String()}, p)
+	assert.NoError(t, p.GotErr)
+	assert.Equal(t, view.Filter, p.Got.FilterStr)
+	assert.Equal(t, view.Order, repo.GotOrdering)
+	assert.Equal(t, view.Id.String(), p.Got.View)
+}
+
+func TestListFromViewOfAnotherUserShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := SetupList()
+	view := vw.NewView(vw.NewViewId(), "a-view", "someone@mail.com")
+	itr.Views = &fk.ViewRepo{Views: []vw.View{view}}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{View: view.Id.String()}, p)
+	assert.True(t, p.GotAuthErr)
+}
@@ -9,6 +9,7 @@ type Request struct {
 	im.FilterStr
 	pa.PaginationParams
 	im.OrderStr
+	View string
 }
 
 type Response struct {
@@ -16,4 +17,5 @@ type Response struct {
 	Pagination pa.Pagination
 	im.FilterStr
 	im.OrderStr
+	View string
 }
@@ -0,0 +1,9 @@
+package raw_mask
+
+import (
+	"context"
+)
+
+type Auth interface {
+	ReadImage(ctx context.Context, group string) error
+}
@@ -0,0 +1,91 @@
+package raw_mask
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image/color"
+	"strconv"
+
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	mk "github.com/lejeunel/go-image-annotator/modules/mask"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
+)
+
+type Interface interface {
+	Execute(context.Context, Request, OutputPort)
+}
+type ImageStore interface {
+	Find(base im.BaseImage) (*im.Image, error)
+}
+
+type Interactor struct {
+	ImageStore
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(store ImageStore, opts ...Option) Interactor {
+	i := &Interactor{ImageStore: store, Auth: auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := "rendering masks of image"
+	imageId, err := im.NewImageIdFromString(r.ImageId)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	image, err := i.ImageStore.Find(im.BaseImage{ImageId: imageId, Collection: r.Collection})
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	if err := visibility.CanRead(ctx, i.Auth, image.Collection.Group); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	layers := []mk.Layer{}
+	for n, m := range image.Masks {
+		bitmap, err := mk.Decode(m.RLE)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: mask %v: %w", errCtx, m.Id, err))
+			return
+		}
+		layers = append(layers, mk.Layer{Bitmap: bitmap, Color: colorOf(m.Label.Color, n)})
+	}
+	var buf bytes.Buffer
+	if err := mk.Render(&buf, image.Specs.Width, image.Specs.Height, layers); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	out.SuccessReadRawMask(Response{Data: buf.Bytes(), MIMEType: mk.MIMEType, NumMasks: len(layers)})
+}
+
+// fallback colors masks whose label has none.
+var fallback = []color.RGBA{
+	{230, 25, 75, 255}, {60, 180, 75, 255}, {255, 225, 25, 255}, {0, 130, 200, 255},
+	{245, 130, 48, 255}, {145, 30, 180, 255}, {70, 240, 240, 255}, {240, 50, 230, 255},
+}
+
+func colorOf(hex string, n int) color.Color {
+	if len(hex) == 7 {
+		if v, err := strconv.ParseUint(hex[1:], 16, 32); err == nil {
+			return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 255}
+		}
+	}
+	return fallback[n%len(fallback)]
+}
@@ -0,0 +1,15 @@
+package raw_mask
+
+type Request struct {
+	ImageId    string
+	Collection string
+}
+
+// Response is a paletted PNG of the size of the image, where the value of
+// each pixel is 0 for background and n for the n-th mask covering it. Each
+// mask is drawn in the color of its label.
+type Response struct {
+	Data     []byte
+	MIMEType string
+	NumMasks int
+}
@@ -0,0 +1,6 @@
+package raw_mask
+
+type OutputPort interface {
+	SuccessReadRawMask(Response)
+	Error(error)
+}
@@ -0,0 +1,71 @@
+package raw_mask
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func CreateImage(opts ...clc.Option) im.Image {
+	image := im.NewImage(im.NewImageId(), clc.NewCollection(clc.NewCollectionId(), "a-collection", opts...))
+	image.Specs = im.Specs{Width: 2, Height: 2}
+	return image
+}
+
+func TestHandleErrorOnFind(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.ImageStore{ErrOnFind: e.ErrNotFound})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String(), Collection: "a-collection"}, p)
+	assert.True(t, p.GotNotFoundErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestImageOfGroupRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	image := CreateImage(clc.WithGroup("a-group"))
+	itr := New(&fk.ImageStore{Return: &image}, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{ImageId: image.Id.String(), Collection: "a-collection"}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestRender(t *testing.T) {
+	p := &FakePresenter{}
+	img := CreateImage()
+	red := lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithColor("#ff0000"))
+	img.Masks = []a.Mask{
+		a.NewMask(a.NewAnnotationId(), a.RLE{Width: 2, Height: 2, Counts: []int{0, 2, 2}}, red),
+		a.NewMask(a.NewAnnotationId(), a.RLE{Width: 2, Height: 2, Counts: []int{3, 1}}, lbl.Label{}),
+	}
+	itr := New(&fk.ImageStore{Return: &img})
+	itr.Execute(t.Context(), Request{ImageId: img.Id.String(), Collection: "a-collection"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "image/png", p.Got.MIMEType)
+	assert.Equal(t, 2, p.Got.NumMasks)
+	decoded, err := png.Decode(bytes.NewReader(p.Got.Data))
+	assert.NoError(t, err)
+	paletted := decoded.(*image.Paletted)
+	assert.Equal(t, uint8(1), paletted.ColorIndexAt(0, 1))
+	assert.Equal(t, uint8(0), paletted.ColorIndexAt(1, 0))
+	assert.Equal(t, uint8(2), paletted.ColorIndexAt(1, 1))
+	assert.Equal(t, color.RGBA{255, 0, 0, 255}, paletted.Palette[1])
+}
+
+func TestRenderWithoutMasks(t *testing.T) {
+	p := &FakePresenter{}
+	image := CreateImage()
+	itr := New(&fk.ImageStore{Return: &image})
+	itr.Execute(t.Context(), Request{ImageId: image.Id.String(), Collection: "a-collection"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 0, p.Got.NumMasks)
+}
@@ -0,0 +1,16 @@
+package raw_mask
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessReadRawMask(r Response) {
+	p.GotSuccess = true
+	p.Got = r
+}
@@ -1,37 +1,75 @@
 package raw
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"strings"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	th "github.com/lejeunel/go-image-annotator/modules/thumbnailer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
 type Interface interface {
-	Execute(Request, OutputPort)
+	Execute(context.Context, Request, OutputPort)
 }
 
 type FileGetter interface {
-	Get(string) (io.Reader, error)
+	GetReaderAt(string) (io.ReaderAt, int64, error)
 }
 
 type Repo interface {
 	GetSpecs(im.ImageId) (*im.Specs, error)
+	GetHash(im.ImageId) (string, error)
+	GetGroups(im.ImageId) ([]*string, error)
+}
+
+type Thumbnailer interface {
+	GetReaderAt(hash string, size int) (io.ReaderAt, int64, error)
+	Generate(hash string, src io.Reader, size int) error
 }
 
 type Interactor struct {
 	FileGetter
 	Repo
+	Thumbnailer
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
 }
 
-func New(fileGetter FileGetter, repo Repo) Interactor {
-	return Interactor{FileGetter: fileGetter, Repo: repo}
+func New(fileGetter FileGetter, repo Repo, thumbnailer Thumbnailer, opts ...Option) Interactor {
+	i := &Interactor{
+		FileGetter:  fileGetter,
+		Repo:        repo,
+		Thumbnailer: thumbnailer,
+		Auth:        auth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
 }
 
-func (i Interactor) Execute(id string, out OutputPort) {
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	errCtx := "reading raw image data"
-	imageId, err := im.NewImageIdFromString(id)
+	if r.Size != 0 {
+		if err := th.ValidateSize(r.Size); err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+	}
+	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
 		out.Error(fmt.Errorf("%v: %w", errCtx, err))
 		return
@@ -41,13 +79,68 @@ func (i Interactor) Execute(id string, out OutputPort) {
 		out.Error(fmt.Errorf("%v: fetching image specifications: %w", errCtx, err))
 		return
 	}
-	reader, err := i.FileGetter.Get(
-		fmt.Sprintf("%v.%v", imageId.String(), strings.Split(specs.MIMEType, "/")[1]),
-	)
+	groups, err := i.Repo.GetGroups(imageId)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: fetching groups of image: %w", errCtx, err))
+		return
+	}
+	if err := visibility.CanReadAny(ctx, i.Auth, groups); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	hash, err := i.Repo.GetHash(imageId)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: fetching image hash: %w", errCtx, err))
+		return
+	}
+
+	var reader io.ReaderAt
+	var size int64
+	if r.Size == 0 {
+		reader, size, err = i.FileGetter.GetReaderAt(rawFileName(imageId, *specs))
+	} else {
+		reader, size, err = i.thumbnail(imageId, *specs, hash, r.Size)
+		specs.MIMEType = th.MIMEType
+		hash = fmt.Sprintf("%v-%v", hash, r.Size)
+	}
 	if err != nil {
 		out.Error(fmt.Errorf("%v: fetching raw-data: %w", errCtx, err))
 		return
 	}
 
-	out.SuccessReadRawImage(Response{Reader: reader, Specs: *specs})
+	resp := Response{
+		ReadSeeker: io.NewSectionReader(reader, 0, size),
+		Size:       size,
+		Hash:       hash,
+		Specs:      *specs,
+	}
+	if closer, ok := reader.(io.Closer); ok {
+		resp.closer = closer
+	}
+	out.SuccessReadRawImage(resp)
+}
+
+// thumbnail fetches a downscaled version of an image, generating it from the
+// original on first request.
+func (i Interactor) thumbnail(id im.ImageId, specs im.Specs, hash string, size int) (io.ReaderAt, int64, error) {
+	reader, n, err := i.Thumbnailer.GetReaderAt(hash, size)
+	if !errors.Is(err, e.ErrNotFound) {
+		return reader, n, err
+	}
+
+	original, n, err := i.FileGetter.GetReaderAt(rawFileName(id, specs))
+	if err != nil {
+		return nil, 0, err
+	}
+	if closer, ok := original.(io.Closer); ok {
+		defer closer.Close()
+	}
+	if err := i.Thumbnailer.Generate(hash, io.NewSectionReader(original, 0, n), size); err != nil {
+		return nil, 0, err
+	}
+	return i.Thumbnailer.GetReaderAt(hash, size)
+}
+
+func rawFileName(id im.ImageId, specs im.Specs) string {
+	return fmt.Sprintf("%v.%v", id.String(), strings.Split(specs.MIMEType, "/")[1])
 }
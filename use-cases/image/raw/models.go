@@ -6,11 +6,26 @@ import (
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 )
 
+// Request reads the original image, or its thumbnail whose longest side
+// is Size pixels long when Size is not zero.
 type Request struct {
 	ImageId string
+	Size    int
 }
 
+// Response gives random access to raw-data, so that byte ranges can be
+// served. It must be closed once read.
 type Response struct {
-	io.Reader
+	io.ReadSeeker
+	Size int64
+	Hash string
 	im.Specs
+	closer io.Closer
+}
+
+func (r Response) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
 }
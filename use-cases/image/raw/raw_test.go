@@ -14,16 +14,16 @@ import (
 func TestHandleErrorOnGetRaw(t *testing.T) {
 	p := &FakePresenter{}
 	itr := New(&fk.FileStore{ErrOnGet: e.ErrNotFound},
-		&fk.ImageRepo{ReturnSpecs: &im.Specs{MIMEType: "image/jpeg"}})
-	itr.Execute(im.NewImageId().String(), p)
+		&fk.ImageRepo{ReturnSpecs: &im.Specs{MIMEType: "image/jpeg"}}, &fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
 	assert.ErrorIs(t, p.GotErr, e.ErrNotFound)
 	assert.False(t, p.GotSuccess)
 }
 
 func TestHandleErrorOnGetSpecs(t *testing.T) {
 	p := &FakePresenter{}
-	itr := New(&fk.FileStore{}, &fk.ImageRepo{ErrOnGetSpecs: e.ErrNotFound})
-	itr.Execute(im.NewImageId().String(), p)
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{ErrOnGetSpecs: e.ErrNotFound}, &fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
 	assert.ErrorIs(t, p.GotErr, e.ErrNotFound)
 	assert.False(t, p.GotSuccess)
 }
@@ -32,11 +32,109 @@ func TestReadRawImage(t *testing.T) {
 	p := &FakePresenter{}
 	data := []byte("the-data")
 	specs := im.Specs{MIMEType: "image/jpeg"}
-	itr := New(&fk.FileStore{Data: data}, &fk.ImageRepo{ReturnSpecs: &specs})
-	itr.Execute(im.NewImageId().String(), p)
+	itr := New(&fk.FileStore{Data: data}, &fk.ImageRepo{ReturnSpecs: &specs, ReturnHash: "cafe"}, &fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
 	assert.True(t, p.GotSuccess)
 	r, err := io.ReadAll(p.Got)
 	assert.NoError(t, err)
 	assert.True(t, bytes.Equal(data, r))
 	assert.Equal(t, specs.MIMEType, p.Got.Specs.MIMEType)
+	assert.Equal(t, "cafe", p.Got.Hash)
+	assert.Equal(t, int64(len(data)), p.Got.Size)
+	assert.NoError(t, p.Got.Close())
+}
+
+func TestReadRawImageRange(t *testing.T) {
+	p := &FakePresenter{}
+	specs := im.Specs{MIMEType: "image/png"}
+	itr := New(&fk.FileStore{Data: []byte("0123456789")}, &fk.ImageRepo{ReturnSpecs: &specs}, &fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	_, err := p.Got.Seek(6, io.SeekStart)
+	assert.NoError(t, err)
+	r, err := io.ReadAll(p.Got)
+	assert.NoError(t, err)
+	assert.Equal(t, "6789", string(r))
+}
+
+func TestHandleErrorOnGetHash(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{
+		ReturnSpecs:  &im.Specs{MIMEType: "image/png"},
+		ErrOnGetHash: e.ErrInternal,
+	}, &fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrInternal)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestReadThumbnailGeneratesItOnce(t *testing.T) {
+	thumbnailer := &fk.Thumbnailer{}
+	specs := im.Specs{MIMEType: "image/png"}
+	itr := New(&fk.FileStore{Data: []byte("original")},
+		&fk.ImageRepo{ReturnSpecs: &specs, ReturnHash: "cafe"}, thumbnailer)
+
+	for range 2 {
+		p := &FakePresenter{}
+		itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String(), Size: 256}, p)
+		assert.True(t, p.GotSuccess)
+		r, err := io.ReadAll(p.Got)
+		assert.NoError(t, err)
+		assert.Equal(t, "thumbnail", string(r))
+		assert.Equal(t, "image/jpeg", p.Got.MIMEType)
+		assert.Equal(t, "cafe-256", p.Got.Hash)
+	}
+	assert.Equal(t, []string{"cafe"}, thumbnailer.GotHashes)
+	assert.Equal(t, "original", string(thumbnailer.GotSource))
+}
+
+func TestReadThumbnailOfInvalidSize(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{ReturnSpecs: &im.Specs{MIMEType: "image/png"}},
+		&fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String(), Size: 100}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestHandleErrorOnGenerateThumbnail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{ReturnSpecs: &im.Specs{MIMEType: "image/png"}},
+		&fk.Thumbnailer{ErrOnGenerate: e.ErrValidation})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String(), Size: 128}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrValidation)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestReadImageOfGroupRequiresAuthorization(t *testing.T) {
+	p := &FakePresenter{}
+	group := "a-group"
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{
+		ReturnSpecs:  &im.Specs{MIMEType: "image/png"},
+		ReturnGroups: []*string{&group},
+	}, &fk.Thumbnailer{}, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestReadImageSharedWithAnotherCollection(t *testing.T) {
+	p := &FakePresenter{}
+	group := "a-group"
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{
+		ReturnSpecs:  &im.Specs{MIMEType: "image/png"},
+		ReturnGroups: []*string{&group, nil},
+	}, &fk.Thumbnailer{}, WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotSuccess)
+}
+
+func TestHandleErrorOnGetGroups(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.FileStore{}, &fk.ImageRepo{
+		ReturnSpecs:    &im.Specs{MIMEType: "image/png"},
+		ErrOnGetGroups: e.ErrInternal,
+	}, &fk.Thumbnailer{})
+	itr.Execute(t.Context(), Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -20,6 +22,17 @@ type Interactor struct {
 	ImageRepo
 	FilterValidator
 	OrderingValidator
+	Collections visibility.CollectionLister
+	Views       visibility.ViewFinder
+	Auth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
 }
 
 type Interface interface {
@@ -30,8 +43,15 @@ func New(
 	ir ImageRepo,
 	fv FilterValidator,
 	ov OrderingValidator,
+	cl visibility.CollectionLister,
+	vf visibility.ViewFinder,
+	opts ...Option,
 ) Interactor {
-	return Interactor{ir, fv, ov}
+	i := &Interactor{ir, fv, ov, cl, vf, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
@@ -53,6 +73,15 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		return
 
 	}
+	if r.View != "" {
+		view, err := visibility.View(ctx, i.Views, r.View)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+		r.FilterStr, r.OrderStr = view.Filter, view.Order
+	}
+
 	if r.FilterStr != "" {
 		if err := i.FilterValidator.Validate(r.FilterStr); err != nil {
 			out.Error(fmt.Errorf("%v: validating query %v: %w", errCtx, r.FilterStr, err))
@@ -67,12 +96,20 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		}
 	}
 
-	adj, err := i.ImageRepo.GetAdjacent(id, r.CurrentCollection, r.FilterStr, r.OrderStr, im.ScrollNext)
+	filter, ok, err := visibility.Restrict(ctx, i.Auth, i.Collections, r.FilterStr)
 	if err != nil {
 		out.Error(fmt.Errorf("%v: %w", errCtx, err))
 		return
 	}
+	adj := &im.AdjacentImages{}
+	if ok {
+		adj, err = i.ImageRepo.GetAdjacent(id, r.CurrentCollection, filter, r.OrderStr, im.ScrollNext)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+	}
 
 	out.SuccessScroll(Response{Adj: *adj,
-		FilterStr: r.FilterStr, OrderStr: r.OrderStr})
+		FilterStr: r.FilterStr, OrderStr: r.OrderStr, View: r.View})
 }
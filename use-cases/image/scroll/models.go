@@ -10,10 +10,12 @@ type Request struct {
 	CurrentCollection clc.CollectionName
 	im.FilterStr
 	im.OrderStr
+	View string
 }
 
 type Response struct {
 	Adj im.AdjacentImages
 	im.FilterStr
 	im.OrderStr
+	View string
 }
@@ -3,14 +3,18 @@ package scroll
 import (
 	"testing"
 
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
 	"github.com/stretchr/testify/assert"
 )
 
 func Setup() Interactor {
-	return New(&fk.ImageRepo{}, &fk.FilterValidator{}, &fk.FilterValidator{})
+	return New(&fk.ImageRepo{}, &fk.FilterValidator{}, &fk.FilterValidator{},
+		&fk.CollectionRepo{ReturnList: []clc.Collection{}}, &fk.ViewRepo{})
 
 }
 
@@ -81,3 +85,40 @@ func TestGetAdjacent(t *testing.T) {
 	assert.Equal(t, nextId.String(), p.Got.Adj.Next.ImageId.String())
 	assert.Equal(t, prevId.String(), p.Got.Adj.Prev.ImageId.String())
 }
+
+func TestScrollRestrictedToReadableCollections(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.ImageRepo{}
+	collections := &fk.CollectionRepo{ReturnList: []clc.Collection{
+		clc.NewCollection(clc.NewCollectionId(), "public"),
+		clc.NewCollection(clc.NewCollectionId(), "private", clc.WithGroup("a-group")),
+	}}
+	itr := New(repo, &fk.FilterValidator{}, &fk.FilterValidator{}, collections, &fk.ViewRepo{},
+		WithAuth(fk.Auth{Err: e.ErrAuthorization}))
+	itr.Execute(t.Context(), Request{CurrentImageId: im.NewImageId().String()}, p)
+	assert.NoError(t, p.GotErr)
+	assert.Equal(t, `collection="public"`, string(repo.GotFilters))
+}
+
+func TestScrollFromSavedView(t *testing.T) {
+	p := &FakePresenter{}
+	itr := Setup()
+	repo := &fk.ImageRepo{}
+	itr.ImageRepo = repo
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com",
+		vw.WithFilter(`collection:"a-collection"`), vw.WithOrder("ingested_at:desc"))
+	itr.Views = &fk.ViewRepo{Views: []vw.View{view}}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{CurrentImageId: im.NewImageId().String(), View: view.Id.String()}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, view.Filter, repo.GotFilters)
+	assert.Equal(t, view.Order, p.Got.OrderStr)
+}
+
+func TestScrollFromMissingViewShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := Setup()
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{CurrentImageId: im.NewImageId().String(), View: vw.NewViewId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
@@ -3,6 +3,7 @@ package create
 import (
 	"testing"
 
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
@@ -52,3 +53,84 @@ func TestCreateLabel(t *testing.T) {
 	assert.Equal(t, repo.Created.Description, req.Description)
 	assert.False(t, repo.Created.Id.IsNil())
 }
+
+func TestCreateLabelWithNameOfAliasShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.LabelRepo{Aliases: map[string]string{"car": "automobile"}})
+	itr.Execute(t.Context(), Request{Name: "car"}, p)
+	assert.True(t, p.GotDuplicationErr)
+}
+
+func TestCreateLabelWithInvalidColorShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.LabelRepo{})
+	itr.Execute(t.Context(), Request{Name: "car", Color: "blue"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestCreateLabelWithUnknownParentShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	parent := "vehicle"
+	itr := New(&fk.LabelRepo{})
+	itr.Execute(t.Context(), Request{Name: "car", Parent: &parent}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestCreateLabelInTaxonomy(t *testing.T) {
+	p := &FakePresenter{}
+	parent := "motor-vehicle"
+	repo := &fk.LabelRepo{
+		ExistingNames: []string{"vehicle"},
+		Aliases:       map[string]string{"motor-vehicle": "vehicle"},
+	}
+	itr := New(repo)
+	req := Request{Name: "car", Parent: &parent, Color: "#ff0000", Aliases: []string{"automobile", " Car"}}
+	itr.Execute(t.Context(), req, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "vehicle", *repo.Created.Parent)
+	assert.Equal(t, "#ff0000", repo.Created.Color)
+	assert.Equal(t, []string{"automobile", "Car"}, repo.Created.Aliases)
+	assert.Equal(t, "vehicle", *p.Got.Parent)
+}
+
+func TestCreateLabelWithAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.LabelRepo{}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "car", Attributes: []lbl.Attribute{
+		{Name: "occluded", Type: lbl.BoolAttribute, Default: false},
+		{Name: "color", Type: lbl.EnumAttribute, Options: []string{"red", "blue"}},
+	}}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Len(t, repo.Created.Attributes, 2)
+	assert.Equal(t, []string{"red", "blue"}, p.Got.Attributes[1].Options)
+}
+
+func TestCreateLabelWithInvalidAttributeShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.LabelRepo{})
+	itr.Execute(t.Context(), Request{Name: "car", Attributes: []lbl.Attribute{
+		{Name: "color", Type: lbl.EnumAttribute},
+	}}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestCreateLabelWithSkeleton(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.LabelRepo{}
+	skeleton := &lbl.Skeleton{Points: []string{"head", "neck"}, Edges: [][2]string{{"head", "neck"}}}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "person", Skeleton: skeleton}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, skeleton, repo.Created.Skeleton)
+}
+
+func TestCreateLabelWithInvalidSkeletonShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.LabelRepo{})
+	itr.Execute(t.Context(), Request{Name: "person", Skeleton: &lbl.Skeleton{
+		Points: []string{"head", "neck"}, Edges: [][2]string{{"head", "hip"}},
+	}}, p)
+	assert.True(t, p.GotValidationErr)
+}
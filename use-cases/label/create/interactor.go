@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	v "github.com/lejeunel/go-image-annotator/modules/string-validator"
+	tx "github.com/lejeunel/go-image-annotator/modules/taxonomy"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -14,39 +18,72 @@ type Interactor struct {
 	Repo
 	v.Validator
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "CreateLabel", au.Target{Type: "label", Id: r.Name})
+	defer record.End()
+
 	errCtx := "creating label"
 	if err := i.Auth.CreateLabel(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 
 	}
 	if err := i.Validator.Validate(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	if err := i.checkDuplicate(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	if err := tx.Color(r.Color); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	parent, err := tx.Parent(i.Repo, r.Name, r.Parent)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	aliases, err := tx.Aliases(i.Repo, r.Name, r.Aliases)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
+	attributes, err := at.Definitions(r.Attributes)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if r.Skeleton != nil {
+		if err := r.Skeleton.Validate(); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
 
-	label := lbl.NewLabel(lbl.NewLabelId(), r.Name, lbl.WithDescription(r.Description))
+	label := lbl.NewLabel(lbl.NewLabelId(), r.Name, lbl.WithDescription(r.Description),
+		lbl.WithParent(parent), lbl.WithColor(r.Color), lbl.WithAliases(aliases),
+		lbl.WithAttributes(attributes), lbl.WithSkeleton(r.Skeleton))
 	if err := i.Repo.Create(label); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
-	out.Success(Response{Name: r.Name, Description: r.Description})
+	out.Success(Response{Name: r.Name, Description: r.Description,
+		Parent: parent, Color: r.Color, Aliases: aliases, Attributes: attributes, Skeleton: r.Skeleton})
 }
 
 func (i *Interactor) checkDuplicate(name string) error {
 	errBaseMsg := "checking for duplicate label with name %v: %w"
-	alreadyExists, err := i.Repo.Exists(name)
+	existing, err := i.Repo.Canonical(name)
 	if err != nil {
 		return fmt.Errorf(errBaseMsg, name, e.ErrInternal)
 	}
-	if alreadyExists {
+	if existing != nil {
 		return fmt.Errorf(errBaseMsg, name, e.ErrDuplicate)
 	}
 	return nil
@@ -66,6 +103,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) *Interactor {
 	i := &Interactor{
 		Repo: r, Validator: v.NewNameValidator(),
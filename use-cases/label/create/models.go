@@ -7,11 +7,21 @@ import (
 type Response struct {
 	Name        string
 	Description string
+	Parent      *string
+	Color       string
+	Aliases     []string
+	Attributes  []lbl.Attribute
+	Skeleton    *lbl.Skeleton
 }
 
 type Request struct {
 	Name        string
 	Description string
+	Parent      *string
+	Color       string
+	Aliases     []string
+	Attributes  []lbl.Attribute
+	Skeleton    *lbl.Skeleton
 }
 
 type CreateModel struct {
@@ -2,9 +2,10 @@ package create
 
 import (
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	tx "github.com/lejeunel/go-image-annotator/modules/taxonomy"
 )
 
 type Repo interface {
 	Create(lbl.Label) error
-	Exists(string) (bool, error)
+	tx.Repo
 }
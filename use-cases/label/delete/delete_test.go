@@ -1,6 +1,10 @@
 package delete
 
 import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"log/slog"
 	"testing"
 
 	fk "github.com/lejeunel/go-image-annotator/fakes"
@@ -54,3 +58,21 @@ func TestDeleteLabel(t *testing.T) {
 	itr.Execute(t.Context(), "my-label", p)
 	assert.True(t, p.GotSuccess)
 }
+
+func TestDeleteLabelIsAudited(t *testing.T) {
+	audit := &fk.AuditRepo{}
+	itr := New(&fk.LabelRepo{ExistingNames: []string{"my-label"}}, WithAudit(al.New(audit, *slog.Default())))
+	ctx := au.AppendRequestIdToContext(st.CreateCtxWithUserId(t.Context(), "admin@mail.com"), "a-request")
+	itr.Execute(ctx, "my-label", &FakePresenter{})
+	itr.Execute(ctx, "missing-label", &FakePresenter{})
+
+	assert.Equal(t, 2, len(audit.Entries))
+	entry := audit.Entries[0]
+	assert.Equal(t, "admin@mail.com", entry.Actor)
+	assert.Equal(t, "DeleteLabel", entry.Action)
+	assert.Equal(t, au.Target{Type: "label", Id: "my-label"}, entry.Target)
+	assert.Equal(t, "a-request", entry.RequestId)
+	assert.Equal(t, au.Success, entry.Outcome)
+	assert.Equal(t, au.Failure, audit.Entries[1].Outcome)
+	assert.NotEmpty(t, audit.Entries[1].Error)
+}
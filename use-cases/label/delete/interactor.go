@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -11,25 +13,29 @@ import (
 type Interactor struct {
 	Repo
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, name string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteLabel", au.Target{Type: "label", Id: name})
+	defer record.End()
+
 	errCtx := "deleting label"
 	if err := i.Auth.DeleteLabel(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	if err := i.isUsed(name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	if err := i.exists(name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.Repo.Delete(name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	out.SuccessDeleteLabel(name)
@@ -48,7 +54,7 @@ func (i *Interactor) exists(name string) error {
 }
 
 func (i *Interactor) isUsed(name string) error {
-	errCtx := fmt.Errorf("checking whether label with name %v is used", name)
+	errCtx := fmt.Errorf("checking whether label with name %v is used by annotations or child labels", name)
 	isUsed, err := i.Repo.IsUsed(name)
 	if err != nil {
 		return fmt.Errorf("%w: %w", errCtx, e.ErrInternal)
@@ -67,6 +73,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) *Interactor {
 	i := &Interactor{
 		Repo: r,
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"testing"
 
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
@@ -11,23 +12,23 @@ import (
 
 func TestHandleErrOnCount(t *testing.T) {
 	p := &FakePresenter{}
-	itr := New(&fk.LabelRepo{ErrOnCount: e.ErrInternal})
-	itr.Execute(t.Context(), p)
+	itr := New(&fk.LabelRepo{ErrOnCount: e.ErrInternal}, &fk.CollectionRepo{})
+	itr.Execute(t.Context(), Request{}, p)
 	assert.True(t, p.GotInternalErr)
 	assert.False(t, p.GotSuccess)
 }
 
 func TestHandleErrWhenCountExceedsLimit(t *testing.T) {
 	p := &FakePresenter{}
-	itr := New(&fk.LabelRepo{Count_: 2}, WithLimit(1))
-	itr.Execute(t.Context(), p)
+	itr := New(&fk.LabelRepo{Count_: 2}, &fk.CollectionRepo{}, WithLimit(1))
+	itr.Execute(t.Context(), Request{}, p)
 	assert.ErrorIs(t, p.GotErr, e.ErrLabelLimitExceeded)
 }
 
 func TestHandleErrOnFetch(t *testing.T) {
 	p := &FakePresenter{}
-	itr := New(&fk.LabelRepo{ErrOnFetch: e.ErrInternal})
-	itr.Execute(t.Context(), p)
+	itr := New(&fk.LabelRepo{ErrOnFetch: e.ErrInternal}, &fk.CollectionRepo{})
+	itr.Execute(t.Context(), Request{}, p)
 	assert.True(t, p.GotInternalErr)
 	assert.False(t, p.GotSuccess)
 }
@@ -35,8 +36,36 @@ func TestHandleErrOnFetch(t *testing.T) {
 func TestFetchLabels(t *testing.T) {
 	p := &FakePresenter{}
 	labels := []string{"first-label", "second-labels"}
-	itr := New(&fk.LabelRepo{ExistingNames: labels})
-	itr.Execute(t.Context(), p)
+	itr := New(&fk.LabelRepo{ExistingNames: labels}, &fk.CollectionRepo{})
+	itr.Execute(t.Context(), Request{}, p)
 	assert.True(t, p.GotSuccess)
 	assert.True(t, slices.Equal(p.Got.Labels, labels))
 }
+
+func TestFetchLabelsOfCollectionWithoutLabelSet(t *testing.T) {
+	p := &FakePresenter{}
+	labels := []string{"first-label", "second-labels"}
+	itr := New(&fk.LabelRepo{ExistingNames: labels}, &fk.CollectionRepo{})
+	itr.Execute(t.Context(), Request{Collection: "a-collection"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, labels, p.Got.Labels)
+	assert.Nil(t, p.Got.Default)
+}
+
+func TestFetchLabelsOfCollectionWithLabelSet(t *testing.T) {
+	p := &FakePresenter{}
+	collection := clc.NewCollection(clc.NewCollectionId(), "a-collection",
+		clc.WithLabels([]string{"car", "person"}), clc.WithDefaultLabel("car"))
+	itr := New(&fk.LabelRepo{Count_: 2}, &fk.CollectionRepo{Return: collection}, WithLimit(1))
+	itr.Execute(t.Context(), Request{Collection: "a-collection"}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []string{"car", "person"}, p.Got.Labels)
+	assert.Equal(t, "car", *p.Got.Default)
+}
+
+func TestHandleErrOnFindCollection(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.LabelRepo{}, &fk.CollectionRepo{ErrOnFind: e.ErrNotFound})
+	itr.Execute(t.Context(), Request{Collection: "a-collection"}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
@@ -10,15 +10,28 @@ import (
 var defaultLabelCountLimit = 200
 
 type Interface interface {
-	Execute(context.Context, OutputPort)
+	Execute(context.Context, Request, OutputPort)
 }
 type Interactor struct {
 	Repo
+	CollectionRepo
 	countLimit int
 }
 
-func (i Interactor) Execute(ctx context.Context, out OutputPort) {
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	errCtx := "listing label"
+	if r.Collection != "" {
+		collection, err := i.CollectionRepo.Find(r.Collection)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+		if len(collection.Labels) > 0 {
+			out.SuccessFetchLabels(Response{Labels: collection.Labels, Default: collection.DefaultLabel})
+			return
+		}
+	}
+
 	count, err := i.Repo.Count()
 	if err != nil {
 		out.Error(fmt.Errorf("%v: %w", errCtx, err))
@@ -35,13 +48,14 @@ func (i Interactor) Execute(ctx context.Context, out OutputPort) {
 		out.Error(fmt.Errorf("%v: %w", errCtx, err))
 		return
 	}
-	out.SuccessFetchLabels(Response{labels})
+	out.SuccessFetchLabels(Response{Labels: labels})
 }
 
-func New(r Repo, opts ...Option) *Interactor {
+func New(r Repo, cr CollectionRepo, opts ...Option) *Interactor {
 	i := &Interactor{
-		Repo:       r,
-		countLimit: defaultLabelCountLimit,
+		Repo:           r,
+		CollectionRepo: cr,
+		countLimit:     defaultLabelCountLimit,
 	}
 	for _, opt := range opts {
 		opt(i)
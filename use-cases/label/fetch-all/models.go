@@ -1,5 +1,10 @@
 package fetchall
 
+type Request struct {
+	Collection string
+}
+
 type Response struct {
-	Labels []string
+	Labels  []string
+	Default *string
 }
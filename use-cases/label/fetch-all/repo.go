@@ -1,6 +1,14 @@
 package fetchall
 
+import (
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+)
+
 type Repo interface {
 	FetchAll() ([]string, error)
 	Count() (int64, error)
 }
+
+type CollectionRepo interface {
+	Find(string) (*clc.Collection, error)
+}
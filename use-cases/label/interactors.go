@@ -7,6 +7,7 @@ import (
 	fetchall "github.com/lejeunel/go-image-annotator/use-cases/label/fetch-all"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/find"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/label/tree"
 	"github.com/lejeunel/go-image-annotator/use-cases/label/update"
 )
 
@@ -17,6 +18,7 @@ type Interactors struct {
 	List            list.Interactor
 	Update          update.Interactor
 	FetchAll        fetchall.Interactor
+	Tree            tree.Interactor
 	DefaultPageSize int
 	Authorizer      auth.Authorizer
 }
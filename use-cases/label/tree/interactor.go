@@ -0,0 +1,25 @@
+package tree
+
+import (
+	"context"
+	"fmt"
+
+	tx "github.com/lejeunel/go-image-annotator/modules/taxonomy"
+)
+
+type Interactor struct {
+	Repo
+}
+
+func (i Interactor) Execute(ctx context.Context, out OutputPort) {
+	labels, err := i.Repo.All()
+	if err != nil {
+		out.Error(fmt.Errorf("building label tree: %w", err))
+		return
+	}
+	out.SuccessLabelTree(Response{Roots: tx.Tree(labels)})
+}
+
+func New(r Repo) *Interactor {
+	return &Interactor{Repo: r}
+}
@@ -0,0 +1,6 @@
+package tree
+
+type OutputPort interface {
+	SuccessLabelTree(Response)
+	Error(error)
+}
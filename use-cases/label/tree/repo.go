@@ -0,0 +1,9 @@
+package tree
+
+import (
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+)
+
+type Repo interface {
+	All() ([]lbl.Label, error)
+}
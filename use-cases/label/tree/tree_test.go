@@ -0,0 +1,31 @@
+package tree
+
+import (
+	"testing"
+
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleErrOnFetch(t *testing.T) {
+	p := &FakePresenter{}
+	itr := New(&fk.LabelRepo{ErrOnFetch: e.ErrInternal})
+	itr.Execute(t.Context(), p)
+	assert.True(t, p.GotInternalErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestLabelTree(t *testing.T) {
+	p := &FakePresenter{}
+	vehicle := "vehicle"
+	itr := New(&fk.LabelRepo{Labels: []lbl.Label{
+		lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithParent(&vehicle)),
+		lbl.NewLabel(lbl.NewLabelId(), "vehicle"),
+	}})
+	itr.Execute(t.Context(), p)
+	assert.True(t, p.GotSuccess)
+	assert.Len(t, p.Got.Roots, 1)
+	assert.Equal(t, "car", p.Got.Roots[0].Children[0].Name)
+}
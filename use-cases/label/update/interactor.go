@@ -4,14 +4,19 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	tx "github.com/lejeunel/go-image-annotator/modules/taxonomy"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
 type Interactor struct {
 	Repo
 	Auth
+	Audit al.AuditLogger
 }
 type Option func(*Interactor)
 
@@ -21,6 +26,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) *Interactor {
 	i := &Interactor{
 		Repo: r,
@@ -33,25 +44,115 @@ func New(r Repo, opts ...Option) *Interactor {
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateLabel", au.Target{Type: "label", Id: r.Name})
+	defer record.End()
+
 	errCtx := "updating label"
 	if err := i.Auth.UpdateLabel(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.ensureNameExists(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	current, err := i.Repo.FindLabel(r.Name)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	m, err := i.updatable(*current, r)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
-	if err := i.Repo.Update(
-		lbl.UpdatableModel{Name: r.Name, NewDescription: r.NewDescription},
-	); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+	if err := i.Repo.Update(*m); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
-	out.SuccessUpdateLabel(Response{Name: r.Name, Description: r.NewDescription})
+	out.SuccessUpdateLabel(Response{Name: r.Name, Description: m.NewDescription,
+		Parent: m.NewParent, Color: m.NewColor, Aliases: m.NewAliases, Attributes: m.NewAttributes,
+		Skeleton: m.NewSkeleton})
+}
+
+// updatable applies the fields set in r to the current label, validating
+// each of them.
+func (i *Interactor) updatable(current lbl.Label, r Request) (*lbl.UpdatableModel, error) {
+	m := lbl.UpdatableModel{
+		Name: r.Name, NewDescription: current.Description,
+		NewParent: current.Parent, NewColor: current.Color, NewAliases: current.Aliases,
+		NewAttributes: current.Attributes, NewSkeleton: current.Skeleton,
+	}
+	var err error
+	if r.NewDescription != nil {
+		m.NewDescription = *r.NewDescription
+	}
+	if r.NewColor != nil {
+		if err := tx.Color(*r.NewColor); err != nil {
+			return nil, err
+		}
+		m.NewColor = *r.NewColor
+	}
+	if r.NewParent != nil {
+		if m.NewParent, err = tx.Parent(i.Repo, r.Name, r.NewParent); err != nil {
+			return nil, err
+		}
+	}
+	if r.NewAliases != nil {
+		if m.NewAliases, err = tx.Aliases(i.Repo, r.Name, *r.NewAliases); err != nil {
+			return nil, err
+		}
+	}
+	if r.NewAttributes != nil {
+		if m.NewAttributes, err = at.Definitions(*r.NewAttributes); err != nil {
+			return nil, err
+		}
+	}
+	if r.NewSkeleton != nil {
+		m.NewSkeleton = r.NewSkeleton
+		if len(r.NewSkeleton.Points) == 0 {
+			m.NewSkeleton = nil
+		}
+		if err := i.checkSkeleton(current, m.NewSkeleton); err != nil {
+			return nil, err
+		}
+	}
+	return &m, nil
+}
+
+// checkSkeleton validates the new skeleton of a label. Poses of the label
+// hold one keypoint per point, so the number of points can only change
+// while there are none.
+func (i *Interactor) checkSkeleton(current lbl.Label, skeleton *lbl.Skeleton) error {
+	if skeleton != nil {
+		if err := skeleton.Validate(); err != nil {
+			return err
+		}
+	}
+	if numPoints(current.Skeleton) == numPoints(skeleton) {
+		return nil
+	}
+	name := current.Name
+	used, err := i.Repo.HasKeypoints(name)
+	if err != nil {
+		return err
+	}
+	if used {
+		return fmt.Errorf("changing the number of points of label %v, which has keypoint annotations: %w",
+			name, e.ErrValidation)
+	}
+	return nil
+}
+
+func numPoints(s *lbl.Skeleton) int {
+	if s == nil {
+		return 0
+	}
+	return len(s.Points)
 }
 
 func (i *Interactor) ensureNameExists(name string) error {
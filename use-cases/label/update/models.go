@@ -1,11 +1,28 @@
 package update
 
+import (
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+)
+
+// Request changes the fields of a label that are set, and leaves the others
+// unchanged. An empty parent removes the parent, and a skeleton without
+// points removes the skeleton.
 type Request struct {
 	Name           string
-	NewDescription string
+	NewDescription *string
+	NewParent      *string
+	NewColor       *string
+	NewAliases     *[]string
+	NewAttributes  *[]lbl.Attribute
+	NewSkeleton    *lbl.Skeleton
 }
 
 type Response struct {
 	Name        string
 	Description string
+	Parent      *string
+	Color       string
+	Aliases     []string
+	Attributes  []lbl.Attribute
+	Skeleton    *lbl.Skeleton
 }
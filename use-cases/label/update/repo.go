@@ -2,9 +2,13 @@ package update
 
 import (
 	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	tx "github.com/lejeunel/go-image-annotator/modules/taxonomy"
 )
 
 type Repo interface {
 	Update(lbl.UpdatableModel) error
 	Exists(string) (bool, error)
+	FindLabel(string) (*lbl.Label, error)
+	HasKeypoints(string) (bool, error)
+	tx.Repo
 }
@@ -3,6 +3,7 @@ package update
 import (
 	"testing"
 
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
 	fk "github.com/lejeunel/go-image-annotator/fakes"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 	"github.com/stretchr/testify/assert"
@@ -31,12 +32,13 @@ func TestUpdateLabel(t *testing.T) {
 	p := &FakePresenter{}
 	repo := &fk.LabelRepo{ExistingNames: []string{name}}
 	itr := New(repo)
+	description := "updated-description"
 	req := Request{
 		Name:           name,
-		NewDescription: "updated-description",
+		NewDescription: &description,
 	}
 	itr.Execute(t.Context(), req, p)
-	assert.Equal(t, p.Got.Description, req.NewDescription)
+	assert.Equal(t, description, p.Got.Description)
 }
 
 func TestHandleInternalError(t *testing.T) {
@@ -49,3 +51,125 @@ func TestHandleInternalError(t *testing.T) {
 	assert.True(t, p.GotInternalErr)
 	assert.False(t, p.GotSuccess)
 }
+
+func TestUpdateLabelParentToDescendantShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	parent := "taxi"
+	repo := &fk.LabelRepo{
+		ExistingNames: []string{"vehicle", "car", "taxi"},
+		Parents:       map[string]string{"car": "vehicle", "taxi": "car"},
+	}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "vehicle", NewParent: &parent}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestUpdateLabelWithAliasOfAnotherLabelShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.LabelRepo{
+		ExistingNames: []string{"car", "taxi"},
+		Aliases:       map[string]string{"automobile": "car"},
+	}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "taxi", NewAliases: &[]string{"automobile"}}, p)
+	assert.True(t, p.GotDuplicationErr)
+}
+
+func TestUpdateLabelTaxonomy(t *testing.T) {
+	p := &FakePresenter{}
+	parent := "vehicle"
+	repo := &fk.LabelRepo{
+		ExistingNames: []string{"vehicle", "car"},
+		Aliases:       map[string]string{"automobile": "car"},
+	}
+	itr := New(repo)
+	color := "#00ff00"
+	req := Request{Name: "car", NewParent: &parent, NewColor: &color,
+		NewAliases: &[]string{"automobile", "Car"}}
+	itr.Execute(t.Context(), req, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "vehicle", *repo.GotUpdatable.NewParent)
+	assert.Equal(t, "#00ff00", repo.GotUpdatable.NewColor)
+	assert.Equal(t, []string{"automobile", "Car"}, repo.GotUpdatable.NewAliases)
+}
+
+func TestUpdateLabelAttributes(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.LabelRepo{ExistingNames: []string{"car"}}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "car", NewAttributes: &[]lbl.Attribute{
+		{Name: "plate", Type: lbl.TextAttribute, Required: true},
+	}}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "plate", repo.GotUpdatable.NewAttributes[0].Name)
+}
+
+func TestUpdateLabelSkeleton(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.LabelRepo{ExistingNames: []string{"person"}, HasKeypoints_: true,
+		Return: lbl.NewLabel(lbl.NewLabelId(), "person", lbl.WithSkeleton(&lbl.Skeleton{Points: []string{"a", "b"}}))}
+	skeleton := &lbl.Skeleton{Points: []string{"head", "neck"}, Edges: [][2]string{{"head", "neck"}}}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "person", NewSkeleton: skeleton}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, skeleton, repo.GotUpdatable.NewSkeleton)
+}
+
+func TestChangingNumberOfPointsOfUsedSkeletonShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.LabelRepo{ExistingNames: []string{"person"}, HasKeypoints_: true,
+		Return: lbl.NewLabel(lbl.NewLabelId(), "person", lbl.WithSkeleton(&lbl.Skeleton{Points: []string{"a", "b"}}))}
+	itr := New(repo)
+	itr.Execute(t.Context(), Request{Name: "person", NewSkeleton: &lbl.Skeleton{Points: []string{"head"}}}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestOmittedFieldsAreLeftUnchanged(t *testing.T) {
+	p := &FakePresenter{}
+	parent := "vehicle"
+	skeleton := &lbl.Skeleton{Points: []string{"front", "back"}}
+	repo := &fk.LabelRepo{ExistingNames: []string{"vehicle", "car"},
+		Return: lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithParent(&parent), lbl.WithColor("#ff0000"),
+			lbl.WithAliases([]string{"automobile"}), lbl.WithSkeleton(skeleton),
+			lbl.WithAttributes([]lbl.Attribute{{Name: "plate", Type: lbl.TextAttribute}}))}
+	description := "a car"
+	New(repo).Execute(t.Context(), Request{Name: "car", NewDescription: &description}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "a car", repo.GotUpdatable.NewDescription)
+	assert.Equal(t, "vehicle", *repo.GotUpdatable.NewParent)
+	assert.Equal(t, "#ff0000", repo.GotUpdatable.NewColor)
+	assert.Equal(t, []string{"automobile"}, repo.GotUpdatable.NewAliases)
+	assert.Equal(t, "plate", repo.GotUpdatable.NewAttributes[0].Name)
+	assert.Equal(t, skeleton, repo.GotUpdatable.NewSkeleton)
+}
+
+func TestEmptyFieldsAreCleared(t *testing.T) {
+	p := &FakePresenter{}
+	parent := "vehicle"
+	repo := &fk.LabelRepo{ExistingNames: []string{"vehicle", "car"},
+		Return: lbl.NewLabel(lbl.NewLabelId(), "car", lbl.WithParent(&parent),
+			lbl.WithAliases([]string{"automobile"}),
+			lbl.WithSkeleton(&lbl.Skeleton{Points: []string{"front", "back"}}))}
+	noParent := ""
+	New(repo).Execute(t.Context(), Request{Name: "car", NewParent: &noParent, NewAliases: &[]string{},
+		NewSkeleton: &lbl.Skeleton{}}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Nil(t, repo.GotUpdatable.NewParent)
+	assert.Empty(t, repo.GotUpdatable.NewAliases)
+	assert.Nil(t, repo.GotUpdatable.NewSkeleton)
+}
+
+func TestEditingColorOfPoseLabelKeepsSkeleton(t *testing.T) {
+	p := &FakePresenter{}
+	skeleton := &lbl.Skeleton{Points: []string{"head", "neck"}, Edges: [][2]string{{"head", "neck"}}}
+	repo := &fk.LabelRepo{ExistingNames: []string{"person"}, HasKeypoints_: true,
+		Return: lbl.NewLabel(lbl.NewLabelId(), "person", lbl.WithSkeleton(skeleton))}
+	description, parent, color, aliases, attributes := "", "", "#00ff00", []string{}, []lbl.Attribute{}
+	New(repo).Execute(t.Context(), Request{Name: "person", NewDescription: &description, NewParent: &parent,
+		NewColor: &color, NewAliases: &aliases, NewAttributes: &attributes}, p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "#00ff00", repo.GotUpdatable.NewColor)
+	assert.Equal(t, skeleton, repo.GotUpdatable.NewSkeleton)
+}
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	m "github.com/lejeunel/go-image-annotator/entities/meta"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	kv "github.com/lejeunel/go-image-annotator/modules/string-validator"
 	vv "github.com/lejeunel/go-image-annotator/modules/value-validator"
@@ -28,6 +30,7 @@ type Interactor struct {
 	KeyValidator   kv.Validator
 	ValueValidator vv.Validator
 	Auth
+	Audit al.AuditLogger
 }
 
 func New(c CollectionRepo, ir ImageRepo,
@@ -58,30 +61,39 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "AddMetadata", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
 	errCtx := "adding metadata"
 	group, err := i.CollectionRepo.GetGroup(r.Collection)
 	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if group != nil {
 		if err := i.Auth.AddMetadata(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: parsing image id %v: %w", errCtx, imageId, err))
+		out.Error(record.Fail(fmt.Errorf("%v: parsing image id %v: %w", errCtx, imageId, err)))
 		return
 	}
 
 	keyExists, err := i.MetaDataRepo.KeyExists(r.Collection, imageId, r.Key)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking existence of key %v: %v: %w",
 				errCtx,
@@ -89,19 +101,19 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 	if keyExists {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf("%v: checking existence of key %v: %w", errCtx, r.Key, e.ErrValidation),
-		)
+		))
 		return
 	}
 
 	collectionExists, err := i.CollectionRepo.Exists(r.Collection)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking existence of collection %v: %v: %w",
 				errCtx,
@@ -109,24 +121,24 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 	if !collectionExists {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking existence of collection %v: %w",
 				errCtx,
 				r.Collection,
 				e.ErrValidation,
 			),
-		)
+		))
 		return
 	}
 
 	imageInCollection, err := i.ImageRepo.ImageExistsInCollection(imageId, r.Collection)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking whether image %v is in collection %v: %v: %w",
 				errCtx,
@@ -135,11 +147,11 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 	if !imageInCollection {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking whether image %v is in collection %v: %v: %w",
 				errCtx,
@@ -148,21 +160,21 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrValidation,
 			),
-		)
+		))
 		return
 	}
 
 	if err := i.KeyValidator.Validate(r.Key); err != nil {
-		out.Error(fmt.Errorf("%v: validating key %v: %w", errCtx, r.Key, err))
+		out.Error(record.Fail(fmt.Errorf("%v: validating key %v: %w", errCtx, r.Key, err)))
 		return
 	}
 	if err := i.ValueValidator.Validate(r.Value); err != nil {
-		out.Error(fmt.Errorf("%v: validating value %v: %w", errCtx, r.Value, err))
+		out.Error(record.Fail(fmt.Errorf("%v: validating value %v: %w", errCtx, r.Value, err)))
 		return
 	}
 	if err := i.MetaDataRepo.Add(r.Collection, imageId, r.Key, r.Value); err != nil {
-		out.Error(fmt.Errorf("%v: adding meta-data with key %v and value %v: %w",
-			errCtx, r.Key, r.Value, err))
+		out.Error(record.Fail(fmt.Errorf("%v: adding meta-data with key %v and value %v: %w",
+			errCtx, r.Key, r.Value, err)))
 		return
 	}
 
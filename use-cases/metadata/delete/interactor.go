@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -22,6 +24,7 @@ type Interactor struct {
 	CollectionRepo
 	MetaDataRepo
 	Auth
+	Audit al.AuditLogger
 }
 
 func New(c CollectionRepo, m MetaDataRepo, opts ...Option) Interactor {
@@ -44,39 +47,48 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteMetadata", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
 	errCtx := "deleting metadata"
 	group, err := i.CollectionRepo.GetGroup(r.Collection)
 	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if group != nil {
 		if err := i.Auth.DeleteMetadata(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: parsing image id %v: %w", errCtx, r.ImageId, err))
+		out.Error(record.Fail(fmt.Errorf("%v: parsing image id %v: %w", errCtx, r.ImageId, err)))
 		return
 	}
 
 	exists, err := i.MetaDataRepo.KeyExists(r.Collection, imageId, r.Key)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: checking existence of key %v: %w", errCtx, r.Key, err))
+		out.Error(record.Fail(fmt.Errorf("%v: checking existence of key %v: %w", errCtx, r.Key, err)))
 		return
 	}
 	if !exists {
-		out.Error(fmt.Errorf("%v: checking existence of key %v: %w", errCtx, r.Key, e.ErrNotFound))
+		out.Error(record.Fail(fmt.Errorf("%v: checking existence of key %v: %w", errCtx, r.Key, e.ErrNotFound)))
 		return
 	}
 
 	if err := i.MetaDataRepo.Delete(r.Collection, imageId, r.Key); err != nil {
-		out.Error(fmt.Errorf("%v: deleting key %v: %v: %w", errCtx, r.Key, err, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: deleting key %v: %v: %w", errCtx, r.Key, err, e.ErrInternal)))
 		return
 
 	}
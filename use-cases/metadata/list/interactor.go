@@ -2,9 +2,13 @@ package list
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
 type Interface interface {
@@ -12,11 +16,25 @@ type Interface interface {
 }
 
 type Interactor struct {
+	CollectionRepo
 	MetaDataRepo
+	Auth
 }
 
-func New(m MetaDataRepo) Interactor {
-	return Interactor{MetaDataRepo: m}
+func New(c CollectionRepo, m MetaDataRepo, opts ...Option) Interactor {
+	i := &Interactor{CollectionRepo: c, MetaDataRepo: m, Auth: sauth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
@@ -27,6 +45,16 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		out.Error(fmt.Errorf("%v: parsing image id %v: %w", errCtx, imageId, err))
 		return
 	}
+	group, err := i.CollectionRepo.GetGroup(r.Collection)
+	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	if err := visibility.CanRead(ctx, i.Auth, group); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
 	meta, err := i.MetaDataRepo.List(r.Collection, imageId)
 	if err != nil {
 		out.Error(fmt.Errorf("%v: %w",
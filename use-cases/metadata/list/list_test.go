@@ -14,7 +14,7 @@ import (
 func TestErrorOnListShouldFail(t *testing.T) {
 	collection := clc.NewCollection(clc.NewCollectionId(), "my-collection")
 	image := im.NewImage(im.NewImageId(), collection)
-	itr := New(&fk.MetaDataRepo{ErrOnList: e.ErrInternal})
+	itr := New(&fk.CollectionRepo{}, &fk.MetaDataRepo{ErrOnList: e.ErrInternal})
 	p := &FakePresenter{}
 	itr.Execute(t.Context(),
 		Request{ImageId: image.Id.String(), Collection: collection.Name},
@@ -23,12 +23,32 @@ func TestErrorOnListShouldFail(t *testing.T) {
 	assert.True(t, p.GotInternalErr)
 }
 
+func TestHandleAuthError(t *testing.T) {
+	itr := New(&fk.CollectionRepo{ReturnGroup: "my-group"}, &fk.MetaDataRepo{})
+	itr.Auth = &fk.Auth{Err: e.ErrAuthorization}
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{ImageId: im.NewImageId().String(), Collection: "my-collection"},
+		p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
+func TestErrorOnGetGroupShouldFail(t *testing.T) {
+	itr := New(&fk.CollectionRepo{ErrOnGetGroup: e.ErrInternal}, &fk.MetaDataRepo{})
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{ImageId: im.NewImageId().String(), Collection: "my-collection"},
+		p)
+	assert.True(t, p.GotInternalErr)
+}
+
 func TestList(t *testing.T) {
 	items := []m.MetaData{
 		{Key: "first-key", Value: "hello"},
 		{Key: "second-key", Value: 123},
 	}
-	itr := New(&fk.MetaDataRepo{ReturnList: items})
+	itr := New(&fk.CollectionRepo{}, &fk.MetaDataRepo{ReturnList: items})
 	p := &FakePresenter{}
 	itr.Execute(t.Context(),
 		Request{ImageId: im.NewImageId().String(), Collection: "my-collection"},
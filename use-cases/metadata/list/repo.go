@@ -6,6 +6,10 @@ import (
 	m "github.com/lejeunel/go-image-annotator/entities/meta"
 )
 
+type CollectionRepo interface {
+	GetGroup(string) (*string, error)
+}
+
 type MetaDataRepo interface {
 	List(clc.CollectionName, im.ImageId) ([]m.MetaData, error)
 }
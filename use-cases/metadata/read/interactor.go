@@ -2,10 +2,13 @@ package read
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	im "github.com/lejeunel/go-image-annotator/entities/image"
 	m "github.com/lejeunel/go-image-annotator/entities/meta"
+	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -17,10 +20,28 @@ type Interactor struct {
 	CollectionRepo
 	ImageRepo
 	MetaDataRepo
+	Auth
 }
 
-func New(c CollectionRepo, ir ImageRepo, m MetaDataRepo) Interactor {
-	return Interactor{c, ir, m}
+func New(c CollectionRepo, ir ImageRepo, m MetaDataRepo, opts ...Option) Interactor {
+	i := &Interactor{
+		CollectionRepo: c,
+		ImageRepo:      ir,
+		MetaDataRepo:   m,
+		Auth:           sauth.NewVoidAuth(),
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
 }
 
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
@@ -57,6 +78,16 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		return
 	}
 
+	group, err := i.CollectionRepo.GetGroup(r.Collection)
+	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	if err := visibility.CanRead(ctx, i.Auth, group); err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
 	imageInCollection, err := i.ImageRepo.ImageExistsInCollection(imageId, r.Collection)
 	if err != nil {
 		out.Error(
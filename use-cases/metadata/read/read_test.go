@@ -37,6 +37,18 @@ func TestMissingCollectionShouldFail(t *testing.T) {
 	assert.ErrorIs(t, p.GotErr, e.ErrValidation)
 }
 
+func TestHandleAuthError(t *testing.T) {
+	itr, collection, image := Setup()
+	itr.CollectionRepo = &fk.CollectionRepo{ExistingNames: []string{collection.Name}, ReturnGroup: "my-group"}
+	itr.Auth = &fk.Auth{Err: e.ErrAuthorization}
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{ImageId: image.Id.String(), Collection: collection.Name},
+		p)
+	assert.True(t, p.GotAuthErr)
+	assert.False(t, p.GotSuccess)
+}
+
 func TestCheckImageInCollectionError(t *testing.T) {
 	itr, collection, image := Setup()
 	itr.CollectionRepo = &fk.CollectionRepo{ExistingNames: []string{collection.Name}}
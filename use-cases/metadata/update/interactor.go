@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"reflect"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	im "github.com/lejeunel/go-image-annotator/entities/image"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	sauth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -24,6 +26,7 @@ type Interactor struct {
 	ImageRepo
 	MetaDataRepo
 	Auth
+	Audit al.AuditLogger
 }
 
 func New(c CollectionRepo, ir ImageRepo, m MetaDataRepo,
@@ -31,6 +34,7 @@ func New(c CollectionRepo, ir ImageRepo, m MetaDataRepo,
 ) Interactor {
 	i := &Interactor{
 		CollectionRepo: c,
+		ImageRepo:      ir,
 		MetaDataRepo:   m,
 		Auth:           sauth.NewVoidAuth(),
 	}
@@ -48,30 +52,39 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateMetadata", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
 	errCtx := "updating metadata"
 	group, err := i.CollectionRepo.GetGroup(r.Collection)
 	if (err != nil) && !errors.Is(err, e.ErrNotFound) {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if group != nil {
 		if err := i.Auth.UpdateMetadata(ctx, *group); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
 
 	imageId, err := im.NewImageIdFromString(r.ImageId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: parsing image id %v: %w", errCtx, imageId, err))
+		out.Error(record.Fail(fmt.Errorf("%v: parsing image id %v: %w", errCtx, imageId, err)))
 		return
 	}
 
 	collectionExists, err := i.CollectionRepo.Exists(r.Collection)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking existence of collection %v: %v: %w",
 				errCtx,
@@ -79,11 +92,11 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 	if !collectionExists {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking existence of collection %v: %v: %w",
 				errCtx,
@@ -91,13 +104,13 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrValidation,
 			),
-		)
+		))
 		return
 	}
 
 	imageInCollection, err := i.ImageRepo.ImageExistsInCollection(imageId, r.Collection)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking whether image %v is in collection %v: %v: %w",
 				errCtx,
@@ -106,11 +119,11 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 	if !imageInCollection {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking whether image %v is in collection %v: %v: %w",
 				errCtx,
@@ -119,13 +132,13 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrValidation,
 			),
-		)
+		))
 		return
 	}
 
 	exists, err := i.MetaDataRepo.KeyExists(r.Collection, imageId, r.Key)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking existence of key %v: %v: %w",
 				errCtx,
@@ -133,19 +146,19 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 	if !exists {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf("%v: checking existence of key %v: %w", errCtx, r.Key, e.ErrValidation),
-		)
+		))
 		return
 	}
 
 	currentValue, err := i.MetaDataRepo.GetValue(r.Collection, imageId, r.Key)
 	if err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: fetching current value at key %v: %v: %w",
 				errCtx,
@@ -153,19 +166,19 @@ func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 				err,
 				e.ErrInternal,
 			),
-		)
+		))
 		return
 	}
 
 	if reflect.TypeOf(*currentValue) != reflect.TypeOf(r.Value) {
-		out.Error(fmt.Errorf("%v: comparing types of current value %v with new value %v: %v: %w",
-			errCtx, *currentValue, r.Value, err, e.ErrValidation))
+		out.Error(record.Fail(fmt.Errorf("%v: comparing types of current value %v with new value %v: %v: %w",
+			errCtx, *currentValue, r.Value, err, e.ErrValidation)))
 		return
 	}
 
 	if err := i.MetaDataRepo.UpdateValue(r.Collection, imageId, r.Key, r.Value); err != nil {
-		out.Error(fmt.Errorf("%v: updating key %v with new value %v: %v: %w",
-			errCtx, r.Key, r.Value, err, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: updating key %v with new value %v: %v: %w",
+			errCtx, r.Key, r.Value, err, e.ErrInternal)))
 		return
 	}
 
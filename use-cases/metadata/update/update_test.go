@@ -72,6 +72,24 @@ func TestImageNotInCollectionShouldFail(t *testing.T) {
 	assert.ErrorIs(t, p.GotErr, e.ErrValidation)
 }
 
+func TestUpdateStoresNewValue(t *testing.T) {
+	collection := clc.NewCollection(clc.NewCollectionId(), "my-collection")
+	key := "the-key"
+	m := &fk.MetaDataRepo{ExistingKeys: []string{key}, ReturnValue: "the-value"}
+	itr := New(&fk.CollectionRepo{ExistingNames: []string{collection.Name}},
+		&fk.ImageRepo{ImageIsInCollection: true}, m)
+	p := &FakePresenter{}
+	itr.Execute(t.Context(),
+		Request{
+			ImageId: im.NewImageId().String(), Collection: collection.Name,
+			Key: key, Value: "the-new-value",
+		},
+		p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, key, m.UpdatedKey)
+	assert.Equal(t, "the-new-value", m.UpdatedValue)
+}
+
 func TestCheckExistenceOfKeyError(t *testing.T) {
 	itr, collection, image, _ := Setup()
 	itr.MetaDataRepo = &fk.MetaDataRepo{ErrOnKeyExists: e.ErrInternal}
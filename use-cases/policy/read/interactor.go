@@ -9,7 +9,7 @@ import (
 )
 
 type Store interface {
-	Get(string) (io.Reader, error)
+	Get(string) (io.ReadCloser, error)
 }
 
 type Auth interface {
@@ -33,6 +33,7 @@ func (i *Interactor) Execute(ctx context.Context, out OutputPort) {
 		out.Error(fmt.Errorf("%v: fetching policy asset: %w", errCtx, err))
 		return
 	}
+	defer r.Close()
 
 	policies, err := io.ReadAll(r)
 	if err != nil {
@@ -6,6 +6,8 @@ import (
 	"io"
 	"strings"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	a "github.com/lejeunel/go-image-annotator/modules/authorizer"
 )
 
@@ -21,31 +23,47 @@ type Auth interface {
 type Interactor struct {
 	Store
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, policies string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "SetPolicies", au.Target{Type: "policies"})
+	defer record.End()
+
 	errCtx := "setting access policies"
 	if err := i.Auth.SetPolicies(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	rules, err := a.NewAuthRulesFromYaml(strings.NewReader(policies))
 	if err != nil {
-		out.Error(fmt.Errorf("%v: reading rules from yaml payload: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: reading rules from yaml payload: %w", errCtx, err)))
 		return
 	}
 
 	i.Auth.SetAuthRules(*rules)
 
 	if err := i.Store.Store(a.DefaultPolicyFileName, strings.NewReader(policies)); err != nil {
-		out.Error(fmt.Errorf("%v: reading rules from yaml payload: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: reading rules from yaml payload: %w", errCtx, err)))
 		return
 	}
 
 	out.SuccessSetPolicy(string(policies))
 }
 
-func New(r Store, a Auth) Interactor {
-	return Interactor{r, a}
+type Option func(*Interactor)
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(r Store, a Auth, opts ...Option) Interactor {
+	i := &Interactor{Store: r, Auth: a}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
 }
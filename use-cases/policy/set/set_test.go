@@ -1,6 +1,9 @@
 package set
 
 import (
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	"log/slog"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -53,3 +56,12 @@ rules:
 	assert.Contains(t, *a.GotRules, "admin")
 	assert.Equal(t, string(fs.GotData), data)
 }
+
+func TestDeniedPolicyChangeIsAudited(t *testing.T) {
+	audit := &fk.AuditRepo{}
+	itr := New(&fk.FileStore{}, &fk.Auth{Err: e.ErrAuthorization}, WithAudit(al.New(audit, *slog.Default())))
+	itr.Execute(t.Context(), "", &FakePresenter{})
+	assert.Equal(t, 1, len(audit.Entries))
+	assert.Equal(t, "SetPolicies", audit.Entries[0].Action)
+	assert.Equal(t, au.Denied, audit.Entries[0].Outcome)
+}
@@ -0,0 +1,13 @@
+package review
+
+import (
+	"github.com/lejeunel/go-image-annotator/use-cases/review/queue"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/review"
+	"github.com/lejeunel/go-image-annotator/use-cases/review/submit"
+)
+
+type Interactors struct {
+	Submit submit.Interactor
+	Review review.Interactor
+	Queue  queue.Interactor
+}
@@ -0,0 +1,90 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Interactor struct {
+	Repo
+	Collections     visibility.CollectionLister
+	DefaultPageSize int
+	MaxPageSize     int
+	Auth            visibility.ReviewAuth
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a visibility.ReviewAuth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func New(r Repo, cl visibility.CollectionLister, dps int, mps int, opts ...Option) Interactor {
+	i := &Interactor{r, cl, dps, mps, auth.NewVoidAuth()}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute lists the submitted annotations the caller may review, oldest
+// first.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	errCtx := "listing review queue"
+	if u.IdentityFromContext(ctx) == nil {
+		out.Error(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+
+	r.PaginationParams.Sanitize(i.DefaultPageSize, i.MaxPageSize)
+
+	names, all, err := visibility.Reviewable(ctx, i.Auth, i.Collections)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	var collections []clc.CollectionName
+	if !all {
+		collections = append([]clc.CollectionName{}, names...)
+	}
+	if r.Collection != "" {
+		if !all && !slices.Contains(names, r.Collection) {
+			out.Error(fmt.Errorf("%v: reviewing annotations of collection %v: %w",
+				errCtx, r.Collection, e.ErrAuthorization))
+			return
+		}
+		collections = []clc.CollectionName{r.Collection}
+	}
+
+	submissions := []rv.Submission{}
+	count := new(int64)
+	if collections == nil || len(collections) > 0 {
+		submissions, err = i.Repo.ListSubmissions(collections, r.PaginationParams)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+		count, err = i.Repo.CountSubmissions(collections)
+		if err != nil {
+			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			return
+		}
+	}
+
+	out.SuccessListQueue(Response{
+		Submissions: submissions,
+		Collection:  r.Collection,
+		Pagination:  pag.New(r.Page, r.PageSize, *count),
+	})
+}
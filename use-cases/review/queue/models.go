@@ -0,0 +1,18 @@
+package queue
+
+import (
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Request struct {
+	// Collection optionally restricts the queue to a collection.
+	Collection string
+	pag.PaginationParams
+}
+
+type Response struct {
+	Submissions []rv.Submission
+	Collection  string
+	Pagination  pag.Pagination
+}
@@ -0,0 +1,6 @@
+package queue
+
+type OutputPort interface {
+	Error(error)
+	SuccessListQueue(Response)
+}
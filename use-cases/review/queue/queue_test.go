@@ -0,0 +1,111 @@
+package queue
+
+import (
+	"context"
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+type groupAuth struct {
+	group string
+}
+
+func (a groupAuth) Review(ctx context.Context, group string) error {
+	if group == a.group {
+		return nil
+	}
+	return e.ErrAuthorization
+}
+
+func collections() *fk.CollectionRepo {
+	return &fk.CollectionRepo{ReturnList: []clc.Collection{
+		clc.NewCollection(clc.NewCollectionId(), "mine", clc.WithGroup("my-group")),
+		clc.NewCollection(clc.NewCollectionId(), "theirs", clc.WithGroup("their-group")),
+	}}
+}
+
+func submissions() []rv.Submission {
+	return []rv.Submission{
+		{AnnotationId: a.NewAnnotationId(), ImageId: im.NewImageId(), Collection: "mine"},
+		{AnnotationId: a.NewAnnotationId(), ImageId: im.NewImageId(), Collection: "mine"},
+	}
+}
+
+func TestListQueue(t *testing.T) {
+	repo := &fk.AnnotationRepo{Submissions: submissions()}
+	p := &FakePresenter{}
+	New(repo, collections(), 10, 100).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{PaginationParams: pag.PaginationParams{Page: 1}}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Nil(t, repo.GotCollections)
+	assert.Len(t, p.Got.Submissions, 2)
+	assert.Equal(t, int64(2), p.Got.Pagination.TotalRecords)
+	assert.Equal(t, 10, p.Got.Pagination.PageSize)
+}
+
+func TestListQueueOfReviewableCollections(t *testing.T) {
+	repo := &fk.AnnotationRepo{Submissions: submissions()}
+	p := &FakePresenter{}
+	New(repo, collections(), 10, 100, WithAuth(groupAuth{"my-group"})).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []string{"mine"}, repo.GotCollections)
+}
+
+func TestListQueueOfCollection(t *testing.T) {
+	repo := &fk.AnnotationRepo{Submissions: submissions()}
+	p := &FakePresenter{}
+	New(repo, collections(), 10, 100, WithAuth(groupAuth{"my-group"})).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{Collection: "mine"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, []string{"mine"}, repo.GotCollections)
+	assert.Equal(t, "mine", p.Got.Collection)
+}
+
+func TestListQueueOfCollectionNotReviewableShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}, collections(), 10, 100, WithAuth(groupAuth{"my-group"})).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{Collection: "theirs"}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestListQueueWithoutReviewableCollectionIsEmpty(t *testing.T) {
+	repo := &fk.AnnotationRepo{Submissions: submissions()}
+	p := &FakePresenter{}
+	New(repo, collections(), 10, 100, WithAuth(groupAuth{"another-group"})).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Empty(t, p.Got.Submissions)
+	assert.Equal(t, int64(0), p.Got.Pagination.TotalRecords)
+}
+
+func TestListQueueRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}, collections(), 10, 100).Execute(t.Context(), Request{}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestListQueueHandlesInternalErrors(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{ErrOnListSubmissions: e.ErrInternal}, collections(), 10, 100).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{}, p)
+	assert.True(t, p.GotInternalErr)
+
+	p = &FakePresenter{}
+	New(&fk.AnnotationRepo{}, &fk.CollectionRepo{ErrOnList: e.ErrInternal}, 10, 100).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{}, p)
+	assert.True(t, p.GotInternalErr)
+}
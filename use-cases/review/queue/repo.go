@@ -0,0 +1,12 @@
+package queue
+
+import (
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	rv "github.com/lejeunel/go-image-annotator/entities/review"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Repo interface {
+	ListSubmissions([]clc.CollectionName, pag.PaginationParams) ([]rv.Submission, error)
+	CountSubmissions([]clc.CollectionName) (*int64, error)
+}
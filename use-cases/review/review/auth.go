@@ -0,0 +1,9 @@
+package review
+
+import (
+	"context"
+)
+
+type Auth interface {
+	Review(ctx context.Context, group string) error
+}
@@ -0,0 +1,111 @@
+package review
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jonboulle/clockwork"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+type Interactor struct {
+	AnnotationRepo
+	Auth
+	clockwork.Clock
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func WithClock(c clockwork.Clock) Option {
+	return func(i *Interactor) {
+		i.Clock = c
+	}
+}
+
+func New(repo AnnotationRepo, opts ...Option) Interactor {
+	i := &Interactor{repo, auth.NewVoidAuth(), clockwork.NewRealClock(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute accepts or rejects a submitted annotation. Rejections must be
+// explained by a comment.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Review", au.Target{Type: "annotation", Id: r.AnnotationId})
+	defer record.End()
+
+	errCtx := "reviewing annotation"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+
+	id, err := a.NewAnnotationIdFromString(r.AnnotationId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %v: %w", errCtx, err, e.ErrValidation)))
+		return
+	}
+	status, err := a.ParseDecision(r.Status)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	group, err := i.AnnotationRepo.GroupOfAnnotation(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching annotation group: %w", errCtx, err)))
+		return
+	}
+	if group != nil {
+		if err := i.Auth.Review(ctx, *group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	current, err := i.AnnotationRepo.FindReview(*id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if !current.IsReviewable() {
+		out.Error(record.Fail(fmt.Errorf("%v: annotation %v is %v, only submitted annotations can be reviewed: %w",
+			errCtx, r.AnnotationId, current.CurrentStatus(), e.ErrValidation)))
+		return
+	}
+
+	now := i.Clock.Now()
+	review, err := a.NewDecision(status, &user.Id, r.Comment, &now)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if err := i.AnnotationRepo.SetReview(*id, review); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+
+	out.SuccessReview(Response{AnnotationId: r.AnnotationId, Review: review})
+}
@@ -0,0 +1,17 @@
+package review
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type Request struct {
+	AnnotationId string
+	// Status is the decision of the reviewer, either accepted or rejected.
+	Status  string
+	Comment string
+}
+
+type Response struct {
+	AnnotationId string
+	Review       a.Review
+}
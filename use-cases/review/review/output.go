@@ -0,0 +1,6 @@
+package review
+
+type OutputPort interface {
+	Error(error)
+	SuccessReview(Response)
+}
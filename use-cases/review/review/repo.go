@@ -0,0 +1,11 @@
+package review
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+)
+
+type AnnotationRepo interface {
+	GroupOfAnnotation(a.AnnotationId) (*string, error)
+	FindReview(a.AnnotationId) (*a.Review, error)
+	SetReview(a.AnnotationId, a.Review) error
+}
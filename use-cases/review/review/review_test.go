@@ -0,0 +1,100 @@
+package review
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func submittedRepo() *fk.AnnotationRepo {
+	return &fk.AnnotationRepo{Review: &a.Review{Status: a.Submitted}}
+}
+
+func TestAcceptSubmittedAnnotation(t *testing.T) {
+	repo := submittedRepo()
+	id := a.NewAnnotationId()
+	now := time.Now()
+	p := &FakePresenter{}
+	New(repo, WithClock(clockwork.NewFakeClockAt(now))).Execute(
+		st.CreateCtxWithUserId(t.Context(), "reviewer@mail.com"),
+		Request{AnnotationId: id.String(), Status: "accepted"}, p)
+
+	assert.True(t, p.GotSuccess)
+	got := repo.SetReviews[id]
+	assert.Equal(t, a.Accepted, got.Status)
+	assert.Equal(t, "reviewer@mail.com", *got.Reviewer)
+	assert.Equal(t, now, *got.Time)
+	assert.Equal(t, got, p.Got.Review)
+}
+
+func TestRejectWithComment(t *testing.T) {
+	repo := submittedRepo()
+	id := a.NewAnnotationId()
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "reviewer@mail.com"),
+		Request{AnnotationId: id.String(), Status: "rejected", Comment: "box too loose"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, a.Rejected, repo.SetReviews[id].Status)
+	assert.Equal(t, "box too loose", repo.SetReviews[id].Comment)
+}
+
+func TestRejectWithoutCommentShouldFail(t *testing.T) {
+	repo := submittedRepo()
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "reviewer@mail.com"),
+		Request{AnnotationId: a.NewAnnotationId().String(), Status: "rejected"}, p)
+	assert.True(t, p.GotValidationErr)
+	assert.Empty(t, repo.SetReviews)
+}
+
+func TestInvalidDecisionShouldFail(t *testing.T) {
+	for _, status := range []string{"", "draft", "submitted", "approved"} {
+		p := &FakePresenter{}
+		New(submittedRepo()).Execute(st.CreateCtxWithUserId(t.Context(), "reviewer@mail.com"),
+			Request{AnnotationId: a.NewAnnotationId().String(), Status: status}, p)
+		assert.True(t, p.GotValidationErr, status)
+	}
+}
+
+func TestReviewAnnotationThatIsNotSubmittedShouldFail(t *testing.T) {
+	for _, status := range []a.ReviewStatus{a.Draft, a.Accepted, a.Rejected} {
+		repo := &fk.AnnotationRepo{Review: &a.Review{Status: status}}
+		p := &FakePresenter{}
+		New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "reviewer@mail.com"),
+			Request{AnnotationId: a.NewAnnotationId().String(), Status: "accepted"}, p)
+		assert.True(t, p.GotValidationErr, status)
+		assert.Empty(t, repo.SetReviews)
+	}
+}
+
+func TestReviewRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(submittedRepo()).Execute(t.Context(),
+		Request{AnnotationId: a.NewAnnotationId().String(), Status: "accepted"}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestReviewHandlesAuthError(t *testing.T) {
+	repo := submittedRepo()
+	p := &FakePresenter{}
+	New(repo, WithAuth(fk.Auth{Err: e.ErrAuthorization})).Execute(
+		st.CreateCtxWithUserId(t.Context(), "annotator@mail.com"),
+		Request{AnnotationId: a.NewAnnotationId().String(), Status: "accepted"}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.Empty(t, repo.SetReviews)
+}
+
+func TestReviewMissingAnnotationShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{ErrOnFindReview: e.ErrNotFound}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "reviewer@mail.com"),
+		Request{AnnotationId: a.NewAnnotationId().String(), Status: "accepted"}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
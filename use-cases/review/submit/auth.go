@@ -0,0 +1,9 @@
+package submit
+
+import (
+	"context"
+)
+
+type Auth interface {
+	Annotate(ctx context.Context, group string) error
+}
@@ -0,0 +1,129 @@
+package submit
+
+import (
+	"context"
+	"fmt"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	at "github.com/lejeunel/go-image-annotator/modules/attributes"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
+)
+
+type Interactor struct {
+	AnnotationRepo
+	ImageStore
+	Auth
+	Audit al.AuditLogger
+}
+
+type Option func(*Interactor)
+
+func WithAuth(a Auth) Option {
+	return func(i *Interactor) {
+		i.Auth = a
+	}
+}
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(repo AnnotationRepo, store ImageStore, opts ...Option) Interactor {
+	i := &Interactor{repo, store, auth.NewVoidAuth(), al.AuditLogger{}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
+
+// Execute submits the draft and rejected annotations of an image for review.
+// Predictions are left out until an annotator accepts them.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "Annotate", au.ImageTarget(r.Collection, r.ImageId))
+	defer record.End()
+
+	errCtx := "submitting annotations for review"
+
+	imageId, err := im.NewImageIdFromString(r.ImageId)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	image, err := i.ImageStore.Find(im.BaseImage{ImageId: imageId, Collection: r.Collection})
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching image: %w", errCtx, err)))
+		return
+	}
+	if image.Collection.Group != nil {
+		if err := i.Auth.Annotate(ctx, *image.Collection.Group); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	ids := []a.AnnotationId{}
+	for _, l := range image.Labels {
+		if l.Review.IsSubmittable() {
+			ids = append(ids, l.Id)
+		}
+	}
+	for _, b := range image.BoundingBoxes {
+		if b.Review.IsSubmittable() && !b.Source.IsPrediction() {
+			if err := at.Complete(b.Label.Attributes, b.Attributes); err != nil {
+				out.Error(record.Fail(fmt.Errorf("%v: bounding box %v: %w", errCtx, b.Id, err)))
+				return
+			}
+			ids = append(ids, b.Id)
+		}
+	}
+	for _, p := range image.Polygons {
+		if p.Review.IsSubmittable() && !p.Source.IsPrediction() {
+			if err := at.Complete(p.Label.Attributes, p.Attributes); err != nil {
+				out.Error(record.Fail(fmt.Errorf("%v: polygon %v: %w", errCtx, p.Id, err)))
+				return
+			}
+			ids = append(ids, p.Id)
+		}
+	}
+	for _, l := range image.Polylines {
+		if l.Review.IsSubmittable() && !l.Source.IsPrediction() {
+			if err := at.Complete(l.Label.Attributes, l.Attributes); err != nil {
+				out.Error(record.Fail(fmt.Errorf("%v: polyline %v: %w", errCtx, l.Id, err)))
+				return
+			}
+			ids = append(ids, l.Id)
+		}
+	}
+	for _, k := range image.Keypoints {
+		if k.Review.IsSubmittable() && !k.Source.IsPrediction() {
+			if err := at.Complete(k.Label.Attributes, k.Attributes); err != nil {
+				out.Error(record.Fail(fmt.Errorf("%v: keypoints %v: %w", errCtx, k.Id, err)))
+				return
+			}
+			ids = append(ids, k.Id)
+		}
+	}
+	for _, m := range image.Masks {
+		if m.Review.IsSubmittable() && !m.Source.IsPrediction() {
+			if err := at.Complete(m.Label.Attributes, m.Attributes); err != nil {
+				out.Error(record.Fail(fmt.Errorf("%v: mask %v: %w", errCtx, m.Id, err)))
+				return
+			}
+			ids = append(ids, m.Id)
+		}
+	}
+
+	for _, id := range ids {
+		if err := i.AnnotationRepo.SetReview(id, a.Review{Status: a.Submitted}); err != nil {
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+			return
+		}
+	}
+
+	out.SuccessSubmit(Response{ImageId: r.ImageId, Collection: r.Collection, NumSubmitted: len(ids)})
+}
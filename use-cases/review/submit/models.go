@@ -0,0 +1,12 @@
+package submit
+
+type Request struct {
+	ImageId    string
+	Collection string
+}
+
+type Response struct {
+	ImageId      string
+	Collection   string
+	NumSubmitted int
+}
@@ -0,0 +1,6 @@
+package submit
+
+type OutputPort interface {
+	Error(error)
+	SuccessSubmit(Response)
+}
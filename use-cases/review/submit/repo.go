@@ -0,0 +1,14 @@
+package submit
+
+import (
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+)
+
+type AnnotationRepo interface {
+	SetReview(a.AnnotationId, a.Review) error
+}
+
+type ImageStore interface {
+	Find(base im.BaseImage) (*im.Image, error)
+}
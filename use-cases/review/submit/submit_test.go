@@ -0,0 +1,103 @@
+package submit
+
+import (
+	"testing"
+
+	a "github.com/lejeunel/go-image-annotator/entities/annotation"
+	clc "github.com/lejeunel/go-image-annotator/entities/collection"
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	lbl "github.com/lejeunel/go-image-annotator/entities/label"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func annotatedImage() *im.Image {
+	label := lbl.NewLabel(lbl.NewLabelId(), "a-label")
+	draft := a.NewBoundingBox(a.NewAnnotationId(), 1, 1, 1, 1, label)
+	rejected := a.NewPolygon(a.NewAnnotationId(), a.Points{}, label)
+	rejected.Review = a.Review{Status: a.Rejected, Comment: "wrong label"}
+	accepted := a.NewImageLabel(label)
+	accepted.Review = a.Review{Status: a.Accepted}
+	return &im.Image{
+		Id:            im.NewImageId(),
+		Collection:    clc.NewCollection(clc.NewCollectionId(), "a-collection", clc.WithGroup("my-group")),
+		Labels:        []a.ImageLabel{accepted},
+		BoundingBoxes: []a.BoundingBox{draft},
+		Polygons:      []a.Polygon{rejected},
+	}
+}
+
+func TestSubmitDraftAndRejectedAnnotations(t *testing.T) {
+	image := annotatedImage()
+	repo := &fk.AnnotationRepo{}
+	p := &FakePresenter{}
+	New(repo, &fk.ImageStore{Return: image}).Execute(t.Context(),
+		Request{ImageId: image.Id.String(), Collection: "a-collection"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 2, p.Got.NumSubmitted)
+	assert.Equal(t, map[a.AnnotationId]a.Review{
+		image.BoundingBoxes[0].Id: {Status: a.Submitted},
+		image.Polygons[0].Id:      {Status: a.Submitted},
+	}, repo.SetReviews)
+}
+
+func TestSubmitSkipsPredictions(t *testing.T) {
+	image := annotatedImage()
+	image.BoundingBoxes[0].Source = a.Source{Model: "a-model", Confidence: 0.5}
+	repo := &fk.AnnotationRepo{}
+	p := &FakePresenter{}
+	New(repo, &fk.ImageStore{Return: image}).Execute(t.Context(),
+		Request{ImageId: image.Id.String(), Collection: "a-collection"}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, 1, p.Got.NumSubmitted)
+	assert.NotContains(t, repo.SetReviews, image.BoundingBoxes[0].Id)
+}
+
+func TestSubmitWithMissingRequiredAttributeShouldFail(t *testing.T) {
+	image := annotatedImage()
+	image.BoundingBoxes[0].Label.Attributes = []lbl.Attribute{
+		{Name: "plate", Type: lbl.TextAttribute, Required: true},
+	}
+	repo := &fk.AnnotationRepo{}
+	p := &FakePresenter{}
+	New(repo, &fk.ImageStore{Return: image}).Execute(t.Context(),
+		Request{ImageId: image.Id.String(), Collection: "a-collection"}, p)
+
+	assert.True(t, p.GotValidationErr)
+	assert.Empty(t, repo.SetReviews)
+}
+
+func TestSubmitWithInvalidImageIdShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}, &fk.ImageStore{}).Execute(t.Context(), Request{ImageId: "not-an-id"}, p)
+	assert.False(t, p.GotSuccess)
+	assert.NotNil(t, p.GotErr)
+}
+
+func TestSubmitMissingImageShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{}, &fk.ImageStore{ErrOnFind: e.ErrNotFound}).Execute(t.Context(),
+		Request{ImageId: im.NewImageId().String()}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestSubmitHandlesAuthError(t *testing.T) {
+	image := annotatedImage()
+	repo := &fk.AnnotationRepo{}
+	p := &FakePresenter{}
+	New(repo, &fk.ImageStore{Return: image}, WithAuth(fk.Auth{Err: e.ErrAuthorization})).
+		Execute(t.Context(), Request{ImageId: image.Id.String()}, p)
+	assert.True(t, p.GotAuthErr)
+	assert.Empty(t, repo.SetReviews)
+}
+
+func TestSubmitHandlesInternalError(t *testing.T) {
+	image := annotatedImage()
+	p := &FakePresenter{}
+	New(&fk.AnnotationRepo{ErrOnSetReview: e.ErrInternal}, &fk.ImageStore{Return: image}).
+		Execute(t.Context(), Request{ImageId: image.Id.String()}, p)
+	assert.True(t, p.GotInternalErr)
+}
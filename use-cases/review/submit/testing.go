@@ -0,0 +1,16 @@
+package submit
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        Response
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessSubmit(r Response) {
+	p.Got = r
+	p.GotSuccess = true
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -11,26 +13,30 @@ import (
 type Interactor struct {
 	Repo
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, name string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteRole", au.Target{Type: "role", Id: name})
+	defer record.End()
+
 	errCtx := fmt.Errorf("deleting role")
 	if err := i.Auth.DeleteRole(ctx); err != nil {
-		out.Error(fmt.Errorf("%w: %w", errCtx, e.ErrAuthorization))
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, e.ErrAuthorization)))
 		return
 	}
 
 	if err := i.ensureExists(name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	if err := i.ensureDeletable(name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.Repo.Delete(name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	out.SuccessDeleteRole(name)
@@ -68,6 +74,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) Interactor {
 	i := &Interactor{
 		Repo: r,
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	rl "github.com/lejeunel/go-image-annotator/entities/role"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -12,6 +14,7 @@ import (
 type Interactor struct {
 	Repo
 	Auth
+	Audit al.AuditLogger
 }
 
 type Option func(*Interactor)
@@ -22,6 +25,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) Interactor {
 	i := &Interactor{
 		Repo: r,
@@ -34,21 +43,24 @@ func New(r Repo, opts ...Option) Interactor {
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateRole", au.Target{Type: "role", Id: r.Name})
+	defer record.End()
+
 	errCtx := "updating role"
 	if err := i.Auth.UpdateRole(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.ensureNameExists(r.Name); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 
 	}
 
 	if r.NewName != r.Name {
 		if err := i.ensureNameDoesNotExist(r.NewName); err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
@@ -56,7 +68,7 @@ func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 	if err := i.Repo.Update(
 		rl.UpdatableModel{Name: r.Name, NewName: r.NewName, NewDescription: r.NewDescription},
 	); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	pw "github.com/lejeunel/go-image-annotator/modules/password-validator"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -17,43 +19,47 @@ type Interactor struct {
 	Repo
 	TokenVerifier
 	pw.PasswordValidator
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "ChangePassword", au.Target{Type: "user", Id: r.Id})
+	defer record.End()
+
 	errCtx := "changing password"
 
 	user, err := i.Repo.Find(r.Id)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: retrieving user info: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: retrieving user info: %w", errCtx, err)))
 		return
 	}
 
 	if ok := i.TokenVerifier.Verify(r.CurrentPassword, user.HashPassword); !ok {
-		out.Error(fmt.Errorf("%v: verifying current password: %w", errCtx, e.ErrInvalidPassword))
+		out.Error(record.Fail(fmt.Errorf("%v: verifying current password: %w", errCtx, e.ErrInvalidPassword)))
 		return
 	}
 
 	if r.FirstPassword != r.SecondPassword {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf("%v: checking for matching passwords: %w", errCtx, e.ErrPasswordMismatch),
-		)
+		))
 		return
 	}
 
 	if err := i.PasswordValidator.Validate(r.FirstPassword); err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking for password validity: %w: %w",
 				errCtx,
 				err,
 				e.ErrInvalidPassword,
 			),
-		)
+		))
 		return
 	}
 
 	if err := i.Repo.UpdatePassword(r.Id, i.TokenVerifier.Hash(r.FirstPassword)); err != nil {
-		out.Error(fmt.Errorf("%v: updating password: %v, %w", errCtx, err, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: updating password: %v, %w", errCtx, err, e.ErrInternal)))
 		return
 	}
 
@@ -62,6 +68,12 @@ func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 
 type Option func(*Interactor)
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, tokenHasher TokenVerifier, passwordValidator pw.PasswordValidator,
 	opts ...Option,
 ) Interactor {
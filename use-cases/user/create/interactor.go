@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	tk "github.com/lejeunel/go-image-annotator/entities/token"
 	usr "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -24,24 +26,28 @@ type Interactor struct {
 	APITokenGenerator APITokenGenerator
 	PasswordGenerator PasswordGenerator
 
-	auth Auth
+	auth  Auth
+	audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.audit.Begin(ctx, "CreateUser", au.Target{Type: "user", Id: r.Id})
+	defer record.End()
+
 	errCtx := "creating user"
 	if err := i.auth.CreateUser(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 
 	}
 	if err := i.checkDuplicate(r.Id); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	token, err := i.APITokenGenerator.Generate()
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
@@ -52,7 +58,7 @@ func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		passwordPair, err := i.PasswordGenerator.Generate()
 		passwordHash = passwordPair.Hash
 		if err != nil {
-			out.Error(fmt.Errorf("%v: %w", errCtx, err))
+			out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 			return
 		}
 	}
@@ -60,7 +66,7 @@ func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 		usr.WithPasswordHash(passwordHash),
 		usr.WithGroups(r.Groups), usr.WithRoles(r.Roles))
 	if err := i.Repo.Create(user); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	out.SuccessCreateUser(Response{
@@ -90,6 +96,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.audit = l
+	}
+}
+
 func New(r Repo,
 	tg APITokenGenerator,
 	pg PasswordGenerator, opts ...Option,
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -12,27 +14,31 @@ import (
 type Interactor struct {
 	Repo
 	Auth
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, id string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteUser", au.Target{Type: "user", Id: id})
+	defer record.End()
+
 	errCtx := "deleting user"
 	if err := i.Auth.DeleteUser(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	if err := i.exists(id); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	currentUser := u.IdentityFromContext(ctx)
 	if currentUser == nil {
-		out.Error(fmt.Errorf("%v: fetching user from context: %w", errCtx, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: fetching user from context: %w", errCtx, e.ErrInternal)))
 		return
 	}
 
 	if currentUser.Id == id {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: attempting to delete user %v while logged-in as %v: %w",
 				errCtx,
@@ -40,12 +46,12 @@ func (i *Interactor) Execute(ctx context.Context, id string, out OutputPort) {
 				currentUser.Id,
 				e.ErrForbiddenOp,
 			),
-		)
+		))
 		return
 	}
 
 	if err := i.Repo.Delete(id); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	out.SuccessDeleteUser(id)
@@ -71,6 +77,12 @@ func WithAuth(a Auth) Option {
 	}
 }
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, opts ...Option) Interactor {
 	i := &Interactor{
 		Repo: r,
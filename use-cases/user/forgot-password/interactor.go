@@ -7,7 +7,9 @@ import (
 
 	"github.com/jonboulle/clockwork"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	tk "github.com/lejeunel/go-image-annotator/entities/token"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -20,34 +22,38 @@ type Interactor struct {
 	expiresMinutes int
 	tokenGenerator TokenGenerator
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, userId string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "RequestForgottenPassword", au.Target{Type: "user", Id: userId})
+	defer record.End()
+
 	errCtx := "requesting forgotten password token"
 	exists, err := i.Repo.Exists(userId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, err))
+		out.Error(record.Fail(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, err)))
 		return
 	}
 	if !exists {
-		out.Error(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, e.ErrNotFound))
+		out.Error(record.Fail(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, e.ErrNotFound)))
 		return
 	}
 
 	if err := i.Repo.DeleteForgottenPasswordTokens(userId); err != nil {
-		out.Error(fmt.Errorf("%v: deleting previous tokens: %w", errCtx, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: deleting previous tokens: %w", errCtx, e.ErrInternal)))
 		return
 	}
 
 	token, err := i.tokenGenerator.Generate()
 	if err != nil {
-		out.Error(fmt.Errorf("%v: generating token: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: generating token: %w", errCtx, err)))
 		return
 	}
 
 	expiresAt := i.Clock.Now().Add(time.Minute * time.Duration(i.expiresMinutes))
 	if err := i.Repo.AddForgottenPasswordState(token.Hash, userId, expiresAt); err != nil {
-		out.Error(fmt.Errorf("%v: storing token: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: storing token: %w", errCtx, err)))
 		return
 	}
 	out.Success(Response{
@@ -58,6 +64,12 @@ func (i *Interactor) Execute(ctx context.Context, userId string, out OutputPort)
 
 type Option func(*Interactor)
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithClock(c clockwork.Clock) Option {
 	return func(i *Interactor) {
 		i.Clock = c
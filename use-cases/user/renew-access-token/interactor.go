@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	tk "github.com/lejeunel/go-image-annotator/entities/token"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
 
@@ -15,28 +17,32 @@ type TokenGenerator interface {
 type Interactor struct {
 	Repo
 	TokenGenerator
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, userId string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "RenewAccessToken", au.Target{Type: "user", Id: userId})
+	defer record.End()
+
 	errCtx := "renewing personal access token"
 	exists, err := i.Repo.Exists(userId)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, err))
+		out.Error(record.Fail(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, err)))
 		return
 	}
 	if !exists {
-		out.Error(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, e.ErrNotFound))
+		out.Error(record.Fail(fmt.Errorf("%v: checking user %v exists: %w", errCtx, userId, e.ErrNotFound)))
 		return
 	}
 
 	token, err := i.TokenGenerator.Generate()
 	if err != nil {
-		out.Error(fmt.Errorf("%v: generating token: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: generating token: %w", errCtx, err)))
 		return
 	}
 
 	if err := i.Repo.SetAccessTokenHash(userId, token.Hash); err != nil {
-		out.Error(fmt.Errorf("%v: setting token hash: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: setting token hash: %w", errCtx, err)))
 		return
 	}
 	out.Success(Response{Id: userId, PersonalAccessToken: token.Value})
@@ -44,6 +50,12 @@ func (i *Interactor) Execute(ctx context.Context, userId string, out OutputPort)
 
 type Option func(*Interactor)
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func New(r Repo, g TokenGenerator, opts ...Option) Interactor {
 	i := &Interactor{
 		Repo:           r,
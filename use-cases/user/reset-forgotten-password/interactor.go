@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/jonboulle/clockwork"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	tk "github.com/lejeunel/go-image-annotator/modules/token"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -18,51 +20,55 @@ type Interactor struct {
 	tk.TokenHasher
 	PasswordValidator
 	clockwork.Clock
+	Audit al.AuditLogger
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "ResetForgottenPassword", au.Target{Type: "user"})
+	defer record.End()
+
 	errCtx := "resetting forgotten password"
 
 	if r.FirstPassword != r.SecondPassword {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf("%v: checking for matching passwords: %w", errCtx, e.ErrPasswordMismatch),
-		)
+		))
 		return
 	}
 
 	if err := i.PasswordValidator.Validate(r.FirstPassword); err != nil {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: checking for password validity: %w: %w",
 				errCtx,
 				err,
 				e.ErrInvalidPassword,
 			),
-		)
+		))
 		return
 	}
 
 	state, err := i.Repo.FindResetPasswordState(i.TokenHasher.Hash(r.Token))
 	if err != nil {
-		out.Error(fmt.Errorf("%v: finding by hash: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: finding by hash: %w", errCtx, err)))
 		return
 	}
 
 	if state.ExpiresAt != nil {
 		if state.ExpiresAt.Before(i.Clock.Now()) {
-			out.Error(
+			out.Error(record.Fail(
 				fmt.Errorf("%v: checking for token expiration: %w", errCtx, e.ErrExpiredToken),
-			)
+			))
 			return
 		}
 	}
 
 	if err := i.Repo.UpdatePassword(state.Id, i.TokenHasher.Hash(r.FirstPassword)); err != nil {
-		out.Error(fmt.Errorf("%v: updating password: %v, %w", errCtx, err, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: updating password: %v, %w", errCtx, err, e.ErrInternal)))
 		return
 	}
 	if err := i.Repo.DeleteForgottenPasswordTokens(state.Id); err != nil {
-		out.Error(fmt.Errorf("%v: deleting token: %v, %w", errCtx, err, e.ErrInternal))
+		out.Error(record.Fail(fmt.Errorf("%v: deleting token: %v, %w", errCtx, err, e.ErrInternal)))
 		return
 	}
 
@@ -71,6 +77,12 @@ func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 
 type Option func(*Interactor)
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithClock(c clockwork.Clock) Option {
 	return func(i *Interactor) {
 		i.Clock = c
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"slices"
 
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
 	rl "github.com/lejeunel/go-image-annotator/entities/role"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
 	auth "github.com/lejeunel/go-image-annotator/modules/authorizer"
 	e "github.com/lejeunel/go-image-annotator/shared/errors"
 )
@@ -15,6 +17,7 @@ type Interactor struct {
 	GroupRepo
 	RoleRepo
 	Auth
+	Audit al.AuditLogger
 }
 
 func New(ur UserRepo, gr GroupRepo, rr RoleRepo, opts ...Option) Interactor {
@@ -32,67 +35,70 @@ func New(ur UserRepo, gr GroupRepo, rr RoleRepo, opts ...Option) Interactor {
 }
 
 func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateUserPrivileges", au.Target{Type: "user", Id: r.Id})
+	defer record.End()
+
 	errCtx := "updating user"
 	if err := i.Auth.UpdateUserPrivileges(ctx); err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 	user, err := i.UserRepo.Find(r.Id)
 	if err != nil {
-		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
 		return
 	}
 
 	for _, g := range r.Groups {
 		exists, err := i.GroupRepo.Exists(g)
 		if err != nil {
-			out.Error(fmt.Errorf("%v: checking whether group %v exists: %w", errCtx, g, err))
+			out.Error(record.Fail(fmt.Errorf("%v: checking whether group %v exists: %w", errCtx, g, err)))
 			return
 		}
 		if !*exists {
-			out.Error(
+			out.Error(record.Fail(
 				fmt.Errorf("%v: checking whether group %v exists: %w", errCtx, g, e.ErrNotFound),
-			)
+			))
 			return
 		}
 	}
 
 	if err := i.UserRepo.SetGroups(r.Id, r.Groups); err != nil {
-		out.Error(fmt.Errorf("%v: applying groups %v: %w", errCtx, r.Groups, err))
+		out.Error(record.Fail(fmt.Errorf("%v: applying groups %v: %w", errCtx, r.Groups, err)))
 		return
 	}
 
 	numAdmins, err := i.UserRepo.CountAdmins()
 	if err != nil {
-		out.Error(fmt.Errorf("%v: fetching number of admin users: %w", errCtx, err))
+		out.Error(record.Fail(fmt.Errorf("%v: fetching number of admin users: %w", errCtx, err)))
 		return
 	}
 	if user.IsAdmin() && (numAdmins == 1) && !slices.Contains(r.Roles, rl.AdminRoleName) {
-		out.Error(
+		out.Error(record.Fail(
 			fmt.Errorf(
 				"%v: attempting to un-assign admin role to the last existing admin: %w",
 				errCtx,
 				e.ErrValidation,
 			),
-		)
+		))
 		return
 	}
 
 	for _, role := range r.Roles {
 		exists, err := i.RoleRepo.Exists(role)
 		if err != nil {
-			out.Error(fmt.Errorf("%v: checking whether role %v exists: %w", errCtx, role, err))
+			out.Error(record.Fail(fmt.Errorf("%v: checking whether role %v exists: %w", errCtx, role, err)))
 			return
 		}
 		if !*exists {
-			out.Error(
+			out.Error(record.Fail(
 				fmt.Errorf("%v: checking whether role %v exists: %w", errCtx, role, e.ErrNotFound),
-			)
+			))
 			return
 		}
 	}
 	if err := i.UserRepo.SetRoles(r.Id, r.Roles); err != nil {
-		out.Error(fmt.Errorf("%v: applying roles %v: %w", errCtx, r.Roles, err))
+		out.Error(record.Fail(fmt.Errorf("%v: applying roles %v: %w", errCtx, r.Roles, err)))
 		return
 	}
 
@@ -101,6 +107,12 @@ func (i *Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
 
 type Option func(*Interactor)
 
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
 func WithAuth(a Auth) Option {
 	return func(i *Interactor) {
 		i.Auth = a
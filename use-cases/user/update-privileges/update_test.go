@@ -2,6 +2,9 @@ package update
 
 import (
 	"fmt"
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	"log/slog"
 	"testing"
 
 	r "github.com/lejeunel/go-image-annotator/entities/role"
@@ -108,3 +111,13 @@ func TestRemovingAdminRoleWhenNoOtherAdminShouldFail(t *testing.T) {
 	itr.Execute(t.Context(), Request{Id: user.Id, Roles: []string{}}, p)
 	assert.True(t, p.GotValidationErr)
 }
+
+func TestUpdatePrivilegesIsAudited(t *testing.T) {
+	user := usr.NewUser("user@example.com")
+	audit := &fk.AuditRepo{}
+	itr := New(&fk.UserRepo{Return: &user}, &fk.GroupRepo{}, &fk.RoleRepo{ExistingNames: []string{"a-role"}},
+		WithAudit(al.New(audit, *slog.Default())))
+	itr.Execute(t.Context(), Request{Id: user.Id, Roles: []string{"a-role"}}, &FakePresenter{})
+	assert.Equal(t, []au.Entry{{Action: "UpdateUserPrivileges", Target: au.Target{Type: "user", Id: user.Id},
+		Time: audit.Entries[0].Time, Outcome: au.Success}}, audit.Entries)
+}
@@ -0,0 +1,86 @@
+package create
+
+import (
+	"testing"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func Setup(repo *fk.ViewRepo, fv *fk.FilterValidator) Interactor {
+	groups := &fk.GroupRepo{ExistingNames: []string{"my-group", "their-group"}}
+	return New(repo, groups, fv, &fk.FilterValidator{})
+}
+
+func TestCreateViewRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	Setup(&fk.ViewRepo{}, &fk.FilterValidator{}).Execute(t.Context(), Request{Name: "a-view"}, p)
+	assert.False(t, p.GotSuccess)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestCreateView(t *testing.T) {
+	p := &FakePresenter{}
+	repo := &fk.ViewRepo{}
+	fv := &fk.FilterValidator{}
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("me@mail.com", u.WithGroups([]string{"my-group"})))
+	req := Request{Name: "cars", Filter: `label:"car"`, Order: "ingested_at", Groups: []string{"my-group"}}
+	Setup(repo, fv).Execute(ctx, req, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, `label:"car"`, fv.Got)
+	assert.False(t, repo.Created.Id.IsNil())
+	assert.Equal(t, "me@mail.com", repo.Created.Owner)
+	assert.Equal(t, req.Filter, repo.Created.Filter)
+	assert.Equal(t, req.Order, repo.Created.Order)
+	assert.Equal(t, req.Groups, repo.Created.Groups)
+	assert.Equal(t, repo.Created, p.Got.View)
+}
+
+func TestCreateViewWithInvalidNameShouldFail(t *testing.T) {
+	for _, name := range []string{"", string(make([]byte, MaxNameLength+1))} {
+		p := &FakePresenter{}
+		Setup(&fk.ViewRepo{}, &fk.FilterValidator{}).Execute(
+			st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{Name: name}, p)
+		assert.True(t, p.GotValidationErr)
+	}
+}
+
+func TestCreateViewWithInvalidFilterShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	Setup(&fk.ViewRepo{}, &fk.FilterValidator{Err: e.ErrValidation}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{Name: "a-view", Filter: "foo:1"}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestCreateViewSharedWithMissingGroupShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	Setup(&fk.ViewRepo{}, &fk.FilterValidator{}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{Name: "a-view", Groups: []string{"missing"}}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestSharingViewWithForeignGroupShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("me@mail.com", u.WithGroups([]string{"my-group"})))
+	Setup(&fk.ViewRepo{}, &fk.FilterValidator{}).Execute(ctx,
+		Request{Name: "a-view", Groups: []string{"their-group"}}, p)
+	assert.True(t, p.GotAuthErr)
+
+	p = &FakePresenter{}
+	ctx = u.AppendUserToContext(t.Context(), u.NewUser("admin@mail.com", u.WithRoles([]string{"admin"})))
+	Setup(&fk.ViewRepo{}, &fk.FilterValidator{}).Execute(ctx,
+		Request{Name: "a-view", Groups: []string{"their-group"}}, p)
+	assert.True(t, p.GotSuccess)
+}
+
+func TestCreateViewHandlesInternalError(t *testing.T) {
+	p := &FakePresenter{}
+	Setup(&fk.ViewRepo{ErrOnCreate: e.ErrInternal}, &fk.FilterValidator{}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{Name: "a-view"}, p)
+	assert.True(t, p.GotInternalErr)
+}
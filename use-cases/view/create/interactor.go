@@ -0,0 +1,104 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+)
+
+const MaxNameLength = 60
+
+type Interactor struct {
+	Repo
+	Validator
+	Audit al.AuditLogger
+}
+
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "CreateView", au.Target{Type: "view", Id: r.Name})
+	defer record.End()
+
+	errCtx := "creating view"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+	if err := i.Validator.Validate(*user, r); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	if r.Groups == nil {
+		r.Groups = []string{}
+	}
+
+	view := vw.NewView(vw.NewViewId(), r.Name, user.Id,
+		vw.WithFilter(r.Filter), vw.WithOrder(r.Order), vw.WithGroups(r.Groups))
+	if err := i.Repo.Create(view); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%v: %w", errCtx, err)))
+		return
+	}
+	out.SuccessCreateView(Response{View: view})
+}
+
+type Validator struct {
+	Groups GroupRepo
+	FilterValidator
+	OrderingValidator
+}
+
+// Validate checks the name and queries of a view, and that user may share
+// it with the requested groups, i.e. that these exist and that user
+// belongs to them, unless an administrator.
+func (v Validator) Validate(user u.User, r Request) error {
+	if r.Name == "" || len(r.Name) > MaxNameLength {
+		return fmt.Errorf("checking that name %q has between 1 and %v characters: %w",
+			r.Name, MaxNameLength, e.ErrValidation)
+	}
+	if r.Filter != "" {
+		if err := v.FilterValidator.Validate(r.Filter); err != nil {
+			return fmt.Errorf("validating query %v: %v: %w", r.Filter, err, e.ErrValidation)
+		}
+	}
+	if r.Order != "" {
+		if err := v.OrderingValidator.Validate(r.Order); err != nil {
+			return fmt.Errorf("validating ordering %v: %v: %w", r.Order, err, e.ErrValidation)
+		}
+	}
+	for _, group := range r.Groups {
+		exists, err := v.Groups.Exists(group)
+		if err != nil {
+			return fmt.Errorf("checking that group %v exists: %w", group, err)
+		}
+		if !*exists {
+			return fmt.Errorf("checking that group %v exists: %w", group, e.ErrNotFound)
+		}
+		if !user.IsAdmin() && !slices.Contains(user.Groups, group) {
+			return fmt.Errorf("sharing view with group %v, which user %v does not belong to: %w",
+				group, user.Id, e.ErrAuthorization)
+		}
+	}
+	return nil
+}
+
+type Option func(*Interactor)
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(r Repo, g GroupRepo, fv FilterValidator, ov OrderingValidator, opts ...Option) Interactor {
+	i := &Interactor{Repo: r, Validator: Validator{g, fv, ov}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
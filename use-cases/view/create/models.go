@@ -0,0 +1,17 @@
+package create
+
+import (
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+)
+
+type Request struct {
+	Name   string
+	Filter im.FilterStr
+	Order  im.OrderStr
+	Groups []string
+}
+
+type Response struct {
+	View vw.View
+}
@@ -0,0 +1,6 @@
+package create
+
+type OutputPort interface {
+	SuccessCreateView(Response)
+	Error(error)
+}
@@ -0,0 +1,22 @@
+package create
+
+import (
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+)
+
+type Repo interface {
+	Create(vw.View) error
+}
+
+type GroupRepo interface {
+	Exists(string) (*bool, error)
+}
+
+type FilterValidator interface {
+	Validate(im.FilterStr) error
+}
+
+type OrderingValidator interface {
+	Validate(im.OrderStr) error
+}
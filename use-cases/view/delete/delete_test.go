@@ -0,0 +1,52 @@
+package delete
+
+import (
+	"testing"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteView(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com")
+	repo := &fk.ViewRepo{Views: []vw.View{view}}
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), view.Id.String(), p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, view.Id, *repo.Deleted)
+}
+
+func TestAdminDeletesViewOfAnotherUser(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "someone@mail.com")
+	p := &FakePresenter{}
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("admin@mail.com", u.WithRoles([]string{"admin"})))
+	New(&fk.ViewRepo{Views: []vw.View{view}}).Execute(ctx, view.Id.String(), p)
+	assert.True(t, p.GotSuccess)
+}
+
+func TestDeleteViewOfAnotherUserShouldFail(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "someone@mail.com")
+	repo := &fk.ViewRepo{Views: []vw.View{view}}
+	p := &FakePresenter{}
+	New(repo).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), view.Id.String(), p)
+	assert.True(t, p.GotAuthErr)
+	assert.Nil(t, repo.Deleted)
+}
+
+func TestDeleteViewWithInvalidIdShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.ViewRepo{}).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), "not-an-id", p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestDeleteViewHandlesInternalError(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com")
+	p := &FakePresenter{}
+	New(&fk.ViewRepo{Views: []vw.View{view}, ErrOnDelete: e.ErrInternal}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), view.Id.String(), p)
+	assert.True(t, p.GotInternalErr)
+}
@@ -0,0 +1,56 @@
+package delete
+
+import (
+	"context"
+	"fmt"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/update"
+)
+
+type Interactor struct {
+	Repo
+	Audit al.AuditLogger
+}
+
+// Execute deletes a view. Only its owner and administrators may do so.
+func (i Interactor) Execute(ctx context.Context, id string, out OutputPort) {
+	record := i.Audit.Begin(ctx, "DeleteView", au.Target{Type: "view", Id: id})
+	defer record.End()
+
+	errCtx := fmt.Errorf("deleting view with id %v", id)
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%w: fetching user identity: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+	view, err := update.FindEditable(i.Repo, *user, id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if err := i.Repo.Delete(view.Id); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessDeleteView(view.Name)
+}
+
+type Option func(*Interactor)
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(r Repo, opts ...Option) Interactor {
+	i := &Interactor{Repo: r}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
@@ -0,0 +1,10 @@
+package delete
+
+import (
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+)
+
+type Repo interface {
+	FindView(vw.ViewId) (*vw.View, error)
+	Delete(vw.ViewId) error
+}
@@ -0,0 +1,14 @@
+package delete
+
+import (
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessDeleteView(string) {
+	p.GotSuccess = true
+}
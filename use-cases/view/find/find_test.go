@@ -0,0 +1,34 @@
+package find
+
+import (
+	"testing"
+
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindView(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com")
+	p := &FakePresenter{}
+	New(&fk.ViewRepo{Views: []vw.View{view}}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), view.Id.String(), p)
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, view, p.Got)
+}
+
+func TestFindViewOfAnotherUserShouldFail(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "someone@mail.com")
+	p := &FakePresenter{}
+	New(&fk.ViewRepo{Views: []vw.View{view}}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), view.Id.String(), p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestFindMissingViewShouldFail(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.ViewRepo{}).Execute(
+		st.CreateCtxWithUserId(t.Context(), "me@mail.com"), vw.NewViewId().String(), p)
+	assert.True(t, p.GotNotFoundErr)
+}
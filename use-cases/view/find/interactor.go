@@ -0,0 +1,25 @@
+package find
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lejeunel/go-image-annotator/modules/visibility"
+)
+
+type Interactor struct {
+	Repo visibility.ViewFinder
+}
+
+func (i Interactor) Execute(ctx context.Context, id string, out OutputPort) {
+	view, err := visibility.View(ctx, i.Repo, id)
+	if err != nil {
+		out.Error(fmt.Errorf("fetching view with id %v: %w", id, err))
+		return
+	}
+	out.SuccessFindView(*view)
+}
+
+func New(r visibility.ViewFinder) Interactor {
+	return Interactor{r}
+}
@@ -0,0 +1,10 @@
+package find
+
+import (
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+)
+
+type OutputPort interface {
+	SuccessFindView(vw.View)
+	Error(error)
+}
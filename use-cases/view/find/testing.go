@@ -0,0 +1,17 @@
+package find
+
+import (
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	t "github.com/lejeunel/go-image-annotator/shared/testing"
+)
+
+type FakePresenter struct {
+	Got        vw.View
+	GotSuccess bool
+	t.TestingErrPresenter
+}
+
+func (p *FakePresenter) SuccessFindView(v vw.View) {
+	p.GotSuccess = true
+	p.Got = v
+}
@@ -0,0 +1,17 @@
+package view
+
+import (
+	"github.com/lejeunel/go-image-annotator/use-cases/view/create"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/delete"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/find"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/list"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/update"
+)
+
+type Interactors struct {
+	Find   find.Interactor
+	Create create.Interactor
+	Delete delete.Interactor
+	List   list.Interactor
+	Update update.Interactor
+}
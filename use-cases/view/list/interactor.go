@@ -0,0 +1,46 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Interactor struct {
+	Repo
+	DefaultPageSize int
+	MaxPageSize     int
+}
+
+// Execute lists the views the caller owns or that are shared with one of
+// their groups.
+func (i Interactor) Execute(ctx context.Context, r pag.PaginationParams, out OutputPort) {
+	errCtx := "listing views"
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(fmt.Errorf("%v: fetching user identity: %w", errCtx, e.ErrAuthentication))
+		return
+	}
+
+	r.Sanitize(i.DefaultPageSize, i.MaxPageSize)
+
+	views, err := i.Repo.ListVisible(*user, r)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+	count, err := i.Repo.CountVisible(*user)
+	if err != nil {
+		out.Error(fmt.Errorf("%v: %w", errCtx, err))
+		return
+	}
+
+	out.SuccessListViews(Response{Views: views, Pagination: pag.New(r.Page, r.PageSize, *count)})
+}
+
+func New(r Repo, dps int, mps int) Interactor {
+	return Interactor{r, dps, mps}
+}
@@ -0,0 +1,43 @@
+package list
+
+import (
+	"testing"
+
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListViews(t *testing.T) {
+	repo := &fk.ViewRepo{Views: []vw.View{
+		vw.NewView(vw.NewViewId(), "a-view", "me@mail.com"),
+		vw.NewView(vw.NewViewId(), "another-view", "me@mail.com"),
+	}}
+	p := &FakePresenter{}
+	New(repo, 10, 100).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		pag.PaginationParams{Page: 1}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, "me@mail.com", repo.GotUser.Id)
+	assert.Len(t, p.Got.Views, 2)
+	assert.Equal(t, int64(2), p.Got.Pagination.TotalRecords)
+	assert.Equal(t, 10, p.Got.Pagination.PageSize)
+}
+
+func TestListViewsRequiresIdentity(t *testing.T) {
+	p := &FakePresenter{}
+	New(&fk.ViewRepo{}, 10, 100).Execute(t.Context(), pag.PaginationParams{}, p)
+	assert.ErrorIs(t, p.GotErr, e.ErrAuthentication)
+}
+
+func TestListViewsHandlesInternalErrors(t *testing.T) {
+	for _, repo := range []*fk.ViewRepo{{ErrOnList: e.ErrInternal}, {ErrOnCount: e.ErrInternal}} {
+		p := &FakePresenter{}
+		New(repo, 10, 100).Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+			pag.PaginationParams{}, p)
+		assert.True(t, p.GotInternalErr)
+	}
+}
@@ -0,0 +1,11 @@
+package list
+
+import (
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Response struct {
+	Views      []vw.View
+	Pagination pag.Pagination
+}
@@ -0,0 +1,6 @@
+package list
+
+type OutputPort interface {
+	SuccessListViews(Response)
+	Error(error)
+}
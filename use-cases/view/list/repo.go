@@ -0,0 +1,12 @@
+package list
+
+import (
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	pag "github.com/lejeunel/go-image-annotator/shared/pagination"
+)
+
+type Repo interface {
+	ListVisible(u.User, pag.PaginationParams) ([]vw.View, error)
+	CountVisible(u.User) (*int64, error)
+}
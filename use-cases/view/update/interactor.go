@@ -0,0 +1,93 @@
+package update
+
+import (
+	"context"
+	"fmt"
+
+	au "github.com/lejeunel/go-image-annotator/entities/audit"
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	al "github.com/lejeunel/go-image-annotator/modules/audit-logger"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	"github.com/lejeunel/go-image-annotator/use-cases/view/create"
+)
+
+type Interactor struct {
+	Repo
+	create.Validator
+	Audit al.AuditLogger
+}
+
+// Execute replaces the name, queries and groups of a view. Only its owner
+// and administrators may do so.
+func (i Interactor) Execute(ctx context.Context, r Request, out OutputPort) {
+	record := i.Audit.Begin(ctx, "UpdateView", au.Target{Type: "view", Id: r.Id})
+	defer record.End()
+
+	errCtx := fmt.Errorf("updating view with id %v", r.Id)
+	user := u.IdentityFromContext(ctx)
+	if user == nil {
+		out.Error(record.Fail(fmt.Errorf("%w: fetching user identity: %w", errCtx, e.ErrAuthentication)))
+		return
+	}
+	view, err := FindEditable(i.Repo, *user, r.Id)
+	if err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	if err := i.Validator.Validate(*user, create.Request{
+		Name: r.Name, Filter: r.Filter, Order: r.Order, Groups: r.Groups,
+	}); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+
+	if r.Groups == nil {
+		r.Groups = []string{}
+	}
+	m := vw.UpdateModel{Id: view.Id, Name: r.Name, Filter: r.Filter, Order: r.Order, Groups: r.Groups}
+	if err := i.Repo.Update(m); err != nil {
+		out.Error(record.Fail(fmt.Errorf("%w: %w", errCtx, err)))
+		return
+	}
+	out.SuccessUpdateView(Response{View: vw.NewView(view.Id, m.Name, view.Owner,
+		vw.WithFilter(m.Filter), vw.WithOrder(m.Order), vw.WithGroups(m.Groups))})
+}
+
+type Finder interface {
+	FindView(vw.ViewId) (*vw.View, error)
+}
+
+// FindEditable fetches the view of a given id, provided user may modify it.
+func FindEditable(f Finder, user u.User, id string) (*vw.View, error) {
+	viewId, err := vw.NewViewIdFromString(id)
+	if err != nil {
+		return nil, err
+	}
+	view, err := f.FindView(*viewId)
+	if err != nil {
+		return nil, err
+	}
+	if !view.IsEditableBy(user) {
+		return nil, fmt.Errorf("view %v is owned by another user: %w", id, e.ErrAuthorization)
+	}
+	return view, nil
+}
+
+type Option func(*Interactor)
+
+func WithAudit(l al.AuditLogger) Option {
+	return func(i *Interactor) {
+		i.Audit = l
+	}
+}
+
+func New(r Repo, g create.GroupRepo, fv create.FilterValidator, ov create.OrderingValidator,
+	opts ...Option,
+) Interactor {
+	i := &Interactor{Repo: r, Validator: create.Validator{Groups: g, FilterValidator: fv, OrderingValidator: ov}}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return *i
+}
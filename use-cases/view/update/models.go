@@ -0,0 +1,18 @@
+package update
+
+import (
+	im "github.com/lejeunel/go-image-annotator/entities/image"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+)
+
+type Request struct {
+	Id     string
+	Name   string
+	Filter im.FilterStr
+	Order  im.OrderStr
+	Groups []string
+}
+
+type Response struct {
+	View vw.View
+}
@@ -0,0 +1,6 @@
+package update
+
+type OutputPort interface {
+	SuccessUpdateView(Response)
+	Error(error)
+}
@@ -0,0 +1,67 @@
+package update
+
+import (
+	"testing"
+
+	u "github.com/lejeunel/go-image-annotator/entities/user"
+	vw "github.com/lejeunel/go-image-annotator/entities/view"
+	fk "github.com/lejeunel/go-image-annotator/fakes"
+	e "github.com/lejeunel/go-image-annotator/shared/errors"
+	st "github.com/lejeunel/go-image-annotator/shared/testing"
+	"github.com/stretchr/testify/assert"
+)
+
+func Setup(view vw.View) (Interactor, *fk.ViewRepo) {
+	repo := &fk.ViewRepo{Views: []vw.View{view}}
+	groups := &fk.GroupRepo{ExistingNames: []string{"my-group"}}
+	return New(repo, groups, &fk.FilterValidator{}, &fk.FilterValidator{}), repo
+}
+
+func TestUpdateView(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com")
+	itr, repo := Setup(view)
+	p := &FakePresenter{}
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("me@mail.com", u.WithGroups([]string{"my-group"})))
+	itr.Execute(ctx, Request{Id: view.Id.String(), Name: "renamed", Filter: "num_boxes>0",
+		Groups: []string{"my-group"}}, p)
+
+	assert.True(t, p.GotSuccess)
+	assert.Equal(t, vw.UpdateModel{Id: view.Id, Name: "renamed", Filter: "num_boxes>0",
+		Groups: []string{"my-group"}}, repo.GotUpdate)
+	assert.Equal(t, "me@mail.com", p.Got.View.Owner)
+}
+
+func TestUpdateViewOfAnotherUserShouldFail(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "someone@mail.com", vw.WithGroups([]string{"my-group"}))
+	itr, _ := Setup(view)
+	p := &FakePresenter{}
+	ctx := u.AppendUserToContext(t.Context(), u.NewUser("me@mail.com", u.WithGroups([]string{"my-group"})))
+	itr.Execute(ctx, Request{Id: view.Id.String(), Name: "renamed"}, p)
+	assert.True(t, p.GotAuthErr)
+}
+
+func TestUpdateViewWithInvalidNameShouldFail(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com")
+	itr, _ := Setup(view)
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"), Request{Id: view.Id.String()}, p)
+	assert.True(t, p.GotValidationErr)
+}
+
+func TestUpdateMissingViewShouldFail(t *testing.T) {
+	itr, _ := Setup(vw.NewView(vw.NewViewId(), "a-view", "me@mail.com"))
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{Id: vw.NewViewId().String(), Name: "renamed"}, p)
+	assert.True(t, p.GotNotFoundErr)
+}
+
+func TestUpdateViewHandlesInternalError(t *testing.T) {
+	view := vw.NewView(vw.NewViewId(), "a-view", "me@mail.com")
+	itr, repo := Setup(view)
+	repo.ErrOnUpdate = e.ErrInternal
+	p := &FakePresenter{}
+	itr.Execute(st.CreateCtxWithUserId(t.Context(), "me@mail.com"),
+		Request{Id: view.Id.String(), Name: "renamed"}, p)
+	assert.True(t, p.GotInternalErr)
+}